@@ -169,9 +169,18 @@ type FuturesDetails struct {
 
 // APIData defines all fields to configure API based data
 type APIData struct {
-	StartDate        time.Time `json:"start-date"`
-	EndDate          time.Time `json:"end-date"`
-	InclusiveEndDate bool      `json:"inclusive-end-date"`
+	StartDate        time.Time    `json:"start-date"`
+	EndDate          time.Time    `json:"end-date"`
+	InclusiveEndDate bool         `json:"inclusive-end-date"`
+	CandleCache      *CandleCache `json:"candle-cache,omitempty"`
+}
+
+// CandleCache defines a database which serves stored candles for API based
+// data, so only missing candles are requested from the exchange
+type CandleCache struct {
+	Config  database.Config `json:"config"`
+	Path    string          `json:"path"`
+	Persist bool            `json:"persist"`
 }
 
 // CSVData defines all fields to configure CSV based data
@@ -0,0 +1,119 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/config/schema"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+// schemaOverrides are the types whose JSON decoding accepts more than their
+// encoded form
+var schemaOverrides = schema.Overrides{
+	reflect.TypeFor[kline.Interval]():  {AnyOf: []*schema.Schema{{Type: schema.TypeString}, {Type: schema.TypeInteger}}},
+	reflect.TypeFor[decimal.Decimal](): {AnyOf: []*schema.Schema{{Type: schema.TypeString}, {Type: schema.TypeNumber}}},
+}
+
+// StrategySchema returns the JSON Schema of a strategy config
+func StrategySchema() (*schema.Schema, error) {
+	s, err := schema.Generate(&Config{}, schemaOverrides)
+	if err != nil {
+		return nil, err
+	}
+	s.Title = "GoCryptoTrader backtester strategy config"
+	return s, nil
+}
+
+// LintStrategyConfig validates a strategy config offline, returning the
+// issues found by JSON path. Unlike Validate, every failing rule is reported
+func LintStrategyConfig(data []byte) ([]schema.Issue, error) {
+	s, err := StrategySchema()
+	if err != nil {
+		return nil, err
+	}
+	issues, err := s.Validate(data)
+	if err != nil {
+		return nil, err
+	}
+	if schema.HasErrors(issues) {
+		// Values of the wrong type cannot be decoded for further checks
+		return issues, nil
+	}
+
+	var c Config
+	if err := json.Unmarshal(data, &c); err != nil {
+		return append(issues, schema.Issue{Severity: schema.SeverityError, Path: "$", Message: err.Error()}), nil
+	}
+	return append(issues, c.lint()...), nil
+}
+
+// lint checks the fields of a strategy config which can be attributed to a
+// JSON path
+func (c *Config) lint() []schema.Issue {
+	var issues []schema.Issue
+	if !slices.ContainsFunc(strategies.GetSupportedStrategies(), func(s strategies.Handler) bool {
+		return strings.EqualFold(s.Name(), c.StrategySettings.Name)
+	}) {
+		issues = append(issues, schema.Issue{Severity: schema.SeverityWarning, Path: "$.strategy-settings.name", Message: fmt.Sprintf("unknown strategy %q, which must be loaded from a plugin", c.StrategySettings.Name)})
+	}
+	if err := c.validateStrategySettings(); err != nil && !errors.Is(err, base.ErrStrategyNotFound) {
+		issues = append(issues, schema.Issue{Severity: schema.SeverityError, Path: "$.funding-settings", Message: err.Error()})
+	}
+	if err := c.validateDate(); err != nil {
+		issues = append(issues, schema.Issue{Severity: schema.SeverityError, Path: "$.data-settings", Message: err.Error()})
+	}
+	if err := c.validateCurrencySettings(); err != nil {
+		issues = append(issues, schema.Issue{Severity: schema.SeverityError, Path: "$.currency-settings", Message: err.Error()})
+	}
+
+	switch {
+	case c.DataSettings.Interval == 0:
+		issues = append(issues, schema.Issue{Severity: schema.SeverityError, Path: "$.data-settings.interval", Message: "interval is not set"})
+	case !slices.Contains(kline.SupportedIntervals, c.DataSettings.Interval):
+		issues = append(issues, schema.Issue{Severity: schema.SeverityError, Path: "$.data-settings.interval", Message: fmt.Sprintf("interval %s is not supported", c.DataSettings.Interval)})
+	}
+	var sources int
+	for _, set := range []bool{c.DataSettings.APIData != nil, c.DataSettings.DatabaseData != nil, c.DataSettings.LiveData != nil, c.DataSettings.CSVData != nil} {
+		if set {
+			sources++
+		}
+	}
+	if sources != 1 {
+		issues = append(issues, schema.Issue{Severity: schema.SeverityError, Path: "$.data-settings", Message: fmt.Sprintf("exactly one of api-data, database-data, live-data or csv-data must be set, found %d", sources)})
+	}
+
+	for i := range c.CurrencySettings {
+		path := "$.currency-settings[" + strconv.Itoa(i) + "]"
+		if name := c.CurrencySettings[i].ExchangeName; name != "" && !exchange.IsSupported(name) {
+			issues = append(issues, schema.Issue{Severity: schema.SeverityError, Path: path + ".exchange-name", Message: fmt.Sprintf("unknown exchange %q", name)})
+		}
+		issues = append(issues, c.CurrencySettings[i].BuySide.lint(path+".buy-side")...)
+		issues = append(issues, c.CurrencySettings[i].SellSide.lint(path+".sell-side")...)
+	}
+	issues = append(issues, c.PortfolioSettings.BuySide.lint("$.portfolio-settings.buy-side")...)
+	issues = append(issues, c.PortfolioSettings.SellSide.lint("$.portfolio-settings.sell-side")...)
+	for i := range c.FundingSettings.ExchangeLevelFunding {
+		if name := c.FundingSettings.ExchangeLevelFunding[i].ExchangeName; name != "" && !exchange.IsSupported(name) {
+			issues = append(issues, schema.Issue{Severity: schema.SeverityError, Path: "$.funding-settings.exchange-level-funding[" + strconv.Itoa(i) + "].exchange-name", Message: fmt.Sprintf("unknown exchange %q", name)})
+		}
+	}
+	return issues
+}
+
+// lint returns an issue at the path when the min max rules are invalid
+func (m *MinMax) lint(path string) []schema.Issue {
+	if err := m.validate(); err != nil {
+		return []schema.Issue{{Severity: schema.SeverityError, Path: path, Message: err.Error()}}
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/buger/jsonparser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config/schema"
+)
+
+func TestStrategySchema(t *testing.T) {
+	t.Parallel()
+	s, err := StrategySchema()
+	require.NoError(t, err)
+	assert.Contains(t, s.Properties, "strategy-settings")
+	assert.Len(t, s.Properties["data-settings"].Properties["interval"].AnyOf, 2, "Intervals should accept strings and integers")
+}
+
+func TestLintStrategyConfig(t *testing.T) {
+	t.Parallel()
+	examples, err := filepath.Glob(filepath.Join("strategyexamples", "*.strat"))
+	require.NoError(t, err)
+	require.NotEmpty(t, examples)
+	for _, example := range examples {
+		data, err := os.ReadFile(example)
+		require.NoError(t, err)
+		issues, err := LintStrategyConfig(data)
+		require.NoError(t, err)
+		assert.Falsef(t, schema.HasErrors(issues), "%s should lint without errors, got %v", example, issues)
+	}
+
+	_, err = LintStrategyConfig([]byte(`{`))
+	assert.Error(t, err, "LintStrategyConfig should error on invalid JSON")
+
+	data, err := os.ReadFile(filepath.Join("strategyexamples", "dca-api-candles.strat"))
+	require.NoError(t, err)
+	set := func(t *testing.T, value string, keys ...string) []byte {
+		t.Helper()
+		b, err := jsonparser.Set(data, []byte(value), keys...)
+		require.NoError(t, err)
+		return b
+	}
+	for _, tc := range []struct {
+		name     string
+		data     []byte
+		severity schema.Severity
+		path     string
+	}{
+		{"wrong type", set(t, `"yes"`, "strategy-settings", "use-simultaneous-signal-processing"), schema.SeverityError, "$.strategy-settings.use-simultaneous-signal-processing"},
+		{"unknown strategy", set(t, `"custom"`, "strategy-settings", "name"), schema.SeverityWarning, "$.strategy-settings.name"},
+		{"unsupported interval", set(t, `12345`, "data-settings", "interval"), schema.SeverityError, "$.data-settings.interval"},
+		{"no data source", set(t, `null`, "data-settings", "api-data"), schema.SeverityError, "$.data-settings"},
+		{"unknown exchange", set(t, `"NotAnExchange"`, "currency-settings", "[0]", "exchange-name"), schema.SeverityError, "$.currency-settings[0].exchange-name"},
+		{"invalid min max", set(t, `"-1"`, "currency-settings", "[0]", "buy-side", "minimum-size"), schema.SeverityError, "$.currency-settings[0].buy-side"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			issues, err := LintStrategyConfig(tc.data)
+			require.NoError(t, err)
+			assert.Truef(t, slices.ContainsFunc(issues, func(i schema.Issue) bool {
+				return i.Path == tc.path && i.Severity == tc.severity
+			}), "LintStrategyConfig should report a %s at %s, got %v", tc.severity, tc.path, issues)
+		})
+	}
+}
@@ -15,17 +15,30 @@ import (
 )
 
 // LoadData retrieves data from a GoCryptoTrader exchange wrapper which calls the exchange's API
-func LoadData(ctx context.Context, dataType int64, startDate, endDate time.Time, interval time.Duration, exch exchange.IBotExchange, fPair currency.Pair, a asset.Item) (*kline.Item, error) {
+// When useCandleCache is set, candles stored in the database are used and only missing candles
+// are requested from the exchange. Fetched candles are stored when persistCandles is set
+func LoadData(ctx context.Context, dataType int64, startDate, endDate time.Time, interval time.Duration, exch exchange.IBotExchange, fPair currency.Pair, a asset.Item, useCandleCache, persistCandles bool) (*kline.Item, error) {
 	var candles *kline.Item
 	var err error
 	switch dataType {
 	case common.DataCandle:
-		candles, err = exch.GetHistoricCandlesExtended(ctx,
-			fPair,
-			a,
-			kline.Interval(interval),
-			startDate,
-			endDate)
+		if useCandleCache {
+			candles, err = kline.GetHistoricCandlesWithCache(ctx,
+				exch,
+				fPair,
+				a,
+				kline.Interval(interval),
+				startDate,
+				endDate,
+				persistCandles)
+		} else {
+			candles, err = exch.GetHistoricCandlesExtended(ctx,
+				fPair,
+				a,
+				kline.Interval(interval),
+				startDate,
+				endDate)
+		}
 		if err != nil {
 			return nil, fmt.Errorf("could not retrieve candle data for %v %v %v: %w", exch.GetName(), a, fPair, err)
 		}
@@ -37,10 +37,10 @@ func TestLoadCandles(t *testing.T) {
 	tt2 := time.Now().Round(gctkline.OneMin.Duration())
 	interval := gctkline.OneMin
 	a := asset.Spot
-	data, err := LoadData(t.Context(), common.DataCandle, tt1, tt2, interval.Duration(), exch, cp, a)
+	data, err := LoadData(t.Context(), common.DataCandle, tt1, tt2, interval.Duration(), exch, cp, a, false, false)
 	require.NoError(t, err, "LoadData must not error")
 	assert.NotEmpty(t, data.Candles, "Candles should not be empty")
-	_, err = LoadData(t.Context(), -1, tt1, tt2, interval.Duration(), exch, cp, a)
+	_, err = LoadData(t.Context(), -1, tt1, tt2, interval.Duration(), exch, cp, a, false, false)
 	assert.ErrorIs(t, err, common.ErrInvalidDataType)
 }
 
@@ -64,7 +64,7 @@ func TestLoadTrades(t *testing.T) {
 	tt1 := time.Now().Add(-time.Minute * 10).Round(interval.Duration())
 	tt2 := time.Now().Round(interval.Duration())
 	a := asset.Spot
-	data, err := LoadData(t.Context(), common.DataTrade, tt1, tt2, interval.Duration(), exch, cp, a)
+	data, err := LoadData(t.Context(), common.DataTrade, tt1, tt2, interval.Duration(), exch, cp, a, false, false)
 	if errors.Is(err, trade.ErrNoTradesSupplied) {
 		t.Skip("exchange returned no trades for selected window")
 	}
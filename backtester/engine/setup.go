@@ -81,6 +81,11 @@ func (bt *BackTest) SetupFromConfig(cfg *config.Config, templatePath, output str
 		if err != nil {
 			return err
 		}
+	} else if cfg.DataSettings.APIData != nil && cfg.DataSettings.APIData.CandleCache != nil {
+		bt.databaseManager, err = engine.SetupDatabaseConnectionManager(&cfg.DataSettings.APIData.CandleCache.Config)
+		if err != nil {
+			return err
+		}
 	}
 
 	bt.verbose = verbose
@@ -772,24 +777,12 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 		if cfg.DataSettings.DatabaseData.InclusiveEndDate {
 			cfg.DataSettings.DatabaseData.EndDate = cfg.DataSettings.DatabaseData.EndDate.Add(cfg.DataSettings.Interval.Duration())
 		}
-		if cfg.DataSettings.DatabaseData.Path == "" {
-			cfg.DataSettings.DatabaseData.Path = filepath.Join(gctcommon.GetDefaultDataDir(runtime.GOOS), "database")
-		}
-		gctdatabase.DB.DataPath = cfg.DataSettings.DatabaseData.Path
-		err = gctdatabase.DB.SetConfig(&cfg.DataSettings.DatabaseData.Config)
-		if err != nil {
-			return nil, err
-		}
-		err = bt.databaseManager.Start(&sync.WaitGroup{})
+		var stopDatabase func()
+		stopDatabase, err = bt.startDatabase(&cfg.DataSettings.DatabaseData.Config, &cfg.DataSettings.DatabaseData.Path)
 		if err != nil {
 			return nil, err
 		}
-		defer func() {
-			stopErr := bt.databaseManager.Stop()
-			if stopErr != nil {
-				log.Errorln(common.Setup, stopErr)
-			}
-		}()
+		defer stopDatabase()
 		resp, err = loadDatabaseData(cfg, exch.GetName(), fPair, a, dataType, isUSDTrackingPair)
 		if err != nil {
 			return nil, fmt.Errorf("unable to retrieve data from GoCryptoTrader database. Error: %v. Please ensure the database is setup correctly and has data before use", err)
@@ -825,6 +818,15 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 			return nil, err
 		}
 
+		if cfg.DataSettings.APIData.CandleCache != nil {
+			var stopDatabase func()
+			stopDatabase, err = bt.startDatabase(&cfg.DataSettings.APIData.CandleCache.Config, &cfg.DataSettings.APIData.CandleCache.Path)
+			if err != nil {
+				return nil, err
+			}
+			defer stopDatabase()
+		}
+
 		resp, err = loadAPIData(cfg, exch, fPair, a, limit, dataType)
 		if err != nil {
 			return resp, err
@@ -868,6 +870,29 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 	return resp, nil
 }
 
+// startDatabase connects to the configured database, setting the default data
+// path if unset, and returns a function to disconnect
+func (bt *BackTest) startDatabase(dbCfg *gctdatabase.Config, path *string) (func(), error) {
+	if *path == "" {
+		*path = filepath.Join(gctcommon.GetDefaultDataDir(runtime.GOOS), "database")
+	}
+	gctdatabase.DB.DataPath = *path
+	err := gctdatabase.DB.SetConfig(dbCfg)
+	if err != nil {
+		return nil, err
+	}
+	err = bt.databaseManager.Start(&sync.WaitGroup{})
+	if err != nil {
+		return nil, err
+	}
+	return func() {
+		stopErr := bt.databaseManager.Stop()
+		if stopErr != nil {
+			log.Errorln(common.Setup, stopErr)
+		}
+	}, nil
+}
+
 func loadDatabaseData(cfg *config.Config, name string, fPair currency.Pair, a asset.Item, dataType int64, isUSDTrackingPair bool) (*kline.DataFromKline, error) {
 	if cfg == nil || cfg.DataSettings.DatabaseData == nil {
 		return nil, errors.New("nil config data received")
@@ -903,6 +928,11 @@ func loadAPIData(cfg *config.Config, exch gctexchange.IBotExchange, fPair curren
 		return nil, err
 	}
 
+	var useCandleCache, persistCandles bool
+	if cfg.DataSettings.APIData.CandleCache != nil {
+		useCandleCache = true
+		persistCandles = cfg.DataSettings.APIData.CandleCache.Persist
+	}
 	candles, err := api.LoadData(context.TODO(),
 		dataType,
 		dates.Start.Time,
@@ -910,7 +940,9 @@ func loadAPIData(cfg *config.Config, exch gctexchange.IBotExchange, fPair curren
 		cfg.DataSettings.Interval.Duration(),
 		exch,
 		fPair,
-		a)
+		a,
+		useCandleCache,
+		persistCandles)
 	if err != nil {
 		return nil, fmt.Errorf("%v. Please check your GoCryptoTrader configuration", err)
 	}
@@ -10,20 +10,24 @@ import (
 	"strings"
 
 	"github.com/buger/jsonparser"
+	backtester "github.com/thrasher-corp/gocryptotrader/backtester/config"
 	"github.com/thrasher-corp/gocryptotrader/common/file"
 	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/config/schema"
 	"github.com/thrasher-corp/gocryptotrader/config/versions"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 )
 
-var commands = []string{"upgrade", "downgrade", "encrypt", "decrypt"}
+var commands = []string{"upgrade", "downgrade", "encrypt", "decrypt", "lint", "schema"}
 
 func main() {
-	fmt.Println("GoCryptoTrader: config-helper tool")
+	fmt.Fprintln(os.Stderr, "GoCryptoTrader: config-helper tool")
 
 	defaultCfgFile := config.DefaultFilePath()
 
 	var in, out, keyStr string
-	var inplace bool
+	var inplace, strategy bool
 	var version uint
 
 	fs := flag.NewFlagSet("config", flag.ExitOnError)
@@ -33,6 +37,7 @@ func main() {
 	fs.BoolVar(&inplace, "edit", false, "Edit; Save result to the original file")
 	fs.StringVar(&keyStr, "key", "", "The key to use for AES encryption")
 	fs.UintVar(&version, "version", 0, "The version to downgrade to")
+	fs.BoolVar(&strategy, "strategy", false, "Lint or print the schema of a backtester strategy config instead")
 
 	cmd, args := parseCommand(os.Args[1:])
 	if cmd == "" {
@@ -50,6 +55,11 @@ func main() {
 		out = in + ".out"
 	}
 
+	if cmd == "schema" {
+		printSchema(fs, out, strategy)
+		return
+	}
+
 	var err error
 	key := []byte(keyStr)
 	data := readFile(in)
@@ -75,6 +85,9 @@ func main() {
 	}
 
 	switch cmd {
+	case "lint":
+		lint(data, strategy)
+		return
 	case "decrypt":
 		if data, err = jsonparser.Set(data, []byte("-1"), "encryptConfig"); err != nil {
 			fatal("Unable to decrypt config data; Error: " + err.Error())
@@ -112,6 +125,57 @@ func main() {
 	fmt.Println("Success! File written to " + out)
 }
 
+// lint prints the issues found in a decrypted config and exits 1 if any are
+// errors
+func lint(data []byte, strategy bool) {
+	var issues []schema.Issue
+	var err error
+	if strategy {
+		issues, err = backtester.LintStrategyConfig(data)
+	} else {
+		issues, err = config.Lint(context.Background(), data, exchange.Exchanges)
+	}
+	if err != nil {
+		fatal("Unable to lint config; Error: " + err.Error())
+	}
+	for _, i := range issues {
+		fmt.Println(i)
+	}
+	if schema.HasErrors(issues) {
+		os.Exit(1)
+	}
+	fmt.Printf("Config is valid with %d warnings\n", len(issues))
+}
+
+// printSchema writes the JSON Schema of the config to the output file, or to
+// stdout when no output file is set
+func printSchema(fs *flag.FlagSet, out string, strategy bool) {
+	var s *schema.Schema
+	var err error
+	if strategy {
+		s, err = backtester.StrategySchema()
+	} else {
+		s, err = config.Schema()
+	}
+	if err != nil {
+		fatal("Unable to generate schema; Error: " + err.Error())
+	}
+	data, err := json.MarshalIndent(s, "", " ")
+	if err != nil {
+		fatal("Unable to encode schema; Error: " + err.Error())
+	}
+	outSet := false
+	fs.Visit(func(f *flag.Flag) { outSet = outSet || f.Name == "out" || f.Name == "edit" })
+	if !outSet {
+		fmt.Println(string(data))
+		return
+	}
+	if err := file.Write(out, data); err != nil {
+		fatal("Unable to write output file `" + out + "`; Error: " + err.Error())
+	}
+	fmt.Println("Success! File written to " + out)
+}
+
 func readFile(in string) []byte {
 	fileData, err := os.ReadFile(in)
 	if err != nil {
@@ -159,6 +223,8 @@ The commands are:
 	decrypt 	decrypt infile and write to outfile
 	upgrade 	upgrade the version of a decrypted config file
 	downgrade 	downgrade the version of a decrypted config file to a specific version
+	lint 		validate infile offline and print any issues by JSON path, exiting 1 on errors
+	schema 		print the JSON Schema of the config, or write it to outfile when -out is set
 
 The arguments are:`)
 	fs.PrintDefaults()
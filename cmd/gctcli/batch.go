@@ -0,0 +1,197 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+	"google.golang.org/grpc"
+)
+
+// Batch error handling modes
+const (
+	batchOnErrorStop     = "stop"
+	batchOnErrorContinue = "continue"
+)
+
+// Batch exit codes. A command failure exits with batchExitFailed, and input
+// which cannot be run at all with batchExitInvalid
+const (
+	batchExitFailed  = 1
+	batchExitInvalid = 2
+)
+
+// batchConn is the connection shared by the commands of a batch
+var batchConn *grpc.ClientConn
+
+var (
+	errBatchInvalidOnError = errors.New("invalid onerror mode, must be stop or continue")
+	errBatchUnknownCommand = errors.New("unknown command")
+	errBatchNested         = errors.New("batch cannot be run from a batch")
+	errBatchUnterminated   = errors.New("unterminated quote")
+	errBatchHelpShown      = errors.New("invalid arguments, help was shown")
+)
+
+var batchCommand = &cli.Command{
+	Name:      "batch",
+	Usage:     "runs commands read from a file or stdin over a single connection, one command per line",
+	ArgsUsage: "<file>",
+	Action:    runBatch,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "file",
+			Usage: "the file to read commands from, stdin is read when unset or -",
+		},
+		&cli.StringFlag{
+			Name:  "onerror",
+			Value: batchOnErrorStop,
+			Usage: "whether to stop or continue when a command fails, the exit code is non-zero if any command failed",
+		},
+	},
+}
+
+func runBatch(c *cli.Context) error {
+	onError := c.String("onerror")
+	if onError != batchOnErrorStop && onError != batchOnErrorContinue {
+		return cli.Exit(fmt.Errorf("%w: %q", errBatchInvalidOnError, onError), batchExitInvalid)
+	}
+
+	path := c.String("file")
+	if path == "" {
+		path = c.Args().First()
+	}
+	var r io.Reader = os.Stdin
+	if path != "" && path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return cli.Exit(err, batchExitInvalid)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	conn, err := newClientConn()
+	if err != nil {
+		return cli.Exit(err, batchExitInvalid)
+	}
+	batchConn = conn
+	defer func() {
+		batchConn = nil
+		if err := conn.Close(); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+	}()
+
+	var ran, failed int
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		args, err := splitBatchLine(scanner.Text())
+		if err != nil {
+			return cli.Exit(fmt.Errorf("line %d: %w", line, err), batchExitInvalid)
+		}
+		if len(args) == 0 {
+			continue
+		}
+		ran++
+		if err := runBatchCommand(c, args); err != nil {
+			failed++
+			fmt.Fprintf(os.Stderr, "line %d: %s: %s\n", line, args[0], err)
+			if onError == batchOnErrorStop {
+				break
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return cli.Exit(err, batchExitInvalid)
+	}
+	if failed > 0 {
+		return cli.Exit(fmt.Sprintf("%d of %d batch commands failed", failed, ran), batchExitFailed)
+	}
+	return nil
+}
+
+// runBatchCommand runs a command with the global flags already parsed.
+// Commands show their help and return nil when their arguments are missing or
+// invalid, so a command which shows help is treated as failed
+func runBatchCommand(c *cli.Context, args []string) error {
+	cmd := c.App.Command(args[0])
+	switch {
+	case cmd == nil:
+		return fmt.Errorf("%w %q", errBatchUnknownCommand, args[0])
+	case cmd.Name == c.Command.Name:
+		return errBatchNested
+	}
+	output.reset()
+	cmdCtx := cli.NewContext(c.App, nil, c)
+	cmdCtx.Command = cmd
+
+	var helpShown bool
+	printHelp := cli.HelpPrinter
+	cli.HelpPrinter = func(w io.Writer, templ string, data any) {
+		helpShown = true
+		printHelp(w, templ, data)
+	}
+	defer func() { cli.HelpPrinter = printHelp }()
+	if err := cmd.Run(cmdCtx, args...); err != nil {
+		return err
+	}
+	if helpShown {
+		return errBatchHelpShown
+	}
+	return nil
+}
+
+// splitBatchLine splits a command line into arguments separated by whitespace.
+// Single and double quotes group arguments, backslashes escape the next
+// character outside single quotes and lines starting with # are ignored
+func splitBatchLine(line string) ([]string, error) {
+	line = strings.TrimSpace(line)
+	if line == "" || line[0] == '#' {
+		return nil, nil
+	}
+	var (
+		args    []string
+		arg     strings.Builder
+		inArg   bool
+		quote   rune
+		escaped bool
+	)
+	for _, r := range line {
+		switch {
+		case escaped:
+			arg.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped, inArg = true, true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				arg.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote, inArg = r, true
+		case r == ' ' || r == '\t':
+			if inArg {
+				args = append(args, arg.String())
+				arg.Reset()
+				inArg = false
+			}
+		default:
+			arg.WriteRune(r)
+			inArg = true
+		}
+	}
+	if quote != 0 || escaped {
+		return nil, errBatchUnterminated
+	}
+	if inArg {
+		args = append(args, arg.String())
+	}
+	return args, nil
+}
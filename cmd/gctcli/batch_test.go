@@ -0,0 +1,144 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+)
+
+func TestSplitBatchLine(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		line string
+		args []string
+		err  error
+	}{
+		{line: ""},
+		{line: "   \t "},
+		{line: "# getinfo"},
+		{line: "  # getinfo"},
+		{line: "getinfo", args: []string{"getinfo"}},
+		{line: "  getexchanges \t --enabled  ", args: []string{"getexchanges", "--enabled"}},
+		{line: `getorders --exchange "binance us" --pair 'BTC-USDT'`, args: []string{"getorders", "--exchange", "binance us", "--pair", "BTC-USDT"}},
+		{line: `echo "it's" 'say "hi"'`, args: []string{"echo", "it's", `say "hi"`}},
+		{line: `echo a\ b \"c\" "d\"e"`, args: []string{"echo", "a b", `"c"`, `d"e`}},
+		{line: `echo 'a\b' \\`, args: []string{"echo", `a\b`, `\`}},
+		{line: `echo "" ''`, args: []string{"echo", "", ""}},
+		{line: `echo a"b c"d`, args: []string{"echo", "ab cd"}},
+		{line: "echo a#b #c", args: []string{"echo", "a#b", "#c"}},
+		{line: `echo "unterminated`, err: errBatchUnterminated},
+		{line: `echo 'unterminated`, err: errBatchUnterminated},
+		{line: `echo trailing\`, err: errBatchUnterminated},
+	} {
+		args, err := splitBatchLine(tc.line)
+		require.ErrorIs(t, err, tc.err, "splitBatchLine must return the correct error for %q", tc.line)
+		assert.Equal(t, tc.args, args, "splitBatchLine should return the correct args for %q", tc.line)
+	}
+}
+
+func TestRunBatchCommand(t *testing.T) {
+	batch := &cli.Command{Name: "batch"}
+	app := &cli.App{
+		Writer: io.Discard,
+		Commands: []*cli.Command{
+			batch,
+			{Name: "ok", Action: func(*cli.Context) error { return nil }},
+			{Name: "needsargs", Action: func(c *cli.Context) error {
+				if c.NArg() == 0 {
+					return cli.ShowSubcommandHelp(c)
+				}
+				return nil
+			}},
+		},
+	}
+	c := cli.NewContext(app, nil, nil)
+	c.Command = batch
+
+	assert.NoError(t, runBatchCommand(c, []string{"ok"}))
+	assert.NoError(t, runBatchCommand(c, []string{"needsargs", "arg"}))
+	assert.ErrorIs(t, runBatchCommand(c, []string{"needsargs"}), errBatchHelpShown, "Commands which only show help should fail")
+	assert.ErrorIs(t, runBatchCommand(c, []string{"missing"}), errBatchUnknownCommand)
+	assert.ErrorIs(t, runBatchCommand(c, []string{"batch"}), errBatchNested)
+}
+
+// testOutputResponse is a response holding a single list, which is split into
+// rows
+var testOutputResponse = map[string]any{
+	"orders": []any{
+		map[string]any{"id": "1", "price": 1.5, "side": map[string]any{"name": "buy"}},
+		map[string]any{"id": "2", "price": 2, "tags": []any{"a", "b"}},
+	},
+}
+
+func TestOutputWriterCSV(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	o := &outputWriter{w: &buf, format: outputCSV}
+	require.NoError(t, o.write(testOutputResponse), "write must not error")
+	require.NoError(t, o.write(testOutputResponse), "write must not error")
+	assert.Equal(t, "id,price,side.name,tags\n"+
+		"1,1.5,buy,\n"+
+		"2,2,,\"[\"\"a\"\",\"\"b\"\"]\"\n"+
+		"1,1.5,buy,\n"+
+		"2,2,,\"[\"\"a\"\",\"\"b\"\"]\"\n", buf.String(), "Streamed csv responses should only write the header once")
+
+	buf.Reset()
+	o.reset()
+	o.fields = []string{"price", "id"}
+	require.NoError(t, o.write(testOutputResponse), "write must not error")
+	assert.Equal(t, "price,id\n1.5,1\n2,2\n", buf.String(), "Selected fields should be written in order")
+}
+
+func TestOutputWriterJSONL(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	o := &outputWriter{w: &buf, format: outputJSONL, fields: []string{"id", "side.name", "tags.1", "missing"}}
+	require.NoError(t, o.write(testOutputResponse), "write must not error")
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2, "jsonl must write a line per row")
+	assert.JSONEq(t, `{"id":"1","side.name":"buy","tags.1":null,"missing":null}`, lines[0])
+	assert.JSONEq(t, `{"id":"2","side.name":null,"tags.1":"b","missing":null}`, lines[1])
+
+	buf.Reset()
+	o.fields = nil
+	require.NoError(t, o.write(map[string]any{"status": "ok", "count": 3}), "write must not error")
+	assert.JSONEq(t, `{"status":"ok","count":3}`, strings.TrimSpace(buf.String()), "Responses without a single list should be written as one row")
+}
+
+func TestOutputWriterJSONFields(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	o := &outputWriter{w: &buf, format: outputJSON, fields: []string{"id"}}
+	require.NoError(t, o.write(testOutputResponse), "write must not error")
+	assert.JSONEq(t, `[{"id":"1"},{"id":"2"}]`, buf.String())
+
+	o.w = errWriter{}
+	assert.ErrorIs(t, o.write(testOutputResponse), errTestWrite, "write should return output errors")
+}
+
+func TestSetupOutput(t *testing.T) {
+	outputFormat, outputFields = "CSV", " id, ,price "
+	t.Cleanup(func() {
+		outputFormat, outputFields = outputJSON, ""
+		output.format, output.fields = outputJSON, nil
+	})
+	require.NoError(t, setupOutput(nil), "setupOutput must not error")
+	assert.Equal(t, outputCSV, output.format)
+	assert.Equal(t, []string{"id", "price"}, output.fields)
+
+	outputFormat = "xml"
+	assert.ErrorIs(t, setupOutput(nil), errInvalidOutputFormat)
+}
+
+var errTestWrite = io.ErrShortWrite
+
+type errWriter struct{}
+
+func (errWriter) Write([]byte) (int, error) {
+	return 0, errTestWrite
+}
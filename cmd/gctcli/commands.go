@@ -15,6 +15,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc/auth"
 	"github.com/urfave/cli/v2"
 )
 
@@ -44,8 +45,7 @@ func getInfo(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var getSubsystemsCommand = &cli.Command{
@@ -69,8 +69,7 @@ func getSubsystems(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var enableSubsystemCommand = &cli.Command{
@@ -118,8 +117,7 @@ func enableSubsystem(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var disableSubsystemCommand = &cli.Command{
@@ -167,8 +165,7 @@ func disableSubsystem(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var getRPCEndpointsCommand = &cli.Command{
@@ -192,8 +189,7 @@ func getRPCEndpoints(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var getCommunicationRelayersCommand = &cli.Command{
@@ -217,8 +213,7 @@ func getCommunicationRelayers(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var getExchangesCommand = &cli.Command{
@@ -256,8 +251,7 @@ func getExchanges(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var enableExchangeCommand = &cli.Command{
@@ -301,8 +295,7 @@ func enableExchange(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var disableExchangeCommand = &cli.Command{
@@ -346,8 +339,7 @@ func disableExchange(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var getExchangeOTPCommand = &cli.Command{
@@ -391,8 +383,7 @@ func getExchangeOTPCode(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var getExchangeOTPsCommand = &cli.Command{
@@ -415,8 +406,7 @@ func getExchangeOTPCodes(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var getExchangeInfoCommand = &cli.Command{
@@ -460,8 +450,7 @@ func getExchangeInfo(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var getTickerCommand = &cli.Command{
@@ -548,8 +537,7 @@ func getTicker(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var getTickersCommand = &cli.Command{
@@ -571,8 +559,7 @@ func getTickers(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var getAccountBalancesCommand = &cli.Command{
@@ -633,8 +620,7 @@ func getAccountBalances(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var getAccountBalancesStreamCommand = &cli.Command{
@@ -765,8 +751,7 @@ func updateAccountBalances(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var getConfigCommand = &cli.Command{
@@ -788,8 +773,7 @@ func getConfig(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var getPortfolioCommand = &cli.Command{
@@ -811,8 +795,7 @@ func getPortfolio(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var getPortfolioSummaryCommand = &cli.Command{
@@ -834,8 +817,7 @@ func getPortfolioSummary(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var addPortfolioAddressCommand = &cli.Command{
@@ -946,8 +928,7 @@ func addPortfolioAddress(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var removePortfolioAddressCommand = &cli.Command{
@@ -1016,8 +997,7 @@ func removePortfolioAddress(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var getForexProvidersCommand = &cli.Command{
@@ -1039,8 +1019,7 @@ func getForexProviders(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var getForexRatesCommand = &cli.Command{
@@ -1062,8 +1041,7 @@ func getForexRates(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var getOrdersCommand = &cli.Command{
@@ -1187,8 +1165,7 @@ func getOrders(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var getManagedOrdersCommand = &cli.Command{
@@ -1273,8 +1250,7 @@ func getManagedOrders(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var getOrderCommand = &cli.Command{
@@ -1368,8 +1344,7 @@ func getOrder(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var submitOrderCommand = &cli.Command{
@@ -1552,8 +1527,7 @@ func submitOrder(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var simulateOrderCommand = &cli.Command{
@@ -1657,8 +1631,7 @@ func simulateOrder(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var cancelOrderCommand = &cli.Command{
@@ -1787,8 +1760,7 @@ func cancelOrder(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var cancelBatchOrdersCommand = &cli.Command{
@@ -1917,8 +1889,7 @@ func cancelBatchOrders(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var cancelAllOrdersCommand = &cli.Command{
@@ -1989,8 +1960,7 @@ func cancelAllOrders(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func modifyOrder(c *cli.Context) error {
@@ -2078,8 +2048,7 @@ func modifyOrder(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var getEventsCommand = &cli.Command{
@@ -2101,8 +2070,7 @@ func getEvents(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var addEventCommand = &cli.Command{
@@ -2263,8 +2231,7 @@ func addEvent(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var removeEventCommand = &cli.Command{
@@ -2313,8 +2280,7 @@ func removeEvent(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var getCryptocurrencyDepositAddressesCommand = &cli.Command{
@@ -2355,8 +2321,7 @@ func getCryptocurrencyDepositAddresses(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var getCryptocurrencyDepositAddressCommand = &cli.Command{
@@ -2445,8 +2410,7 @@ func getCryptocurrencyDepositAddress(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var getAvailableTransferChainsCommand = &cli.Command{
@@ -2507,8 +2471,7 @@ func getAvailableTransferChains(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var withdrawCryptocurrencyFundsCommand = &cli.Command{
@@ -2637,8 +2600,7 @@ func withdrawCryptocurrencyFunds(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var withdrawFiatFundsCommand = &cli.Command{
@@ -2730,8 +2692,7 @@ func withdrawFiatFunds(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var withdrawalRequestCommand = &cli.Command{
@@ -2854,8 +2815,7 @@ func withdrawalRequestByID(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func withdrawalRequestByExchangeID(c *cli.Context) error {
@@ -2931,8 +2891,7 @@ func withdrawalRequestByExchangeID(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func withdrawalRequestByDate(c *cli.Context) error {
@@ -3005,8 +2964,7 @@ func withdrawalRequestByDate(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var getLoggerDetailsCommand = &cli.Command{
@@ -3054,8 +3012,7 @@ func getLoggerDetails(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var setLoggerDetailsCommand = &cli.Command{
@@ -3120,8 +3077,7 @@ func setLoggerDetails(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var getTickerStreamCommand = &cli.Command{
@@ -3397,8 +3353,7 @@ func getAuditEvent(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var (
@@ -3560,8 +3515,7 @@ func gctScriptAutoload(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(executeCommand)
-	return nil
+	return jsonOutput(executeCommand)
 }
 
 func gctScriptExecute(c *cli.Context) error {
@@ -3599,9 +3553,7 @@ func gctScriptExecute(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(executeCommand)
-
-	return nil
+	return jsonOutput(executeCommand)
 }
 
 func gctScriptStatus(c *cli.Context) error {
@@ -3618,8 +3570,7 @@ func gctScriptStatus(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(executeCommand)
-	return nil
+	return jsonOutput(executeCommand)
 }
 
 func gctScriptList(c *cli.Context) error {
@@ -3636,8 +3587,7 @@ func gctScriptList(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(executeCommand)
-	return nil
+	return jsonOutput(executeCommand)
 }
 
 func gctScriptStop(c *cli.Context) error {
@@ -3666,8 +3616,7 @@ func gctScriptStop(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(executeCommand)
-	return nil
+	return jsonOutput(executeCommand)
 }
 
 func gctScriptStopAll(c *cli.Context) error {
@@ -3684,8 +3633,7 @@ func gctScriptStopAll(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(executeCommand)
-	return nil
+	return jsonOutput(executeCommand)
 }
 
 func gctScriptRead(c *cli.Context) error {
@@ -3716,8 +3664,7 @@ func gctScriptRead(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(executeCommand)
-	return nil
+	return jsonOutput(executeCommand)
 }
 
 func gctScriptQuery(c *cli.Context) error {
@@ -3748,9 +3695,7 @@ func gctScriptQuery(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(executeCommand)
-
-	return nil
+	return jsonOutput(executeCommand)
 }
 
 func gctScriptUpload(c *cli.Context) error {
@@ -3816,8 +3761,7 @@ func gctScriptUpload(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(uploadCommand)
-	return nil
+	return jsonOutput(uploadCommand)
 }
 
 const klineMessage = `interval in seconds. supported values are: 15, 60(1min), 180(3min), 300(5min), 600(10min),
@@ -3958,8 +3902,7 @@ func getHistoricCandles(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var getHistoricCandlesExtendedCommand = &cli.Command{
@@ -4019,6 +3962,10 @@ var getHistoricCandlesExtendedCommand = &cli.Command{
 			Aliases: []string{"fill"},
 			Usage:   "will create candles for missing intervals using stored trade data <true/false>",
 		},
+		&cli.BoolFlag{
+			Name:  "cache",
+			Usage: "serve stored candles from the database and only fetch missing ranges from the exchange, fetched candles are stored when used with sync <true/false>",
+		},
 	},
 }
 
@@ -4106,6 +4053,19 @@ func getHistoricCandlesExtended(c *cli.Context) error {
 		return errors.New("cannot forcefully overwrite without sync")
 	}
 
+	var useCache bool
+	if c.IsSet("cache") {
+		useCache = c.Bool("cache")
+	}
+
+	if useCache && useDB {
+		return errors.New("cannot use the candle cache when sourcing data from the database")
+	}
+
+	if useCache && force {
+		return errors.New("cannot forcefully overwrite when using the candle cache")
+	}
+
 	candleInterval := time.Duration(candleGranularity) * time.Second
 	var s, e time.Time
 	s, err = time.ParseInLocation(time.DateTime, startTime, time.Local)
@@ -4145,13 +4105,13 @@ func getHistoricCandlesExtended(c *cli.Context) error {
 			UseDb:                 useDB,
 			FillMissingWithTrades: fillMissingData,
 			Force:                 force,
+			UseCache:              useCache,
 		})
 	if err != nil {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var findMissingSavedCandleIntervalsCommand = &cli.Command{
@@ -4294,8 +4254,7 @@ func findMissingSavedCandleIntervals(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var shutdownCommand = &cli.Command{
@@ -4317,8 +4276,7 @@ func shutdown(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var getMarginRatesHistoryCommand = &cli.Command{
@@ -4516,8 +4474,7 @@ func getMarginRatesHistory(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var getCurrencyTradeURLCommand = &cli.Command{
@@ -4603,6 +4560,484 @@ func getCurrencyTradeURL(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
+}
+
+var getCompositePricesCommand = &cli.Command{
+	Name:      "getcompositeprices",
+	Usage:     "returns composite reference prices calculated across exchanges",
+	ArgsUsage: "<asset> <pair>",
+	Action:    getCompositePrices,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:    "asset",
+			Aliases: []string{"a"},
+			Usage:   "the asset type of the currency pair, if set without a pair all composite prices for the asset are returned",
+		},
+		&cli.StringFlag{
+			Name:    "pair",
+			Aliases: []string{"p"},
+			Usage:   "the currency pair, eg btc-usd",
+		},
+		&cli.BoolFlag{
+			Name:    "constituents",
+			Aliases: []string{"c"},
+			Usage:   "include each exchange market used to calculate the composite price",
+		},
+	},
+}
+
+func getCompositePrices(c *cli.Context) error {
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().First()
+	}
+	if assetType != "" && !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	var pair string
+	if c.IsSet("pair") {
+		pair = c.String("pair")
+	} else {
+		pair = c.Args().Get(1)
+	}
+
+	var rpcPair *gctrpc.CurrencyPair
+	if pair != "" {
+		if !validPair(pair) {
+			return errInvalidPair
+		}
+		p, err := currency.NewPairDelimiter(pair, pairDelimiter)
+		if err != nil {
+			return err
+		}
+		rpcPair = &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		}
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetCompositePrices(c.Context, &gctrpc.GetCompositePricesRequest{
+		Asset:               assetType,
+		Pair:                rpcPair,
+		IncludeConstituents: c.Bool("constituents"),
+	})
+	if err != nil {
+		return err
+	}
+	return jsonOutput(result)
+}
+
+var getDatabaseChangeFeedStreamCommand = &cli.Command{
+	Name:   "getdatabasechangefeedstream",
+	Usage:  "streams notifications for candles, trades, withdrawals, audit events and data history jobs stored in the database",
+	Action: getDatabaseChangeFeedStream,
+	Flags: []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:    "channel",
+			Aliases: []string{"c"},
+			Usage:   "channel(s) to follow, all channels are followed when unset. Supported: gct_candle, gct_trade, gct_withdrawal, gct_audit_event, gct_datahistoryjob",
+		},
+	},
+}
+
+func getDatabaseChangeFeedStream(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetDatabaseChangeFeedStream(c.Context, &gctrpc.GetDatabaseChangeFeedStreamRequest{
+		Channels: c.StringSlice("channel"),
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+		if err := jsonOutput(resp); err != nil {
+			return err
+		}
+	}
+}
+
+var getRateLimitStatusCommand = &cli.Command{
+	Name:      "getratelimitstatus",
+	Usage:     "gets the exchange reported rate limit budget and any rate limit backoff in effect",
+	ArgsUsage: "<exchange>",
+	Action:    getRateLimitStatus,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the rate limit status for",
+		},
+	},
+}
+
+func getRateLimitStatus(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetRateLimitStatus(c.Context,
+		&gctrpc.GenericExchangeNameRequest{
+			Exchange: exchangeName,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return jsonOutput(result)
+}
+
+var generateAPITokenCommand = &cli.Command{
+	Name:   "generateapitoken",
+	Usage:  "generates a gRPC API token and the token hash to set in a remote control user's config",
+	Action: generateAPIToken,
+}
+
+func generateAPIToken(_ *cli.Context) error {
+	token, hash, err := auth.GenerateToken()
+	if err != nil {
+		return err
+	}
+	return jsonOutput(map[string]string{
+		"token":     token,
+		"tokenHash": hash,
+	})
+}
+
+var reloadExchangeCredentialsCommand = &cli.Command{
+	Name:      "reloadexchangecredentials",
+	Usage:     "reloads exchange API credentials from config and their secret providers, or all exchanges when no exchange is specified",
+	ArgsUsage: "<exchange>",
+	Action:    reloadExchangeCredentials,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to reload the API credentials for",
+		},
+	},
+}
+
+func reloadExchangeCredentials(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.ReloadExchangeCredentials(c.Context,
+		&gctrpc.GenericExchangeNameRequest{
+			Exchange: exchangeName,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return jsonOutput(result)
+}
+
+var getPendingWithdrawalsCommand = &cli.Command{
+	Name:   "getpendingwithdrawals",
+	Usage:  "gets withdrawals held by the withdrawal policy until they are approved",
+	Action: getPendingWithdrawals,
+}
+
+func getPendingWithdrawals(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetPendingWithdrawals(c.Context, &gctrpc.GetPendingWithdrawalsRequest{})
+	if err != nil {
+		return err
+	}
+
+	return jsonOutput(result)
+}
+
+var approveWithdrawalCommand = &cli.Command{
+	Name:      "approvewithdrawal",
+	Usage:     "approves a pending withdrawal requested by another operator and sends it to the exchange, confirmed with the approver's OTP code e.g. from gen_otp -approver",
+	ArgsUsage: "<id> <otp>",
+	Action:    approveWithdrawal,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "id",
+			Usage: "the pending withdrawal id",
+		},
+		&cli.StringFlag{
+			Name:  "otp",
+			Usage: "the withdrawal approver's OTP code",
+		},
+	},
+}
+
+func approveWithdrawal(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+
+	var otp string
+	if c.IsSet("otp") {
+		otp = c.String("otp")
+	} else {
+		otp = c.Args().Get(1)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.ApproveWithdrawal(c.Context,
+		&gctrpc.ApproveWithdrawalRequest{
+			Id:      id,
+			OtpCode: otp,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return jsonOutput(result)
+}
+
+var rejectWithdrawalCommand = &cli.Command{
+	Name:      "rejectwithdrawal",
+	Usage:     "rejects a pending withdrawal so it is never sent",
+	ArgsUsage: "<id>",
+	Action:    rejectWithdrawal,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "id",
+			Usage: "the pending withdrawal id",
+		},
+	},
+}
+
+func rejectWithdrawal(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.RejectWithdrawal(c.Context,
+		&gctrpc.RejectWithdrawalRequest{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return jsonOutput(result)
+}
+
+var reloadConfigCommand = &cli.Command{
+	Name:   "reloadconfig",
+	Usage:  "reloads the engine config file, applying changes which do not require a restart and listing those which do",
+	Action: reloadConfig,
+}
+
+func reloadConfig(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.ReloadConfig(c.Context, &gctrpc.ReloadConfigRequest{})
+	if err != nil {
+		return err
+	}
+
+	return jsonOutput(result)
+}
+
+var orderFeedFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:  "exchange",
+		Usage: "the exchange to follow, all exchanges are followed when unset",
+	},
+	&cli.StringFlag{
+		Name:  "asset",
+		Usage: "the asset type to follow, all asset types are followed when unset",
+	},
+	&cli.StringFlag{
+		Name:  "pair",
+		Usage: "the currency pair to follow, all pairs are followed when unset",
+	},
+}
+
+var getOrderUpdatesStreamCommand = &cli.Command{
+	Name:   "getorderupdatesstream",
+	Usage:  "streams order manager orders as they are added or change status",
+	Action: getOrderUpdatesStream,
+	Flags:  orderFeedFlags,
+}
+
+func getOrderUpdatesStream(c *cli.Context) error {
+	assetType, pair, err := orderFeedArgs(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetOrderUpdatesStream(c.Context, &gctrpc.GetOrderUpdatesStreamRequest{
+		Exchange:  c.String("exchange"),
+		AssetType: assetType,
+		Pair:      pair,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+		if err := jsonOutput(resp); err != nil {
+			return err
+		}
+	}
+}
+
+var getFillsStreamCommand = &cli.Command{
+	Name:   "getfillsstream",
+	Usage:  "streams the fills of orders as they are received or derived from order updates",
+	Action: getFillsStream,
+	Flags:  orderFeedFlags,
+}
+
+func getFillsStream(c *cli.Context) error {
+	assetType, pair, err := orderFeedArgs(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetFillsStream(c.Context, &gctrpc.GetFillsStreamRequest{
+		Exchange:  c.String("exchange"),
+		AssetType: assetType,
+		Pair:      pair,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+		if err := jsonOutput(resp); err != nil {
+			return err
+		}
+	}
+}
+
+// orderFeedArgs validates the optional asset and pair filters of the order
+// feed stream commands
+func orderFeedArgs(c *cli.Context) (string, *gctrpc.CurrencyPair, error) {
+	assetType := strings.ToLower(c.String("asset"))
+	if assetType != "" && !validAsset(assetType) {
+		return "", nil, errInvalidAsset
+	}
+	if !c.IsSet("pair") {
+		return assetType, nil, nil
+	}
+	if !validPair(c.String("pair")) {
+		return "", nil, errInvalidPair
+	}
+	p, err := currency.NewPairDelimiter(c.String("pair"), pairDelimiter)
+	if err != nil {
+		return "", nil, err
+	}
+	return assetType, &gctrpc.CurrencyPair{
+		Delimiter: p.Delimiter,
+		Base:      p.Base.String(),
+		Quote:     p.Quote.String(),
+	}, nil
 }
@@ -106,9 +106,7 @@ func stateGetAll(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	jsonOutput(result)
-
-	return nil
+	return jsonOutput(result)
 }
 
 func stateGetDeposit(c *cli.Context) error {
@@ -155,8 +153,7 @@ func stateGetDeposit(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func stateGetWithdrawal(c *cli.Context) error {
@@ -203,8 +200,7 @@ func stateGetWithdrawal(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func stateGetTrading(c *cli.Context) error {
@@ -251,8 +247,7 @@ func stateGetTrading(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func stateGetPairTrading(c *cli.Context) error {
@@ -299,6 +294,5 @@ func stateGetPairTrading(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
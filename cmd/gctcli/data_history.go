@@ -333,8 +333,7 @@ func getDataHistoryJob(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func getActiveDataHistoryJobs(c *cli.Context) error {
@@ -351,8 +350,7 @@ func getActiveDataHistoryJobs(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func upsertDataHistoryJob(c *cli.Context) error {
@@ -525,8 +523,7 @@ func upsertDataHistoryJob(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func getDataHistoryJobsBetween(c *cli.Context) error {
@@ -572,8 +569,7 @@ func getDataHistoryJobsBetween(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func setDataHistoryJobStatus(c *cli.Context) error {
@@ -626,8 +622,7 @@ func setDataHistoryJobStatus(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func getDataHistoryJobSummary(c *cli.Context) error {
@@ -657,8 +652,7 @@ func getDataHistoryJobSummary(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func setPrerequisiteJob(c *cli.Context) error {
@@ -700,6 +694,5 @@ func setPrerequisiteJob(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
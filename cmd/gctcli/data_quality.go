@@ -0,0 +1,203 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var dataQualityCommands = &cli.Command{
+	Name:      "dataquality",
+	Usage:     "scan stored candle and trade data for gaps, duplicates, outliers and other integrity issues",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "check",
+			Usage: "runs a data quality check against stored data and returns the report",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     "exchange",
+					Usage:    "eg binance",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     "asset",
+					Usage:    "eg spot",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:     "pair",
+					Usage:    "eg btc-usdt",
+					Required: true,
+				},
+				&cli.Uint64Flag{
+					Name:     "interval",
+					Usage:    klineMessage,
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:        "start_date",
+					Usage:       "formatted as: " + time.DateTime,
+					Value:       time.Now().AddDate(0, 0, -1).Truncate(time.Hour).Format(time.DateTime),
+					Destination: &startTime,
+				},
+				&cli.StringFlag{
+					Name:        "end_date",
+					Usage:       "formatted as: " + time.DateTime,
+					Value:       time.Now().Truncate(time.Hour).Format(time.DateTime),
+					Destination: &endTime,
+				},
+				&cli.StringSliceFlag{
+					Name:  "secondary_exchange",
+					Usage: "exchange(s) with stored candles to compare close prices against, eg --secondary_exchange=kraken --secondary_exchange=bitstamp",
+				},
+				&cli.BoolFlag{
+					Name:  "include_trades",
+					Usage: "compare stored trades against the stored candle ranges",
+				},
+				&cli.BoolFlag{
+					Name:  "create_repair_jobs",
+					Usage: "create data history jobs to refetch and overwrite candles for any repairable issues",
+				},
+			},
+			Action: runDataQualityCheck,
+		},
+		{
+			Name:  "getreports",
+			Usage: "returns stored data quality reports, newest first",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "id",
+					Usage: guidExample,
+				},
+				&cli.StringFlag{
+					Name:  "exchange",
+					Usage: "eg binance",
+				},
+				&cli.StringFlag{
+					Name:  "asset",
+					Usage: "eg spot",
+				},
+				&cli.StringFlag{
+					Name:  "pair",
+					Usage: "eg btc-usdt",
+				},
+				&cli.BoolFlag{
+					Name:  "include_issues",
+					Usage: "include every issue found rather than only the issue summary",
+				},
+			},
+			Action: getDataQualityReports,
+		},
+	},
+}
+
+func runDataQualityCheck(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	assetType := c.String("asset")
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	pair := c.String("pair")
+	if !validPair(pair) {
+		return errInvalidPair
+	}
+	p, err := currency.NewPairDelimiter(pair, pairDelimiter)
+	if err != nil {
+		return fmt.Errorf("cannot process pair: %w", err)
+	}
+
+	if c.IsSet("start_date") {
+		startTime = c.String("start_date")
+	}
+	if c.IsSet("end_date") {
+		endTime = c.String("end_date")
+	}
+	s, err := time.ParseInLocation(time.DateTime, startTime, time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+	e, err := time.ParseInLocation(time.DateTime, endTime, time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+
+	candleInterval := time.Duration(c.Int64("interval")) * time.Second
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.RunDataQualityCheck(c.Context, &gctrpc.DataQualityCheckRequest{
+		Exchange: c.String("exchange"),
+		Asset:    assetType,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		},
+		Interval:           int64(candleInterval),
+		Start:              s.Format(common.SimpleTimeFormatWithTimezone),
+		End:                e.Format(common.SimpleTimeFormatWithTimezone),
+		SecondaryExchanges: c.StringSlice("secondary_exchange"),
+		IncludeTrades:      c.Bool("include_trades"),
+		CreateRepairJobs:   c.Bool("create_repair_jobs"),
+	})
+	if err != nil {
+		return err
+	}
+	return jsonOutput(result)
+}
+
+func getDataQualityReports(c *cli.Context) error {
+	assetType := c.String("asset")
+	if assetType != "" && !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	var rpcPair *gctrpc.CurrencyPair
+	if pair := c.String("pair"); pair != "" {
+		if !validPair(pair) {
+			return errInvalidPair
+		}
+		p, err := currency.NewPairDelimiter(pair, pairDelimiter)
+		if err != nil {
+			return fmt.Errorf("cannot process pair: %w", err)
+		}
+		rpcPair = &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		}
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetDataQualityReports(c.Context, &gctrpc.GetDataQualityReportsRequest{
+		Id:            c.String("id"),
+		Exchange:      c.String("exchange"),
+		Asset:         assetType,
+		Pair:          rpcPair,
+		IncludeIssues: c.Bool("include_issues"),
+	})
+	if err != nil {
+		return err
+	}
+	return jsonOutput(result)
+}
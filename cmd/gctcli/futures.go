@@ -622,8 +622,7 @@ func getManagedPosition(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func getAllManagedPositions(c *cli.Context) error {
@@ -693,8 +692,7 @@ func getAllManagedPositions(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func getCollateral(c *cli.Context) error {
@@ -767,8 +765,7 @@ func getCollateral(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func getFundingRates(c *cli.Context) error {
@@ -892,8 +889,7 @@ func getFundingRates(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func getLatestFundingRate(c *cli.Context) error {
@@ -966,8 +962,7 @@ func getLatestFundingRate(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func getCollateralMode(c *cli.Context) error {
@@ -1011,8 +1006,7 @@ func getCollateralMode(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func setCollateralMode(c *cli.Context) error {
@@ -1067,8 +1061,7 @@ func setCollateralMode(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func setLeverage(c *cli.Context) error {
@@ -1164,8 +1157,7 @@ func setLeverage(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func getLeverage(c *cli.Context) error {
@@ -1250,8 +1242,7 @@ func getLeverage(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func changePositionMargin(c *cli.Context) error {
@@ -1351,8 +1342,7 @@ func changePositionMargin(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func getFuturesPositionSummary(c *cli.Context) error {
@@ -1432,8 +1422,7 @@ func getFuturesPositionSummary(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func getFuturePositionOrders(c *cli.Context) error {
@@ -1559,8 +1548,7 @@ func getFuturePositionOrders(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func setMarginType(c *cli.Context) error {
@@ -1632,8 +1620,7 @@ func setMarginType(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func getOpenInterest(c *cli.Context) error {
@@ -1707,6 +1694,5 @@ func getOpenInterest(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
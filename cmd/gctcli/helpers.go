@@ -33,8 +33,12 @@ func clearScreen() error {
 }
 
 func closeConn(conn *grpc.ClientConn, cancel context.CancelFunc) {
-	if err := conn.Close(); err != nil {
-		fmt.Println(err)
+	// The batch connection is shared by its commands and closed once they
+	// have all run
+	if conn != batchConn {
+		if err := conn.Close(); err != nil {
+			fmt.Println(err)
+		}
 	}
 	if cancel != nil {
 		cancel()
@@ -2,6 +2,8 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"log"
 	"os"
@@ -11,7 +13,6 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/core"
-	"github.com/thrasher-corp/gocryptotrader/encoding/json"
 	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	"github.com/thrasher-corp/gocryptotrader/gctrpc/auth"
 	"github.com/thrasher-corp/gocryptotrader/signaler"
@@ -27,34 +28,31 @@ var (
 	password      string
 	pairDelimiter string
 	certPath      string
+	apiToken      string
+	clientCert    string
+	clientKey     string
 	timeout       time.Duration
 	exchangeCreds accounts.Credentials
+	account       string
 	verbose       bool
 	ignoreTimeout bool
 )
 
 const defaultTimeout = time.Second * 30
 
-func jsonOutput(in any) {
-	j, err := json.MarshalIndent(in, "", " ")
-	if err != nil {
-		return
-	}
-	fmt.Print(string(j))
+// jsonOutput writes a command's response in the selected output format
+func jsonOutput(in any) error {
+	return output.write(in)
 }
 
 func setupClient(c *cli.Context) (*grpc.ClientConn, context.CancelFunc, error) {
-	creds, err := credentials.NewClientTLSFromFile(certPath, "")
-	if err != nil {
-		return nil, nil, err
-	}
-
-	opts := []grpc.DialOption{
-		grpc.WithTransportCredentials(creds),
-		grpc.WithPerRPCCredentials(auth.BasicAuth{
-			Username: username,
-			Password: password,
-		}),
+	conn := batchConn
+	if conn == nil {
+		var err error
+		conn, err = newClientConn()
+		if err != nil {
+			return nil, nil, err
+		}
 	}
 
 	var cancel context.CancelFunc
@@ -65,11 +63,63 @@ func setupClient(c *cli.Context) (*grpc.ClientConn, context.CancelFunc, error) {
 		flag, values := exchangeCreds.GetMetaData()
 		c.Context = metadata.AppendToOutgoingContext(c.Context, flag, values)
 	}
+	if account != "" {
+		c.Context = metadata.AppendToOutgoingContext(c.Context, string(accounts.ContextAccountFlag), account)
+	}
 	if verbose {
 		c.Context = metadata.AppendToOutgoingContext(c.Context, "verbose", "true")
 	}
-	conn, err := grpc.NewClient(host, opts...)
-	return conn, cancel, err
+	return conn, cancel, nil
+}
+
+// newClientConn returns a connection to the gRPC server authenticated by the
+// global connection flags
+func newClientConn() (*grpc.ClientConn, error) {
+	creds, err := clientTransportCredentials()
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+	}
+	switch {
+	case apiToken != "":
+		opts = append(opts, grpc.WithPerRPCCredentials(auth.TokenAuth{Token: apiToken}))
+	case clientCert == "":
+		// Clients authenticating with a certificate send no authorization
+		// header, as it takes precedence over the certificate
+		opts = append(opts, grpc.WithPerRPCCredentials(auth.BasicAuth{
+			Username: username,
+			Password: password,
+		}))
+	}
+	return grpc.NewClient(host, opts...)
+}
+
+// clientTransportCredentials returns the TLS credentials for connecting to the
+// gRPC server, presenting a client certificate when one is set
+func clientTransportCredentials() (credentials.TransportCredentials, error) {
+	if clientCert == "" {
+		return credentials.NewClientTLSFromFile(certPath, "")
+	}
+	cert, err := tls.LoadX509KeyPair(clientCert, clientKey)
+	if err != nil {
+		return nil, err
+	}
+	serverCert, err := os.ReadFile(certPath)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(serverCert) {
+		return nil, fmt.Errorf("no certificates found in %s", certPath)
+	}
+	return credentials.NewTLS(&tls.Config{
+		RootCAs:      pool,
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}), nil
 }
 
 func main() {
@@ -109,6 +159,21 @@ func main() {
 			Usage:       "the path to TLS cert of the gRPC server",
 			Destination: &certPath,
 		},
+		&cli.StringFlag{
+			Name:        "apitoken",
+			Usage:       "the gRPC API token, used instead of the gRPC username and password",
+			Destination: &apiToken,
+		},
+		&cli.StringFlag{
+			Name:        "clientcert",
+			Usage:       "the path to a TLS client cert for authenticating to the gRPC server",
+			Destination: &clientCert,
+		},
+		&cli.StringFlag{
+			Name:        "clientkey",
+			Usage:       "the path to the TLS client cert key",
+			Destination: &clientKey,
+		},
 		&cli.DurationFlag{
 			Name:        "timeout",
 			Value:       defaultTimeout,
@@ -145,6 +210,11 @@ func main() {
 			Usage:       "override config API One Time Password (OTP) for request",
 			Destination: &exchangeCreds.OneTimePassword,
 		},
+		&cli.StringFlag{
+			Name:        "account",
+			Usage:       "the name of the configured exchange account to use for the request, defaults to the exchange's default credentials",
+			Destination: &account,
+		},
 		&cli.BoolFlag{
 			Name:        "verbose",
 			Usage:       "allows the request to generate a more verbose outputs server side",
@@ -156,7 +226,20 @@ func main() {
 			Usage:       "ignores the context timeout for requests",
 			Destination: &ignoreTimeout,
 		},
+		&cli.StringFlag{
+			Name:        "output",
+			Aliases:     []string{"o"},
+			Value:       outputJSON,
+			Usage:       "the output format, one of json, jsonl, csv or table",
+			Destination: &outputFormat,
+		},
+		&cli.StringFlag{
+			Name:        "fields",
+			Usage:       "comma separated response fields to output, with nested fields and list elements separated by dots e.g. pair.base,bids.0.price",
+			Destination: &outputFields,
+		},
 	}
+	app.Before = setupOutput
 	app.Commands = []*cli.Command{
 		getInfoCommand,
 		getSubsystemsCommand,
@@ -213,6 +296,7 @@ func main() {
 		websocketManagerCommand,
 		tradeCommand,
 		dataHistoryCommands,
+		dataQualityCommands,
 		currencyStateManagementCommand,
 		futuresCommands,
 		shutdownCommand,
@@ -220,6 +304,19 @@ func main() {
 		getMarginRatesHistoryCommand,
 		orderbookCommand,
 		getCurrencyTradeURLCommand,
+		getCompositePricesCommand,
+		getDatabaseChangeFeedStreamCommand,
+		getRateLimitStatusCommand,
+		generateAPITokenCommand,
+		reloadExchangeCredentialsCommand,
+		getPendingWithdrawalsCommand,
+		approveWithdrawalCommand,
+		rejectWithdrawalCommand,
+		reloadConfigCommand,
+		getOrderUpdatesStreamCommand,
+		getFillsStreamCommand,
+		tuiCommand,
+		batchCommand,
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -135,8 +135,7 @@ func getNominal(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var impact = &cli.Command{
@@ -220,8 +219,7 @@ func getImpact(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var purchase = &cli.BoolFlag{
@@ -343,9 +341,7 @@ func getMovement(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-
-	return nil
+	return jsonOutput(result)
 }
 
 var getOrderbookCommand = &cli.Command{
@@ -455,10 +451,9 @@ func getOrderbook(c *cli.Context) error {
 		askLen := uint64(len(result.Asks) - 1) //nolint:gosec // Can fit in uint64
 		maxLen := min(max(bidLen, askLen), depthLimit)
 		renderOrderbookExchangeStyle(result, exchangeName, assetType, maxLen, askLen, bidLen)
-	} else {
-		jsonOutput(result)
+		return nil
 	}
-	return nil
+	return jsonOutput(result)
 }
 
 var getOrderbooksCommand = &cli.Command{
@@ -480,8 +475,7 @@ func getOrderbooks(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 var getOrderbookStreamCommand = &cli.Command{
@@ -839,6 +833,5 @@ func whaleBomb(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
@@ -0,0 +1,309 @@
+package main
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/urfave/cli/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Output formats selected with the --output flag
+const (
+	outputJSON  = "json"
+	outputJSONL = "jsonl"
+	outputCSV   = "csv"
+	outputTable = "table"
+)
+
+var (
+	outputFormat string
+	outputFields string
+
+	errInvalidOutputFormat = errors.New("invalid output format, must be json, jsonl, csv or table")
+)
+
+// responseMarshaler encodes gRPC responses with every field present, so that the
+// fields of jsonl, csv and table output do not depend on which values are set
+var responseMarshaler = protojson.MarshalOptions{EmitUnpopulated: true, UseProtoNames: true}
+
+// outputWriter writes command responses in the selected output format
+type outputWriter struct {
+	w      io.Writer
+	format string
+	fields []string
+	// columns are the csv columns, fixed by the first response written so that
+	// the rows of streamed responses line up with the header
+	columns []string
+}
+
+var output = &outputWriter{w: os.Stdout, format: outputJSON}
+
+// setupOutput validates the output flags before a command is run
+func setupOutput(_ *cli.Context) error {
+	format := strings.ToLower(outputFormat)
+	if !slices.Contains([]string{outputJSON, outputJSONL, outputCSV, outputTable}, format) {
+		return fmt.Errorf("%w: %q", errInvalidOutputFormat, outputFormat)
+	}
+	output.format = format
+	output.fields = nil
+	for f := range strings.SplitSeq(outputFields, ",") {
+		if f = strings.TrimSpace(f); f != "" {
+			output.fields = append(output.fields, f)
+		}
+	}
+	return nil
+}
+
+// reset clears state carried between the responses of a command
+func (o *outputWriter) reset() {
+	o.columns = nil
+}
+
+// write writes a response. Plain json output without field selection is the
+// response as returned. Otherwise the response is split into rows: the
+// elements of a list, or of the only field of a response holding a single
+// list, or else the response itself
+func (o *outputWriter) write(in any) error {
+	if o.format == outputJSON && len(o.fields) == 0 {
+		j, err := json.MarshalIndent(in, "", " ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(o.w, string(j))
+		return err
+	}
+
+	v, err := toOutputValue(in)
+	if err != nil {
+		return err
+	}
+	rows, isList := outputRows(v)
+	if len(o.fields) > 0 {
+		for i := range rows {
+			rows[i] = o.selectFields(rows[i])
+		}
+	}
+
+	switch o.format {
+	case outputJSON:
+		var j []byte
+		if isList {
+			j, err = json.MarshalIndent(rows, "", " ")
+		} else {
+			j, err = json.MarshalIndent(rows[0], "", " ")
+		}
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(o.w, string(j))
+		return err
+	case outputJSONL:
+		for i := range rows {
+			j, err := json.Marshal(rows[i])
+			if err != nil {
+				return err
+			}
+			if _, err := fmt.Fprintln(o.w, string(j)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case outputCSV:
+		return o.writeCSV(rows)
+	default:
+		return o.writeTable(rows)
+	}
+}
+
+func (o *outputWriter) writeCSV(rows []any) error {
+	w := csv.NewWriter(o.w)
+	if o.columns == nil {
+		o.columns = o.rowColumns(rows)
+		if len(o.columns) == 0 {
+			return nil
+		}
+		if err := w.Write(o.columns); err != nil {
+			return err
+		}
+	}
+	for i := range rows {
+		if err := w.Write(o.cells(rows[i], o.columns)); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+func (o *outputWriter) writeTable(rows []any) error {
+	columns := o.rowColumns(rows)
+	if len(columns) == 0 {
+		return nil
+	}
+	w := tabwriter.NewWriter(o.w, 0, 0, 2, ' ', 0)
+	header := make([]string, len(columns))
+	for i := range columns {
+		header[i] = strings.ToUpper(columns[i])
+	}
+	if _, err := fmt.Fprintln(w, strings.Join(header, "\t")); err != nil {
+		return err
+	}
+	for i := range rows {
+		if _, err := fmt.Fprintln(w, strings.Join(o.cells(rows[i], columns), "\t")); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+// rowColumns returns the selected fields, or the sorted nested field paths of
+// all rows
+func (o *outputWriter) rowColumns(rows []any) []string {
+	if len(o.fields) > 0 {
+		return o.fields
+	}
+	seen := make(map[string]struct{})
+	for i := range rows {
+		flat := make(map[string]any)
+		flattenOutputValue("", rows[i], flat)
+		for k := range flat {
+			seen[k] = struct{}{}
+		}
+	}
+	columns := make([]string, 0, len(seen))
+	for k := range seen {
+		columns = append(columns, k)
+	}
+	slices.Sort(columns)
+	return columns
+}
+
+// cells returns the values of a row's columns as text. Selected fields are
+// keyed by their path, so are used as is even when they hold an object
+func (o *outputWriter) cells(row any, columns []string) []string {
+	m, _ := row.(map[string]any)
+	flat := make(map[string]any)
+	flattenOutputValue("", row, flat)
+	cells := make([]string, len(columns))
+	for i, c := range columns {
+		if v, ok := m[c]; ok {
+			cells[i] = formatOutputValue(v)
+			continue
+		}
+		cells[i] = formatOutputValue(flat[c])
+	}
+	return cells
+}
+
+// selectFields returns the selected fields of a row keyed by their path. Fields
+// missing from the row are null
+func (o *outputWriter) selectFields(row any) any {
+	selected := make(map[string]any, len(o.fields))
+	for _, f := range o.fields {
+		selected[f] = lookupOutputValue(row, f)
+	}
+	return selected
+}
+
+// toOutputValue converts a response into generic JSON values
+func toOutputValue(in any) (any, error) {
+	var j []byte
+	var err error
+	if m, ok := in.(proto.Message); ok {
+		j, err = responseMarshaler.Marshal(m)
+	} else {
+		j, err = json.Marshal(in)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var v any
+	return v, json.Unmarshal(j, &v)
+}
+
+// outputRows splits a response into rows, reporting whether it was a list
+func outputRows(v any) ([]any, bool) {
+	switch t := v.(type) {
+	case []any:
+		return t, true
+	case map[string]any:
+		if len(t) == 1 {
+			for _, f := range t {
+				if rows, ok := f.([]any); ok {
+					return rows, true
+				}
+			}
+		}
+	}
+	return []any{v}, false
+}
+
+// flattenOutputValue stores the values nested in v by their dot separated path.
+// Lists are kept as single values
+func flattenOutputValue(path string, v any, flat map[string]any) {
+	m, ok := v.(map[string]any)
+	if !ok || len(m) == 0 {
+		if path == "" {
+			path = "value"
+		}
+		flat[path] = v
+		return
+	}
+	for k, val := range m {
+		if path != "" {
+			k = path + "." + k
+		}
+		flattenOutputValue(k, val, flat)
+	}
+}
+
+// lookupOutputValue returns the value at a dot separated path, where list
+// elements are addressed by index
+func lookupOutputValue(v any, path string) any {
+	for p := range strings.SplitSeq(path, ".") {
+		switch t := v.(type) {
+		case map[string]any:
+			v = t[p]
+		case []any:
+			i, err := strconv.Atoi(p)
+			if err != nil || i < 0 || i >= len(t) {
+				return nil
+			}
+			v = t[i]
+		default:
+			return nil
+		}
+	}
+	return v
+}
+
+// formatOutputValue formats a value as a csv or table cell, with lists and
+// objects as compact JSON
+func formatOutputValue(v any) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(t)
+	default:
+		j, err := json.Marshal(t)
+		if err != nil {
+			return fmt.Sprint(t)
+		}
+		return string(j)
+	}
+}
@@ -232,8 +232,7 @@ func enableDisableExchangePair(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func getExchangePairs(c *cli.Context) error {
@@ -277,8 +276,7 @@ func getExchangePairs(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func enableDisableExchangeAsset(c *cli.Context) error {
@@ -324,8 +322,7 @@ func enableDisableExchangeAsset(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func enableDisableAllExchangePairs(c *cli.Context) error {
@@ -357,8 +354,7 @@ func enableDisableAllExchangePairs(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func updateExchangeSupportedPairs(c *cli.Context) error {
@@ -388,8 +384,7 @@ func updateExchangeSupportedPairs(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func getExchangeAssets(c *cli.Context) error {
@@ -419,6 +414,5 @@ func getExchangeAssets(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
@@ -346,8 +346,7 @@ func getTecnicalAnalysis(c *cli.Context, algo string) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func getBollingerBands(c *cli.Context) error {
@@ -502,8 +501,7 @@ func getBollingerBands(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func getMACD(c *cli.Context) error {
@@ -642,8 +640,7 @@ func getMACD(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func getCoco(c *cli.Context) error {
@@ -795,6 +792,5 @@ func getCoco(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
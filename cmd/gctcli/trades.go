@@ -299,8 +299,7 @@ func findMissingSavedTradeIntervals(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func setExchangeTradeProcessing(c *cli.Context) error {
@@ -342,8 +341,7 @@ func setExchangeTradeProcessing(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func getSavedTrades(c *cli.Context) error {
@@ -432,8 +430,7 @@ func getSavedTrades(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func getRecentTrades(c *cli.Context) error {
@@ -494,8 +491,7 @@ func getRecentTrades(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func getHistoricTrades(c *cli.Context) error {
@@ -724,6 +720,5 @@ func convertSavedTradesToCandles(c *cli.Context) error {
 		return err
 	}
 
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
@@ -0,0 +1,662 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+	"golang.org/x/term"
+	"google.golang.org/grpc"
+)
+
+var (
+	errTUINotTerminal   = errors.New("tui requires an interactive terminal")
+	errTUINoExchanges   = errors.New("no exchanges are enabled")
+	errTUINoOrder       = errors.New("no open order selected")
+	errTUIInvalidNumber = errors.New("invalid number")
+)
+
+var tuiCommand = &cli.Command{
+	Name:      "tui",
+	Usage:     "opens an interactive terminal UI showing live orderbooks, tickers, orders, positions, balances and subsystem status",
+	ArgsUsage: "<exchange> <pair> <asset>",
+	Action:    runTUI,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to select on start",
+		},
+		&cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair to select on start",
+		},
+		&cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type to select on start",
+		},
+		&cli.IntFlag{
+			Name:  "depth",
+			Value: 10,
+			Usage: "the number of orderbook levels shown on each side",
+		},
+		&cli.DurationFlag{
+			Name:  "refresh",
+			Value: 5 * time.Second,
+			Usage: "how often positions and subsystem status are refreshed and failed streams are retried",
+		},
+	},
+}
+
+// tuiMarket is an enabled pair of an exchange which can be selected
+type tuiMarket struct {
+	asset string
+	pair  currency.Pair
+}
+
+// tuiPrompt collects input from the status line. Confirmation prompts accept a
+// single y or n keypress
+type tuiPrompt struct {
+	label   string
+	input   string
+	confirm bool
+	done    func(input string)
+}
+
+// tui holds the state of the interactive terminal UI. Streams and pollers
+// update it concurrently and request a redraw
+type tui struct {
+	client  gctrpc.GoCryptoTraderServiceClient
+	ctx     context.Context
+	depth   int
+	refresh time.Duration
+	redraw  chan struct{}
+
+	m            sync.Mutex
+	exchanges    []string
+	exchIdx      int
+	markets      map[string][]tuiMarket
+	marketIdx    int
+	ticker       *gctrpc.TickerResponse
+	orderbook    *gctrpc.OrderbookResponse
+	balances     *gctrpc.GetAccountBalancesResponse
+	orders       map[string]*gctrpc.OrderDetails
+	orderIdx     int
+	positions    []*gctrpc.FuturePosition
+	subsystems   map[string]bool
+	errs         map[string]string
+	status       string
+	statusErr    bool
+	prompt       *tuiPrompt
+	streamCancel context.CancelFunc
+}
+
+func runTUI(c *cli.Context) error {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return errTUINotTerminal
+	}
+
+	// The UI runs until closed, so only unary calls are given a timeout
+	ignoreTimeout = true
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	ctx, stop := context.WithCancel(c.Context)
+	defer stop()
+	t := &tui{
+		client:  gctrpc.NewGoCryptoTraderServiceClient(conn),
+		ctx:     ctx,
+		depth:   c.Int("depth"),
+		refresh: c.Duration("refresh"),
+		redraw:  make(chan struct{}, 1),
+		markets: make(map[string][]tuiMarket),
+		orders:  make(map[string]*gctrpc.OrderDetails),
+		errs:    make(map[string]string),
+	}
+	if t.refresh <= 0 {
+		t.refresh = 5 * time.Second
+	}
+
+	exchangeName := c.String("exchange")
+	if exchangeName == "" {
+		exchangeName = c.Args().First()
+	}
+	pair := c.String("pair")
+	if pair == "" {
+		pair = c.Args().Get(1)
+	}
+	assetType := c.String("asset")
+	if assetType == "" {
+		assetType = c.Args().Get(2)
+	}
+	if err := t.loadExchanges(exchangeName, pair, assetType); err != nil {
+		return err
+	}
+
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := term.Restore(fd, state); err != nil {
+			fmt.Println(err)
+		}
+	}()
+	out := bufio.NewWriter(os.Stdout)
+	// Use the alternate screen with the cursor hidden and line wrapping off so
+	// that long lines are clipped
+	fmt.Fprint(out, "\033[?1049h\033[?25l\033[?7l")
+	defer func() {
+		fmt.Fprint(out, "\033[?7h\033[?25h\033[?1049l")
+		if err := out.Flush(); err != nil {
+			fmt.Println(err)
+		}
+	}()
+
+	keys := make(chan string)
+	go readTUIKeys(os.Stdin, keys)
+	go t.streamOrders()
+	go t.poll()
+	t.selectMarket()
+
+	// Redraw periodically as well so that terminal resizes are picked up
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		if err := t.render(out); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case k, ok := <-keys:
+			if !ok || !t.handleKey(k) {
+				return nil
+			}
+		case <-t.redraw:
+		case <-ticker.C:
+		}
+	}
+}
+
+// requestRedraw asks the UI loop to redraw without blocking
+func (t *tui) requestRedraw() {
+	select {
+	case t.redraw <- struct{}{}:
+	default:
+	}
+}
+
+// setStatus shows a message on the status line
+func (t *tui) setStatus(isErr bool, format string, args ...any) {
+	t.m.Lock()
+	t.status, t.statusErr = fmt.Sprintf(format, args...), isErr
+	t.m.Unlock()
+	t.requestRedraw()
+}
+
+// setErr records or clears the error shown in place of a section's data
+func (t *tui) setErr(section string, err error) {
+	t.m.Lock()
+	if err == nil {
+		delete(t.errs, section)
+	} else {
+		t.errs[section] = err.Error()
+	}
+	t.m.Unlock()
+	t.requestRedraw()
+}
+
+// loadExchanges loads the enabled exchanges and selects the requested market,
+// or the first enabled pair of the first exchange
+func (t *tui) loadExchanges(exchangeName, pair, assetType string) error {
+	ctx, cancel := context.WithTimeout(t.ctx, timeout)
+	defer cancel()
+	resp, err := t.client.GetExchanges(ctx, &gctrpc.GetExchangesRequest{Enabled: true})
+	if err != nil {
+		return err
+	}
+	if resp.Exchanges == "" {
+		return errTUINoExchanges
+	}
+	t.exchanges = strings.Split(resp.Exchanges, ",")
+	if exchangeName != "" {
+		t.exchIdx = max(slices.IndexFunc(t.exchanges, func(e string) bool { return strings.EqualFold(e, exchangeName) }), 0)
+	}
+	if err := t.loadMarkets(); err != nil {
+		return err
+	}
+	if pair == "" {
+		return nil
+	}
+	p, err := currency.NewPairDelimiter(pair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+	markets := t.markets[t.exchanges[t.exchIdx]]
+	t.marketIdx = max(slices.IndexFunc(markets, func(m tuiMarket) bool {
+		return m.pair.Equal(p) && (assetType == "" || strings.EqualFold(m.asset, assetType))
+	}), 0)
+	return nil
+}
+
+// loadMarkets loads the enabled pairs of the selected exchange if they have not
+// been loaded already
+func (t *tui) loadMarkets() error {
+	exch := t.exchanges[t.exchIdx]
+	t.m.Lock()
+	_, ok := t.markets[exch]
+	t.m.Unlock()
+	if ok {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(t.ctx, timeout)
+	defer cancel()
+	resp, err := t.client.GetExchangePairs(ctx, &gctrpc.GetExchangePairsRequest{Exchange: exch})
+	if err != nil {
+		return err
+	}
+	var markets []tuiMarket
+	for a, pairs := range resp.SupportedAssets {
+		if pairs.EnabledPairs == "" {
+			continue
+		}
+		for p := range strings.SplitSeq(pairs.EnabledPairs, ",") {
+			cp, err := currency.NewPairFromString(p)
+			if err != nil {
+				return err
+			}
+			markets = append(markets, tuiMarket{asset: a, pair: cp})
+		}
+	}
+	slices.SortFunc(markets, func(a, b tuiMarket) int {
+		if c := strings.Compare(a.asset, b.asset); c != 0 {
+			return c
+		}
+		return strings.Compare(a.pair.String(), b.pair.String())
+	})
+	t.m.Lock()
+	t.markets[exch] = markets
+	t.m.Unlock()
+	return nil
+}
+
+// market returns the selected exchange and market, which is false when the
+// exchange has no enabled pairs
+func (t *tui) market() (string, tuiMarket, bool) {
+	exch := t.exchanges[t.exchIdx]
+	markets := t.markets[exch]
+	if t.marketIdx >= len(markets) {
+		return exch, tuiMarket{}, false
+	}
+	return exch, markets[t.marketIdx], true
+}
+
+// selectMarket restarts the market and balance streams for the selected
+// exchange and market, and loads its open orders
+func (t *tui) selectMarket() {
+	t.m.Lock()
+	if t.streamCancel != nil {
+		t.streamCancel()
+	}
+	ctx, cancel := context.WithCancel(t.ctx)
+	t.streamCancel = cancel
+	t.ticker, t.orderbook, t.balances = nil, nil, nil
+	for _, section := range []string{"ticker", "orderbook", "balances"} {
+		delete(t.errs, section)
+	}
+	exch, m, ok := t.market()
+	t.m.Unlock()
+	t.requestRedraw()
+	if !ok {
+		return
+	}
+
+	pair := &gctrpc.CurrencyPair{
+		Base:      m.pair.Base.String(),
+		Quote:     m.pair.Quote.String(),
+		Delimiter: m.pair.Delimiter,
+	}
+	go tuiStream(t, ctx, "ticker", func(ctx context.Context) (grpc.ServerStreamingClient[gctrpc.TickerResponse], error) {
+		return t.client.GetTickerStream(ctx, &gctrpc.GetTickerStreamRequest{Exchange: exch, Pair: pair, AssetType: m.asset})
+	}, func(resp *gctrpc.TickerResponse) {
+		t.ticker = resp
+	})
+	go tuiStream(t, ctx, "orderbook", func(ctx context.Context) (grpc.ServerStreamingClient[gctrpc.OrderbookResponse], error) {
+		return t.client.GetOrderbookStream(ctx, &gctrpc.GetOrderbookStreamRequest{Exchange: exch, Pair: pair, AssetType: m.asset})
+	}, func(resp *gctrpc.OrderbookResponse) {
+		t.orderbook = resp
+	})
+	go tuiStream(t, ctx, "balances", func(ctx context.Context) (grpc.ServerStreamingClient[gctrpc.GetAccountBalancesResponse], error) {
+		return t.client.GetAccountBalancesStream(ctx, &gctrpc.GetAccountBalancesRequest{Exchange: exch, AssetType: m.asset})
+	}, func(resp *gctrpc.GetAccountBalancesResponse) {
+		t.balances = resp
+	})
+	go func() {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		resp, err := t.client.GetManagedOrders(ctx, &gctrpc.GetOrdersRequest{Exchange: exch, AssetType: m.asset, Pair: pair})
+		if err != nil {
+			if ctx.Err() == nil {
+				t.setErr("orders", err)
+			}
+			return
+		}
+		t.m.Lock()
+		for _, o := range resp.Orders {
+			t.updateOrder(o)
+		}
+		t.m.Unlock()
+		t.setErr("orders", nil)
+	}()
+}
+
+// tuiStream relays a stream's messages to update until ctx is cancelled,
+// reopening it after the refresh interval when it fails
+func tuiStream[T any](t *tui, ctx context.Context, section string, open func(context.Context) (grpc.ServerStreamingClient[T], error), update func(*T)) {
+	for {
+		stream, err := open(ctx)
+		for err == nil {
+			var resp *T
+			if resp, err = stream.Recv(); err == nil {
+				t.m.Lock()
+				update(resp)
+				delete(t.errs, section)
+				t.m.Unlock()
+				t.requestRedraw()
+			}
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		if !errors.Is(err, io.EOF) {
+			t.setErr(section, err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(t.refresh):
+		}
+	}
+}
+
+// streamOrders keeps open orders of all exchanges up to date
+func (t *tui) streamOrders() {
+	tuiStream(t, t.ctx, "orders", func(ctx context.Context) (grpc.ServerStreamingClient[gctrpc.OrderDetails], error) {
+		return t.client.GetOrderUpdatesStream(ctx, &gctrpc.GetOrderUpdatesStreamRequest{})
+	}, t.updateOrder)
+}
+
+// updateOrder stores an open order, or removes it once it is no longer open.
+// The caller must hold the lock
+func (t *tui) updateOrder(o *gctrpc.OrderDetails) {
+	key := o.Exchange + " " + o.Id
+	if s, err := order.StringToOrderStatus(o.Status); err == nil && s.IsInactive() {
+		delete(t.orders, key)
+		return
+	}
+	t.orders[key] = o
+}
+
+// poll refreshes positions and subsystem status, which have no streams
+func (t *tui) poll() {
+	ticker := time.NewTicker(t.refresh)
+	defer ticker.Stop()
+	for {
+		ctx, cancel := context.WithTimeout(t.ctx, timeout)
+		positions, err := t.client.GetAllManagedPositions(ctx, &gctrpc.GetAllManagedPositionsRequest{})
+		t.setErr("positions", err)
+		if err == nil {
+			t.m.Lock()
+			t.positions = positions.Positions
+			t.m.Unlock()
+		}
+		subsystems, err := t.client.GetSubsystems(ctx, &gctrpc.GetSubsystemsRequest{})
+		t.setErr("subsystems", err)
+		if err == nil {
+			t.m.Lock()
+			t.subsystems = subsystems.SubsystemsStatus
+			t.m.Unlock()
+		}
+		cancel()
+		select {
+		case <-t.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// handleKey applies a keypress, returning false when the UI should close
+func (t *tui) handleKey(k string) bool {
+	t.m.Lock()
+	if p := t.prompt; p != nil {
+		done := t.handlePromptKey(p, k)
+		t.m.Unlock()
+		if done != nil {
+			go done()
+		}
+		return true
+	}
+	t.m.Unlock()
+
+	switch k {
+	case "q", keyCtrlC:
+		return false
+	case keyLeft, "h":
+		t.moveExchange(-1)
+	case keyRight, "l":
+		t.moveExchange(1)
+	case keyUp, "k":
+		t.moveMarket(-1)
+	case keyDown, "j":
+		t.moveMarket(1)
+	case keyTab:
+		t.moveOrder(1)
+	case keyBackTab:
+		t.moveOrder(-1)
+	case "c":
+		t.cancelSelectedOrder()
+	case "m":
+		t.modifySelectedOrder()
+	case "r":
+		t.selectMarket()
+	}
+	return true
+}
+
+// handlePromptKey applies a keypress to the open prompt, returning its
+// completion to run once the prompt is closed. The caller must hold the lock
+func (t *tui) handlePromptKey(p *tuiPrompt, k string) func() {
+	if p.confirm {
+		t.prompt = nil
+		if k == "y" || k == "Y" {
+			return func() { p.done("y") }
+		}
+		return nil
+	}
+	switch k {
+	case keyEsc, keyCtrlC:
+		t.prompt = nil
+	case keyEnter:
+		t.prompt = nil
+		return func() { p.done(p.input) }
+	case keyBackspace:
+		if p.input != "" {
+			p.input = p.input[:len(p.input)-1]
+		}
+	default:
+		if len(k) == 1 {
+			p.input += k
+		}
+	}
+	return nil
+}
+
+func (t *tui) moveExchange(delta int) {
+	t.m.Lock()
+	t.exchIdx = (t.exchIdx + delta + len(t.exchanges)) % len(t.exchanges)
+	t.marketIdx = 0
+	t.m.Unlock()
+	if err := t.loadMarkets(); err != nil {
+		t.setStatus(true, "Failed to load %s pairs: %s", t.exchanges[t.exchIdx], err)
+	}
+	t.selectMarket()
+}
+
+func (t *tui) moveMarket(delta int) {
+	t.m.Lock()
+	n := len(t.markets[t.exchanges[t.exchIdx]])
+	if n == 0 {
+		t.m.Unlock()
+		return
+	}
+	t.marketIdx = (t.marketIdx + delta + n) % n
+	t.m.Unlock()
+	t.selectMarket()
+}
+
+func (t *tui) moveOrder(delta int) {
+	t.m.Lock()
+	if n := len(t.orders); n > 0 {
+		t.orderIdx = (t.orderIdx + delta + n) % n
+	}
+	t.m.Unlock()
+	t.requestRedraw()
+}
+
+// sortedOrders returns open orders in display order. The caller must hold the
+// lock
+func (t *tui) sortedOrders() []*gctrpc.OrderDetails {
+	orders := make([]*gctrpc.OrderDetails, 0, len(t.orders))
+	for _, o := range t.orders {
+		orders = append(orders, o)
+	}
+	slices.SortFunc(orders, func(a, b *gctrpc.OrderDetails) int {
+		if c := strings.Compare(a.Exchange, b.Exchange); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.CreationTime, b.CreationTime); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Id, b.Id)
+	})
+	return orders
+}
+
+// selectedOrder returns the selected open order. The caller must hold the lock
+func (t *tui) selectedOrder() (*gctrpc.OrderDetails, error) {
+	orders := t.sortedOrders()
+	if len(orders) == 0 {
+		return nil, errTUINoOrder
+	}
+	return orders[min(t.orderIdx, len(orders)-1)], nil
+}
+
+func (t *tui) cancelSelectedOrder() {
+	t.m.Lock()
+	defer t.m.Unlock()
+	o, err := t.selectedOrder()
+	if err != nil {
+		t.status, t.statusErr = err.Error(), true
+		return
+	}
+	t.prompt = &tuiPrompt{
+		label:   fmt.Sprintf("Cancel %s order %s? (y/n)", o.Exchange, o.Id),
+		confirm: true,
+		done: func(string) {
+			ctx, cancel := context.WithTimeout(t.ctx, timeout)
+			defer cancel()
+			_, err := t.client.CancelOrder(ctx, &gctrpc.CancelOrderRequest{
+				Exchange:  o.Exchange,
+				OrderId:   o.Id,
+				Pair:      &gctrpc.CurrencyPair{Base: o.BaseCurrency, Quote: o.QuoteCurrency, Delimiter: pairDelimiter},
+				AssetType: o.AssetType,
+				Side:      o.OrderSide,
+			})
+			if err != nil {
+				t.setStatus(true, "Cancel order %s failed: %s", o.Id, err)
+				return
+			}
+			t.setStatus(false, "Cancelled order %s", o.Id)
+		},
+	}
+}
+
+// modifySelectedOrder prompts for the new price then the new amount of the
+// selected order. Blank input keeps the current value
+func (t *tui) modifySelectedOrder() {
+	t.m.Lock()
+	defer t.m.Unlock()
+	o, err := t.selectedOrder()
+	if err != nil {
+		t.status, t.statusErr = err.Error(), true
+		return
+	}
+	t.prompt = &tuiPrompt{
+		label: fmt.Sprintf("New price for %s order %s (blank keeps %s): ", o.Exchange, o.Id, formatTUIFloat(o.Price)),
+		done: func(priceInput string) {
+			price, err := parseTUIFloat(priceInput, o.Price)
+			if err != nil {
+				t.setStatus(true, "%s", err)
+				return
+			}
+			t.m.Lock()
+			t.prompt = &tuiPrompt{
+				label: fmt.Sprintf("New amount (blank keeps %s): ", formatTUIFloat(o.Amount)),
+				done: func(amountInput string) {
+					amount, err := parseTUIFloat(amountInput, o.Amount)
+					if err != nil {
+						t.setStatus(true, "%s", err)
+						return
+					}
+					ctx, cancel := context.WithTimeout(t.ctx, timeout)
+					defer cancel()
+					_, err = t.client.ModifyOrder(ctx, &gctrpc.ModifyOrderRequest{
+						Exchange: o.Exchange,
+						OrderId:  o.Id,
+						Pair:     &gctrpc.CurrencyPair{Base: o.BaseCurrency, Quote: o.QuoteCurrency, Delimiter: pairDelimiter},
+						Asset:    o.AssetType,
+						Price:    price,
+						Amount:   amount,
+					})
+					if err != nil {
+						t.setStatus(true, "Modify order %s failed: %s", o.Id, err)
+						return
+					}
+					t.setStatus(false, "Modified order %s", o.Id)
+				},
+			}
+			t.m.Unlock()
+			t.requestRedraw()
+		},
+	}
+}
+
+// parseTUIFloat parses prompt input, returning current for blank input
+func parseTUIFloat(input string, current float64) (float64, error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return current, nil
+	}
+	f, err := strconv.ParseFloat(input, 64)
+	if err != nil || f <= 0 {
+		return 0, fmt.Errorf("%w %q", errTUIInvalidNumber, input)
+	}
+	return f, nil
+}
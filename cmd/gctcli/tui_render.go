@@ -0,0 +1,316 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/term"
+)
+
+// Named keys parsed from terminal input. Other keys are passed as the
+// character typed
+const (
+	keyUp        = "up"
+	keyDown      = "down"
+	keyLeft      = "left"
+	keyRight     = "right"
+	keyTab       = "tab"
+	keyBackTab   = "backtab"
+	keyEnter     = "enter"
+	keyBackspace = "backspace"
+	keyEsc       = "esc"
+	keyCtrlC     = "ctrl-c"
+)
+
+const (
+	reverseText = "\033[7m"
+	tuiHelp     = "←/→ exchange  ↑/↓ pair  tab order  c cancel  m modify  r refresh  q quit"
+)
+
+// readTUIKeys sends keypresses read from a raw mode terminal until reading
+// fails
+func readTUIKeys(r io.Reader, keys chan<- string) {
+	defer close(keys)
+	buf := make([]byte, 64)
+	for {
+		n, err := r.Read(buf)
+		if err != nil {
+			return
+		}
+		for _, k := range parseTUIKeys(buf[:n]) {
+			keys <- k
+		}
+	}
+}
+
+// parseTUIKeys splits raw terminal input into keys, decoding the escape
+// sequences sent for arrow keys and shift+tab
+func parseTUIKeys(b []byte) []string {
+	var keys []string
+	for len(b) > 0 {
+		switch b[0] {
+		case 0x1b:
+			if len(b) >= 3 && (b[1] == '[' || b[1] == 'O') {
+				switch b[2] {
+				case 'A':
+					keys = append(keys, keyUp)
+				case 'B':
+					keys = append(keys, keyDown)
+				case 'C':
+					keys = append(keys, keyRight)
+				case 'D':
+					keys = append(keys, keyLeft)
+				case 'Z':
+					keys = append(keys, keyBackTab)
+				}
+				b = b[3:]
+				continue
+			}
+			keys = append(keys, keyEsc)
+		case 0x03:
+			keys = append(keys, keyCtrlC)
+		case '\t':
+			keys = append(keys, keyTab)
+		case '\r', '\n':
+			keys = append(keys, keyEnter)
+		case 0x7f, 0x08:
+			keys = append(keys, keyBackspace)
+		default:
+			r, size := utf8.DecodeRune(b)
+			keys = append(keys, string(r))
+			b = b[size:]
+			continue
+		}
+		b = b[1:]
+	}
+	return keys
+}
+
+// render redraws the screen, clipping sections which do not fit the terminal
+// with the status line always on the last row
+func (t *tui) render(out *bufio.Writer) error {
+	width, height, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		width, height = 120, 40
+	}
+
+	t.m.Lock()
+	lines := t.renderLines(width)
+	footer := t.renderFooter()
+	t.m.Unlock()
+
+	if len(lines) > height-1 {
+		lines = lines[:max(height-1, 0)]
+	}
+	fmt.Fprint(out, "\033[H")
+	for i := range lines {
+		fmt.Fprint(out, lines[i], defaultText, "\033[K\r\n")
+	}
+	fmt.Fprint(out, "\033[J", fmt.Sprintf("\033[%d;1H", height), footer, defaultText, "\033[K")
+	return out.Flush()
+}
+
+// renderLines returns the lines of each section. The caller must hold the lock
+func (t *tui) renderLines(width int) []string {
+	lines := []string{
+		whiteText + "GoCryptoTrader" + defaultText + "  " + time.Now().Format(time.DateTime),
+		t.renderExchanges(),
+	}
+	exch, m, ok := t.market()
+	if !ok {
+		lines = append(lines, grayText+"No enabled pairs for "+exch)
+	} else {
+		lines = append(lines, fmt.Sprintf("Pair: %s%s %s%s (%d/%d)", whiteText, m.pair, m.asset, defaultText, t.marketIdx+1, len(t.markets[exch])))
+	}
+	lines = append(lines, "")
+	lines = append(lines, t.renderTicker()...)
+	lines = append(lines, "")
+	lines = append(lines, t.renderOrderbook()...)
+	lines = append(lines, "")
+	lines = append(lines, t.renderOrders()...)
+	lines = append(lines, "")
+	lines = append(lines, t.renderPositions()...)
+	lines = append(lines, "")
+	lines = append(lines, t.renderBalances()...)
+	lines = append(lines, "")
+	lines = append(lines, t.renderSubsystems(width)...)
+	return lines
+}
+
+func (t *tui) renderExchanges() string {
+	var sb strings.Builder
+	sb.WriteString("Exchanges:")
+	for i, e := range t.exchanges {
+		sb.WriteString(" ")
+		if i == t.exchIdx {
+			sb.WriteString(reverseText + " " + e + " " + defaultText)
+			continue
+		}
+		sb.WriteString(" " + e + " ")
+	}
+	return sb.String()
+}
+
+// sectionTitle returns a section's title line and, when it failed to update,
+// its error
+func (t *tui) sectionTitle(section, title string) []string {
+	lines := []string{whiteText + title}
+	if err, ok := t.errs[section]; ok {
+		lines = append(lines, redText+"  "+err)
+	}
+	return lines
+}
+
+func (t *tui) renderTicker() []string {
+	lines := t.sectionTitle("ticker", "Ticker")
+	if t.ticker == nil {
+		return append(lines, grayText+"  waiting for data")
+	}
+	return append(lines, fmt.Sprintf("  Last %s  Bid %s  Ask %s  High %s  Low %s  Volume %s  %s",
+		formatTUIFloat(t.ticker.Last),
+		formatTUIFloat(t.ticker.Bid),
+		formatTUIFloat(t.ticker.Ask),
+		formatTUIFloat(t.ticker.High),
+		formatTUIFloat(t.ticker.Low),
+		formatTUIFloat(t.ticker.Volume),
+		grayText+time.Unix(t.ticker.LastUpdated, 0).Format(time.TimeOnly)))
+}
+
+func (t *tui) renderOrderbook() []string {
+	lines := t.sectionTitle("orderbook", "Orderbook")
+	if t.orderbook == nil {
+		return append(lines, grayText+"  waiting for data")
+	}
+	lines = append(lines, grayText+fmt.Sprintf("  %16s %16s | %-16s %-16s", "Bid amount", "Bid price", "Ask price", "Ask amount"))
+	rows := min(max(len(t.orderbook.Bids), len(t.orderbook.Asks)), t.depth)
+	for i := range rows {
+		bid, ask := strings.Repeat(" ", 33), ""
+		if i < len(t.orderbook.Bids) {
+			bid = fmt.Sprintf("%16s %16s", formatTUIFloat(t.orderbook.Bids[i].Amount), formatTUIFloat(t.orderbook.Bids[i].Price))
+		}
+		if i < len(t.orderbook.Asks) {
+			ask = fmt.Sprintf("%-16s %-16s", formatTUIFloat(t.orderbook.Asks[i].Price), formatTUIFloat(t.orderbook.Asks[i].Amount))
+		}
+		lines = append(lines, "  "+greenText+bid+defaultText+" | "+redText+ask)
+	}
+	return lines
+}
+
+func (t *tui) renderOrders() []string {
+	orders := t.sortedOrders()
+	lines := t.sectionTitle("orders", fmt.Sprintf("Open orders (%d)", len(orders)))
+	if len(orders) == 0 {
+		return lines
+	}
+	lines = append(lines, grayText+fmt.Sprintf("  %-12s %-12s %-8s %-20s %-5s %-8s %14s %14s %14s %s", "Exchange", "Pair", "Asset", "ID", "Side", "Type", "Price", "Amount", "Open", "Status"))
+	selected := min(t.orderIdx, len(orders)-1)
+	for i, o := range orders {
+		line := fmt.Sprintf("  %-12s %-12s %-8s %-20s %-5s %-8s %14s %14s %14s %s",
+			o.Exchange,
+			o.BaseCurrency+pairDelimiter+o.QuoteCurrency,
+			o.AssetType,
+			o.Id,
+			o.OrderSide,
+			o.OrderType,
+			formatTUIFloat(o.Price),
+			formatTUIFloat(o.Amount),
+			formatTUIFloat(o.OpenVolume),
+			o.Status)
+		if i == selected {
+			line = reverseText + line
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func (t *tui) renderPositions() []string {
+	lines := t.sectionTitle("positions", fmt.Sprintf("Positions (%d)", len(t.positions)))
+	if len(t.positions) == 0 {
+		return lines
+	}
+	lines = append(lines, grayText+fmt.Sprintf("  %-12s %-12s %-10s %-6s %14s %14s %14s %14s %s", "Exchange", "Pair", "Asset", "Side", "Size", "Price", "Unrealised", "Realised", "Status"))
+	for _, p := range t.positions {
+		var pair string
+		if p.Pair != nil {
+			pair = p.Pair.Base + pairDelimiter + p.Pair.Quote
+		}
+		lines = append(lines, fmt.Sprintf("  %-12s %-12s %-10s %-6s %14s %14s %14s %14s %s",
+			p.Exchange, pair, p.Asset, p.CurrentDirection, p.CurrentSize, p.CurrentPrice, p.UnrealisedPnl, p.RealisedPnl, p.Status))
+	}
+	return lines
+}
+
+func (t *tui) renderBalances() []string {
+	lines := t.sectionTitle("balances", "Balances")
+	if t.balances == nil {
+		return append(lines, grayText+"  waiting for data")
+	}
+	lines = append(lines, grayText+fmt.Sprintf("  %-16s %-10s %18s %18s %18s", "Account", "Currency", "Total", "Hold", "Free"))
+	for _, a := range t.balances.Accounts {
+		for _, c := range a.Currencies {
+			if c.TotalValue == 0 {
+				continue
+			}
+			lines = append(lines, fmt.Sprintf("  %-16s %-10s %18s %18s %18s", a.Id, c.Currency, formatTUIFloat(c.TotalValue), formatTUIFloat(c.Hold), formatTUIFloat(c.Free)))
+		}
+	}
+	return lines
+}
+
+// renderSubsystems lists subsystems across as many lines as needed to fit the
+// width
+func (t *tui) renderSubsystems(width int) []string {
+	lines := t.sectionTitle("subsystems", "Subsystems")
+	names := make([]string, 0, len(t.subsystems))
+	for name := range t.subsystems {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	var line strings.Builder
+	lineWidth := 0
+	for _, name := range names {
+		colour := redText
+		if t.subsystems[name] {
+			colour = greenText
+		}
+		if lineWidth > 0 && lineWidth+len(name)+3 > width {
+			lines = append(lines, line.String())
+			line.Reset()
+			lineWidth = 0
+		}
+		line.WriteString("  " + colour + "● " + defaultText + name)
+		lineWidth += len(name) + 4
+	}
+	if lineWidth > 0 {
+		lines = append(lines, line.String())
+	}
+	return lines
+}
+
+// renderFooter returns the prompt when one is open, otherwise the status
+// message and key help. The caller must hold the lock
+func (t *tui) renderFooter() string {
+	if t.prompt != nil {
+		return whiteText + t.prompt.label + t.prompt.input
+	}
+	if t.status == "" {
+		return grayText + tuiHelp
+	}
+	colour := greenText
+	if t.statusErr {
+		colour = redText
+	}
+	return colour + t.status + defaultText + "  " + grayText + tuiHelp
+}
+
+func formatTUIFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -0,0 +1,415 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"google.golang.org/grpc"
+)
+
+var errTUITest = errors.New("test error")
+
+// tuiTestClient serves enabled pairs and records order requests. Streams fail
+// so that the UI waits for the refresh interval before retrying
+type tuiTestClient struct {
+	gctrpc.GoCryptoTraderServiceClient
+
+	pairs    map[string]*gctrpc.GetExchangePairsResponse
+	m        sync.Mutex
+	cancels  []*gctrpc.CancelOrderRequest
+	modifies []*gctrpc.ModifyOrderRequest
+}
+
+func (c *tuiTestClient) GetExchangePairs(_ context.Context, r *gctrpc.GetExchangePairsRequest, _ ...grpc.CallOption) (*gctrpc.GetExchangePairsResponse, error) {
+	if resp, ok := c.pairs[r.Exchange]; ok {
+		return resp, nil
+	}
+	return nil, errTUITest
+}
+
+func (c *tuiTestClient) GetTickerStream(context.Context, *gctrpc.GetTickerStreamRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[gctrpc.TickerResponse], error) {
+	return nil, errTUITest
+}
+
+func (c *tuiTestClient) GetOrderbookStream(context.Context, *gctrpc.GetOrderbookStreamRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[gctrpc.OrderbookResponse], error) {
+	return nil, errTUITest
+}
+
+func (c *tuiTestClient) GetAccountBalancesStream(context.Context, *gctrpc.GetAccountBalancesRequest, ...grpc.CallOption) (grpc.ServerStreamingClient[gctrpc.GetAccountBalancesResponse], error) {
+	return nil, errTUITest
+}
+
+func (c *tuiTestClient) GetManagedOrders(context.Context, *gctrpc.GetOrdersRequest, ...grpc.CallOption) (*gctrpc.GetOrdersResponse, error) {
+	return &gctrpc.GetOrdersResponse{}, nil
+}
+
+func (c *tuiTestClient) CancelOrder(_ context.Context, r *gctrpc.CancelOrderRequest, _ ...grpc.CallOption) (*gctrpc.GenericResponse, error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.cancels = append(c.cancels, r)
+	return &gctrpc.GenericResponse{}, nil
+}
+
+func (c *tuiTestClient) ModifyOrder(_ context.Context, r *gctrpc.ModifyOrderRequest, _ ...grpc.CallOption) (*gctrpc.ModifyOrderResponse, error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.modifies = append(c.modifies, r)
+	return &gctrpc.ModifyOrderResponse{}, nil
+}
+
+// newTestTUI returns a tui with binance markets loaded, and bybit and okx
+// markets served by the client
+func newTestTUI(t *testing.T, orders ...*gctrpc.OrderDetails) (*tui, *tuiTestClient) {
+	t.Helper()
+	c := &tuiTestClient{pairs: map[string]*gctrpc.GetExchangePairsResponse{
+		"bybit": {SupportedAssets: map[string]*gctrpc.PairsSupported{"spot": {EnabledPairs: "BTC-USDT"}, "margin": {}}},
+		"okx":   {},
+	}}
+	tu := &tui{
+		client:  c,
+		ctx:     t.Context(),
+		depth:   10,
+		refresh: time.Hour,
+		redraw:  make(chan struct{}, 1),
+		markets: map[string][]tuiMarket{"binance": {
+			{asset: "spot", pair: currency.NewBTCUSDT()},
+			{asset: "spot", pair: currency.NewPair(currency.ETH, currency.USDT)},
+			{asset: "margin", pair: currency.NewBTCUSDT()},
+		}},
+		exchanges: []string{"binance", "bybit", "okx"},
+		orders:    make(map[string]*gctrpc.OrderDetails),
+		errs:      make(map[string]string),
+	}
+	for _, o := range orders {
+		tu.updateOrder(o)
+	}
+	return tu, c
+}
+
+// promptLabel returns the label of the open prompt, if any
+func (t *tui) promptLabel() string {
+	t.m.Lock()
+	defer t.m.Unlock()
+	if t.prompt == nil {
+		return ""
+	}
+	return t.prompt.label
+}
+
+var testTUIOrders = []*gctrpc.OrderDetails{
+	{Exchange: "binance", Id: "1", BaseCurrency: "BTC", QuoteCurrency: "USDT", AssetType: "spot", OrderSide: "BUY", OrderType: "LIMIT", CreationTime: "2025-01-01 00:00:00", Status: "NEW", Price: 20000, Amount: 0.5, OpenVolume: 0.25},
+	{Exchange: "binance", Id: "2", BaseCurrency: "ETH", QuoteCurrency: "USDT", AssetType: "spot", OrderSide: "SELL", OrderType: "LIMIT", CreationTime: "2025-01-02 00:00:00", Status: "NEW", Price: 3000, Amount: 2, OpenVolume: 2},
+}
+
+func TestParseTUIKeys(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		in   string
+		keys []string
+	}{
+		{in: ""},
+		{in: "q", keys: []string{"q"}},
+		{in: "hjkl", keys: []string{"h", "j", "k", "l"}},
+		{in: "€y", keys: []string{"€", "y"}},
+		{in: "\x1b[A\x1b[B\x1b[C\x1b[D", keys: []string{keyUp, keyDown, keyRight, keyLeft}},
+		{in: "\x1bOA\x1bOB\x1bOC\x1bOD", keys: []string{keyUp, keyDown, keyRight, keyLeft}},
+		{in: "\x1b[Z", keys: []string{keyBackTab}},
+		{in: "\x1b[5~", keys: []string{"~"}},
+		{in: "\x1b", keys: []string{keyEsc}},
+		{in: "\x1bx", keys: []string{keyEsc, "x"}},
+		{in: "\x03", keys: []string{keyCtrlC}},
+		{in: "\t", keys: []string{keyTab}},
+		{in: "\r\n", keys: []string{keyEnter, keyEnter}},
+		{in: "\x7f\x08", keys: []string{keyBackspace, keyBackspace}},
+		{in: "1.5\x7f\r", keys: []string{"1", ".", "5", keyBackspace, keyEnter}},
+	} {
+		assert.Equalf(t, tc.keys, parseTUIKeys([]byte(tc.in)), "parseTUIKeys should return the correct keys for %q", tc.in)
+	}
+}
+
+func TestTUIHandleKeyNavigation(t *testing.T) {
+	t.Parallel()
+	tu, _ := newTestTUI(t, testTUIOrders...)
+	// Each key is applied to the state left by the previous key
+	for _, tc := range []struct {
+		key      string
+		exchIdx  int
+		market   int
+		orderIdx int
+	}{
+		{key: keyDown, market: 1},
+		{key: "j", market: 2},
+		{key: "j"},
+		{key: keyUp, market: 2},
+		{key: "k", market: 1},
+		{key: keyTab, market: 1, orderIdx: 1},
+		{key: keyTab, market: 1},
+		{key: keyBackTab, market: 1, orderIdx: 1},
+		{key: "x", market: 1, orderIdx: 1},
+		{key: keyRight, exchIdx: 1, orderIdx: 1},
+		{key: keyDown, exchIdx: 1, orderIdx: 1},
+		{key: "l", exchIdx: 2, orderIdx: 1},
+		{key: "j", exchIdx: 2, orderIdx: 1},
+		{key: "l", orderIdx: 1},
+		{key: keyLeft, exchIdx: 2, orderIdx: 1},
+		{key: "h", exchIdx: 1, orderIdx: 1},
+	} {
+		require.Truef(t, tu.handleKey(tc.key), "handleKey must not close the UI for key %q", tc.key)
+		assert.Equalf(t, tc.exchIdx, tu.exchIdx, "exchIdx should be correct after key %q", tc.key)
+		assert.Equalf(t, tc.market, tu.marketIdx, "marketIdx should be correct after key %q", tc.key)
+		assert.Equalf(t, tc.orderIdx, tu.orderIdx, "orderIdx should be correct after key %q", tc.key)
+	}
+	assert.Len(t, tu.markets["bybit"], 1, "Markets should be loaded when an exchange is selected")
+	_, ok := tu.markets["okx"]
+	assert.True(t, ok, "Exchanges without enabled pairs should be loaded")
+
+	for _, k := range []string{"q", keyCtrlC} {
+		assert.Falsef(t, tu.handleKey(k), "handleKey should close the UI for key %q", k)
+	}
+}
+
+func TestTUIHandleKeyCancelOrder(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name     string
+		orders   []*gctrpc.OrderDetails
+		keys     []string
+		label    string
+		status   string
+		cancelID string
+	}{
+		{name: "no orders", keys: []string{"c"}, status: errTUINoOrder.Error()},
+		{name: "prompt", orders: testTUIOrders, keys: []string{"c"}, label: "Cancel binance order 1? (y/n)"},
+		{name: "declined", orders: testTUIOrders, keys: []string{"c", "n"}},
+		{name: "confirmed", orders: testTUIOrders, keys: []string{"c", "y"}, status: "Cancelled order 1", cancelID: "1"},
+		{name: "selected", orders: testTUIOrders, keys: []string{keyTab, "c", "Y"}, status: "Cancelled order 2", cancelID: "2"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			tu, c := newTestTUI(t, tc.orders...)
+			for _, k := range tc.keys {
+				require.Truef(t, tu.handleKey(k), "handleKey must not close the UI for key %q", k)
+			}
+			assert.Equal(t, tc.label, tu.promptLabel(), "prompt label should be correct")
+			require.Eventually(t, func() bool {
+				tu.m.Lock()
+				defer tu.m.Unlock()
+				return tu.status == tc.status
+			}, time.Second, time.Millisecond, "status must be set")
+
+			c.m.Lock()
+			defer c.m.Unlock()
+			if tc.cancelID == "" {
+				assert.Empty(t, c.cancels, "CancelOrder should not be called")
+				return
+			}
+			require.Len(t, c.cancels, 1, "CancelOrder must be called once")
+			assert.Equal(t, tc.cancelID, c.cancels[0].OrderId, "OrderId should be the selected order's")
+			assert.Equal(t, "binance", c.cancels[0].Exchange, "Exchange should be the selected order's")
+			assert.Equal(t, "spot", c.cancels[0].AssetType, "AssetType should be the selected order's")
+		})
+	}
+}
+
+func TestTUIHandleKeyModifyOrder(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name   string
+		orders []*gctrpc.OrderDetails
+		price  []string
+		amount []string
+		status string
+		modify *gctrpc.ModifyOrderRequest
+	}{
+		{name: "no orders", status: errTUINoOrder.Error()},
+		{name: "escaped", orders: testTUIOrders, price: []string{"1", keyEsc}},
+		{name: "invalid price", orders: testTUIOrders, price: []string{"x", keyEnter}, status: fmt.Sprintf("%s %q", errTUIInvalidNumber, "x")},
+		{name: "invalid amount", orders: testTUIOrders, price: []string{keyEnter}, amount: []string{"-", "1", keyEnter}, status: fmt.Sprintf("%s %q", errTUIInvalidNumber, "-1")},
+		{
+			name:   "price",
+			orders: testTUIOrders,
+			price:  []string{"2", "1", "9", keyBackspace, "0", "0", "0", "0", keyEnter},
+			amount: []string{keyEnter},
+			status: "Modified order 1",
+			modify: &gctrpc.ModifyOrderRequest{Exchange: "binance", OrderId: "1", Asset: "spot", Price: 210000, Amount: 0.5},
+		},
+		{
+			name:   "amount",
+			orders: testTUIOrders,
+			price:  []string{keyEnter},
+			amount: []string{"0", ".", "7", "5", keyEnter},
+			status: "Modified order 1",
+			modify: &gctrpc.ModifyOrderRequest{Exchange: "binance", OrderId: "1", Asset: "spot", Price: 20000, Amount: 0.75},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			tu, c := newTestTUI(t, tc.orders...)
+			require.True(t, tu.handleKey("m"), "handleKey must not close the UI")
+			if tc.price != nil {
+				require.Equal(t, "New price for binance order 1 (blank keeps 20000): ", tu.promptLabel(), "price prompt must be open")
+			}
+			for _, k := range tc.price {
+				require.Truef(t, tu.handleKey(k), "handleKey must not close the UI for key %q", k)
+			}
+			if tc.amount != nil {
+				require.Eventually(t, func() bool {
+					return tu.promptLabel() == "New amount (blank keeps 0.5): "
+				}, time.Second, time.Millisecond, "amount prompt must be open")
+			}
+			for _, k := range tc.amount {
+				require.Truef(t, tu.handleKey(k), "handleKey must not close the UI for key %q", k)
+			}
+			require.Eventually(t, func() bool {
+				tu.m.Lock()
+				defer tu.m.Unlock()
+				return tu.prompt == nil && tu.status == tc.status
+			}, time.Second, time.Millisecond, "prompt must be closed with the status set")
+
+			c.m.Lock()
+			defer c.m.Unlock()
+			if tc.modify == nil {
+				assert.Empty(t, c.modifies, "ModifyOrder should not be called")
+				return
+			}
+			require.Len(t, c.modifies, 1, "ModifyOrder must be called once")
+			got := c.modifies[0]
+			assert.Equal(t, tc.modify.Exchange, got.Exchange, "Exchange should be correct")
+			assert.Equal(t, tc.modify.OrderId, got.OrderId, "OrderId should be correct")
+			assert.Equal(t, tc.modify.Asset, got.Asset, "Asset should be correct")
+			assert.Equal(t, tc.modify.Price, got.Price, "Price should be correct")
+			assert.Equal(t, tc.modify.Amount, got.Amount, "Amount should be correct")
+		})
+	}
+}
+
+func TestRenderOrderbook(t *testing.T) {
+	t.Parallel()
+	header := grayText + "        Bid amount        Bid price | Ask price        Ask amount      "
+	for _, tc := range []struct {
+		name      string
+		depth     int
+		orderbook *gctrpc.OrderbookResponse
+		err       string
+		lines     []string
+	}{
+		{name: "waiting", lines: []string{whiteText + "Orderbook", grayText + "  waiting for data"}},
+		{name: "error", err: "stream failed", lines: []string{whiteText + "Orderbook", redText + "  stream failed", grayText + "  waiting for data"}},
+		{name: "empty", depth: 10, orderbook: &gctrpc.OrderbookResponse{}, lines: []string{whiteText + "Orderbook", header}},
+		{
+			name:  "depth",
+			depth: 2,
+			orderbook: &gctrpc.OrderbookResponse{
+				Bids: []*gctrpc.OrderbookItem{{Price: 100, Amount: 1.5}, {Price: 99.5, Amount: 2}, {Price: 99, Amount: 3}},
+				Asks: []*gctrpc.OrderbookItem{{Price: 101, Amount: 0.25}},
+			},
+			lines: []string{
+				whiteText + "Orderbook",
+				header,
+				"  " + greenText + "             1.5              100" + defaultText + " | " + redText + "101              0.25            ",
+				"  " + greenText + "               2             99.5" + defaultText + " | " + redText,
+			},
+		},
+		{
+			name:  "more asks",
+			depth: 10,
+			orderbook: &gctrpc.OrderbookResponse{
+				Asks: []*gctrpc.OrderbookItem{{Price: 101, Amount: 0.25}},
+			},
+			lines: []string{
+				whiteText + "Orderbook",
+				header,
+				"  " + greenText + strings.Repeat(" ", 33) + defaultText + " | " + redText + "101              0.25            ",
+			},
+		},
+	} {
+		tu := &tui{depth: tc.depth, orderbook: tc.orderbook, errs: map[string]string{}}
+		if tc.err != "" {
+			tu.errs["orderbook"] = tc.err
+		}
+		assert.Equalf(t, tc.lines, tu.renderOrderbook(), "renderOrderbook should return the correct lines for %s", tc.name)
+	}
+}
+
+func TestRenderOrders(t *testing.T) {
+	t.Parallel()
+	header := grayText + "  Exchange     Pair         Asset    ID                   Side  Type              Price         Amount           Open Status"
+	btcusdt := fmt.Sprintf("%-12s", "BTC"+pairDelimiter+"USDT")
+	ethusdt := fmt.Sprintf("%-12s", "ETH"+pairDelimiter+"USDT")
+	for _, tc := range []struct {
+		name     string
+		orders   []*gctrpc.OrderDetails
+		orderIdx int
+		lines    []string
+	}{
+		{name: "no orders", lines: []string{whiteText + "Open orders (0)"}},
+		{
+			name:   "orders",
+			orders: []*gctrpc.OrderDetails{testTUIOrders[1], testTUIOrders[0]},
+			lines: []string{
+				whiteText + "Open orders (2)",
+				header,
+				reverseText + "  binance      " + btcusdt + " spot     1                    BUY   LIMIT             20000            0.5           0.25 NEW",
+				"  binance      " + ethusdt + " spot     2                    SELL  LIMIT              3000              2              2 NEW",
+			},
+		},
+		{
+			name:     "selection clamped",
+			orders:   testTUIOrders[:1],
+			orderIdx: 3,
+			lines: []string{
+				whiteText + "Open orders (1)",
+				header,
+				reverseText + "  binance      " + btcusdt + " spot     1                    BUY   LIMIT             20000            0.5           0.25 NEW",
+			},
+		},
+	} {
+		tu := &tui{orders: map[string]*gctrpc.OrderDetails{}, orderIdx: tc.orderIdx, errs: map[string]string{}}
+		for _, o := range tc.orders {
+			tu.updateOrder(o)
+		}
+		assert.Equalf(t, tc.lines, tu.renderOrders(), "renderOrders should return the correct lines for %s", tc.name)
+	}
+}
+
+func TestRenderPositions(t *testing.T) {
+	t.Parallel()
+	btcusdt := fmt.Sprintf("%-12s", "BTC"+pairDelimiter+"USDT")
+	for _, tc := range []struct {
+		name      string
+		positions []*gctrpc.FuturePosition
+		err       string
+		lines     []string
+	}{
+		{name: "no positions", lines: []string{whiteText + "Positions (0)"}},
+		{name: "error", err: "poll failed", lines: []string{whiteText + "Positions (0)", redText + "  poll failed"}},
+		{
+			name: "positions",
+			positions: []*gctrpc.FuturePosition{
+				{Exchange: "bybit", Asset: "usdtmarginedfutures", Pair: &gctrpc.CurrencyPair{Base: "BTC", Quote: "USDT"}, CurrentDirection: "LONG", CurrentSize: "0.1", CurrentPrice: "20000", UnrealisedPnl: "12.5", RealisedPnl: "0", Status: "OPEN"},
+				{Exchange: "okx", Asset: "futures", CurrentDirection: "SHORT", CurrentSize: "1", CurrentPrice: "3000", UnrealisedPnl: "-1", RealisedPnl: "2", Status: "CLOSED"},
+			},
+			lines: []string{
+				whiteText + "Positions (2)",
+				grayText + "  Exchange     Pair         Asset      Side             Size          Price     Unrealised       Realised Status",
+				"  bybit        " + btcusdt + " usdtmarginedfutures LONG              0.1          20000           12.5              0 OPEN",
+				"  okx                       futures    SHORT               1           3000             -1              2 CLOSED",
+			},
+		},
+	} {
+		tu := &tui{positions: tc.positions, errs: map[string]string{}}
+		if tc.err != "" {
+			tu.errs["positions"] = tc.err
+		}
+		assert.Equalf(t, tc.lines, tu.renderPositions(), "renderPositions should return the correct lines for %s", tc.name)
+	}
+}
@@ -116,8 +116,7 @@ func getwebsocketInfo(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func enableDisableWebsocket(c *cli.Context) error {
@@ -145,8 +144,7 @@ func enableDisableWebsocket(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func getSubscriptions(c *cli.Context) error {
@@ -173,8 +171,7 @@ func getSubscriptions(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func setProxy(c *cli.Context) error {
@@ -208,8 +205,7 @@ func setProxy(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
 
 func setURL(c *cli.Context) error {
@@ -243,6 +239,5 @@ func setURL(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	jsonOutput(result)
-	return nil
+	return jsonOutput(result)
 }
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/pquerna/otp/totp"
 	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/config/secrets"
 	"github.com/thrasher-corp/gocryptotrader/core"
 )
 
@@ -23,12 +25,13 @@ func containsOTP(cfg *config.Config) bool {
 }
 
 func main() {
-	var cfgFile, code string
+	var cfgFile, code, approver string
 	var single bool
 	var err error
 
 	flag.StringVar(&cfgFile, "config", config.DefaultFilePath(), "The config input file to process.")
 	flag.BoolVar(&single, "single", false, "prompt for single use OTP code gen")
+	flag.StringVar(&approver, "approver", "", "generate withdrawal approval OTP codes for the named withdrawal approver in the config")
 	flag.Parse()
 
 	log.Println("GoCryptoTrader: OTP code generator tool.")
@@ -66,6 +69,10 @@ func main() {
 	}
 	log.Println("Loaded config file.")
 
+	if approver != "" {
+		generateApproverCodes(&cfg, approver)
+	}
+
 	if !containsOTP(&cfg) {
 		log.Fatal("No exchanges with OTP code stored. Exiting.")
 	}
@@ -84,3 +91,30 @@ func main() {
 		time.Sleep(defaultSleepTime)
 	}
 }
+
+// generateApproverCodes generates withdrawal approval OTP codes for a
+// withdrawal approver, resolving their OTP secret if it is a secret reference
+func generateApproverCodes(cfg *config.Config, name string) {
+	var secret string
+	for x := range cfg.WithdrawalPolicy.Approvers {
+		if cfg.WithdrawalPolicy.Approvers[x].Name == name {
+			secret = cfg.WithdrawalPolicy.Approvers[x].OTPSecret
+			break
+		}
+	}
+	if secret == "" {
+		log.Fatalf("No withdrawal approver %q with OTP secret stored. Exiting.", name)
+	}
+	secret, err := secrets.Resolve(context.Background(), secret)
+	if err != nil {
+		log.Fatalf("Unable to resolve OTP secret for withdrawal approver %q. Err: %s", name, err)
+	}
+	for {
+		code, err := totp.GenerateCode(secret, time.Now())
+		if err != nil {
+			log.Fatalf("Unable to generate OTP code. Err: %s", err)
+		}
+		log.Printf("Withdrawal approver %s: %s\n", name, code)
+		time.Sleep(defaultSleepTime)
+	}
+}
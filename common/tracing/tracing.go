@@ -0,0 +1,86 @@
+// Package tracing provides OpenTelemetry span helpers and OTLP export setup.
+// Spans are created against the global tracer provider, which discards them
+// until Setup has been called
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Setup sets the global tracer provider to export spans to the configured
+// OTLP collector and W3C trace context as the global propagator. The returned
+// Shutdown must be called to flush pending spans
+func Setup(ctx context.Context, cfg *Config) (Shutdown, error) {
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if cfg.Endpoint == "" {
+		return nil, errEndpointUnset
+	}
+	if cfg.SampleRatio < 0 || cfg.SampleRatio > 1 {
+		return nil, fmt.Errorf("%w: %v", errInvalidSampleRate, cfg.SampleRatio)
+	}
+	opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create OTLP trace exporter: %w", err)
+	}
+	serviceName := cfg.ServiceName
+	if serviceName == "" {
+		serviceName = DefaultServiceName
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(attribute.String("service.name", serviceName)))
+	if err != nil {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	log.Infof(log.Global, "Tracing exporting spans to OTLP collector %s as %s", cfg.Endpoint, serviceName)
+	return provider.Shutdown, nil
+}
+
+// Start starts a span as a child of any span in the context
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End records the error on the span, if any, and ends it
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// LogFields returns structured logging fields holding the trace and span IDs
+// of the span in the context. Nil is returned when the context has no span
+func LogFields(ctx context.Context) log.ExtraFields {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return nil
+	}
+	return log.ExtraFields{
+		TraceIDKey: sc.TraceID().String(),
+		SpanIDKey:  sc.SpanID().String(),
+	}
+}
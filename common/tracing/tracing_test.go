@@ -0,0 +1,72 @@
+package tracing
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestSetup(t *testing.T) {
+	_, err := Setup(t.Context(), nil)
+	require.ErrorIs(t, err, errNilConfig)
+
+	_, err = Setup(t.Context(), &Config{})
+	require.ErrorIs(t, err, errEndpointUnset)
+
+	_, err = Setup(t.Context(), &Config{Endpoint: DefaultEndpoint, SampleRatio: 1.1})
+	require.ErrorIs(t, err, errInvalidSampleRate)
+
+	prev := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+	shutdown, err := Setup(t.Context(), &Config{Endpoint: "localhost:1", Insecure: true, SampleRatio: DefaultSampleRatio})
+	require.NoError(t, err, "Setup must not error")
+	_, ok := otel.GetTracerProvider().(*sdktrace.TracerProvider)
+	assert.True(t, ok, "global tracer provider should be set")
+	require.NoError(t, shutdown(t.Context()), "shutdown must not error")
+}
+
+func TestStartEnd(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	ctx, parent := Start(t.Context(), "parent", attribute.String("exchange", "test"))
+	assert.NotNil(t, LogFields(ctx), "LogFields should return fields for a context with a span")
+	_, child := Start(ctx, "child")
+	End(child, errors.New("test"))
+	End(parent, nil)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "child", spans[0].Name())
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	assert.Len(t, spans[0].Events(), 1, "error should be recorded as an event")
+	assert.Equal(t, spans[1].SpanContext().SpanID(), spans[0].Parent().SpanID(), "child should be parented to the span in the context")
+	assert.Equal(t, "parent", spans[1].Name())
+	assert.Equal(t, codes.Unset, spans[1].Status().Code)
+	assert.Contains(t, spans[1].Attributes(), attribute.String("exchange", "test"))
+}
+
+func TestLogFields(t *testing.T) {
+	assert.Nil(t, LogFields(t.Context()), "LogFields should return nil without a span")
+
+	recorder := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	ctx, span := Start(t.Context(), "test")
+	defer span.End()
+	fields := LogFields(ctx)
+	require.NotNil(t, fields, "LogFields must return fields for a context with a span")
+	assert.Equal(t, span.SpanContext().TraceID().String(), fields[TraceIDKey])
+	assert.Equal(t, span.SpanContext().SpanID().String(), fields[SpanIDKey])
+}
@@ -0,0 +1,43 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+const (
+	// DefaultEndpoint is the default OTLP gRPC collector address
+	DefaultEndpoint = "localhost:4317"
+	// DefaultServiceName is the default service name attached to all spans
+	DefaultServiceName = "gocryptotrader"
+	// DefaultSampleRatio samples all traces
+	DefaultSampleRatio = 1.0
+
+	// TraceIDKey is the structured logging field which holds the trace ID
+	TraceIDKey log.Key = "trace_id"
+	// SpanIDKey is the structured logging field which holds the span ID
+	SpanIDKey log.Key = "span_id"
+
+	instrumentationName = "github.com/thrasher-corp/gocryptotrader"
+)
+
+var (
+	errNilConfig         = errors.New("tracing config is nil")
+	errEndpointUnset     = errors.New("tracing endpoint unset")
+	errInvalidSampleRate = errors.New("tracing sample ratio must be between 0 and 1")
+)
+
+// Config holds the OpenTelemetry trace exporter settings. Spans are exported
+// to an OTLP gRPC collector such as the OpenTelemetry Collector or Jaeger
+type Config struct {
+	Enabled     bool    `json:"enabled"`
+	Endpoint    string  `json:"endpoint"`
+	Insecure    bool    `json:"insecure"`
+	ServiceName string  `json:"serviceName"`
+	SampleRatio float64 `json:"sampleRatio"`
+}
+
+// Shutdown flushes any pending spans and stops the exporter
+type Shutdown func(context.Context) error
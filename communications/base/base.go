@@ -36,6 +36,13 @@ func (b *Base) IsConnected() bool {
 	return b.Connected
 }
 
+// Shutdown marks the package as disconnected. Packages which start routines or
+// hold connections override it to stop them
+func (b *Base) Shutdown() error {
+	b.Connected = false
+	return nil
+}
+
 // GetName returns a package name
 func (b *Base) GetName() string {
 	return b.Name
@@ -19,6 +19,7 @@ type ICommunicate interface {
 	IsConnected() bool
 	GetName() string
 	SetServiceStarted(time.Time)
+	Shutdown() error
 }
 
 // Setup sets up communication variables and initiates a connection to the
@@ -50,6 +51,16 @@ func (c IComm) PushEvent(event Event) {
 	}
 }
 
+// Shutdown stops all communication links, ending any connections and routines
+// they have started
+func (c IComm) Shutdown() {
+	for i := range c {
+		if err := c[i].Shutdown(); err != nil {
+			log.Errorf(log.CommunicationMgr, "Communications: %s failed to shutdown. Err: %s", c[i].GetName(), err)
+		}
+	}
+}
+
 // GetStatus returns the status of the comms relayers
 func (c IComm) GetStatus() map[string]CommsStatus {
 	result := make(map[string]CommsStatus)
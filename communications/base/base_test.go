@@ -53,6 +53,7 @@ type CommunicationProvider struct {
 	isConnected      bool
 	ConnectCalled    bool
 	PushEventCalled  bool
+	ShutdownCalled   bool
 	ServiceStartTime time.Time
 }
 
@@ -82,6 +83,11 @@ func (p *CommunicationProvider) SetServiceStarted(t time.Time) {
 	p.ServiceStartTime = t
 }
 
+func (p *CommunicationProvider) Shutdown() error {
+	p.ShutdownCalled = true
+	return nil
+}
+
 func TestSetup(t *testing.T) {
 	var ic IComm
 	testConfigs := []struct {
@@ -151,3 +157,29 @@ func TestPushEvent(t *testing.T) {
 		}
 	}
 }
+
+func TestIComm_Shutdown(t *testing.T) {
+	t.Parallel()
+	ic := IComm{&CommunicationProvider{isEnabled: true}, &CommunicationProvider{}}
+	ic.Shutdown()
+	for i := range ic {
+		p, ok := ic[i].(*CommunicationProvider)
+		if !ok {
+			t.Fatal("unable to type assert provider")
+		}
+		if !p.ShutdownCalled {
+			t.Fatalf("provider %d should be shutdown", i)
+		}
+	}
+}
+
+func TestBaseShutdown(t *testing.T) {
+	t.Parallel()
+	b := Base{Connected: true}
+	if err := b.Shutdown(); err != nil {
+		t.Fatal(err)
+	}
+	if b.IsConnected() {
+		t.Error("Base should not be connected after shutdown")
+	}
+}
@@ -46,7 +46,7 @@ type Slack struct {
 	ReconnectURL    string
 	WebsocketConn   *gws.Conn
 	Connected       bool
-	Shutdown        bool
+	shutdown        chan struct{}
 	mu              sync.Mutex
 }
 
@@ -75,6 +75,36 @@ func (s *Slack) Connect() error {
 	return nil
 }
 
+// Shutdown closes the websocket connection and stops its reader and keep alive
+// routines
+func (s *Slack) Shutdown() error {
+	select {
+	case <-s.shutdown:
+		return nil
+	default:
+		if s.shutdown != nil {
+			close(s.shutdown)
+		}
+	}
+	s.Connected = false
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.WebsocketConn == nil {
+		return nil
+	}
+	return s.WebsocketConn.Close()
+}
+
+// isShutdown returns whether Shutdown has been called since connecting
+func (s *Slack) isShutdown() bool {
+	select {
+	case <-s.shutdown:
+		return true
+	default:
+		return false
+	}
+}
+
 // PushEvent pushes an event to either a slack channel or specific client
 func (s *Slack) PushEvent(event base.Event) error {
 	if s.Connected {
@@ -210,15 +240,22 @@ func (s *Slack) WebsocketConnect() error {
 	}
 	resp.Body.Close()
 
+	if s.shutdown == nil || s.isShutdown() {
+		s.shutdown = make(chan struct{})
+	}
 	go s.WebsocketReader()
 	return nil
 }
 
-// WebsocketReader reads incoming events from the websocket connection
+// WebsocketReader reads incoming events from the websocket connection until
+// Shutdown is called
 func (s *Slack) WebsocketReader() {
 	for {
 		_, resp, err := s.WebsocketConn.ReadMessage()
 		if err != nil {
+			if s.isShutdown() {
+				return
+			}
 			log.Errorln(log.CommunicationMgr, err)
 		}
 
@@ -347,7 +384,11 @@ func (s *Slack) WebsocketKeepAlive() {
 	defer ticker.Stop()
 
 	for {
-		<-ticker.C
+		select {
+		case <-ticker.C:
+		case <-s.shutdown:
+			return
+		}
 		if err := s.WebsocketSend("ping", ""); err != nil {
 			log.Errorf(log.CommunicationMgr, "Slack: WebsocketKeepAlive() error %s\n", err)
 		}
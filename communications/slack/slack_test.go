@@ -316,3 +316,13 @@ func TestHandleMessage(t *testing.T) {
 		t.Error("slack HandleMessage(), Sent message through nil websocket")
 	}
 }
+
+func TestShutdown(t *testing.T) {
+	t.Parallel()
+	s := Slack{shutdown: make(chan struct{}), Connected: true}
+	require.NoError(t, s.Shutdown())
+	assert.False(t, s.IsConnected(), "Shutdown should disconnect")
+	assert.True(t, s.isShutdown(), "Shutdown should signal the websocket routines")
+	assert.NoError(t, s.Shutdown(), "Shutdown should not error when already shutdown")
+	assert.NoError(t, new(Slack).Shutdown(), "Shutdown should not error when never connected")
+}
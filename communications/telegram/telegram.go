@@ -56,6 +56,7 @@ type Telegram struct {
 	Token             string
 	Offset            int64
 	AuthorisedClients map[string]int64
+	shutdown          chan struct{}
 }
 
 // IsConnected returns whether or not the connection is connected
@@ -78,10 +79,35 @@ func (t *Telegram) Connect() error {
 
 	log.Debugln(log.CommunicationMgr, "Telegram: Connected successfully!")
 	t.Connected = true
+	t.shutdown = make(chan struct{})
 	go t.PollerStart()
 	return nil
 }
 
+// Shutdown stops the long polling sequence so that another poller can take
+// over receiving updates
+func (t *Telegram) Shutdown() error {
+	select {
+	case <-t.shutdown:
+	default:
+		if t.shutdown != nil {
+			close(t.shutdown)
+		}
+	}
+	t.Connected = false
+	return nil
+}
+
+// isShutdown returns whether Shutdown has been called since connecting
+func (t *Telegram) isShutdown() bool {
+	select {
+	case <-t.shutdown:
+		return true
+	default:
+		return false
+	}
+}
+
 // PushEvent sends an event to a supplied recipient list via telegram
 func (t *Telegram) PushEvent(event base.Event) error {
 	if !t.Connected {
@@ -104,14 +130,18 @@ func (t *Telegram) PushEvent(event base.Event) error {
 	return errs
 }
 
-// PollerStart starts the long polling sequence
+// PollerStart starts the long polling sequence, which runs until Shutdown is
+// called
 func (t *Telegram) PollerStart() {
 	errWait := func(err error) {
 		log.Errorln(log.CommunicationMgr, err)
-		time.Sleep(ErrWaiter)
+		select {
+		case <-time.After(ErrWaiter):
+		case <-t.shutdown:
+		}
 	}
 
-	for {
+	for !t.isShutdown() {
 		if !t.initConnected {
 			err := t.InitialConnect()
 			if err != nil {
@@ -126,6 +156,9 @@ func (t *Telegram) PollerStart() {
 			errWait(err)
 			continue
 		}
+		if t.isShutdown() {
+			return
+		}
 
 		for i := range resp.Result {
 			if resp.Result[i].UpdateID > t.Offset {
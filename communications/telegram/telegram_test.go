@@ -99,3 +99,15 @@ func TestSendHTTPRequest(t *testing.T) {
 		t.Error("telegram SendHTTPRequest() error")
 	}
 }
+
+func TestShutdown(t *testing.T) {
+	t.Parallel()
+	T := Telegram{shutdown: make(chan struct{})}
+	T.Connected = true
+	assert.NoError(t, T.Shutdown())
+	assert.False(t, T.IsConnected(), "Shutdown should disconnect")
+	assert.True(t, T.isShutdown(), "Shutdown should signal the poller")
+	assert.NoError(t, T.Shutdown(), "Shutdown should not error when already shutdown")
+	T.PollerStart() // Returns immediately once shutdown
+	assert.NoError(t, new(Telegram).Shutdown(), "Shutdown should not error when never connected")
+}
@@ -4,6 +4,8 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
@@ -17,14 +19,18 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/convert"
 	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/common/tracing"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config/secrets"
 	"github.com/thrasher-corp/gocryptotrader/config/versions"
 	"github.com/thrasher-corp/gocryptotrader/connchecker"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/currency/forexprovider"
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc/auth"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
@@ -34,6 +40,21 @@ var (
 	errExchangeConfigIsNil = errors.New("exchange config is nil")
 	errPairsManagerIsNil   = errors.New("currency pairs manager is nil")
 	errDecryptFailed       = errors.New("failed to decrypt config after 3 attempts")
+
+	errRemoteControlUserNoName       = errors.New("remote control user name is empty")
+	errRemoteControlUserNoCredential = errors.New("remote control user has no token hash or certificate common name")
+	errRemoteControlUserBadTokenHash = errors.New("remote control user token hash must be a hex encoded SHA-256 hash")
+	errRemoteControlUserNoRoles      = errors.New("remote control user has no roles")
+
+	errWithdrawalAddressNoCurrency = errors.New("withdrawal address book entry currency is empty")
+	errWithdrawalAddressNoAddress  = errors.New("withdrawal address book entry address is empty")
+	errWithdrawalApproverNoName    = errors.New("withdrawal approver name is empty")
+	errWithdrawalApproverNoSecret  = errors.New("withdrawal approver OTP secret is empty")
+
+	errAccountNoName          = errors.New("exchange account name is empty")
+	errAccountReservedName    = errors.New("exchange account name is reserved for the default credentials")
+	errAccountDuplicateName   = errors.New("exchange account name is duplicated")
+	errAccountMissingRequired = errors.New("exchange account credentials are missing required values")
 )
 
 // GetCurrencyConfig returns currency configurations
@@ -169,6 +190,7 @@ func (c *Config) PurgeExchangeAPICredentials() {
 
 		c.Exchanges[x].API.Credentials.PEMKey = ""
 		c.Exchanges[x].API.Credentials.OTPSecret = ""
+		c.Exchanges[x].API.Accounts = nil
 	}
 }
 
@@ -943,6 +965,7 @@ func (c *Config) CheckExchangeConfigValues() error {
 				log.Warnf(log.ConfigMgr, warningExchangeAuthAPIDefaultOrEmptyValues, e.Name)
 			}
 		}
+		e.API.checkAccounts(e.Name)
 		if !e.Features.Supports.RESTCapabilities.AutoPairUpdates &&
 			!e.Features.Supports.WebsocketCapabilities.AutoPairUpdates {
 			lastUpdated := time.Unix(e.CurrencyPairs.LastUpdated, 0)
@@ -1316,6 +1339,119 @@ func (c *Config) CheckDataHistoryMonitorConfig() {
 	}
 }
 
+// CheckDataQualityManagerConfig ensures the data quality config is valid, or
+// sets default values
+func (c *Config) CheckDataQualityManagerConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.DataQualityManager.CheckInterval <= 0 {
+		c.DataQualityManager.CheckInterval = defaultDataQualityCheckInterval
+	}
+	if c.DataQualityManager.VolatilityWindow <= 1 {
+		c.DataQualityManager.VolatilityWindow = defaultDataQualityVolatilityWindow
+	}
+	if c.DataQualityManager.OutlierThreshold <= 0 {
+		c.DataQualityManager.OutlierThreshold = defaultDataQualityOutlierThreshold
+	}
+	if c.DataQualityManager.DivergenceTolerancePercentage <= 0 {
+		c.DataQualityManager.DivergenceTolerancePercentage = defaultDataQualityDivergence
+	}
+	if c.DataQualityManager.MaxReports <= 0 {
+		c.DataQualityManager.MaxReports = defaultDataQualityMaxReports
+	}
+}
+
+// CheckCompositePriceManagerConfig ensures the composite price config is
+// valid, or sets default values
+func (c *Config) CheckCompositePriceManagerConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.CompositePriceManager.Interval <= 0 {
+		c.CompositePriceManager.Interval = defaultCompositePriceInterval
+	}
+	if c.CompositePriceManager.MaxQuoteAge <= 0 {
+		c.CompositePriceManager.MaxQuoteAge = defaultCompositePriceMaxQuoteAge
+	}
+	if c.CompositePriceManager.OutlierTolerancePercentage <= 0 {
+		c.CompositePriceManager.OutlierTolerancePercentage = defaultCompositePriceOutlierPercent
+	}
+	if c.CompositePriceManager.VirtualExchangeName == "" {
+		c.CompositePriceManager.VirtualExchangeName = defaultCompositePriceExchangeName
+	}
+	if c.CompositePriceManager.HistoryInterval <= 0 {
+		c.CompositePriceManager.HistoryInterval = defaultCompositePriceHistoryInterval
+	}
+	for i := range c.CompositePriceManager.Targets {
+		t := &c.CompositePriceManager.Targets[i]
+		if len(t.EquivalentQuotes) == 0 && t.Pair.Quote.Equal(currency.USD) {
+			t.EquivalentQuotes = []currency.Code{currency.USDT, currency.USDC}
+		}
+	}
+}
+
+// CheckDatabaseRetentionConfig ensures the database retention config is valid,
+// or sets default values
+func (c *Config) CheckDatabaseRetentionConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.DatabaseRetention.CheckInterval <= 0 {
+		c.DatabaseRetention.CheckInterval = defaultRetentionCheckInterval
+	}
+	if c.DatabaseRetention.BatchPeriod <= 0 {
+		c.DatabaseRetention.BatchPeriod = defaultRetentionBatchPeriod
+	}
+	for i := range c.DatabaseRetention.Rules {
+		c.DatabaseRetention.Rules[i].DataType = strings.ToLower(c.DatabaseRetention.Rules[i].DataType)
+	}
+}
+
+// CheckMetricsConfig ensures the metrics exporter config is valid, or sets
+// default values
+func (c *Config) CheckMetricsConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.Metrics.ListenAddress == "" {
+		c.Metrics.ListenAddress = defaultMetricsListenAddress
+	}
+	if c.Metrics.Path == "" {
+		c.Metrics.Path = defaultMetricsPath
+	}
+	if !strings.HasPrefix(c.Metrics.Path, "/") {
+		c.Metrics.Path = "/" + c.Metrics.Path
+	}
+}
+
+// CheckTracingConfig ensures the tracing config is valid, or sets default
+// values
+func (c *Config) CheckTracingConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.Tracing.Endpoint == "" {
+		c.Tracing.Endpoint = tracing.DefaultEndpoint
+	}
+	if c.Tracing.ServiceName == "" {
+		c.Tracing.ServiceName = tracing.DefaultServiceName
+	}
+	if c.Tracing.SampleRatio <= 0 || c.Tracing.SampleRatio > 1 {
+		c.Tracing.SampleRatio = tracing.DefaultSampleRatio
+	}
+}
+
+// CheckHealthConfig ensures the health config is valid, or sets default values
+func (c *Config) CheckHealthConfig() {
+	m.Lock()
+	defer m.Unlock()
+	if c.Health.ListenAddress == "" {
+		c.Health.ListenAddress = defaultHealthListenAddress
+	}
+	if c.Health.SyncStaleThreshold <= 0 {
+		c.Health.SyncStaleThreshold = defaultHealthSyncStaleThreshold
+	}
+	if c.Health.OrderPollStaleThreshold <= 0 {
+		c.Health.OrderPollStaleThreshold = defaultHealthOrderPollStaleThreshold
+	}
+}
+
 // CheckCurrencyStateManager ensures the currency state config is valid, or sets
 // default values
 func (c *Config) CheckCurrencyStateManager() {
@@ -1619,6 +1755,136 @@ func (c *Config) CheckRemoteControlConfig() {
 		log.Warnln(log.ConfigMgr, "gRPC proxy cannot be enabled when gRPC is disabled, disabling gRPC proxy")
 		c.RemoteControl.GRPC.GRPCProxyEnabled = false
 	}
+	if c.RemoteControl.GRPC.WebsocketGatewayEnabled && !c.RemoteControl.GRPC.GRPCProxyEnabled {
+		log.Warnln(log.ConfigMgr, "gRPC websocket gateway is served by the gRPC proxy and will not start while the proxy is disabled")
+	}
+
+	c.RemoteControl.Users = checkRemoteControlUsers(c.RemoteControl.Users)
+}
+
+// checkRemoteControlUsers returns the valid gRPC users, warning for and
+// dropping users which are missing a name or credential, or have invalid roles
+func checkRemoteControlUsers(users []RemoteControlUser) []RemoteControlUser {
+	valid := make([]RemoteControlUser, 0, len(users))
+	names := make(map[string]struct{}, len(users))
+	for i := range users {
+		u := users[i]
+		if err := u.check(); err != nil {
+			log.Warnf(log.ConfigMgr, "Remote control user %d %q: %s, disabling user", i, u.Name, err)
+			continue
+		}
+		if _, ok := names[u.Name]; ok {
+			log.Warnf(log.ConfigMgr, "Remote control user %q is duplicated, disabling user", u.Name)
+			continue
+		}
+		names[u.Name] = struct{}{}
+		valid = append(valid, u)
+	}
+	return valid
+}
+
+// check validates and normalises a gRPC user
+func (u *RemoteControlUser) check() error {
+	if u.Name == "" {
+		return errRemoteControlUserNoName
+	}
+	if u.TokenHash == "" && u.CertificateCommonName == "" {
+		return errRemoteControlUserNoCredential
+	}
+	if u.TokenHash != "" {
+		u.TokenHash = strings.ToLower(u.TokenHash)
+		if b, err := hex.DecodeString(u.TokenHash); err != nil || len(b) != sha256.Size {
+			return errRemoteControlUserBadTokenHash
+		}
+	}
+	if len(u.Roles) == 0 {
+		return errRemoteControlUserNoRoles
+	}
+	for j := range u.Roles {
+		r, err := auth.ParseRole(string(u.Roles[j]))
+		if err != nil {
+			return err
+		}
+		u.Roles[j] = r
+	}
+	return nil
+}
+
+// CheckWithdrawalPolicyConfig checks and sets default values for the
+// withdrawal policy config. Address book entries without an added time are
+// time stamped now, so the new address time lock applies from when they are
+// first loaded
+func (c *Config) CheckWithdrawalPolicyConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	p := &c.WithdrawalPolicy
+	if p.PerTransactionLimitUSD < 0 {
+		log.Warnln(log.ConfigMgr, "Withdrawal policy per transaction limit cannot be negative, setting to unlimited")
+		p.PerTransactionLimitUSD = 0
+	}
+	if p.DailyLimitUSD < 0 {
+		log.Warnln(log.ConfigMgr, "Withdrawal policy daily limit cannot be negative, setting to unlimited")
+		p.DailyLimitUSD = 0
+	}
+	if p.NewAddressTimeLock < 0 {
+		p.NewAddressTimeLock = 0
+	}
+	setDefaultIfZeroWarn("Withdrawal policy", "approval expiry", &p.ApprovalExpiry, defaultWithdrawalApprovalExpiry)
+
+	now := time.Now().UTC()
+	addresses := make([]WithdrawalAddress, 0, len(p.AddressBook))
+	for i := range p.AddressBook {
+		a := p.AddressBook[i]
+		if err := a.check(now); err != nil {
+			log.Warnf(log.ConfigMgr, "Withdrawal address book entry %d %q: %s, removing entry", i, a.Label, err)
+			continue
+		}
+		addresses = append(addresses, a)
+	}
+	p.AddressBook = addresses
+
+	approvers := make([]WithdrawalApprover, 0, len(p.Approvers))
+	names := make(map[string]struct{}, len(p.Approvers))
+	for i := range p.Approvers {
+		a := p.Approvers[i]
+		var err error
+		switch {
+		case a.Name == "":
+			err = errWithdrawalApproverNoName
+		case a.OTPSecret == "":
+			err = errWithdrawalApproverNoSecret
+		}
+		if err != nil {
+			log.Warnf(log.ConfigMgr, "Withdrawal approver %d %q: %s, removing approver", i, a.Name, err)
+			continue
+		}
+		if _, ok := names[a.Name]; ok {
+			log.Warnf(log.ConfigMgr, "Withdrawal approver %q is duplicated, removing approver", a.Name)
+			continue
+		}
+		names[a.Name] = struct{}{}
+		approvers = append(approvers, a)
+	}
+	p.Approvers = approvers
+
+	if p.Enabled && p.RequireApproval && len(p.Approvers) == 0 {
+		log.Warnln(log.ConfigMgr, "Withdrawal policy requires approval but has no approvers, withdrawals will expire without being sent")
+	}
+}
+
+// check validates and normalises a withdrawal address book entry
+func (a *WithdrawalAddress) check(now time.Time) error {
+	if a.Currency.IsEmpty() {
+		return errWithdrawalAddressNoCurrency
+	}
+	if a.Address == "" {
+		return errWithdrawalAddressNoAddress
+	}
+	if a.AddedAt.IsZero() {
+		a.AddedAt = now
+	}
+	return nil
 }
 
 // CheckConfig checks all config settings
@@ -1641,11 +1907,18 @@ func (c *Config) CheckConfig() error {
 
 	c.CheckConnectionMonitorConfig()
 	c.CheckDataHistoryMonitorConfig()
+	c.CheckDataQualityManagerConfig()
+	c.CheckCompositePriceManagerConfig()
+	c.CheckDatabaseRetentionConfig()
+	c.CheckMetricsConfig()
+	c.CheckTracingConfig()
+	c.CheckHealthConfig()
 	c.CheckCurrencyStateManager()
 	c.CheckCommunicationsConfig()
 	c.CheckClientBankAccounts()
 	c.CheckBankAccountConfig()
 	c.CheckRemoteControlConfig()
+	c.CheckWithdrawalPolicyConfig()
 	c.CheckSyncManagerConfig()
 
 	if err := c.CheckCurrencyConfigValues(); err != nil {
@@ -1763,3 +2036,70 @@ func (c *Exchange) Validate() error {
 
 	return nil
 }
+
+// checkAccounts removes named accounts which are unnamed, duplicated or missing
+// credentials required by the exchange
+func (a *APIConfig) checkAccounts(exchName string) {
+	if len(a.Accounts) == 0 {
+		return
+	}
+	kept := make([]APIAccountConfig, 0, len(a.Accounts))
+	names := make(map[string]struct{}, len(a.Accounts))
+	for i := range a.Accounts {
+		acc := a.Accounts[i]
+		acc.Name = strings.TrimSpace(acc.Name)
+		name := accounts.NormaliseAccountName(acc.Name)
+		var err error
+		switch _, dupe := names[name]; {
+		case acc.Name == "":
+			err = errAccountNoName
+		case name == accounts.DefaultAccount:
+			err = errAccountReservedName
+		case dupe:
+			err = errAccountDuplicateName
+		case !a.CredentialsValidator.valid(&acc.Credentials):
+			err = errAccountMissingRequired
+		}
+		if err != nil {
+			log.Warnf(log.ConfigMgr, "Exchange %s account %d %q: %s, removing account", exchName, i, acc.Name, err)
+			continue
+		}
+		names[name] = struct{}{}
+		kept = append(kept, acc)
+	}
+	if len(kept) == 0 {
+		kept = nil
+	}
+	a.Accounts = kept
+}
+
+// valid returns whether the credentials hold the values required by the
+// validator
+func (v *APICredentialsValidatorConfig) valid(c *APICredentialsConfig) bool {
+	if v == nil {
+		return true
+	}
+	return (!v.RequiresKey || c.Key != "" && c.Key != DefaultAPIKey) &&
+		(!v.RequiresSecret || c.Secret != "" && c.Secret != DefaultAPISecret) &&
+		(!v.RequiresClientID || c.ClientID != "" && c.ClientID != DefaultAPIClientID)
+}
+
+// ResolveSecrets returns a copy of the credentials with secret references e.g.
+// env:BINANCE_API_KEY resolved by their secret providers. The config itself
+// retains the references so that resolved secrets are never saved
+func (a *APICredentialsConfig) ResolveSecrets(ctx context.Context) (APICredentialsConfig, error) {
+	r := *a
+	for _, f := range []*string{&r.Key, &r.Secret, &r.ClientID, &r.Subaccount, &r.PEMKey, &r.OTPSecret, &r.TradePassword, &r.PIN} {
+		v, err := secrets.Resolve(ctx, *f)
+		if err != nil {
+			return APICredentialsConfig{}, err
+		}
+		*f = v
+	}
+	return r, nil
+}
+
+// HasSecretReferences returns whether any credentials are secret references
+func (a *APICredentialsConfig) HasSecretReferences() bool {
+	return slices.ContainsFunc([]string{a.Key, a.Secret, a.ClientID, a.Subaccount, a.PEMKey, a.OTPSecret, a.TradePassword, a.PIN}, secrets.IsReference)
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/convert"
 	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/common/tracing"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/config/versions"
 	"github.com/thrasher-corp/gocryptotrader/connchecker"
@@ -26,6 +27,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/encoding/json"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc/auth"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
@@ -1879,6 +1881,78 @@ func TestCheckRemoteControlConfig(t *testing.T) {
 	assert.True(t, c.RemoteControl.GRPC.GRPCProxyEnabled, "gRPCProxyEnabled should be true when gRPC is enabled")
 }
 
+func TestCheckRemoteControlUsers(t *testing.T) {
+	t.Parallel()
+	hash := auth.HashToken("token")
+	got := checkRemoteControlUsers([]RemoteControlUser{
+		{Name: "reader", TokenHash: strings.ToUpper(hash), Roles: []auth.Role{"MarketData"}},
+		{Name: "trader", CertificateCommonName: "trader.example", Roles: []auth.Role{auth.RoleTrading, auth.RoleMarketData}},
+		{Name: "reader", TokenHash: hash, Roles: []auth.Role{auth.RoleAdmin}},
+		{TokenHash: hash, Roles: []auth.Role{auth.RoleAdmin}},
+		{Name: "nocreds", Roles: []auth.Role{auth.RoleAdmin}},
+		{Name: "badhash", TokenHash: "token", Roles: []auth.Role{auth.RoleAdmin}},
+		{Name: "noroles", TokenHash: hash},
+		{Name: "badrole", TokenHash: hash, Roles: []auth.Role{"root"}},
+	})
+	require.Len(t, got, 2, "Only valid users should be kept")
+	assert.Equal(t, "reader", got[0].Name)
+	assert.Equal(t, hash, got[0].TokenHash, "Token hash should be normalised to lower case")
+	assert.Equal(t, []auth.Role{auth.RoleMarketData}, got[0].Roles, "Roles should be normalised")
+	assert.Equal(t, "trader", got[1].Name)
+
+	u := RemoteControlUser{}
+	assert.ErrorIs(t, u.check(), errRemoteControlUserNoName)
+	u.Name = "bob"
+	assert.ErrorIs(t, u.check(), errRemoteControlUserNoCredential)
+	u.TokenHash = "abc"
+	assert.ErrorIs(t, u.check(), errRemoteControlUserBadTokenHash)
+	u.TokenHash = hash
+	assert.ErrorIs(t, u.check(), errRemoteControlUserNoRoles)
+	u.Roles = []auth.Role{"root"}
+	assert.ErrorIs(t, u.check(), auth.ErrInvalidRole)
+	u.Roles = []auth.Role{auth.RoleWithdrawals}
+	assert.NoError(t, u.check())
+}
+
+func TestCheckWithdrawalPolicyConfig(t *testing.T) {
+	t.Parallel()
+	added := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := &Config{WithdrawalPolicy: WithdrawalPolicyConfig{
+		Enabled:                true,
+		PerTransactionLimitUSD: -1,
+		DailyLimitUSD:          -1,
+		NewAddressTimeLock:     -time.Hour,
+		Approvers: []WithdrawalApprover{
+			{Name: "alice", OTPSecret: "env:ALICE_OTP"},
+			{Name: "alice", OTPSecret: "env:ALICE_OTP2"},
+			{OTPSecret: "secret"},
+			{Name: "bob"},
+		},
+		AddressBook: []WithdrawalAddress{
+			{Currency: currency.BTC, Address: "bc1qtest", AddedAt: added},
+			{Currency: currency.ETH, Chain: "erc20", Address: "0xtest"},
+			{Address: "nocurrency"},
+			{Currency: currency.BTC},
+		},
+	}}
+	c.CheckWithdrawalPolicyConfig()
+	p := c.WithdrawalPolicy
+	assert.Zero(t, p.PerTransactionLimitUSD, "Negative per transaction limit should be reset")
+	assert.Zero(t, p.DailyLimitUSD, "Negative daily limit should be reset")
+	assert.Zero(t, p.NewAddressTimeLock, "Negative time lock should be reset")
+	assert.Equal(t, defaultWithdrawalApprovalExpiry, p.ApprovalExpiry)
+	require.Len(t, p.Approvers, 1, "Only valid unique approvers should be kept")
+	assert.Equal(t, "env:ALICE_OTP", p.Approvers[0].OTPSecret)
+	require.Len(t, p.AddressBook, 2, "Only valid address book entries should be kept")
+	assert.Equal(t, added, p.AddressBook[0].AddedAt, "Existing added time should be kept")
+	assert.False(t, p.AddressBook[1].AddedAt.IsZero(), "Added time should be set")
+
+	a := WithdrawalAddress{}
+	assert.ErrorIs(t, a.check(added), errWithdrawalAddressNoCurrency)
+	a.Currency = currency.BTC
+	assert.ErrorIs(t, a.check(added), errWithdrawalAddressNoAddress)
+}
+
 func TestCheckConfig(t *testing.T) {
 	t.Parallel()
 	cp1 := currency.NewPair(currency.DOGE, currency.XRP)
@@ -2335,3 +2409,125 @@ func TestCheckSyncManagerConfig(t *testing.T) {
 		t.Errorf("received %v expected %v", c.SyncManagerConfig.NumWorkers, DefaultSyncerWorkers)
 	}
 }
+
+func TestCheckCompositePriceManagerConfig(t *testing.T) {
+	t.Parallel()
+	c := &Config{
+		CompositePriceManager: CompositePriceManager{
+			Targets: []CompositePriceTarget{
+				{Asset: asset.Spot, Pair: currency.NewPair(currency.BTC, currency.USD)},
+				{Asset: asset.Spot, Pair: currency.NewPair(currency.BTC, currency.EUR)},
+			},
+		},
+	}
+	c.CheckCompositePriceManagerConfig()
+	assert.Equal(t, defaultCompositePriceInterval, c.CompositePriceManager.Interval)
+	assert.Equal(t, defaultCompositePriceMaxQuoteAge, c.CompositePriceManager.MaxQuoteAge)
+	assert.Equal(t, float64(defaultCompositePriceOutlierPercent), c.CompositePriceManager.OutlierTolerancePercentage)
+	assert.Equal(t, defaultCompositePriceExchangeName, c.CompositePriceManager.VirtualExchangeName)
+	assert.Equal(t, defaultCompositePriceHistoryInterval, c.CompositePriceManager.HistoryInterval)
+	assert.Equal(t, []currency.Code{currency.USDT, currency.USDC}, c.CompositePriceManager.Targets[0].EquivalentQuotes)
+	assert.Empty(t, c.CompositePriceManager.Targets[1].EquivalentQuotes)
+}
+
+func TestCheckDatabaseRetentionConfig(t *testing.T) {
+	t.Parallel()
+	c := &Config{
+		DatabaseRetention: DatabaseRetentionManager{
+			Rules: []DatabaseRetentionRule{{DataType: "Candle", Exchange: "binance", Asset: asset.Spot}},
+		},
+	}
+	c.CheckDatabaseRetentionConfig()
+	assert.Equal(t, defaultRetentionCheckInterval, c.DatabaseRetention.CheckInterval)
+	assert.Equal(t, defaultRetentionBatchPeriod, c.DatabaseRetention.BatchPeriod)
+	assert.Equal(t, "candle", c.DatabaseRetention.Rules[0].DataType)
+}
+
+func TestCheckTracingConfig(t *testing.T) {
+	t.Parallel()
+	c := &Config{Tracing: tracing.Config{SampleRatio: 2}}
+	c.CheckTracingConfig()
+	assert.Equal(t, tracing.DefaultEndpoint, c.Tracing.Endpoint)
+	assert.Equal(t, tracing.DefaultServiceName, c.Tracing.ServiceName)
+	assert.Equal(t, tracing.DefaultSampleRatio, c.Tracing.SampleRatio)
+
+	c.Tracing.SampleRatio = 0.25
+	c.CheckTracingConfig()
+	assert.Equal(t, 0.25, c.Tracing.SampleRatio, "valid sample ratio should not be changed")
+}
+
+func TestCheckHealthConfig(t *testing.T) {
+	t.Parallel()
+	c := &Config{Health: HealthConfig{SyncStaleThreshold: -1}}
+	c.CheckHealthConfig()
+	assert.Equal(t, defaultHealthListenAddress, c.Health.ListenAddress)
+	assert.Equal(t, defaultHealthSyncStaleThreshold, c.Health.SyncStaleThreshold)
+	assert.Equal(t, defaultHealthOrderPollStaleThreshold, c.Health.OrderPollStaleThreshold)
+
+	c.Health.OrderPollStaleThreshold = time.Second
+	c.CheckHealthConfig()
+	assert.Equal(t, time.Second, c.Health.OrderPollStaleThreshold, "valid threshold should not be changed")
+}
+
+func TestCheckMetricsConfig(t *testing.T) {
+	t.Parallel()
+	c := &Config{}
+	c.CheckMetricsConfig()
+	assert.Equal(t, defaultMetricsListenAddress, c.Metrics.ListenAddress)
+	assert.Equal(t, defaultMetricsPath, c.Metrics.Path)
+
+	c.Metrics.Path = "gct/metrics"
+	c.CheckMetricsConfig()
+	assert.Equal(t, "/gct/metrics", c.Metrics.Path, "path should be rooted")
+}
+
+func TestAPICredentialsResolveSecrets(t *testing.T) {
+	t.Parallel()
+	secretFile := filepath.Join(t.TempDir(), "secret")
+	require.NoError(t, os.WriteFile(secretFile, []byte("filesecret\n"), 0o600))
+
+	c := APICredentialsConfig{Key: "plainkey", Secret: "file://" + filepath.ToSlash(secretFile)}
+	assert.True(t, c.HasSecretReferences())
+	r, err := c.ResolveSecrets(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "plainkey", r.Key)
+	assert.Equal(t, "filesecret", r.Secret)
+	assert.Equal(t, "file://"+filepath.ToSlash(secretFile), c.Secret, "ResolveSecrets must not modify the config")
+
+	c.PIN = "file://" + filepath.ToSlash(secretFile) + "missing"
+	_, err = c.ResolveSecrets(t.Context())
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	assert.False(t, (&APICredentialsConfig{Key: "plainkey", Secret: "plainsecret"}).HasSecretReferences())
+}
+
+func TestCheckAPIAccounts(t *testing.T) {
+	t.Parallel()
+	a := &APIConfig{
+		CredentialsValidator: &APICredentialsValidatorConfig{RequiresKey: true, RequiresSecret: true},
+		Accounts: []APIAccountConfig{
+			{Name: " trading ", Credentials: APICredentialsConfig{Key: "k1", Secret: "s1"}},
+			{Name: "Trading", Credentials: APICredentialsConfig{Key: "k2", Secret: "s2"}},
+			{Name: "Default", Credentials: APICredentialsConfig{Key: "k3", Secret: "s3"}},
+			{Credentials: APICredentialsConfig{Key: "k4", Secret: "s4"}},
+			{Name: "nosecret", Credentials: APICredentialsConfig{Key: "k5", Secret: DefaultAPISecret}},
+			{Name: "hedge", Credentials: APICredentialsConfig{Key: "env:HEDGE_KEY", Secret: "env:HEDGE_SECRET", Subaccount: "hedge"}},
+		},
+	}
+	a.checkAccounts("test")
+	require.Len(t, a.Accounts, 2, "Only valid uniquely named accounts should be kept")
+	assert.Equal(t, "trading", a.Accounts[0].Name, "Account names should be trimmed")
+	assert.Equal(t, "k1", a.Accounts[0].Credentials.Key, "First account of a duplicated name should be kept")
+	assert.Equal(t, "hedge", a.Accounts[1].Name)
+
+	a = &APIConfig{Accounts: []APIAccountConfig{{Name: "sub", Credentials: APICredentialsConfig{Subaccount: "sub"}}}}
+	a.checkAccounts("test")
+	assert.Len(t, a.Accounts, 1, "Accounts should be kept when there is no credentials validator")
+
+	a = &APIConfig{
+		CredentialsValidator: &APICredentialsValidatorConfig{RequiresKey: true},
+		Accounts:             []APIAccountConfig{{Name: "nokey"}},
+	}
+	a.checkAccounts("test")
+	assert.Nil(t, a.Accounts, "Accounts should be nil when no accounts are valid")
+}
@@ -5,11 +5,15 @@ import (
 	"sync"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/common/tracing"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/subscription"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc/auth"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/portfolio"
@@ -38,7 +42,25 @@ const (
 	DefaultAPIClientID                   = "ClientID"
 	defaultDataHistoryMonitorCheckTimer  = time.Minute
 	defaultCurrencyStateManagerDelay     = time.Minute
+	defaultDataQualityCheckInterval      = time.Hour
+	defaultDataQualityVolatilityWindow   = 20
+	defaultDataQualityOutlierThreshold   = 5
+	defaultDataQualityDivergence         = 1
+	defaultDataQualityMaxReports         = 100
+	defaultCompositePriceInterval        = time.Second * 10
+	defaultCompositePriceMaxQuoteAge     = time.Minute
+	defaultCompositePriceOutlierPercent  = 2
+	defaultCompositePriceExchangeName    = "composite"
+	defaultCompositePriceHistoryInterval = kline.OneMin
+	defaultRetentionCheckInterval        = time.Hour * 24
+	defaultRetentionBatchPeriod          = time.Hour * 24
+	defaultMetricsListenAddress          = "localhost:9464"
+	defaultMetricsPath                   = "/metrics"
+	defaultHealthListenAddress           = "localhost:9465"
+	defaultHealthSyncStaleThreshold      = time.Minute * 5
+	defaultHealthOrderPollStaleThreshold = time.Minute
 	defaultMaxJobsPerCycle               = 5
+	defaultWithdrawalApprovalExpiry      = time.Hour * 24
 	// DefaultSyncerWorkers limits the number of sync workers
 	DefaultSyncerWorkers = 15
 	// DefaultSyncerTimeoutREST the default time to switch from REST to websocket protocols without a response
@@ -91,33 +113,41 @@ var (
 // Config is the overarching object that holds all the information for
 // prestart management of Portfolio, Communications, Webserver and Enabled Exchanges
 type Config struct {
-	Name                 string                    `json:"name"`
-	Version              int                       `json:"version"`
-	DataDirectory        string                    `json:"dataDirectory"`
-	EncryptConfig        int                       `json:"encryptConfig"`
-	GlobalHTTPTimeout    time.Duration             `json:"globalHTTPTimeout"`
-	Database             database.Config           `json:"database"`
-	Logging              log.Config                `json:"logging"`
-	SyncManagerConfig    SyncManagerConfig         `json:"syncManager"`
-	ConnectionMonitor    ConnectionMonitorConfig   `json:"connectionMonitor"`
-	OrderManager         OrderManager              `json:"orderManager"`
-	DataHistoryManager   DataHistoryManager        `json:"dataHistoryManager"`
-	CurrencyStateManager CurrencyStateManager      `json:"currencyStateManager"`
-	Profiler             Profiler                  `json:"profiler"`
-	NTPClient            NTPClientConfig           `json:"ntpclient"`
-	GCTScript            gctscript.Config          `json:"gctscript"`
-	Currency             currency.Config           `json:"currencyConfig"`
-	Communications       base.CommunicationsConfig `json:"communications"`
-	RemoteControl        RemoteControlConfig       `json:"remoteControl"`
-	Portfolio            *portfolio.Base           `json:"portfolioAddresses"`
-	Exchanges            []Exchange                `json:"exchanges"`
-	BankAccounts         []banking.Account         `json:"bankAccounts"`
+	Name                  string                    `json:"name"`
+	Version               int                       `json:"version"`
+	DataDirectory         string                    `json:"dataDirectory"`
+	EncryptConfig         int                       `json:"encryptConfig"`
+	GlobalHTTPTimeout     time.Duration             `json:"globalHTTPTimeout"`
+	Database              database.Config           `json:"database"`
+	Logging               log.Config                `json:"logging"`
+	SyncManagerConfig     SyncManagerConfig         `json:"syncManager"`
+	ConnectionMonitor     ConnectionMonitorConfig   `json:"connectionMonitor"`
+	OrderManager          OrderManager              `json:"orderManager"`
+	DataHistoryManager    DataHistoryManager        `json:"dataHistoryManager"`
+	DataQualityManager    DataQualityManager        `json:"dataQualityManager"`
+	CompositePriceManager CompositePriceManager     `json:"compositePriceManager"`
+	DatabaseRetention     DatabaseRetentionManager  `json:"databaseRetentionManager"`
+	CurrencyStateManager  CurrencyStateManager      `json:"currencyStateManager"`
+	Profiler              Profiler                  `json:"profiler"`
+	Metrics               MetricsConfig             `json:"metrics"`
+	Tracing               tracing.Config            `json:"tracing"`
+	Health                HealthConfig              `json:"health"`
+	SharedRateLimiter     SharedRateLimiterConfig   `json:"sharedRateLimiter"`
+	NTPClient             NTPClientConfig           `json:"ntpclient"`
+	GCTScript             gctscript.Config          `json:"gctscript"`
+	Currency              currency.Config           `json:"currencyConfig"`
+	Communications        base.CommunicationsConfig `json:"communications"`
+	RemoteControl         RemoteControlConfig       `json:"remoteControl"`
+	WithdrawalPolicy      WithdrawalPolicyConfig    `json:"withdrawalPolicy"`
+	Portfolio             *portfolio.Base           `json:"portfolioAddresses"`
+	Exchanges             []Exchange                `json:"exchanges"`
+	BankAccounts          []banking.Account         `json:"bankAccounts"`
 
 	// Deprecated config settings, will be removed at a future date
-	CurrencyPairFormat  *currency.PairFormat  `json:"currencyPairFormat,omitempty"`
-	FiatDisplayCurrency *currency.Code        `json:"fiatDispayCurrency,omitempty"`
-	Cryptocurrencies    *currency.Currencies  `json:"cryptocurrencies,omitempty"`
-	SMS                 *base.SMSGlobalConfig `json:"smsGlobal,omitempty"`
+	CurrencyPairFormat  *currency.PairFormat  `json:"currencyPairFormat,omitempty" jsonschema:"deprecated"`
+	FiatDisplayCurrency *currency.Code        `json:"fiatDispayCurrency,omitempty" jsonschema:"deprecated"`
+	Cryptocurrencies    *currency.Currencies  `json:"cryptocurrencies,omitempty" jsonschema:"deprecated"`
+	SMS                 *base.SMSGlobalConfig `json:"smsGlobal,omitempty" jsonschema:"deprecated"`
 	// encryption session values
 	storedSalt            []byte
 	sessionDK             []byte
@@ -146,6 +176,80 @@ type DataHistoryManager struct {
 	Verbose             bool          `json:"verbose"`
 }
 
+// DataQualityManager holds all information required for the data quality
+// manager to periodically scan stored candle and trade data
+type DataQualityManager struct {
+	Enabled                       bool                `json:"enabled"`
+	CheckInterval                 time.Duration       `json:"checkInterval"`
+	VolatilityWindow              int                 `json:"volatilityWindow"`
+	OutlierThreshold              float64             `json:"outlierThreshold"`
+	DivergenceTolerancePercentage float64             `json:"divergenceTolerancePercentage"`
+	MaxReports                    int                 `json:"maxReports"`
+	AutoCreateRepairJobs          bool                `json:"autoCreateRepairJobs"`
+	Verbose                       bool                `json:"verbose"`
+	Targets                       []DataQualityTarget `json:"targets"`
+}
+
+// DataQualityTarget defines a stored data set which is scanned by the data
+// quality manager
+type DataQualityTarget struct {
+	Exchange           string         `json:"exchange"`
+	Asset              asset.Item     `json:"asset"`
+	Pair               currency.Pair  `json:"pair"`
+	Interval           kline.Interval `json:"interval"`
+	Lookback           time.Duration  `json:"lookback"`
+	SecondaryExchanges []string       `json:"secondaryExchanges,omitempty"`
+	IncludeTrades      bool           `json:"includeTrades"`
+}
+
+// CompositePriceManager holds all information required for the composite
+// price manager to calculate reference prices across exchanges
+type CompositePriceManager struct {
+	Enabled                    bool                   `json:"enabled"`
+	Interval                   time.Duration          `json:"interval"`
+	MaxQuoteAge                time.Duration          `json:"maxQuoteAge"`
+	OutlierTolerancePercentage float64                `json:"outlierTolerancePercentage"`
+	VirtualExchangeName        string                 `json:"virtualExchangeName"`
+	RecordHistory              bool                   `json:"recordHistory"`
+	HistoryInterval            kline.Interval         `json:"historyInterval"`
+	Verbose                    bool                   `json:"verbose"`
+	Targets                    []CompositePriceTarget `json:"targets"`
+}
+
+// CompositePriceTarget defines a currency pair which has a composite price
+// calculated. Markets quoted in any of the equivalent quote currencies are
+// converted and combined with markets quoted in the pair's quote currency
+type CompositePriceTarget struct {
+	Asset            asset.Item      `json:"asset"`
+	Pair             currency.Pair   `json:"pair"`
+	Exchanges        []string        `json:"exchanges,omitempty"`
+	EquivalentQuotes []currency.Code `json:"equivalentQuotes,omitempty"`
+}
+
+// DatabaseRetentionManager holds all information required for the database
+// retention manager to prune and downsample stored candle and trade data
+type DatabaseRetentionManager struct {
+	Enabled       bool                    `json:"enabled"`
+	CheckInterval time.Duration           `json:"checkInterval"`
+	BatchPeriod   time.Duration           `json:"batchPeriod"`
+	Verbose       bool                    `json:"verbose"`
+	Rules         []DatabaseRetentionRule `json:"rules"`
+}
+
+// DatabaseRetentionRule defines how long a stored data set is kept. Candles can
+// be downsampled to a coarser interval before they are deleted. A zero
+// retention period keeps the data forever. When no pairs are set, the
+// exchange's enabled pairs for the asset are used
+type DatabaseRetentionRule struct {
+	DataType           string         `json:"dataType"`
+	Exchange           string         `json:"exchange"`
+	Asset              asset.Item     `json:"asset"`
+	Pairs              currency.Pairs `json:"pairs,omitempty"`
+	Interval           kline.Interval `json:"interval,omitempty"`
+	RetentionPeriod    time.Duration  `json:"retentionPeriod"`
+	DownsampleInterval kline.Interval `json:"downsampleInterval,omitempty"`
+}
+
 // CurrencyStateManager defines a set of configuration options for the currency
 // state manager
 type CurrencyStateManager struct {
@@ -193,6 +297,8 @@ type Exchange struct {
 	WebsocketResponseMaxLimit     time.Duration          `json:"websocketResponseMaxLimit"`
 	WebsocketTrafficTimeout       time.Duration          `json:"websocketTrafficTimeout"`
 	ConnectionMonitorDelay        time.Duration          `json:"connectionMonitorDelay"`
+	WebsocketCaptureFile          string                 `json:"websocketCaptureFile,omitempty"`
+	WebsocketSubscriptionTimeout  time.Duration          `json:"websocketSubscriptionTimeout,omitempty"`
 	ProxyAddress                  string                 `json:"proxyAddress,omitempty"`
 	BaseCurrencies                currency.Currencies    `json:"baseCurrencies"`
 	CurrencyPairs                 *currency.PairsManager `json:"currencyPairs"`
@@ -202,18 +308,18 @@ type Exchange struct {
 	Orderbook                     Orderbook              `json:"orderbook"`
 
 	// Deprecated settings which will be removed in a future update
-	AuthenticatedAPISupport          *bool   `json:"authenticatedApiSupport,omitempty"`
-	AuthenticatedWebsocketAPISupport *bool   `json:"authenticatedWebsocketApiSupport,omitempty"`
-	APIKey                           *string `json:"apiKey,omitempty"`
-	APISecret                        *string `json:"apiSecret,omitempty"`
-	APIAuthPEMKeySupport             *bool   `json:"apiAuthPemKeySupport,omitempty"`
-	APIAuthPEMKey                    *string `json:"apiAuthPemKey,omitempty"`
-	APIURL                           *string `json:"apiUrl,omitempty"`
-	APIURLSecondary                  *string `json:"apiUrlSecondary,omitempty"`
-	ClientID                         *string `json:"clientId,omitempty"`
-	SupportsAutoPairUpdates          *bool   `json:"supportsAutoPairUpdates,omitempty"`
-	Websocket                        *bool   `json:"websocket,omitempty"`
-	WebsocketURL                     *string `json:"websocketUrl,omitempty"`
+	AuthenticatedAPISupport          *bool   `json:"authenticatedApiSupport,omitempty" jsonschema:"deprecated"`
+	AuthenticatedWebsocketAPISupport *bool   `json:"authenticatedWebsocketApiSupport,omitempty" jsonschema:"deprecated"`
+	APIKey                           *string `json:"apiKey,omitempty" jsonschema:"deprecated"`
+	APISecret                        *string `json:"apiSecret,omitempty" jsonschema:"deprecated"`
+	APIAuthPEMKeySupport             *bool   `json:"apiAuthPemKeySupport,omitempty" jsonschema:"deprecated"`
+	APIAuthPEMKey                    *string `json:"apiAuthPemKey,omitempty" jsonschema:"deprecated"`
+	APIURL                           *string `json:"apiUrl,omitempty" jsonschema:"deprecated"`
+	APIURLSecondary                  *string `json:"apiUrlSecondary,omitempty" jsonschema:"deprecated"`
+	ClientID                         *string `json:"clientId,omitempty" jsonschema:"deprecated"`
+	SupportsAutoPairUpdates          *bool   `json:"supportsAutoPairUpdates,omitempty" jsonschema:"deprecated"`
+	Websocket                        *bool   `json:"websocket,omitempty" jsonschema:"deprecated"`
+	WebsocketURL                     *string `json:"websocketUrl,omitempty" jsonschema:"deprecated"`
 }
 
 // Profiler defines the profiler configuration to enable pprof
@@ -224,6 +330,31 @@ type Profiler struct {
 	BlockProfileRate     int    `json:"block_profile_rate"`
 }
 
+// MetricsConfig defines the metrics exporter settings. Metrics are served in
+// the OpenMetrics text format for scraping by Prometheus compatible collectors
+type MetricsConfig struct {
+	Enabled       bool   `json:"enabled"`
+	ListenAddress string `json:"listenAddress"`
+	Path          string `json:"path"`
+}
+
+// HealthConfig defines the health check server settings. Liveness, readiness
+// and a detailed subsystem health report are served over HTTP
+type HealthConfig struct {
+	Enabled                 bool          `json:"enabled"`
+	ListenAddress           string        `json:"listenAddress"`
+	SyncStaleThreshold      time.Duration `json:"syncStaleThreshold"`
+	OrderPollStaleThreshold time.Duration `json:"orderPollStaleThreshold"`
+}
+
+// SharedRateLimiterConfig defines a rate limit budget shared between bot
+// processes on the same host. Path defaults to ratelimit.db in the data
+// directory when unset
+type SharedRateLimiterConfig struct {
+	Enabled bool   `json:"enabled"`
+	Path    string `json:"path"`
+}
+
 // NTPClientConfig defines a network time protocol configuration to allow for
 // positive and negative differences
 type NTPClientConfig struct {
@@ -241,13 +372,69 @@ type GRPCConfig struct {
 	GRPCProxyListenAddress string `json:"grpcProxyListenAddress"`
 	GRPCAllowBotShutdown   bool   `json:"grpcAllowBotShutdown"`
 	TimeInNanoSeconds      bool   `json:"timeInNanoSeconds"`
+	// ClientCAFile enables mutual TLS, verifying client certificates against
+	// the CA certificates in the file
+	ClientCAFile string `json:"clientCAFile,omitempty"`
+	// WebsocketGatewayEnabled serves server streaming RPCs to websocket
+	// clients on the gRPC proxy
+	WebsocketGatewayEnabled bool `json:"websocketGatewayEnabled,omitempty"`
+	// WebsocketGatewayAllowedOrigins lists the browser origins allowed to
+	// connect to the websocket gateway in addition to the proxy's own origin
+	WebsocketGatewayAllowedOrigins []string `json:"websocketGatewayAllowedOrigins,omitempty"`
+}
+
+// RemoteControlUser stores a gRPC user, authenticated by either an API token
+// or a client certificate, and the roles granted to them
+type RemoteControlUser struct {
+	Name                  string      `json:"name"`
+	TokenHash             string      `json:"tokenHash,omitempty"`
+	CertificateCommonName string      `json:"certificateCommonName,omitempty"`
+	Roles                 []auth.Role `json:"roles"`
+}
+
+// WithdrawalPolicyConfig stores the withdrawal policy applied to crypto and
+// fiat withdrawals. Limits are in USD and a zero limit is unlimited. When
+// approval is required, withdrawals are held as pending until a second
+// operator listed as an approver confirms them with a TOTP code
+type WithdrawalPolicyConfig struct {
+	Enabled                bool                 `json:"enabled"`
+	PerTransactionLimitUSD float64              `json:"perTransactionLimitUSD"`
+	DailyLimitUSD          float64              `json:"dailyLimitUSD"`
+	NewAddressTimeLock     time.Duration        `json:"newAddressTimeLock"`
+	RequireApproval        bool                 `json:"requireApproval"`
+	ApprovalExpiry         time.Duration        `json:"approvalExpiry"`
+	Approvers              []WithdrawalApprover `json:"approvers,omitempty"`
+	AddressBook            []WithdrawalAddress  `json:"addressBook,omitempty"`
+}
+
+// WithdrawalApprover is an operator allowed to approve pending withdrawals.
+// The name matches a remote control user name and the OTP secret is a base32
+// TOTP secret, or a secret reference e.g. env:GCT_APPROVER_OTP
+type WithdrawalApprover struct {
+	Name      string `json:"name"`
+	OTPSecret string `json:"otpSecret"`
+}
+
+// WithdrawalAddress is an address book entry crypto withdrawals are allowed
+// to be sent to. An empty exchange or chain matches any exchange or chain.
+// Entries cannot be used until the new address time lock has passed since
+// they were added
+type WithdrawalAddress struct {
+	Label      string        `json:"label,omitempty"`
+	Exchange   string        `json:"exchange,omitempty"`
+	Currency   currency.Code `json:"currency"`
+	Chain      string        `json:"chain,omitempty"`
+	Address    string        `json:"address"`
+	AddressTag string        `json:"addressTag,omitempty"`
+	AddedAt    time.Time     `json:"addedAt"`
 }
 
 // RemoteControlConfig stores the RPC services config
 type RemoteControlConfig struct {
-	Username string     `json:"username"`
-	Password string     `json:"password"`
-	GRPC     GRPCConfig `json:"gRPC"`
+	Username string              `json:"username"`
+	Password string              `json:"password"`
+	GRPC     GRPCConfig          `json:"gRPC"`
+	Users    []RemoteControlUser `json:"users,omitempty"`
 }
 
 // Post holds the bot configuration data
@@ -313,6 +500,14 @@ type APICredentialsConfig struct {
 	PIN           string `json:"pin,omitempty"`
 }
 
+// APIAccountConfig stores the API credentials of a named exchange account,
+// used alongside the default credentials e.g. for sub accounts or separate
+// trading and hedging keys
+type APIAccountConfig struct {
+	Name        string               `json:"name"`
+	Credentials APICredentialsConfig `json:"credentials"`
+}
+
 // APICredentialsValidatorConfig stores the API credentials validator settings
 type APICredentialsValidatorConfig struct {
 	// For Huobi (optional)
@@ -331,6 +526,7 @@ type APIConfig struct {
 	PEMKeySupport                 bool `json:"pemKeySupport,omitempty"`
 
 	Credentials          APICredentialsConfig           `json:"credentials"`
+	Accounts             []APIAccountConfig             `json:"accounts,omitempty"`
 	CredentialsValidator *APICredentialsValidatorConfig `json:"credentialsValidator,omitempty"`
 	OldEndPoints         *APIEndpointsConfig            `json:"endpoints,omitempty"`
 	Endpoints            map[string]string              `json:"urlEndpoints"`
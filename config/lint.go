@@ -0,0 +1,192 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/buger/jsonparser"
+	"github.com/thrasher-corp/gocryptotrader/config/schema"
+	"github.com/thrasher-corp/gocryptotrader/config/versions"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+var errLintEncrypted = errors.New("config is encrypted and must be decrypted before linting")
+
+// schemaOverrides are the types whose JSON decoding accepts more than their
+// encoded form
+var schemaOverrides = schema.Overrides{
+	reflect.TypeFor[kline.Interval](): {AnyOf: []*schema.Schema{{Type: schema.TypeString}, {Type: schema.TypeInteger}}},
+}
+
+// Schema returns the JSON Schema of the config, with its version limited to
+// those registered in config/versions
+func Schema() (*schema.Schema, error) {
+	s, err := schema.Generate(&Config{}, schemaOverrides)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := versions.Manager.LatestVersion()
+	if err != nil {
+		return nil, err
+	}
+	maxVersion := float64(latest)
+	s.Properties["version"].Minimum = new(float64)
+	s.Properties["version"].Maximum = &maxVersion
+	s.Title = "GoCryptoTrader config"
+	return s, nil
+}
+
+// Lint validates a decrypted config offline, returning the issues found by JSON
+// path. Configs at an older version are upgraded in memory before they are
+// checked against the schema and for mistakes which otherwise only surface
+// at runtime. When supportedExchanges is set exchange names are checked
+// against it
+func Lint(ctx context.Context, data []byte, supportedExchanges []string) ([]schema.Issue, error) {
+	if IsEncrypted(data) {
+		return nil, errLintEncrypted
+	}
+	latest, err := versions.Manager.LatestVersion()
+	if err != nil {
+		return nil, err
+	}
+	var issues []schema.Issue
+	version, err := jsonparser.GetInt(data, "version")
+	if err != nil && !errors.Is(err, jsonparser.KeyPathNotFoundError) {
+		return []schema.Issue{{Severity: schema.SeverityError, Path: "$.version", Message: err.Error()}}, nil
+	}
+	switch {
+	case version > int64(latest) || version < 0:
+		return []schema.Issue{{Severity: schema.SeverityError, Path: "$.version", Message: fmt.Sprintf("version %d is not between 0 and the latest version %d", version, latest)}}, nil
+	case version < int64(latest):
+		issues = append(issues, schema.Issue{Severity: schema.SeverityWarning, Path: "$.version", Message: fmt.Sprintf("version %d is older than the latest version %d and was upgraded for linting, run `config upgrade` to upgrade the file", version, latest)})
+		if data, err = versions.Manager.Deploy(ctx, data, versions.UseLatestVersion); err != nil {
+			return nil, err
+		}
+	}
+
+	s, err := Schema()
+	if err != nil {
+		return nil, err
+	}
+	schemaIssues, err := s.Validate(data)
+	if err != nil {
+		return nil, err
+	}
+	issues = append(issues, schemaIssues...)
+	if schema.HasErrors(schemaIssues) {
+		// Values of the wrong type cannot be decoded for further checks
+		return issues, nil
+	}
+
+	var c Config
+	if err := json.Unmarshal(data, &c); err != nil {
+		return append(issues, schema.Issue{Severity: schema.SeverityError, Path: "$", Message: err.Error()}), nil
+	}
+	issues = append(issues, c.lintExchanges(supportedExchanges)...)
+	// Exchange subscription intervals are update rates rather than candle
+	// intervals, so only the top level subsystems are checked
+	c.Exchanges = nil
+	issues = append(issues, lintIntervals("$", reflect.ValueOf(&c))...)
+	return issues, nil
+}
+
+// lintExchanges checks exchange names are supported and unique, and that
+// enabled pairs are available
+func (c *Config) lintExchanges(supportedExchanges []string) []schema.Issue {
+	var issues []schema.Issue
+	seen := make(map[string]bool, len(c.Exchanges))
+	for i := range c.Exchanges {
+		e := &c.Exchanges[i]
+		path := "$.exchanges[" + strconv.Itoa(i) + "]"
+		name := strings.ToLower(e.Name)
+		switch {
+		case name == "":
+			issues = append(issues, schema.Issue{Severity: schema.SeverityError, Path: path + ".name", Message: "exchange name is empty"})
+		case len(supportedExchanges) > 0 && !slices.ContainsFunc(supportedExchanges, func(s string) bool { return strings.EqualFold(s, name) }):
+			issues = append(issues, schema.Issue{Severity: schema.SeverityError, Path: path + ".name", Message: fmt.Sprintf("unknown exchange %q", e.Name)})
+		case seen[name]:
+			issues = append(issues, schema.Issue{Severity: schema.SeverityError, Path: path + ".name", Message: fmt.Sprintf("duplicate exchange %q", e.Name)})
+		}
+		seen[name] = true
+
+		if e.CurrencyPairs == nil {
+			if e.Enabled {
+				issues = append(issues, schema.Issue{Severity: schema.SeverityError, Path: path + ".currencyPairs", Message: "enabled exchange has no currency pairs"})
+			}
+			continue
+		}
+		for _, a := range e.CurrencyPairs.GetAssetTypes(false) {
+			store, err := e.CurrencyPairs.Get(a)
+			if err != nil {
+				continue
+			}
+			assetPath := schema.JoinPath(path+".currencyPairs.pairs", a.String())
+			if store.AssetEnabled && len(store.Enabled) == 0 {
+				issues = append(issues, schema.Issue{Severity: schema.SeverityWarning, Path: assetPath + ".enabled", Message: "asset is enabled without any enabled pairs"})
+			}
+			if len(store.Available) == 0 {
+				// Available pairs are fetched from the exchange on startup
+				continue
+			}
+			for _, p := range store.Enabled {
+				if !store.Available.Contains(p, true) {
+					issues = append(issues, schema.Issue{Severity: schema.SeverityError, Path: assetPath + ".enabled", Message: fmt.Sprintf("pair %s is not in the available pairs", p)})
+				}
+			}
+		}
+	}
+	return issues
+}
+
+var intervalType = reflect.TypeFor[kline.Interval]()
+
+// lintIntervals walks a decoded config returning an issue for each kline
+// interval which is set but not supported
+func lintIntervals(path string, v reflect.Value) []schema.Issue {
+	var issues []schema.Issue
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			issues = lintIntervals(path, v.Elem())
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := range t.NumField() {
+			f := t.Field(i)
+			name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+			if !f.IsExported() || name == "-" {
+				continue
+			}
+			if name == "" {
+				name = f.Name
+			}
+			issues = append(issues, lintIntervals(schema.JoinPath(path, name), v.Field(i))...)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := range v.Len() {
+			issues = append(issues, lintIntervals(path+"["+strconv.Itoa(i)+"]", v.Index(i))...)
+		}
+	case reflect.Map:
+		keys := v.MapKeys()
+		slices.SortFunc(keys, func(a, b reflect.Value) int {
+			return strings.Compare(fmt.Sprint(a.Interface()), fmt.Sprint(b.Interface()))
+		})
+		for _, k := range keys {
+			issues = append(issues, lintIntervals(schema.JoinPath(path, fmt.Sprint(k.Interface())), v.MapIndex(k))...)
+		}
+	case reflect.Int64:
+		if v.Type() != intervalType || v.IsZero() {
+			break
+		}
+		if i := kline.Interval(v.Int()); !slices.Contains(kline.SupportedIntervals, i) && i != kline.Raw {
+			issues = append(issues, schema.Issue{Severity: schema.SeverityError, Path: path, Message: fmt.Sprintf("interval %s is not supported", i)})
+		}
+	}
+	return issues
+}
@@ -0,0 +1,80 @@
+package config
+
+import (
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/buger/jsonparser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config/schema"
+	"github.com/thrasher-corp/gocryptotrader/config/versions"
+)
+
+func TestSchema(t *testing.T) {
+	t.Parallel()
+	s, err := Schema()
+	require.NoError(t, err)
+	latest, err := versions.Manager.LatestVersion()
+	require.NoError(t, err)
+	assert.Equal(t, float64(latest), *s.Properties["version"].Maximum)
+	assert.True(t, s.Properties["currencyPairFormat"].Deprecated)
+	assert.NotEmpty(t, s.Properties["exchanges"].Items.Properties)
+}
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+	data, err := os.ReadFile(TestFile)
+	require.NoError(t, err)
+	supported := []string{"BTC Markets", "BTSE", "Binance", "Binanceus", "Bitfinex", "Bitflyer", "Bithumb", "Bitmex", "Bitstamp", "Bybit", "COINUT", "Coinbase", "Deribit", "GateIO", "Gemini", "HitBTC", "Huobi", "Kraken", "Kucoin", "Lbank", "Okx", "Poloniex", "Yobit"}
+
+	issues, err := Lint(t.Context(), data, supported)
+	require.NoError(t, err)
+	assert.False(t, schema.HasErrors(issues), "Lint should not find errors in the test config")
+
+	_, err = Lint(t.Context(), []byte(`THORS-HAMMER`), nil)
+	assert.ErrorIs(t, err, errLintEncrypted)
+
+	issues, err = Lint(t.Context(), []byte(`{"version":65535}`), nil)
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.Equal(t, "$.version", issues[0].Path)
+
+	set := func(t *testing.T, value string, keys ...string) []byte {
+		t.Helper()
+		b, err := jsonparser.Set(data, []byte(value), keys...)
+		require.NoError(t, err)
+		return b
+	}
+	for _, tc := range []struct {
+		name     string
+		data     []byte
+		severity schema.Severity
+		path     string
+	}{
+		{"wrong type", set(t, `"yes"`, "exchanges", "[1]", "enabled"), schema.SeverityError, "$.exchanges[1].enabled"},
+		{"deprecated field", set(t, `{}`, "currencyPairFormat"), schema.SeverityWarning, "$.currencyPairFormat"},
+		{"unknown field", set(t, `true`, "typo"), schema.SeverityWarning, "$.typo"},
+		{"unknown exchange", set(t, `"NotAnExchange"`, "exchanges", "[0]", "name"), schema.SeverityError, "$.exchanges[0].name"},
+		{"duplicate exchange", set(t, `"BTSE"`, "exchanges", "[0]", "name"), schema.SeverityError, "$.exchanges[1].name"},
+		{"unavailable pair", set(t, `"BTC-NZD"`, "exchanges", "[0]", "currencyPairs", "pairs", "spot", "enabled"), schema.SeverityError, "$.exchanges[0].currencyPairs.pairs.spot.enabled"},
+		{"unsupported interval", set(t, `12345`, "compositePriceManager", "historyInterval"), schema.SeverityError, "$.compositePriceManager.historyInterval"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			issues, err := Lint(t.Context(), tc.data, supported)
+			require.NoError(t, err)
+			assert.Truef(t, slices.ContainsFunc(issues, func(i schema.Issue) bool {
+				return i.Path == tc.path && i.Severity == tc.severity
+			}), "Lint should report a %s at %s, got %v", tc.severity, tc.path, issues)
+		})
+	}
+
+	v0, err := os.ReadFile(TestFileV0)
+	require.NoError(t, err)
+	issues, err = Lint(t.Context(), v0, nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, issues)
+	assert.Equal(t, schema.Issue{Severity: schema.SeverityWarning, Path: "$.version", Message: issues[0].Message}, issues[0], "Lint should warn that an older config was upgraded")
+}
@@ -0,0 +1,359 @@
+// Package schema generates JSON Schemas from Go config types and validates
+// JSON documents against them, reporting issues by JSON path
+package schema
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+// Draft is the JSON Schema dialect generated schemas conform to
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// JSON Schema types
+const (
+	TypeObject  = "object"
+	TypeArray   = "array"
+	TypeString  = "string"
+	TypeNumber  = "number"
+	TypeInteger = "integer"
+	TypeBoolean = "boolean"
+)
+
+// Tag is the struct tag read when generating a schema. A field tagged
+// `jsonschema:"deprecated"` is marked as deprecated
+const Tag = "jsonschema"
+
+var errNilType = errors.New("cannot generate a schema for a nil type")
+
+// Schema is a subset of JSON Schema sufficient to describe config types
+type Schema struct {
+	Schema      string             `json:"$schema,omitempty"`
+	Title       string             `json:"title,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Type        string             `json:"type,omitempty"`
+	Format      string             `json:"format,omitempty"`
+	Deprecated  bool               `json:"deprecated,omitempty"`
+	Enum        []any              `json:"enum,omitempty"`
+	Minimum     *float64           `json:"minimum,omitempty"`
+	Maximum     *float64           `json:"maximum,omitempty"`
+	AnyOf       []*Schema          `json:"anyOf,omitempty"`
+	Items       *Schema            `json:"items,omitempty"`
+	Properties  map[string]*Schema `json:"properties,omitempty"`
+	// AdditionalProperties is false for structs, which reject unknown
+	// fields, or the schema of map values
+	AdditionalProperties any `json:"additionalProperties,omitempty"`
+}
+
+// Overrides maps Go types to the schema used for them in place of the
+// generated one, for types whose JSON unmarshalling accepts more than their
+// marshalled form
+type Overrides map[reflect.Type]*Schema
+
+type jsonMarshaler interface {
+	MarshalJSON() ([]byte, error)
+}
+
+type jsonUnmarshaler interface {
+	UnmarshalJSON([]byte) error
+}
+
+var (
+	timeType            = reflect.TypeFor[time.Time]()
+	jsonMarshalerType   = reflect.TypeFor[jsonMarshaler]()
+	textMarshalerType   = reflect.TypeFor[encoding.TextMarshaler]()
+	jsonUnmarshalerType = reflect.TypeFor[jsonUnmarshaler]()
+)
+
+// Generate returns the JSON Schema of the type of v
+func Generate(v any, overrides Overrides) (*Schema, error) {
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return nil, errNilType
+	}
+	g := generator{overrides: overrides, visiting: make(map[reflect.Type]bool)}
+	s := g.generate(t)
+	s.Schema = Draft
+	return s, nil
+}
+
+type generator struct {
+	overrides Overrides
+	visiting  map[reflect.Type]bool
+}
+
+func (g *generator) generate(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if s, ok := g.overrides[t]; ok {
+		c := *s
+		return &c
+	}
+	if t == timeType {
+		return &Schema{Type: TypeString, Format: "date-time"}
+	}
+	if s := marshalerSchema(t); s != nil {
+		return s
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: TypeBoolean}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &Schema{Type: TypeInteger}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return &Schema{Type: TypeInteger, Minimum: new(float64)}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: TypeNumber}
+	case reflect.String:
+		return &Schema{Type: TypeString}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			// Byte slices are encoded as base64 strings
+			return &Schema{Type: TypeString}
+		}
+		return &Schema{Type: TypeArray, Items: g.generate(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: TypeObject, AdditionalProperties: g.generate(t.Elem())}
+	case reflect.Struct:
+		if g.visiting[t] {
+			// Recursive types are left unconstrained below the first level
+			return &Schema{Type: TypeObject}
+		}
+		g.visiting[t] = true
+		defer delete(g.visiting, t)
+		s := &Schema{Type: TypeObject, Properties: make(map[string]*Schema), AdditionalProperties: false}
+		g.addFields(s, t)
+		return s
+	default:
+		// Interfaces and anything else accept any value
+		return &Schema{}
+	}
+}
+
+// addFields adds the JSON encoded fields of a struct, including those of
+// embedded structs, to the schema's properties
+func (g *generator) addFields(s *Schema, t reflect.Type) {
+	for i := range t.NumField() {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				g.addFields(s, ft)
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		p := g.generate(f.Type)
+		p.Deprecated = slices.Contains(strings.Split(f.Tag.Get(Tag), ","), "deprecated")
+		s.Properties[name] = p
+	}
+}
+
+// marshalerSchema returns the schema of types with custom JSON or text
+// marshalling, inferred from the encoding of their zero value, or nil when
+// the encoding is not a JSON primitive
+func marshalerSchema(t reflect.Type) *Schema {
+	pt := reflect.PointerTo(t)
+	if t.Implements(textMarshalerType) || pt.Implements(textMarshalerType) {
+		if !t.Implements(jsonMarshalerType) && !pt.Implements(jsonMarshalerType) {
+			return &Schema{Type: TypeString}
+		}
+	}
+	if !t.Implements(jsonMarshalerType) && !pt.Implements(jsonMarshalerType) && !pt.Implements(jsonUnmarshalerType) {
+		return nil
+	}
+	b, err := json.Marshal(reflect.New(t).Interface())
+	if err != nil || len(b) == 0 {
+		return &Schema{}
+	}
+	switch {
+	case b[0] == '"':
+		return &Schema{Type: TypeString}
+	case b[0] == 't' || b[0] == 'f':
+		return &Schema{Type: TypeBoolean}
+	case b[0] == '-' || (b[0] >= '0' && b[0] <= '9'):
+		return &Schema{Type: TypeNumber}
+	case b[0] == 'n':
+		return &Schema{}
+	}
+	return nil
+}
+
+// Severity is the severity of a validation issue
+type Severity string
+
+// Issue severities
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Issue is a problem found at a JSON path of a validated document
+type Issue struct {
+	Severity Severity
+	Path     string
+	Message  string
+}
+
+// String returns the issue in a single line
+func (i Issue) String() string {
+	return fmt.Sprintf("%s: %s: %s", i.Severity, i.Path, i.Message)
+}
+
+// Validate checks a JSON document against the schema, returning the issues
+// found. A document which is not valid JSON returns an error
+func (s *Schema) Validate(data []byte) ([]Issue, error) {
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	var issues []Issue
+	s.validate("$", v, &issues)
+	return issues, nil
+}
+
+func (s *Schema) validate(path string, v any, issues *[]Issue) {
+	if v == nil {
+		// Null decodes to the zero value of any Go type
+		return
+	}
+	if len(s.AnyOf) > 0 {
+		for _, sub := range s.AnyOf {
+			var subIssues []Issue
+			sub.validate(path, v, &subIssues)
+			if !HasErrors(subIssues) {
+				*issues = append(*issues, subIssues...)
+				return
+			}
+		}
+		types := make([]string, len(s.AnyOf))
+		for i, sub := range s.AnyOf {
+			types[i] = sub.Type
+		}
+		addIssue(issues, SeverityError, path, "expected one of %s, got %s", strings.Join(types, ", "), typeOf(v))
+		return
+	}
+	if s.Type != "" && !matchesType(s.Type, v) {
+		addIssue(issues, SeverityError, path, "expected %s, got %s", s.Type, typeOf(v))
+		return
+	}
+	if len(s.Enum) > 0 && !slices.ContainsFunc(s.Enum, func(e any) bool { return reflect.DeepEqual(e, v) }) {
+		addIssue(issues, SeverityError, path, "value %v is not one of %v", v, s.Enum)
+	}
+
+	switch val := v.(type) {
+	case float64:
+		if s.Minimum != nil && val < *s.Minimum {
+			addIssue(issues, SeverityError, path, "value %v is less than the minimum %v", val, *s.Minimum)
+		}
+		if s.Maximum != nil && val > *s.Maximum {
+			addIssue(issues, SeverityError, path, "value %v is greater than the maximum %v", val, *s.Maximum)
+		}
+	case []any:
+		if s.Items == nil {
+			return
+		}
+		for i := range val {
+			s.Items.validate(path+"["+strconv.Itoa(i)+"]", val[i], issues)
+		}
+	case map[string]any:
+		keys := make([]string, 0, len(val))
+		for k := range val {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			p := JoinPath(path, k)
+			if prop, ok := s.Properties[k]; ok {
+				if prop.Deprecated {
+					addIssue(issues, SeverityWarning, p, "field is deprecated")
+				}
+				prop.validate(p, val[k], issues)
+				continue
+			}
+			switch ap := s.AdditionalProperties.(type) {
+			case *Schema:
+				ap.validate(p, val[k], issues)
+			case bool:
+				if !ap {
+					// Unknown fields are ignored when decoding, so are most
+					// likely typos or leftovers from an older version
+					addIssue(issues, SeverityWarning, p, "unknown field is ignored")
+				}
+			}
+		}
+	}
+}
+
+// JoinPath appends an object key to a JSON path, quoting keys which are not
+// plain identifiers
+func JoinPath(path, key string) string {
+	for _, r := range key {
+		if r != '_' && r != '-' && (r < '0' || r > '9') && (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return path + "[" + strconv.Quote(key) + "]"
+		}
+	}
+	return path + "." + key
+}
+
+// HasErrors returns whether any of the issues are errors
+func HasErrors(issues []Issue) bool {
+	return slices.ContainsFunc(issues, func(i Issue) bool { return i.Severity == SeverityError })
+}
+
+func addIssue(issues *[]Issue, severity Severity, path, format string, args ...any) {
+	*issues = append(*issues, Issue{Severity: severity, Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+func matchesType(schemaType string, v any) bool {
+	switch schemaType {
+	case TypeInteger:
+		f, ok := v.(float64)
+		return ok && f == math.Trunc(f)
+	case TypeNumber:
+		_, ok := v.(float64)
+		return ok
+	default:
+		return typeOf(v) == schemaType
+	}
+}
+
+func typeOf(v any) string {
+	switch v.(type) {
+	case map[string]any:
+		return TypeObject
+	case []any:
+		return TypeArray
+	case string:
+		return TypeString
+	case float64:
+		return TypeNumber
+	case bool:
+		return TypeBoolean
+	}
+	return "null"
+}
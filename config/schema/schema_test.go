@@ -0,0 +1,111 @@
+package schema
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEmbedded struct {
+	Embedded string `json:"embedded"`
+}
+
+type testConfig struct {
+	testEmbedded
+	Name     string            `json:"name"`
+	Count    uint              `json:"count"`
+	Rate     float64           `json:"rate"`
+	Enabled  bool              `json:"enabled"`
+	Started  time.Time         `json:"started"`
+	Tags     []string          `json:"tags"`
+	Limits   map[string]int    `json:"limits"`
+	Old      string            `json:"old" jsonschema:"deprecated"`
+	Child    *testConfig       `json:"child"`
+	Ignored  string            `json:"-"`
+	Settings map[string]string `json:"settings,omitempty"`
+	hidden   string
+}
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+	_, err := Generate(nil, nil)
+	assert.ErrorIs(t, err, errNilType)
+
+	s, err := Generate(&testConfig{}, Overrides{})
+	require.NoError(t, err)
+	assert.Equal(t, Draft, s.Schema)
+	assert.Equal(t, TypeObject, s.Type)
+	assert.Equal(t, false, s.AdditionalProperties, "Structs should not allow additional properties")
+	assert.Contains(t, s.Properties, "embedded", "Embedded struct fields should be promoted")
+	assert.NotContains(t, s.Properties, "Ignored", "Fields tagged - should be skipped")
+	assert.NotContains(t, s.Properties, "hidden", "Unexported fields should be skipped")
+	assert.Equal(t, TypeInteger, s.Properties["count"].Type)
+	assert.Equal(t, 0.0, *s.Properties["count"].Minimum, "Unsigned integers should have a minimum of 0")
+	assert.Equal(t, TypeNumber, s.Properties["rate"].Type)
+	assert.Equal(t, "date-time", s.Properties["started"].Format)
+	assert.Equal(t, TypeString, s.Properties["tags"].Items.Type)
+	assert.Equal(t, TypeInteger, s.Properties["limits"].AdditionalProperties.(*Schema).Type)
+	assert.True(t, s.Properties["old"].Deprecated)
+	assert.Equal(t, TypeObject, s.Properties["child"].Type)
+	assert.Empty(t, s.Properties["child"].Properties, "Recursive types should not be expanded")
+
+	s, err = Generate(testConfig{}, Overrides{reflect.TypeFor[time.Time](): {Type: TypeInteger}})
+	require.NoError(t, err)
+	assert.Equal(t, TypeInteger, s.Properties["started"].Type, "Overrides should replace the generated schema")
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+	s, err := Generate(&testConfig{}, nil)
+	require.NoError(t, err)
+
+	_, err = s.Validate([]byte(`{`))
+	assert.Error(t, err, "Validate should error on invalid JSON")
+
+	issues, err := s.Validate([]byte(`{"name":"a","count":1,"rate":1.5,"tags":["a"],"limits":{"a":1},"child":{"name":"b"},"settings":null}`))
+	require.NoError(t, err)
+	assert.Empty(t, issues, "A valid document should have no issues")
+
+	// The recursive child is unconstrained so its fields are not checked
+	issues, err = s.Validate([]byte(`{"name":1,"count":-1,"rate":"1","tags":[1],"limits":{"a b":1.5},"old":"x","typo":true,"child":{"enabled":"yes"}}`))
+	require.NoError(t, err)
+	assert.Equal(t, []Issue{
+		{Severity: SeverityError, Path: "$.count", Message: "value -1 is less than the minimum 0"},
+		{Severity: SeverityError, Path: `$.limits["a b"]`, Message: "expected integer, got number"},
+		{Severity: SeverityError, Path: "$.name", Message: "expected string, got number"},
+		{Severity: SeverityWarning, Path: "$.old", Message: "field is deprecated"},
+		{Severity: SeverityError, Path: "$.rate", Message: "expected number, got string"},
+		{Severity: SeverityError, Path: "$.tags[0]", Message: "expected string, got number"},
+		{Severity: SeverityWarning, Path: "$.typo", Message: "unknown field is ignored"},
+	}, issues)
+	assert.True(t, HasErrors(issues))
+	assert.False(t, HasErrors(issues[3:4]))
+
+	maxVal := 2.0
+	s = &Schema{AnyOf: []*Schema{{Type: TypeString}, {Type: TypeInteger, Maximum: &maxVal}}}
+	issues, err = s.Validate([]byte(`3`))
+	require.NoError(t, err)
+	assert.Equal(t, []Issue{{Severity: SeverityError, Path: "$", Message: "expected one of string, integer, got number"}}, issues)
+	issues, err = s.Validate([]byte(`"1h"`))
+	require.NoError(t, err)
+	assert.Empty(t, issues)
+
+	s = &Schema{Enum: []any{"a", "b"}}
+	issues, err = s.Validate([]byte(`"c"`))
+	require.NoError(t, err)
+	assert.Equal(t, []Issue{{Severity: SeverityError, Path: "$", Message: "value c is not one of [a b]"}}, issues)
+}
+
+func TestIssueString(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "error: $.a: bad", Issue{Severity: SeverityError, Path: "$.a", Message: "bad"}.String())
+}
+
+func TestJoinPath(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "$.a-b_1", JoinPath("$", "a-b_1"))
+	assert.Equal(t, `$["BTC/USD"]`, JoinPath("$", "BTC/USD"))
+}
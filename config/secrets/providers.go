@@ -0,0 +1,217 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+const (
+	defaultVaultAddress = "http://127.0.0.1:8200"
+	vaultTimeout        = 15 * time.Second
+	// maxSecretSize limits reads of secret files and responses
+	maxSecretSize = 1 << 20
+)
+
+var (
+	errVaultTokenUnset     = errors.New("VAULT_TOKEN environment variable is not set")
+	errVaultBadStatus      = errors.New("unexpected vault response status")
+	errVaultFieldUnset     = errors.New("vault reference must set the secret field as the URI fragment e.g. #key")
+	errKeyringUnsupported  = errors.New("keyring secrets are unsupported on this platform")
+	errKeyringInvalidRef   = errors.New("keyring reference must be in the form keyring:service/account")
+	errVaultFieldNotString = errors.New("vault secret field is not a string")
+)
+
+// envProvider resolves env:NAME references from environment variables
+type envProvider struct{}
+
+// Resolve implements Provider
+func (envProvider) Resolve(_ context.Context, ref *url.URL) (string, error) {
+	name := referenceName(ref)
+	if name == "" {
+		return "", errInvalidReference
+	}
+	v, ok := os.LookupEnv(name)
+	if !ok {
+		return "", fmt.Errorf("%w: environment variable %q is not set", errSecretNotFound, name)
+	}
+	return v, nil
+}
+
+// fileProvider resolves file:///path references from files such as those
+// mounted on tmpfs by Docker and Kubernetes secrets. Trailing line endings are
+// trimmed
+type fileProvider struct{}
+
+// Resolve implements Provider
+func (fileProvider) Resolve(_ context.Context, ref *url.URL) (string, error) {
+	path := ref.Opaque
+	if path == "" {
+		path = ref.Path
+	}
+	if path == "" {
+		return "", errInvalidReference
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	b, err := io.ReadAll(io.LimitReader(f, maxSecretSize))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(b), "\r\n"), nil
+}
+
+// vaultProvider resolves secrets from the HashiCorp Vault HTTP API using the
+// token set by the VAULT_TOKEN environment variable. References are in the
+// form vault://host:port/secret/data/path#field, where an empty host uses the
+// VAULT_ADDR environment variable. KV version 1 and 2 secret engines are
+// supported. References use HTTPS unless ?tls=false is set or the host is a
+// loopback address, as used by Vault dev servers, so that the token is never
+// sent unencrypted to a remote host
+type vaultProvider struct {
+	client *http.Client
+}
+
+func newVaultProvider() *vaultProvider {
+	return &vaultProvider{client: common.NewHTTPClientWithTimeout(vaultTimeout)}
+}
+
+// Resolve implements Provider
+func (v *vaultProvider) Resolve(ctx context.Context, ref *url.URL) (string, error) {
+	field := ref.Fragment
+	if field == "" {
+		return "", errVaultFieldUnset
+	}
+	path := strings.Trim(ref.Path, "/")
+	if path == "" {
+		return "", errInvalidReference
+	}
+	token := os.Getenv("VAULT_TOKEN")
+	if token == "" {
+		return "", errVaultTokenUnset
+	}
+
+	addr := vaultAddress(ref)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimRight(addr, "/")+"/v1/"+path, http.NoBody)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", token)
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return "", fmt.Errorf("%w: vault path %q", errSecretNotFound, path)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%w: %s", errVaultBadStatus, resp.Status)
+	}
+
+	var secret struct {
+		Data map[string]any `json:"data"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxSecretSize)).Decode(&secret); err != nil {
+		return "", err
+	}
+	data := secret.Data
+	// KV version 2 nests the secret data alongside its metadata
+	if nested, ok := data["data"].(map[string]any); ok {
+		if _, hasMetadata := data["metadata"]; hasMetadata {
+			data = nested
+		}
+	}
+	val, ok := data[field]
+	if !ok {
+		return "", fmt.Errorf("%w: vault field %q", errSecretNotFound, field)
+	}
+	s, ok := val.(string)
+	if !ok {
+		return "", fmt.Errorf("%w: vault field %q", errVaultFieldNotString, field)
+	}
+	return s, nil
+}
+
+// vaultAddress returns the Vault server address of a reference
+func vaultAddress(ref *url.URL) string {
+	if ref.Host == "" {
+		if addr := os.Getenv("VAULT_ADDR"); addr != "" {
+			return addr
+		}
+		return defaultVaultAddress
+	}
+	sch := "https"
+	switch ref.Query().Get("tls") {
+	case "false":
+		sch = "http"
+	case "":
+		if isLoopbackHost(ref.Hostname()) {
+			sch = "http"
+		}
+	}
+	return sch + "://" + ref.Host
+}
+
+// isLoopbackHost returns whether a host is localhost or a loopback IP address
+func isLoopbackHost(host string) bool {
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// keyringProvider resolves keyring:service/account references from the OS
+// keyring via the macOS security or Linux Secret Service secret-tool commands
+type keyringProvider struct {
+	goos string
+	run  func(ctx context.Context, name string, args ...string) ([]byte, error)
+}
+
+func newKeyringProvider() *keyringProvider {
+	return &keyringProvider{
+		goos: runtime.GOOS,
+		run: func(ctx context.Context, name string, args ...string) ([]byte, error) {
+			return exec.CommandContext(ctx, name, args...).Output()
+		},
+	}
+}
+
+// Resolve implements Provider
+func (k *keyringProvider) Resolve(ctx context.Context, ref *url.URL) (string, error) {
+	service, account, ok := strings.Cut(referenceName(ref), "/")
+	if !ok || service == "" || account == "" {
+		return "", errKeyringInvalidRef
+	}
+	var out []byte
+	var err error
+	switch k.goos {
+	case "darwin":
+		out, err = k.run(ctx, "security", "find-generic-password", "-s", service, "-a", account, "-w")
+	case "linux", "freebsd", "openbsd":
+		out, err = k.run(ctx, "secret-tool", "lookup", "service", service, "username", account)
+	default:
+		return "", fmt.Errorf("%w: %s", errKeyringUnsupported, k.goos)
+	}
+	if err != nil {
+		return "", fmt.Errorf("%w: keyring service %q account %q: %w", errSecretNotFound, service, account, err)
+	}
+	return strings.TrimRight(string(out), "\r\n"), nil
+}
@@ -0,0 +1,123 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// Secret reference schemes supported by the default providers
+const (
+	EnvScheme     = "env"
+	FileScheme    = "file"
+	VaultScheme   = "vault"
+	KeyringScheme = "keyring"
+)
+
+// Provider resolves secret references for a URI scheme
+type Provider interface {
+	Resolve(ctx context.Context, ref *url.URL) (string, error)
+}
+
+var (
+	errNilProvider      = errors.New("secret provider is nil")
+	errEmptyScheme      = errors.New("secret provider scheme is empty")
+	errInvalidReference = errors.New("invalid secret reference")
+	errSecretNotFound   = errors.New("secret not found")
+	errResolvingSecret  = errors.New("error resolving secret")
+)
+
+var (
+	providersMu sync.RWMutex
+	providers   = map[string]Provider{
+		EnvScheme:     envProvider{},
+		FileScheme:    fileProvider{},
+		VaultScheme:   newVaultProvider(),
+		KeyringScheme: newKeyringProvider(),
+	}
+)
+
+// Register registers a provider for a URI scheme, replacing any existing
+// provider for the scheme
+func Register(scheme string, p Provider) error {
+	if scheme == "" {
+		return errEmptyScheme
+	}
+	if p == nil {
+		return fmt.Errorf("%w for scheme %q", errNilProvider, scheme)
+	}
+	providersMu.Lock()
+	providers[strings.ToLower(scheme)] = p
+	providersMu.Unlock()
+	return nil
+}
+
+// IsReference returns whether a value is a secret reference for a registered
+// provider e.g. env:BINANCE_API_KEY
+func IsReference(s string) bool {
+	_, ok := provider(s)
+	return ok
+}
+
+// Resolve resolves a secret reference with its provider. Values which are not
+// secret references are returned unchanged
+func Resolve(ctx context.Context, s string) (string, error) {
+	p, ok := provider(s)
+	if !ok {
+		return s, nil
+	}
+	ref, err := url.Parse(s)
+	if err != nil {
+		// The parse error would contain the reference, which may be a secret
+		// value which happens to look like a reference
+		return "", fmt.Errorf("%w: unable to parse %s reference", errInvalidReference, scheme(s))
+	}
+	v, err := p.Resolve(ctx, ref)
+	if err != nil {
+		return "", fmt.Errorf("%w %s: %w", errResolvingSecret, Redact(s), err)
+	}
+	return v, nil
+}
+
+// Redact returns a secret reference with any userinfo and query removed, for
+// including in logs and errors
+func Redact(s string) string {
+	ref, err := url.Parse(s)
+	if err != nil {
+		return scheme(s) + ":<redacted>"
+	}
+	ref.User = nil
+	ref.RawQuery = ""
+	return ref.String()
+}
+
+func provider(s string) (Provider, bool) {
+	sch := scheme(s)
+	if sch == "" {
+		return nil, false
+	}
+	providersMu.RLock()
+	p, ok := providers[sch]
+	providersMu.RUnlock()
+	return p, ok
+}
+
+func scheme(s string) string {
+	sch, _, ok := strings.Cut(s, ":")
+	if !ok {
+		return ""
+	}
+	return strings.ToLower(sch)
+}
+
+// referenceName returns the name from references written either as
+// scheme:name or scheme://name
+func referenceName(ref *url.URL) string {
+	if ref.Opaque != "" {
+		return ref.Opaque
+	}
+	return ref.Host + ref.Path
+}
@@ -233,6 +233,11 @@ func (m *manager) Version(version uint16) any {
 	return nil
 }
 
+// LatestVersion returns the highest registered version number
+func (m *manager) LatestVersion() (uint16, error) {
+	return m.latest()
+}
+
 // latest returns the highest version number
 func (m *manager) latest() (uint16, error) {
 	m.m.RLock()
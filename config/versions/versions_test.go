@@ -132,6 +132,10 @@ func TestLatest(t *testing.T) {
 	v, err = m.latest()
 	require.NoError(t, err)
 	assert.Equal(t, uint16(2), v)
+
+	v, err = m.LatestVersion()
+	require.NoError(t, err)
+	assert.Equal(t, uint16(2), v, "LatestVersion should return the highest registered version")
 }
 
 func TestVersion(t *testing.T) {
@@ -19,25 +19,27 @@ func Connect(cfg *database.Config) (*database.Instance, error) {
 	if !cfg.Enabled {
 		return nil, database.ErrDatabaseSupportDisabled
 	}
+	db, err := sql.Open(database.DBPostgreSQL, DSN(cfg))
+	if err != nil {
+		return nil, err
+	}
+	err = database.DB.SetPostgresConnection(db)
+	if err != nil {
+		return nil, err
+	}
+	return database.DB, nil
+}
+
+// DSN returns the connection string for the supplied config
+func DSN(cfg *database.Config) string {
 	if cfg.SSLMode == "" {
 		cfg.SSLMode = "disable"
 	}
-
 	host := net.JoinHostPort(cfg.Host, strconv.FormatUint(uint64(cfg.Port), 10))
-	configDSN := fmt.Sprintf("postgres://%s:%s@%s/%s?sslmode=%s",
+	return fmt.Sprintf("postgres://%s:%s@%s/%s?sslmode=%s",
 		cfg.Username,
 		cfg.Password,
 		host,
 		cfg.Database,
 		cfg.SSLMode)
-
-	db, err := sql.Open(database.DBPostgreSQL, configDSN)
-	if err != nil {
-		return nil, err
-	}
-	err = database.DB.SetPostgresConnection(db)
-	if err != nil {
-		return nil, err
-	}
-	return database.DB, nil
 }
@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS withdrawal_approval
+(
+    id uuid PRIMARY KEY,
+    exchange varchar(128) NOT NULL,
+    currency varchar(30) NOT NULL,
+    chain varchar(128) NOT NULL,
+    address TEXT NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    amount_usd DOUBLE PRECISION NOT NULL,
+    request TEXT NOT NULL,
+    requested_by varchar(255) NOT NULL,
+    approved_by varchar(255) NOT NULL,
+    status varchar(30) NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX withdrawal_approval_status_idx ON withdrawal_approval (status);
+CREATE INDEX withdrawal_approval_created_at_idx ON withdrawal_approval (created_at);
+-- +goose Down
+DROP TABLE withdrawal_approval;
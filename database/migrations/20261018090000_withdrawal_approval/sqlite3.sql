@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE withdrawal_approval
+(
+    id text NOT NULL primary key,
+    exchange text NOT NULL,
+    currency text NOT NULL,
+    chain text NOT NULL,
+    address text NOT NULL,
+    amount real NOT NULL,
+    amount_usd real NOT NULL,
+    request text NOT NULL,
+    requested_by text NOT NULL,
+    approved_by text NOT NULL,
+    status text NOT NULL,
+    expires_at timestamp NOT NULL,
+    created_at timestamp NOT NULL,
+    updated_at timestamp NOT NULL
+);
+CREATE INDEX withdrawal_approval_status_idx ON withdrawal_approval (status);
+CREATE INDEX withdrawal_approval_created_at_idx ON withdrawal_approval (created_at);
+-- +goose Down
+DROP TABLE withdrawal_approval;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE withdrawal_approval
+    ADD account varchar(255) NOT NULL DEFAULT 'default';
+
+-- +goose Down
+ALTER TABLE withdrawal_approval
+    DROP account;
@@ -8,6 +8,7 @@ import (
 	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
 	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
 	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/changefeed"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/sqlboiler/boil"
 	"github.com/thrasher-corp/sqlboiler/queries/qm"
@@ -58,6 +59,11 @@ func Event(id, msgtype, message string) {
 		log.Errorf(log.Global, "Event Transaction commit failed: %v", err)
 		return
 	}
+	changefeed.Publish(changefeed.AuditEventChannel, &changefeed.AuditEventPayload{
+		Identifier: id,
+		Type:       msgtype,
+		Message:    message,
+	})
 }
 
 // GetEvent () returns list of order events matching query
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/csv"
+	"errors"
 	"fmt"
 	"io"
 	"math"
@@ -17,6 +18,7 @@ import (
 	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
 	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
 	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/changefeed"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/sqlboiler/boil"
@@ -99,6 +101,43 @@ func Series(exchangeName, base, quote string, interval int64, asset string, star
 	return out, err
 }
 
+// Oldest returns the timestamp of the earliest stored candle for the series
+func Oldest(exchangeName, base, quote string, interval int64, asset string) (time.Time, error) {
+	if exchangeName == "" || base == "" || quote == "" || asset == "" || interval <= 0 {
+		return time.Time{}, errInvalidInput
+	}
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return time.Time{}, err
+	}
+	queries := []qm.QueryMod{
+		qm.Where("base = ?", strings.ToUpper(base)),
+		qm.Where("quote = ?", strings.ToUpper(quote)),
+		qm.Where("interval = ?", interval),
+		qm.Where("asset = ?", strings.ToLower(asset)),
+		qm.Where("exchange_name_id = ?", exchangeUUID.String()),
+		qm.OrderBy("timestamp"),
+	}
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		ret, err := modelSQLite.Candles(queries...).One(context.TODO(), database.DB.SQL)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return time.Time{}, fmt.Errorf("%w: %s %s %s %v %s", ErrNoCandleDataFound, exchangeName, base, quote, interval, asset)
+			}
+			return time.Time{}, err
+		}
+		return time.Parse(time.RFC3339, ret.Timestamp)
+	}
+	ret, err := modelPSQL.Candles(queries...).One(context.TODO(), database.DB.SQL)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return time.Time{}, fmt.Errorf("%w: %s %s %s %v %s", ErrNoCandleDataFound, exchangeName, base, quote, interval, asset)
+		}
+		return time.Time{}, err
+	}
+	return ret.Timestamp, nil
+}
+
 // DeleteCandles will delete all existing matching candles
 func DeleteCandles(in *Item) (int64, error) {
 	if database.DB.SQL == nil {
@@ -213,9 +252,36 @@ func Insert(in *Item) (uint64, error) {
 	if err != nil {
 		return 0, err
 	}
+	changefeed.Publish(changefeed.CandleChannel, notificationPayload(in, totalInserted))
 	return totalInserted, nil
 }
 
+// notificationPayload summarises inserted candles for the change feed
+func notificationPayload(in *Item, inserted uint64) *changefeed.CandlePayload {
+	payload := &changefeed.CandlePayload{
+		ExchangeID: in.ExchangeID,
+		Asset:      strings.ToLower(in.Asset),
+		Base:       strings.ToUpper(in.Base),
+		Quote:      strings.ToUpper(in.Quote),
+		Interval:   in.Interval,
+		Inserted:   inserted,
+		Start:      in.Candles[0].Timestamp.UTC(),
+		End:        in.Candles[0].Timestamp.UTC(),
+		LastClose:  in.Candles[0].Close,
+	}
+	for i := range in.Candles[1:] {
+		c := &in.Candles[i+1]
+		if c.Timestamp.Before(payload.Start) {
+			payload.Start = c.Timestamp.UTC()
+		}
+		if c.Timestamp.After(payload.End) {
+			payload.End = c.Timestamp.UTC()
+			payload.LastClose = c.Close
+		}
+	}
+	return payload
+}
+
 func insertSQLite(ctx context.Context, tx *sql.Tx, in *Item) (uint64, error) {
 	var totalInserted uint64
 	for x := range in.Candles {
@@ -194,6 +194,16 @@ func TestSeries(t *testing.T) {
 
 			_, err = Series(testExchanges[0].Name, "BTC", "MOON", 864000, "spot", start, end)
 			assert.ErrorIs(t, err, ErrNoCandleDataFound)
+
+			oldest, err := Oldest(testExchanges[0].Name, "BTC", "USDT", 86400, "spot")
+			require.NoError(t, err)
+			assert.True(t, oldest.Equal(start), "Oldest should return the first seeded candle timestamp")
+
+			_, err = Oldest("", "", "", 0, "")
+			require.ErrorIs(t, err, errInvalidInput)
+
+			_, err = Oldest(testExchanges[0].Name, "BTC", "MOON", 86400, "spot")
+			assert.ErrorIs(t, err, ErrNoCandleDataFound)
 			assert.NoError(t, testhelpers.CloseDatabase(dbConn))
 		})
 	}
@@ -243,3 +253,26 @@ func genOHCLVData() (out Item, err error) {
 
 	return out, nil
 }
+
+func TestNotificationPayload(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	p := notificationPayload(&Item{
+		ExchangeID: "id",
+		Base:       "btc",
+		Quote:      "usdt",
+		Interval:   60,
+		Asset:      "SPOT",
+		Candles: []Candle{
+			{Timestamp: start.Add(time.Minute), Close: 2},
+			{Timestamp: start.Add(time.Minute * 2), Close: 3},
+			{Timestamp: start, Close: 1},
+		},
+	}, 3)
+	assert.Equal(t, "BTC", p.Base)
+	assert.Equal(t, "spot", p.Asset)
+	assert.Equal(t, uint64(3), p.Inserted)
+	assert.Equal(t, start, p.Start, "start should be the earliest candle")
+	assert.Equal(t, start.Add(time.Minute*2), p.End, "end should be the latest candle")
+	assert.Equal(t, 3.0, p.LastClose, "last close should be from the latest candle")
+}
@@ -0,0 +1,202 @@
+package changefeed
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var defaultFeed = newFeed()
+
+func newFeed() *feed {
+	return &feed{subscribers: make(map[*Subscription]struct{})}
+}
+
+// Subscribe returns a subscription which receives notifications for the
+// supplied channels. All channels are subscribed to when none are supplied
+func Subscribe(channels ...string) (*Subscription, error) {
+	return defaultFeed.subscribe(channels...)
+}
+
+// Publish publishes a payload to a change feed channel. When connected to
+// postgres the payload is sent via pg_notify, otherwise it is distributed to
+// in-process subscribers. Errors are logged as the change has already been
+// committed and should not be reported as a failed insert
+func Publish(channel string, payload any) {
+	if err := defaultFeed.publish(channel, payload); err != nil {
+		log.Errorf(log.DatabaseMgr, "Change feed unable to publish to %s: %v", channel, err)
+	}
+}
+
+// StartListener LISTENs to all change feed channels on a dedicated postgres
+// connection and distributes notifications to in-process subscribers
+func StartListener(dsn string) error {
+	return defaultFeed.startListener(dsn)
+}
+
+// StopListener closes the postgres LISTEN connection
+func StopListener() error {
+	return defaultFeed.stopListener()
+}
+
+// Channel returns the channel notifications are delivered on. The channel is
+// closed when the subscription is closed
+func (s *Subscription) Channel() <-chan Notification {
+	return s.ch
+}
+
+// Close removes the subscription from the feed
+func (s *Subscription) Close() error {
+	return s.feed.unsubscribe(s)
+}
+
+func (f *feed) subscribe(channels ...string) (*Subscription, error) {
+	if len(channels) == 0 {
+		channels = Channels
+	}
+	s := &Subscription{
+		ch:       make(chan Notification, subscriberBufferSize),
+		channels: make(map[string]struct{}, len(channels)),
+		feed:     f,
+	}
+	for i := range channels {
+		if !slices.Contains(Channels, channels[i]) {
+			return nil, fmt.Errorf("%w %q", errUnknownChannel, channels[i])
+		}
+		s.channels[channels[i]] = struct{}{}
+	}
+	f.m.Lock()
+	f.subscribers[s] = struct{}{}
+	f.m.Unlock()
+	return s, nil
+}
+
+func (f *feed) unsubscribe(s *Subscription) error {
+	f.m.Lock()
+	defer f.m.Unlock()
+	if _, ok := f.subscribers[s]; !ok {
+		return errSubscriptionClosed
+	}
+	delete(f.subscribers, s)
+	close(s.ch)
+	return nil
+}
+
+func (f *feed) publish(channel string, payload any) error {
+	if !slices.Contains(Channels, channel) {
+		return fmt.Errorf("%w %q", errUnknownChannel, channel)
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	if len(data) > maxPayloadSize {
+		return fmt.Errorf("%w %d bytes", errPayloadTooLarge, len(data))
+	}
+	if database.DB.GetConfig() != nil && repository.GetSQLDialect() == database.DBPostgreSQL {
+		if database.DB.SQL == nil {
+			return database.ErrDatabaseSupportDisabled
+		}
+		_, err = database.DB.SQL.Exec("SELECT pg_notify($1, $2)", channel, string(data))
+		return err
+	}
+	f.broadcast(Notification{
+		Channel:   channel,
+		Payload:   data,
+		Timestamp: time.Now(),
+	})
+	return nil
+}
+
+// broadcast sends the notification to all interested subscribers. Slow
+// subscribers do not block the feed and miss notifications once their buffer
+// is full
+func (f *feed) broadcast(n Notification) {
+	f.m.RLock()
+	defer f.m.RUnlock()
+	for s := range f.subscribers {
+		if _, ok := s.channels[n.Channel]; !ok {
+			continue
+		}
+		select {
+		case s.ch <- n:
+		default:
+			log.Warnf(log.DatabaseMgr, "Change feed subscriber buffer full, dropping %s notification", n.Channel)
+		}
+	}
+}
+
+func (f *feed) startListener(dsn string) error {
+	f.m.Lock()
+	defer f.m.Unlock()
+	if f.listener != nil {
+		return errListenerAlreadyStarted
+	}
+	f.listener = pq.NewListener(dsn, minReconnectInterval, maxReconnectInterval, func(_ pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Errorf(log.DatabaseMgr, "Change feed listener connection error: %v", err)
+		}
+	})
+	f.shutdown = make(chan struct{})
+	f.wg.Add(1)
+	go f.listen(f.listener, f.shutdown)
+	return nil
+}
+
+func (f *feed) stopListener() error {
+	f.m.Lock()
+	if f.listener == nil {
+		f.m.Unlock()
+		return errListenerNotStarted
+	}
+	close(f.shutdown)
+	err := f.listener.Close()
+	f.listener = nil
+	f.m.Unlock()
+	f.wg.Wait()
+	return err
+}
+
+func (f *feed) listen(l *pq.Listener, shutdown <-chan struct{}) {
+	defer f.wg.Done()
+	for i := range Channels {
+		// Listen blocks until a connection is established, so it is called
+		// here rather than when the listener is started
+		if err := l.Listen(Channels[i]); err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			log.Errorf(log.DatabaseMgr, "Change feed unable to listen to %s: %v", Channels[i], err)
+		}
+	}
+	log.Debugln(log.DatabaseMgr, "Change feed listener started.")
+	for {
+		select {
+		case <-shutdown:
+			return
+		case n, ok := <-l.NotificationChannel():
+			if !ok {
+				return
+			}
+			if n == nil {
+				// A nil notification is sent after the connection has been
+				// re-established
+				log.Warnln(log.DatabaseMgr, "Change feed listener reconnected, notifications may have been missed")
+				continue
+			}
+			f.broadcast(Notification{
+				Channel:   n.Channel,
+				Payload:   []byte(n.Extra),
+				Timestamp: time.Now(),
+			})
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package changefeed
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// Channels which repository inserts are published to. These are used as the
+// postgres notification channel names so external consumers can LISTEN to them
+const (
+	CandleChannel         = "gct_candle"
+	TradeChannel          = "gct_trade"
+	WithdrawalChannel     = "gct_withdrawal"
+	AuditEventChannel     = "gct_audit_event"
+	DataHistoryJobChannel = "gct_datahistoryjob"
+)
+
+const (
+	// postgres rejects notification payloads of 8000 bytes or more
+	maxPayloadSize       = 7999
+	subscriberBufferSize = 100
+	minReconnectInterval = 10 * time.Second
+	maxReconnectInterval = time.Minute
+)
+
+var (
+	// Channels holds all supported change feed channels
+	Channels = []string{
+		CandleChannel,
+		TradeChannel,
+		WithdrawalChannel,
+		AuditEventChannel,
+		DataHistoryJobChannel,
+	}
+
+	errUnknownChannel         = errors.New("unknown change feed channel")
+	errPayloadTooLarge        = errors.New("change feed payload exceeds maximum size")
+	errListenerAlreadyStarted = errors.New("change feed listener already started")
+	errListenerNotStarted     = errors.New("change feed listener not started")
+	errSubscriptionClosed     = errors.New("change feed subscription already closed")
+)
+
+// Notification is a single change published to the feed
+type Notification struct {
+	Channel   string
+	Payload   []byte
+	Timestamp time.Time
+}
+
+// Subscription receives notifications for the channels it is subscribed to
+type Subscription struct {
+	ch       chan Notification
+	channels map[string]struct{}
+	feed     *feed
+}
+
+// feed distributes notifications to in-process subscribers. When the
+// database driver is postgres, notifications are sourced from a LISTEN
+// connection so changes made by other processes are also received
+type feed struct {
+	m           sync.RWMutex
+	subscribers map[*Subscription]struct{}
+	listener    *pq.Listener
+	shutdown    chan struct{}
+	wg          sync.WaitGroup
+}
+
+// CandlePayload is published when candles are inserted
+type CandlePayload struct {
+	ExchangeID string    `json:"exchange_id"`
+	Asset      string    `json:"asset"`
+	Base       string    `json:"base"`
+	Quote      string    `json:"quote"`
+	Interval   int64     `json:"interval"`
+	Inserted   uint64    `json:"inserted"`
+	Start      time.Time `json:"start"`
+	End        time.Time `json:"end"`
+	LastClose  float64   `json:"last_close"`
+}
+
+// TradePayload is published for each exchange, asset and pair combination
+// when trades are inserted
+type TradePayload struct {
+	Exchange   string    `json:"exchange,omitempty"`
+	ExchangeID string    `json:"exchange_id"`
+	Asset      string    `json:"asset"`
+	Base       string    `json:"base"`
+	Quote      string    `json:"quote"`
+	Inserted   int       `json:"inserted"`
+	Start      time.Time `json:"start"`
+	End        time.Time `json:"end"`
+	LastPrice  float64   `json:"last_price"`
+	LastAmount float64   `json:"last_amount"`
+	LastSide   string    `json:"last_side,omitempty"`
+}
+
+// WithdrawalPayload is published when a withdrawal event is inserted
+type WithdrawalPayload struct {
+	ID         string  `json:"id"`
+	Exchange   string  `json:"exchange"`
+	ExchangeID string  `json:"exchange_id"`
+	Status     string  `json:"status"`
+	Currency   string  `json:"currency"`
+	Amount     float64 `json:"amount"`
+	Type       int64   `json:"type"`
+}
+
+// AuditEventPayload is published when an audit event is inserted
+type AuditEventPayload struct {
+	Identifier string `json:"identifier"`
+	Type       string `json:"type"`
+	Message    string `json:"message"`
+}
+
+// DataHistoryJobPayload is published when a data history job is inserted or
+// its status changes
+type DataHistoryJobPayload struct {
+	ID         string `json:"id"`
+	Nickname   string `json:"nickname"`
+	Exchange   string `json:"exchange,omitempty"`
+	ExchangeID string `json:"exchange_id,omitempty"`
+	Asset      string `json:"asset"`
+	Base       string `json:"base"`
+	Quote      string `json:"quote"`
+	DataType   int64  `json:"data_type"`
+	Status     int64  `json:"status"`
+}
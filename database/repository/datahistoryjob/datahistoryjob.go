@@ -10,6 +10,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
 	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/changefeed"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/datahistoryjobresult"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/sqlboiler/boil"
@@ -65,7 +66,23 @@ func (db *DBService) Upsert(jobs ...*DataHistoryJob) error {
 		return err
 	}
 
-	return tx.Commit()
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	for i := range jobs {
+		changefeed.Publish(changefeed.DataHistoryJobChannel, &changefeed.DataHistoryJobPayload{
+			ID:         jobs[i].ID,
+			Nickname:   strings.ToLower(jobs[i].Nickname),
+			Exchange:   strings.ToLower(jobs[i].ExchangeName),
+			ExchangeID: jobs[i].ExchangeID,
+			Asset:      strings.ToLower(jobs[i].Asset),
+			Base:       strings.ToUpper(jobs[i].Base),
+			Quote:      strings.ToUpper(jobs[i].Quote),
+			DataType:   jobs[i].DataType,
+			Status:     jobs[i].Status,
+		})
+	}
+	return nil
 }
 
 // GetByNickName returns a job by its nickname
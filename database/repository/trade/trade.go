@@ -13,6 +13,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
 	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
 	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/changefeed"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -59,7 +60,49 @@ func Insert(trades ...Data) error {
 		return err
 	}
 
-	return tx.Commit()
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	payloads := notificationPayloads(trades)
+	for i := range payloads {
+		changefeed.Publish(changefeed.TradeChannel, payloads[i])
+	}
+	return nil
+}
+
+// notificationPayloads summarises inserted trades for the change feed, one
+// payload per exchange, asset and currency pair
+func notificationPayloads(trades []Data) []*changefeed.TradePayload {
+	var payloads []*changefeed.TradePayload
+	lookup := make(map[string]*changefeed.TradePayload)
+	for i := range trades {
+		key := trades[i].ExchangeNameID + trades[i].AssetType + trades[i].Base + "-" + trades[i].Quote
+		payload, ok := lookup[key]
+		if !ok {
+			payload = &changefeed.TradePayload{
+				Exchange:   trades[i].Exchange,
+				ExchangeID: trades[i].ExchangeNameID,
+				Asset:      trades[i].AssetType,
+				Base:       trades[i].Base,
+				Quote:      trades[i].Quote,
+				Start:      trades[i].Timestamp.UTC(),
+				End:        trades[i].Timestamp.UTC(),
+			}
+			lookup[key] = payload
+			payloads = append(payloads, payload)
+		}
+		payload.Inserted++
+		if trades[i].Timestamp.Before(payload.Start) {
+			payload.Start = trades[i].Timestamp.UTC()
+		}
+		if !trades[i].Timestamp.Before(payload.End) {
+			payload.End = trades[i].Timestamp.UTC()
+			payload.LastPrice = trades[i].Price
+			payload.LastAmount = trades[i].Amount
+			payload.LastSide = trades[i].Side
+		}
+	}
+	return payloads
 }
 
 // VerifyTradeInIntervals will query for ONE trade within each kline interval and verify if data exists
@@ -384,6 +427,40 @@ func getInRangePostgres(exchangeName, assetType, base, quote string, startDate,
 	return td, nil
 }
 
+// Oldest returns the timestamp of the earliest stored trade for an exchange
+// market
+func Oldest(exchangeName, assetType, base, quote string) (time.Time, error) {
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return time.Time{}, err
+	}
+	q := []qm.QueryMod{
+		qm.Where("exchange_name_id = ?", exchangeUUID),
+		qm.Where("asset = ?", strings.ToLower(assetType)),
+		qm.Where("base = ?", strings.ToUpper(base)),
+		qm.Where("quote = ?", strings.ToUpper(quote)),
+		qm.OrderBy("timestamp"),
+	}
+	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
+		result, err := sqlite3.Trades(q...).One(context.TODO(), database.DB.SQL)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return time.Time{}, fmt.Errorf("%w: %s %s %s %s", ErrNoTradesFound, exchangeName, assetType, base, quote)
+			}
+			return time.Time{}, err
+		}
+		return time.Parse(time.RFC3339, result.Timestamp)
+	}
+	result, err := postgres.Trades(q...).One(context.TODO(), database.DB.SQL)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return time.Time{}, fmt.Errorf("%w: %s %s %s %s", ErrNoTradesFound, exchangeName, assetType, base, quote)
+		}
+		return time.Time{}, err
+	}
+	return result.Timestamp, nil
+}
+
 // DeleteTrades will remove trades from the database using trade.Data
 func DeleteTrades(trades ...Data) error {
 	ctx := context.TODO()
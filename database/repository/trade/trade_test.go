@@ -171,6 +171,10 @@ func tradeSQLTester(t *testing.T) {
 		t.Error("Bad get!")
 	}
 
+	oldest, err := Oldest(testExchanges[0].Name, asset.Spot.String(), currency.BTC.String(), currency.USD.String())
+	require.NoError(t, err)
+	assert.True(t, oldest.Equal(firstTime.Add(time.Minute)), "Oldest should return the first inserted trade timestamp")
+
 	ranges, err := kline.CalculateCandleDateRanges(firstTime, firstTime.Add(20*time.Minute), kline.OneMin, 100)
 	if err != nil {
 		t.Error(err)
@@ -206,6 +210,9 @@ func tradeSQLTester(t *testing.T) {
 	if len(v) != 0 {
 		t.Errorf("should all be dead %v", v)
 	}
+
+	_, err = Oldest(testExchanges[0].Name, asset.Spot.String(), currency.BTC.String(), currency.USD.String())
+	assert.ErrorIs(t, err, ErrNoTradesFound)
 }
 
 func seedDB() error {
@@ -216,3 +223,21 @@ func seedDB() error {
 
 	return nil
 }
+
+func TestNotificationPayloads(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	payloads := notificationPayloads([]Data{
+		{Exchange: "one", ExchangeNameID: "1", AssetType: "spot", Base: "BTC", Quote: "USDT", Price: 2, Side: "BUY", Timestamp: start.Add(time.Second)},
+		{Exchange: "one", ExchangeNameID: "1", AssetType: "spot", Base: "ETH", Quote: "USDT", Price: 10, Timestamp: start},
+		{Exchange: "one", ExchangeNameID: "1", AssetType: "spot", Base: "BTC", Quote: "USDT", Price: 1, Side: "SELL", Timestamp: start},
+	})
+	require.Len(t, payloads, 2, "trades must be grouped by exchange, asset and pair")
+	assert.Equal(t, 2, payloads[0].Inserted)
+	assert.Equal(t, start, payloads[0].Start)
+	assert.Equal(t, start.Add(time.Second), payloads[0].End)
+	assert.Equal(t, 2.0, payloads[0].LastPrice, "last price should be from the latest trade")
+	assert.Equal(t, "BUY", payloads[0].LastSide)
+	assert.Equal(t, "ETH", payloads[1].Base)
+	assert.Equal(t, 1, payloads[1].Inserted)
+}
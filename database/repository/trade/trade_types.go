@@ -1,6 +1,12 @@
 package trade
 
-import "time"
+import (
+	"errors"
+	"time"
+)
+
+// ErrNoTradesFound returns when no trade data is found
+var ErrNoTradesFound = errors.New("no trade data found")
 
 // Data defines trade data in its simplest
 // db friendly form
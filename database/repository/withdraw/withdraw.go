@@ -12,6 +12,7 @@ import (
 	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
 	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
 	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/changefeed"
 	exchangeDB "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
@@ -35,6 +36,7 @@ func Event(res *withdraw.Response) {
 		return
 	}
 
+	exchangeName := res.Exchange.Name
 	res.Exchange.Name = exchangeUUID.String()
 	tx, err := sqlDB.BeginTx(ctx, nil)
 	if err != nil {
@@ -61,6 +63,15 @@ func Event(res *withdraw.Response) {
 		log.Errorf(log.DatabaseMgr, "Event Transaction commit failed: %v", err)
 		return
 	}
+	changefeed.Publish(changefeed.WithdrawalChannel, &changefeed.WithdrawalPayload{
+		ID:         res.ID.String(),
+		Exchange:   exchangeName,
+		ExchangeID: res.Exchange.ID,
+		Status:     res.Exchange.Status,
+		Currency:   res.RequestDetails.Currency.String(),
+		Amount:     res.RequestDetails.Amount,
+		Type:       int64(res.RequestDetails.Type),
+	})
 }
 
 func addPSQLEvent(ctx context.Context, tx *sql.Tx, res *withdraw.Response) (err error) {
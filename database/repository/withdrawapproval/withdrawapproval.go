@@ -0,0 +1,112 @@
+package withdrawapproval
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+const selectColumns = "SELECT id, exchange, currency, chain, address, amount, amount_usd, request, account, requested_by, approved_by, status, expires_at, created_at, updated_at FROM withdrawal_approval"
+
+// Insert stores a withdrawal approval
+func Insert(d *Details) error {
+	if d == nil {
+		return errNilDetails
+	}
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+	_, err := database.DB.SQL.ExecContext(context.TODO(),
+		"INSERT INTO withdrawal_approval (id, exchange, currency, chain, address, amount, amount_usd, request, account, requested_by, approved_by, status, expires_at, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)",
+		d.ID.String(), d.Exchange, d.Currency, d.Chain, d.Address, d.Amount, d.AmountUSD, string(d.Request),
+		d.Account, d.RequestedBy, d.ApprovedBy, d.Status, d.ExpiresAt.UTC(), d.CreatedAt.UTC(), d.UpdatedAt.UTC())
+	return err
+}
+
+// UpdateStatus changes a withdrawal approval status from one status to
+// another. ErrStatusChanged is returned if the stored status is no longer the
+// from status, so only one operator can act on a pending withdrawal
+func UpdateStatus(id, from, to, approvedBy string, updatedAt time.Time) error {
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+	res, err := database.DB.SQL.ExecContext(context.TODO(),
+		"UPDATE withdrawal_approval SET status = $1, approved_by = $2, updated_at = $3 WHERE id = $4 AND status = $5",
+		to, approvedBy, updatedAt.UTC(), id, from)
+	if err != nil {
+		return err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return fmt.Errorf("%w %s", ErrStatusChanged, id)
+	}
+	return nil
+}
+
+// GetByID returns a withdrawal approval by ID
+func GetByID(id string) (*Details, error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	d, err := scan(database.DB.SQL.QueryRowContext(context.TODO(), selectColumns+" WHERE id = $1", id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w %s", ErrNotFound, id)
+	}
+	return d, err
+}
+
+// GetByStatus returns all withdrawal approvals with a status, oldest first
+func GetByStatus(status string) ([]Details, error) {
+	return query(selectColumns+" WHERE status = $1 ORDER BY created_at", status)
+}
+
+// GetCreatedSince returns all withdrawal approvals created at or after a time,
+// oldest first
+func GetCreatedSince(t time.Time) ([]Details, error) {
+	return query(selectColumns+" WHERE created_at >= $1 ORDER BY created_at", t.UTC())
+}
+
+func query(q string, args ...any) ([]Details, error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	rows, err := database.DB.SQL.QueryContext(context.TODO(), q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var resp []Details
+	for rows.Next() {
+		d, err := scan(rows)
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, *d)
+	}
+	return resp, rows.Err()
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scan(s scanner) (*Details, error) {
+	var d Details
+	var id, request string
+	if err := s.Scan(&id, &d.Exchange, &d.Currency, &d.Chain, &d.Address, &d.Amount, &d.AmountUSD, &request,
+		&d.Account, &d.RequestedBy, &d.ApprovedBy, &d.Status, &d.ExpiresAt, &d.CreatedAt, &d.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if err := d.ID.Parse(id); err != nil {
+		return nil, err
+	}
+	d.Request = []byte(request)
+	return &d, nil
+}
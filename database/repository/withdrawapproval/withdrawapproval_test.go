@@ -0,0 +1,126 @@
+package withdrawapproval
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+func TestMain(m *testing.M) {
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		fmt.Printf("failed to create temp file: %v", err)
+		os.Exit(1)
+	}
+
+	t := m.Run()
+
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestWithdrawApproval(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		config *database.Config
+		closer func(dbConn *database.Instance) error
+	}{
+		{
+			"SQLite",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			testhelpers.CloseDatabase,
+		},
+		{
+			"Postgres",
+			testhelpers.PostgresTestDatabase,
+			nil,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&tc.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+			dbConn, err := testhelpers.ConnectToDatabase(tc.config)
+			require.NoError(t, err, "ConnectToDatabase must not error")
+			withdrawApprovalHelper(t)
+			if tc.closer != nil {
+				assert.NoError(t, tc.closer(dbConn))
+			}
+		})
+	}
+}
+
+func withdrawApprovalHelper(t *testing.T) {
+	t.Helper()
+	assert.ErrorIs(t, Insert(nil), errNilDetails)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	d := &Details{
+		ID:          uuid.Must(uuid.NewV4()),
+		Exchange:    "binance",
+		Currency:    "BTC",
+		Chain:       "bitcoin",
+		Address:     "bc1qtest",
+		Amount:      0.5,
+		AmountUSD:   25000,
+		Request:     []byte(`{"exchange":"binance"}`),
+		Account:     "treasury",
+		RequestedBy: "alice",
+		Status:      StatusPending,
+		ExpiresAt:   now.Add(time.Hour),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	require.NoError(t, Insert(d), "Insert must not error")
+
+	got, err := GetByID(d.ID.String())
+	require.NoError(t, err, "GetByID must not error")
+	assert.Equal(t, d.ID, got.ID)
+	assert.Equal(t, d.Request, got.Request)
+	assert.Equal(t, d.Account, got.Account)
+	assert.Equal(t, d.AmountUSD, got.AmountUSD)
+	assert.True(t, d.ExpiresAt.Equal(got.ExpiresAt), "ExpiresAt should round trip")
+
+	_, err = GetByID(uuid.Must(uuid.NewV4()).String())
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	pending, err := GetByStatus(StatusPending)
+	require.NoError(t, err, "GetByStatus must not error")
+	require.Len(t, pending, 1)
+
+	since, err := GetCreatedSince(now.Add(-time.Minute))
+	require.NoError(t, err, "GetCreatedSince must not error")
+	assert.Len(t, since, 1)
+	since, err = GetCreatedSince(now.Add(time.Minute))
+	require.NoError(t, err, "GetCreatedSince must not error")
+	assert.Empty(t, since)
+
+	require.NoError(t, UpdateStatus(d.ID.String(), StatusPending, StatusApproved, "bob", now), "UpdateStatus must not error")
+	assert.ErrorIs(t, UpdateStatus(d.ID.String(), StatusPending, StatusRejected, "carol", now), ErrStatusChanged, "Only pending withdrawals should be updated")
+
+	got, err = GetByID(d.ID.String())
+	require.NoError(t, err, "GetByID must not error")
+	assert.Equal(t, StatusApproved, got.Status)
+	assert.Equal(t, "bob", got.ApprovedBy)
+
+	pending, err = GetByStatus(StatusPending)
+	require.NoError(t, err, "GetByStatus must not error")
+	assert.Empty(t, pending)
+}
@@ -0,0 +1,50 @@
+package withdrawapproval
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// Withdrawal approval statuses
+const (
+	StatusPending  = "pending"
+	StatusApproved = "approved"
+	StatusRejected = "rejected"
+	StatusExpired  = "expired"
+	// StatusFailed is set when an approved withdrawal could not be sent
+	StatusFailed = "failed"
+)
+
+var (
+	// ErrNotFound is returned when no withdrawal approval matches the ID
+	ErrNotFound = errors.New("withdrawal approval not found")
+	// ErrStatusChanged is returned when a withdrawal approval status update
+	// is attempted but the stored status no longer matches the expected one,
+	// such as when another operator has already approved it
+	ErrStatusChanged = errors.New("withdrawal approval status has changed")
+
+	errNilDetails = errors.New("withdrawal approval details are nil")
+)
+
+// Details holds a withdrawal request governed by the withdrawal policy. The
+// request is stored JSON encoded along with the requester's exchange account
+// so pending withdrawals can be sent with the same credentials once approved
+type Details struct {
+	ID          uuid.UUID
+	Exchange    string
+	Currency    string
+	Chain       string
+	Address     string
+	Amount      float64
+	AmountUSD   float64
+	Request     []byte
+	Account     string
+	RequestedBy string
+	ApprovedBy  string
+	Status      string
+	ExpiresAt   time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
@@ -76,6 +76,12 @@ func IsRunning() bool {
 	return dispatcher.isRunning()
 }
 
+// QueueDepth returns the amount of jobs waiting to be relayed and the capacity
+// of the job queue
+func QueueDepth() (depth, capacity int) {
+	return dispatcher.queueDepth()
+}
+
 // start sets defaults and config and spawns workers.
 // Does not provide locking protection.
 func (d *Dispatcher) start(workers, channelCapacity int) error {
@@ -167,6 +173,20 @@ func (d *Dispatcher) isRunning() bool {
 	return d.running
 }
 
+// queueDepth returns the current job queue length and capacity
+func (d *Dispatcher) queueDepth() (depth, capacity int) {
+	if d == nil {
+		return 0, 0
+	}
+
+	d.m.RLock()
+	defer d.m.RUnlock()
+	if !d.running {
+		return 0, 0
+	}
+	return len(d.jobs), cap(d.jobs)
+}
+
 // relayer routine relays communications across the defined routes.
 func (d *Dispatcher) relayer() {
 	for {
@@ -81,6 +81,24 @@ func TestStartStop(t *testing.T) {
 	assert.False(t, d.isRunning(), "IsRunning should return false")
 }
 
+func TestQueueDepth(t *testing.T) {
+	t.Parallel()
+	var d *Dispatcher
+	depth, capacity := d.queueDepth()
+	assert.Zero(t, depth, "queueDepth should return zero depth for a nil dispatcher")
+	assert.Zero(t, capacity, "queueDepth should return zero capacity for a nil dispatcher")
+
+	d = NewDispatcher()
+	depth, capacity = d.queueDepth()
+	assert.Zero(t, capacity, "queueDepth should return zero capacity when not running")
+
+	require.NoError(t, d.start(1, 50), "start must not error")
+	depth, capacity = d.queueDepth()
+	assert.Zero(t, depth, "queueDepth should return zero depth with no jobs")
+	assert.Equal(t, 50, capacity, "queueDepth should return the jobs limit")
+	require.NoError(t, d.stop(), "stop must not error")
+}
+
 func TestSubscribe(t *testing.T) {
 	t.Parallel()
 	var d *Dispatcher
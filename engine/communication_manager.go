@@ -2,6 +2,7 @@ package engine
 
 import (
 	"fmt"
+	"sync"
 	"sync/atomic"
 
 	"github.com/thrasher-corp/gocryptotrader/communications"
@@ -17,6 +18,7 @@ type CommunicationManager struct {
 	started  atomic.Bool
 	shutdown chan struct{}
 	relayMsg chan base.Event
+	commsMtx sync.RWMutex
 	comms    *communications.Communications
 }
 
@@ -64,9 +66,38 @@ func (m *CommunicationManager) GetStatus() (map[string]base.CommsStatus, error)
 	if !m.IsRunning() {
 		return nil, fmt.Errorf("communications manager %w", ErrSubSystemNotStarted)
 	}
+	m.commsMtx.RLock()
+	defer m.commsMtx.RUnlock()
 	return m.comms.GetStatus(), nil
 }
 
+// Reload restarts the communication relayers with those enabled in the
+// supplied config. The current relayers are shut down before the new ones
+// connect so that relayers such as Telegram's poller do not run twice
+func (m *CommunicationManager) Reload(cfg *base.CommunicationsConfig) error {
+	if m == nil {
+		return fmt.Errorf("communications manager %w", ErrNilSubsystem)
+	}
+	if cfg == nil {
+		return errNilConfig
+	}
+	if !cfg.IsAnyEnabled() {
+		return communications.ErrNoRelayersEnabled
+	}
+	m.commsMtx.Lock()
+	defer m.commsMtx.Unlock()
+	if m.comms != nil {
+		m.comms.Shutdown()
+	}
+	comms, err := communications.NewComm(cfg)
+	if err != nil {
+		return err
+	}
+	m.comms = comms
+	log.Debugln(log.CommunicationMgr, "Communications manager relayers reloaded")
+	return nil
+}
+
 // Stop attempts to shutdown the subsystem
 func (m *CommunicationManager) Stop() error {
 	if m == nil {
@@ -99,14 +130,21 @@ func (m *CommunicationManager) PushEvent(evt base.Event) {
 func (m *CommunicationManager) run() {
 	log.Debugf(log.Global, "Communications manager %s", MsgSubSystemStarted)
 	defer func() {
-		// TO-DO shutdown comms connections for connected services (Slack etc)
+		m.commsMtx.RLock()
+		if m.comms != nil {
+			m.comms.Shutdown()
+		}
+		m.commsMtx.RUnlock()
 		log.Debugf(log.CommunicationMgr, "Communications manager %s", MsgSubSystemShutdown)
 	}()
 
 	for {
 		select {
 		case msg := <-m.relayMsg:
-			m.comms.PushEvent(msg)
+			m.commsMtx.RLock()
+			comms := m.comms
+			m.commsMtx.RUnlock()
+			comms.PushEvent(msg)
 		case <-m.shutdown:
 			return
 		}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/communications"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 )
@@ -130,3 +131,42 @@ func TestPushEvent(t *testing.T) {
 	m = nil
 	m.PushEvent(base.Event{})
 }
+
+func TestCommunicationManagerReload(t *testing.T) {
+	t.Parallel()
+	assert.ErrorIs(t, (*CommunicationManager)(nil).Reload(&base.CommunicationsConfig{}), ErrNilSubsystem)
+
+	m, err := SetupCommunicationManager(&base.CommunicationsConfig{
+		SlackConfig: base.SlackConfig{
+			Name:    "Slack",
+			Enabled: true,
+		},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, m.Start())
+
+	assert.ErrorIs(t, m.Reload(nil), errNilConfig)
+	assert.ErrorIs(t, m.Reload(&base.CommunicationsConfig{}), communications.ErrNoRelayersEnabled)
+
+	err = m.Reload(&base.CommunicationsConfig{
+		SMTPConfig: base.SMTPConfig{
+			Name:    "SMTP",
+			Enabled: true,
+		},
+	})
+	assert.NoError(t, err)
+	status, err := m.GetStatus()
+	assert.NoError(t, err)
+	assert.Contains(t, status, "SMTP", "Reload should swap in the newly enabled relayer")
+	assert.NotContains(t, status, "Slack", "Reload should drop relayers no longer enabled")
+
+	old := m.comms.IComm[0]
+	require.True(t, old.IsConnected(), "relayer must be connected before reloading")
+	require.NoError(t, m.Reload(&base.CommunicationsConfig{
+		SMTPConfig: base.SMTPConfig{
+			Name:    "SMTP",
+			Enabled: true,
+		},
+	}))
+	assert.False(t, old.IsConnected(), "Reload should shut down the previous relayers")
+}
@@ -0,0 +1,626 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	exchangeDB "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stats"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupCompositePriceManager creates a composite price manager subsystem
+func SetupCompositePriceManager(em iExchangeManager, dcm iDatabaseConnectionManager, cfg *config.CompositePriceManager) (*CompositePriceManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if cfg.RecordHistory && dcm == nil {
+		return nil, errNilDatabaseConnectionManager
+	}
+	if cfg.VirtualExchangeName == "" {
+		return nil, errVirtualExchangeNameUnset
+	}
+	if cfg.Interval <= 0 {
+		return nil, fmt.Errorf("%w interval %v", errInvalidTimes, cfg.Interval)
+	}
+	if cfg.MaxQuoteAge <= 0 {
+		return nil, fmt.Errorf("%w max quote age %v", errInvalidTimes, cfg.MaxQuoteAge)
+	}
+	if cfg.RecordHistory && cfg.HistoryInterval <= 0 {
+		return nil, kline.ErrInvalidInterval
+	}
+	targets := make([]compositePriceTarget, len(cfg.Targets))
+	for i := range cfg.Targets {
+		if !cfg.Targets[i].Asset.IsValid() {
+			return nil, fmt.Errorf("%w %s", asset.ErrNotSupported, cfg.Targets[i].Asset)
+		}
+		if cfg.Targets[i].Pair.IsEmpty() {
+			return nil, errCurrencyPairUnset
+		}
+		targets[i] = compositePriceTarget{
+			asset:            cfg.Targets[i].Asset,
+			pair:             cfg.Targets[i].Pair,
+			exchanges:        cfg.Targets[i].Exchanges,
+			equivalentQuotes: cfg.Targets[i].EquivalentQuotes,
+		}
+	}
+	return &CompositePriceManager{
+		shutdown:            make(chan struct{}),
+		exchangeManager:     em,
+		database:            dcm,
+		interval:            cfg.Interval,
+		maxQuoteAge:         cfg.MaxQuoteAge,
+		outlierTolerance:    cfg.OutlierTolerancePercentage,
+		virtualExchangeName: cfg.VirtualExchangeName,
+		recordHistory:       cfg.RecordHistory,
+		historyInterval:     cfg.HistoryInterval,
+		verbose:             cfg.Verbose,
+		targets:             targets,
+		subscriptions:       make(map[string]*dispatch.Pipe),
+		quotes:              make(map[key.ExchangeAssetPair]*compositeQuote),
+		prices:              make(map[key.PairAsset]*CompositePrice),
+		candles:             make(map[key.PairAsset]*kline.Candle),
+		tickerSubscriber:    ticker.SubscribeToExchangeTickers,
+		orderbookSubscriber: orderbook.SubscribeToExchangeOrderbooks,
+		tickerPublisher:     publishCompositeTicker,
+		volumeLookup:        statsVolume,
+		fiatRate:            currency.GetForeignExchangeRate,
+		marketRate:          ticker.FindLast,
+		candleSaver:         kline.StoreInDatabase,
+		exchangeRegistrar:   registerVirtualExchange,
+	}, nil
+}
+
+// Start runs the subsystem
+func (m *CompositePriceManager) Start(ctx context.Context) error {
+	if m == nil {
+		return fmt.Errorf("%s %w", CompositePriceManagerName, ErrNilSubsystem)
+	}
+	if _, err := m.exchangeManager.GetExchangeByName(m.virtualExchangeName); err == nil {
+		return fmt.Errorf("%w %s", errVirtualExchangeNameExists, m.virtualExchangeName)
+	}
+	if !m.started.CompareAndSwap(false, true) {
+		return fmt.Errorf("%s %w", CompositePriceManagerName, ErrSubSystemAlreadyStarted)
+	}
+	m.shutdown = make(chan struct{})
+	m.wg.Add(1)
+	go m.run(ctx)
+	log.Debugf(log.Global, "Composite price manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *CompositePriceManager) IsRunning() bool {
+	if m == nil {
+		return false
+	}
+	return m.started.Load()
+}
+
+// Stop stops the subsystem
+func (m *CompositePriceManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", CompositePriceManagerName, ErrNilSubsystem)
+	}
+	if !m.started.CompareAndSwap(true, false) {
+		return fmt.Errorf("%s %w", CompositePriceManagerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.Global, "Composite price manager %s", MsgSubSystemShuttingDown)
+	close(m.shutdown)
+	m.wg.Wait()
+	m.subscriptionsMtx.Lock()
+	for name, pipe := range m.subscriptions {
+		if err := pipe.Release(); err != nil {
+			log.Errorf(log.DispatchMgr, "Composite price manager unable to release %s subscription: %v", name, err)
+		}
+		delete(m.subscriptions, name)
+	}
+	m.subscriptionsMtx.Unlock()
+	log.Debugf(log.Global, "Composite price manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// run subscribes to exchange feeds and calculates composite prices at each
+// interval
+func (m *CompositePriceManager) run(ctx context.Context) {
+	defer m.wg.Done()
+	timer := time.NewTimer(0) // Prime firing of channel for initial subscription.
+	defer timer.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			m.subscribe()
+			m.calculateAll(time.Now())
+			timer.Reset(m.interval)
+		}
+	}
+}
+
+// subscribe attaches to the ticker and orderbook dispatch feeds of every
+// loaded exchange. Feeds are only available once an exchange has published
+// data, so missing feeds are retried on the next interval
+func (m *CompositePriceManager) subscribe() {
+	exchanges, err := m.exchangeManager.GetExchanges()
+	if err != nil {
+		log.Errorf(log.Global, "Composite price manager cannot get exchanges: %v", err)
+		return
+	}
+	m.subscriptionsMtx.Lock()
+	defer m.subscriptionsMtx.Unlock()
+	for i := range exchanges {
+		name := exchanges[i].GetName()
+		if !m.isExchangeTracked(name) {
+			continue
+		}
+		m.subscribeFeed("ticker "+name, name, m.tickerSubscriber)
+		m.subscribeFeed("orderbook "+name, name, m.orderbookSubscriber)
+	}
+}
+
+// subscribeFeed subscribes to a single feed if it is not already subscribed.
+// Requires subscriptionsMtx to be held
+func (m *CompositePriceManager) subscribeFeed(feed, exch string, subscriber func(string) (dispatch.Pipe, error)) {
+	if _, ok := m.subscriptions[feed]; ok {
+		return
+	}
+	pipe, err := subscriber(exch)
+	if err != nil {
+		if m.verbose {
+			log.Debugf(log.Global, "Composite price manager %s feed not yet available: %v", feed, err)
+		}
+		return
+	}
+	m.subscriptions[feed] = &pipe
+	m.wg.Add(1)
+	go m.consume(&pipe)
+}
+
+// consume processes dispatch updates from a feed until shutdown
+func (m *CompositePriceManager) consume(pipe *dispatch.Pipe) {
+	defer m.wg.Done()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case data, ok := <-pipe.Channel():
+			if !ok {
+				return
+			}
+			switch d := data.(type) {
+			case *ticker.Price:
+				m.updateFromTicker(d)
+			case orderbook.Outbound:
+				m.updateFromOrderbook(d)
+			}
+		}
+	}
+}
+
+// isExchangeTracked returns whether any target uses the exchange
+func (m *CompositePriceManager) isExchangeTracked(exch string) bool {
+	for i := range m.targets {
+		if m.targets[i].includesExchange(exch) {
+			return true
+		}
+	}
+	return false
+}
+
+// isMarketTracked returns whether the market is a constituent of any target
+func (m *CompositePriceManager) isMarketTracked(exch string, a asset.Item, p currency.Pair) bool {
+	for i := range m.targets {
+		if m.targets[i].includesMarket(exch, a, p) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *compositePriceTarget) includesExchange(exch string) bool {
+	if len(t.exchanges) == 0 {
+		return true
+	}
+	for i := range t.exchanges {
+		if strings.EqualFold(t.exchanges[i], exch) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *compositePriceTarget) includesQuote(c currency.Code) bool {
+	if t.pair.Quote.Equal(c) {
+		return true
+	}
+	for i := range t.equivalentQuotes {
+		if t.equivalentQuotes[i].Equal(c) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *compositePriceTarget) includesMarket(exch string, a asset.Item, p currency.Pair) bool {
+	return t.asset == a &&
+		t.pair.Base.Equal(p.Base) &&
+		t.includesQuote(p.Quote) &&
+		t.includesExchange(exch)
+}
+
+// updateFromTicker stores the latest ticker price and volume for a market
+func (m *CompositePriceManager) updateFromTicker(t *ticker.Price) {
+	if t == nil || !m.isMarketTracked(t.ExchangeName, t.AssetType, t.Pair) {
+		return
+	}
+	updated := t.LastUpdated
+	if updated.IsZero() {
+		updated = time.Now()
+	}
+	m.quotesMtx.Lock()
+	defer m.quotesMtx.Unlock()
+	q := m.getQuote(t.ExchangeName, t.AssetType, t.Pair)
+	q.last = t.Last
+	if t.Bid > 0 && t.Ask > 0 {
+		q.bid, q.ask = t.Bid, t.Ask
+	}
+	q.volume = t.Volume
+	q.updated = updated
+}
+
+// updateFromOrderbook stores the latest best bid and ask for a market
+func (m *CompositePriceManager) updateFromOrderbook(o orderbook.Outbound) {
+	depth, ok := o.(*orderbook.Depth)
+	if !ok || !m.isMarketTracked(depth.Exchange(), depth.Asset(), depth.Pair()) {
+		return
+	}
+	bid, err := depth.GetBestBid()
+	if err != nil {
+		return
+	}
+	ask, err := depth.GetBestAsk()
+	if err != nil {
+		return
+	}
+	m.quotesMtx.Lock()
+	defer m.quotesMtx.Unlock()
+	q := m.getQuote(depth.Exchange(), depth.Asset(), depth.Pair())
+	q.bid, q.ask = bid, ask
+	q.updated = time.Now()
+}
+
+// getQuote returns the stored quote for a market, creating it if required.
+// Requires quotesMtx to be held
+func (m *CompositePriceManager) getQuote(exch string, a asset.Item, p currency.Pair) *compositeQuote {
+	k := key.NewExchangeAssetPair(strings.ToLower(exch), a, p)
+	q, ok := m.quotes[k]
+	if !ok {
+		q = &compositeQuote{}
+		m.quotes[k] = q
+	}
+	return q
+}
+
+// price returns the mid price when both sides of the book are known, falling
+// back to the last traded price
+func (q *compositeQuote) price() float64 {
+	if q.bid > 0 && q.ask > 0 && q.bid < q.ask {
+		return (q.bid + q.ask) / 2
+	}
+	return q.last
+}
+
+// calculateAll calculates, publishes and records the composite price for
+// every target
+func (m *CompositePriceManager) calculateAll(now time.Time) {
+	for i := range m.targets {
+		cp, err := m.calculate(&m.targets[i], now)
+		if err != nil {
+			if m.verbose {
+				log.Debugf(log.Global, "Composite price manager %s %s: %v", m.targets[i].asset, m.targets[i].pair, err)
+			}
+			continue
+		}
+		m.m.Lock()
+		m.prices[key.PairAsset{Base: cp.Pair.Base.Item, Quote: cp.Pair.Quote.Item, Asset: cp.Asset}] = cp
+		m.m.Unlock()
+		if err := m.tickerPublisher(cp); err != nil {
+			log.Errorf(log.Global, "Composite price manager cannot publish %s %s ticker: %v", cp.Asset, cp.Pair, err)
+		}
+		if m.recordHistory {
+			m.recordCandle(cp)
+		}
+		if m.verbose {
+			log.Debugf(log.Global, "Composite price manager %s %s vwap: %v median: %v filtered: %v constituents: %d",
+				cp.Asset, cp.Pair, cp.VolumeWeightedPrice, cp.MedianPrice, cp.FilteredPrice, len(cp.Constituents))
+		}
+	}
+}
+
+// calculate builds a composite price for a target from all fresh quotes
+func (m *CompositePriceManager) calculate(t *compositePriceTarget, now time.Time) (*CompositePrice, error) {
+	cp := &CompositePrice{
+		Exchange:    m.virtualExchangeName,
+		Asset:       t.asset,
+		Pair:        t.pair,
+		LastUpdated: now,
+	}
+	var bids, asks []float64
+	m.quotesMtx.RLock()
+	for k, q := range m.quotes {
+		if !t.includesMarket(k.Exchange, k.Asset, k.Pair()) || now.Sub(q.updated) > m.maxQuoteAge {
+			continue
+		}
+		price := q.price()
+		if price <= 0 {
+			continue
+		}
+		rate, err := m.conversionRate(k.Quote.Currency(), t.pair.Quote, t)
+		if err != nil {
+			if m.verbose {
+				log.Debugf(log.Global, "Composite price manager %s %s excluded: %v", k.Exchange, k.Pair(), err)
+			}
+			continue
+		}
+		volume := q.volume
+		if volume <= 0 {
+			volume = m.volumeLookup(k.Exchange, k.Pair(), k.Asset)
+		}
+		cp.Constituents = append(cp.Constituents, CompositeConstituent{
+			Exchange:       k.Exchange,
+			Pair:           k.Pair(),
+			Price:          price,
+			ConvertedPrice: price * rate,
+			ConversionRate: rate,
+			Volume:         volume,
+			LastUpdated:    q.updated,
+		})
+		if q.bid > 0 && q.ask > 0 && q.bid < q.ask {
+			bids = append(bids, q.bid*rate)
+			asks = append(asks, q.ask*rate)
+		}
+	}
+	m.quotesMtx.RUnlock()
+	if len(cp.Constituents) == 0 {
+		return nil, errNoCompositeQuotes
+	}
+	slices.SortFunc(cp.Constituents, func(a, b CompositeConstituent) int {
+		return strings.Compare(a.Exchange+a.Pair.String(), b.Exchange+b.Pair.String())
+	})
+
+	prices := make([]float64, len(cp.Constituents))
+	for i := range cp.Constituents {
+		prices[i] = cp.Constituents[i].ConvertedPrice
+	}
+	cp.MedianPrice = median(prices)
+
+	var weighted, volume, filteredWeighted, filteredVolume, filteredSum float64
+	var filteredCount int
+	for i := range cp.Constituents {
+		c := &cp.Constituents[i]
+		weighted += c.ConvertedPrice * c.Volume
+		volume += c.Volume
+		if math.Abs(c.ConvertedPrice-cp.MedianPrice)/cp.MedianPrice*100 > m.outlierTolerance {
+			c.Excluded = true
+			continue
+		}
+		filteredWeighted += c.ConvertedPrice * c.Volume
+		filteredVolume += c.Volume
+		filteredSum += c.ConvertedPrice
+		filteredCount++
+	}
+	cp.TotalVolume = volume
+	cp.VolumeWeightedPrice = cp.MedianPrice
+	if volume > 0 {
+		cp.VolumeWeightedPrice = weighted / volume
+	}
+	switch {
+	case filteredVolume > 0:
+		cp.FilteredPrice = filteredWeighted / filteredVolume
+	case filteredCount > 0:
+		cp.FilteredPrice = filteredSum / float64(filteredCount)
+	default:
+		cp.FilteredPrice = cp.MedianPrice
+	}
+	if len(bids) > 0 {
+		cp.Bid, cp.Ask = median(bids), median(asks)
+		if cp.Bid >= cp.Ask {
+			cp.Bid, cp.Ask = 0, 0
+		}
+	}
+	return cp, nil
+}
+
+// conversionRate returns the rate used to convert a price quoted in one
+// currency into another. Fiat currencies are converted via the forex
+// providers, otherwise a live market between the two currencies is used.
+// Equivalent quote currencies without a live market are treated as pegged
+func (m *CompositePriceManager) conversionRate(from, to currency.Code, t *compositePriceTarget) (float64, error) {
+	if from.Equal(to) {
+		return 1, nil
+	}
+	if from.IsFiatCurrency() && to.IsFiatCurrency() {
+		return m.fiatRate(currency.NewPair(from, to))
+	}
+	if rate, err := m.marketRate(currency.NewPair(from, to), asset.Spot); err == nil && rate > 0 {
+		return rate, nil
+	}
+	if rate, err := m.marketRate(currency.NewPair(to, from), asset.Spot); err == nil && rate > 0 {
+		return 1 / rate, nil
+	}
+	if slices.ContainsFunc(t.equivalentQuotes, from.Equal) {
+		return 1, nil
+	}
+	return 0, fmt.Errorf("%w %s to %s", errNoConversionRate, from, to)
+}
+
+// recordCandle folds the composite filtered price into the current history
+// candle, saving the previous candle once its interval has completed
+func (m *CompositePriceManager) recordCandle(cp *CompositePrice) {
+	k := key.PairAsset{Base: cp.Pair.Base.Item, Quote: cp.Pair.Quote.Item, Asset: cp.Asset}
+	start := cp.LastUpdated.Truncate(m.historyInterval.Duration())
+	m.m.Lock()
+	c, ok := m.candles[k]
+	if ok && c.Time.Equal(start) {
+		c.High = math.Max(c.High, cp.FilteredPrice)
+		c.Low = math.Min(c.Low, cp.FilteredPrice)
+		c.Close = cp.FilteredPrice
+		m.m.Unlock()
+		return
+	}
+	m.candles[k] = &kline.Candle{
+		Time:  start,
+		Open:  cp.FilteredPrice,
+		High:  cp.FilteredPrice,
+		Low:   cp.FilteredPrice,
+		Close: cp.FilteredPrice,
+	}
+	m.m.Unlock()
+	if !ok {
+		return
+	}
+	if err := m.saveCandle(cp.Asset, cp.Pair, c); err != nil {
+		log.Errorf(log.Global, "Composite price manager cannot save %s %s candle: %v", cp.Asset, cp.Pair, err)
+	}
+}
+
+// saveCandle stores a completed candle under the virtual exchange name
+func (m *CompositePriceManager) saveCandle(a asset.Item, p currency.Pair, c *kline.Candle) error {
+	db := m.database.GetInstance()
+	if db == nil || !db.IsConnected() {
+		return database.ErrDatabaseNotConnected
+	}
+	m.m.Lock()
+	if !m.virtualInDB {
+		if err := m.exchangeRegistrar(m.virtualExchangeName); err != nil {
+			m.m.Unlock()
+			return err
+		}
+		m.virtualInDB = true
+	}
+	m.m.Unlock()
+	_, err := m.candleSaver(&kline.Item{
+		Exchange: m.virtualExchangeName,
+		Pair:     p,
+		Asset:    a,
+		Interval: m.historyInterval,
+		Candles:  []kline.Candle{*c},
+	}, false)
+	return err
+}
+
+// GetCompositePrice returns the latest composite price for a pair
+func (m *CompositePriceManager) GetCompositePrice(a asset.Item, p currency.Pair) (*CompositePrice, error) {
+	if m == nil {
+		return nil, fmt.Errorf("%s %w", CompositePriceManagerName, ErrNilSubsystem)
+	}
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("%s %w", CompositePriceManagerName, ErrSubSystemNotStarted)
+	}
+	m.m.RLock()
+	defer m.m.RUnlock()
+	cp, ok := m.prices[key.PairAsset{Base: p.Base.Item, Quote: p.Quote.Item, Asset: a}]
+	if !ok {
+		return nil, fmt.Errorf("%w %s %s", errCompositePriceNotFound, a, p)
+	}
+	cpy := *cp
+	cpy.Constituents = slices.Clone(cp.Constituents)
+	return &cpy, nil
+}
+
+// GetCompositePrices returns the latest composite price for every target
+func (m *CompositePriceManager) GetCompositePrices() ([]CompositePrice, error) {
+	if m == nil {
+		return nil, fmt.Errorf("%s %w", CompositePriceManagerName, ErrNilSubsystem)
+	}
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("%s %w", CompositePriceManagerName, ErrSubSystemNotStarted)
+	}
+	m.m.RLock()
+	defer m.m.RUnlock()
+	resp := make([]CompositePrice, 0, len(m.prices))
+	for _, cp := range m.prices {
+		cpy := *cp
+		cpy.Constituents = slices.Clone(cp.Constituents)
+		resp = append(resp, cpy)
+	}
+	slices.SortFunc(resp, func(a, b CompositePrice) int {
+		return strings.Compare(a.Asset.String()+a.Pair.String(), b.Asset.String()+b.Pair.String())
+	})
+	return resp, nil
+}
+
+// publishCompositeTicker pushes the composite price through the ticker
+// service under the virtual exchange name
+func publishCompositeTicker(cp *CompositePrice) error {
+	return ticker.ProcessTicker(&ticker.Price{
+		ExchangeName: cp.Exchange,
+		Pair:         cp.Pair,
+		AssetType:    cp.Asset,
+		Last:         cp.FilteredPrice,
+		Bid:          cp.Bid,
+		Ask:          cp.Ask,
+		Volume:       cp.TotalVolume,
+		IndexPrice:   cp.FilteredPrice,
+		LastUpdated:  cp.LastUpdated,
+	})
+}
+
+// statsVolume returns the volume recorded by the stats package for a market
+func statsVolume(exch string, p currency.Pair, a asset.Item) float64 {
+	items := stats.SortExchangesByVolume(p, a, true)
+	for i := range items {
+		if strings.EqualFold(items[i].Exchange, exch) {
+			return items[i].Volume
+		}
+	}
+	return 0
+}
+
+// registerVirtualExchange ensures the virtual exchange name exists in the
+// exchange table so candles can reference it
+func registerVirtualExchange(name string) error {
+	_, err := exchangeDB.UUIDByName(name)
+	if !errors.Is(err, exchangeDB.ErrNoExchangeFound) {
+		return err
+	}
+	if err := exchangeDB.Insert(exchangeDB.Details{Name: name}); err != nil {
+		return err
+	}
+	_, err = exchangeDB.UUIDByName(name)
+	return err
+}
+
+// median returns the median of the supplied values
+func median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
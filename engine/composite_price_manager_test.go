@@ -0,0 +1,272 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+type connectedDatabase struct {
+	dataBaseConnection
+}
+
+func (d *connectedDatabase) IsConnected() bool {
+	return true
+}
+
+type fakeDatabaseConnectionManager struct {
+	instance database.IDatabase
+}
+
+func (f *fakeDatabaseConnectionManager) GetInstance() database.IDatabase {
+	return f.instance
+}
+
+func compositeTestConfig() *config.CompositePriceManager {
+	return &config.CompositePriceManager{
+		Interval:                   time.Second,
+		MaxQuoteAge:                time.Minute,
+		OutlierTolerancePercentage: 2,
+		VirtualExchangeName:        "composite",
+		HistoryInterval:            kline.OneMin,
+		Targets: []config.CompositePriceTarget{
+			{
+				Asset:            asset.Spot,
+				Pair:             currency.NewPair(currency.BTC, currency.USD),
+				EquivalentQuotes: []currency.Code{currency.USDT, currency.USDC},
+			},
+		},
+	}
+}
+
+func setupTestCompositePriceManager(t *testing.T) *CompositePriceManager {
+	t.Helper()
+	m, err := SetupCompositePriceManager(NewExchangeManager(), &fakeDatabaseConnectionManager{}, compositeTestConfig())
+	require.NoError(t, err)
+	m.tickerPublisher = func(*CompositePrice) error { return nil }
+	m.volumeLookup = func(string, currency.Pair, asset.Item) float64 { return 0 }
+	m.marketRate = func(currency.Pair, asset.Item) (float64, error) { return 0, ticker.ErrTickerNotFound }
+	m.fiatRate = func(currency.Pair) (float64, error) { return 0, errNoConversionRate }
+	return m
+}
+
+func TestSetupCompositePriceManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupCompositePriceManager(nil, nil, nil)
+	require.ErrorIs(t, err, errNilExchangeManager)
+
+	_, err = SetupCompositePriceManager(NewExchangeManager(), nil, nil)
+	require.ErrorIs(t, err, errNilConfig)
+
+	cfg := compositeTestConfig()
+	cfg.RecordHistory = true
+	_, err = SetupCompositePriceManager(NewExchangeManager(), nil, cfg)
+	require.ErrorIs(t, err, errNilDatabaseConnectionManager)
+
+	cfg = compositeTestConfig()
+	cfg.VirtualExchangeName = ""
+	_, err = SetupCompositePriceManager(NewExchangeManager(), nil, cfg)
+	require.ErrorIs(t, err, errVirtualExchangeNameUnset)
+
+	cfg = compositeTestConfig()
+	cfg.Interval = 0
+	_, err = SetupCompositePriceManager(NewExchangeManager(), nil, cfg)
+	require.ErrorIs(t, err, errInvalidTimes)
+
+	cfg = compositeTestConfig()
+	cfg.Targets[0].Asset = asset.Empty
+	_, err = SetupCompositePriceManager(NewExchangeManager(), nil, cfg)
+	require.ErrorIs(t, err, asset.ErrNotSupported)
+
+	cfg = compositeTestConfig()
+	cfg.Targets[0].Pair = currency.EMPTYPAIR
+	_, err = SetupCompositePriceManager(NewExchangeManager(), nil, cfg)
+	require.ErrorIs(t, err, errCurrencyPairUnset)
+
+	m, err := SetupCompositePriceManager(NewExchangeManager(), nil, compositeTestConfig())
+	require.NoError(t, err)
+	require.Len(t, m.targets, 1)
+}
+
+func TestCompositePriceManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *CompositePriceManager
+	assert.ErrorIs(t, m.Start(t.Context()), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	assert.False(t, m.IsRunning())
+
+	m, err := SetupCompositePriceManager(&fakeExchangeManagerino{}, nil, compositeTestConfig())
+	require.NoError(t, err)
+	assert.ErrorIs(t, m.Start(t.Context()), errVirtualExchangeNameExists)
+
+	m = setupTestCompositePriceManager(t)
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, m.Start(t.Context()))
+	assert.True(t, m.IsRunning())
+	assert.ErrorIs(t, m.Start(t.Context()), ErrSubSystemAlreadyStarted)
+	require.NoError(t, m.Stop())
+	assert.False(t, m.IsRunning())
+}
+
+func TestCompositeIncludesMarket(t *testing.T) {
+	t.Parallel()
+	target := &compositePriceTarget{
+		asset:            asset.Spot,
+		pair:             currency.NewPair(currency.BTC, currency.USD),
+		exchanges:        []string{"Binance"},
+		equivalentQuotes: []currency.Code{currency.USDT},
+	}
+	assert.True(t, target.includesMarket("binance", asset.Spot, currency.NewPair(currency.BTC, currency.USDT)))
+	assert.False(t, target.includesMarket("binance", asset.Spot, currency.NewPair(currency.BTC, currency.USDC)), "USDC is not an equivalent quote")
+	assert.False(t, target.includesMarket("kraken", asset.Spot, currency.NewPair(currency.BTC, currency.USD)), "kraken is not a configured exchange")
+	assert.False(t, target.includesMarket("binance", asset.Futures, currency.NewPair(currency.BTC, currency.USD)))
+}
+
+func TestCompositeConversionRate(t *testing.T) {
+	t.Parallel()
+	m := setupTestCompositePriceManager(t)
+	target := &m.targets[0]
+
+	rate, err := m.conversionRate(currency.USD, currency.USD, target)
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, rate)
+
+	rate, err = m.conversionRate(currency.USDT, currency.USD, target)
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, rate, "equivalent quote without a market should be treated as pegged")
+
+	_, err = m.conversionRate(currency.ETH, currency.USD, target)
+	assert.ErrorIs(t, err, errNoConversionRate)
+
+	m.marketRate = func(p currency.Pair, _ asset.Item) (float64, error) {
+		if p.Equal(currency.NewPair(currency.USD, currency.USDT)) {
+			return 1.25, nil
+		}
+		return 0, ticker.ErrTickerNotFound
+	}
+	rate, err = m.conversionRate(currency.USDT, currency.USD, target)
+	require.NoError(t, err)
+	assert.Equal(t, 0.8, rate, "inverse market should be used")
+
+	m.fiatRate = func(currency.Pair) (float64, error) { return 1.1, nil }
+	rate, err = m.conversionRate(currency.EUR, currency.USD, target)
+	require.NoError(t, err)
+	assert.Equal(t, 1.1, rate)
+}
+
+func TestCompositeCalculate(t *testing.T) {
+	t.Parallel()
+	m := setupTestCompositePriceManager(t)
+	now := time.Now()
+	for _, tick := range []*ticker.Price{
+		{ExchangeName: "Binance", Pair: currency.NewPair(currency.BTC, currency.USDT), AssetType: asset.Spot, Last: 100, Volume: 10, LastUpdated: now},
+		{ExchangeName: "Kraken", Pair: currency.NewPair(currency.BTC, currency.USD), AssetType: asset.Spot, Last: 102, Volume: 30, LastUpdated: now},
+		{ExchangeName: "Coinbase", Pair: currency.NewPair(currency.BTC, currency.USDC), AssetType: asset.Spot, Last: 101, Bid: 100.5, Ask: 101.5, Volume: 20, LastUpdated: now},
+		{ExchangeName: "Bad", Pair: currency.NewPair(currency.BTC, currency.USD), AssetType: asset.Spot, Last: 150, Volume: 40, LastUpdated: now},
+		{ExchangeName: "Stale", Pair: currency.NewPair(currency.BTC, currency.USD), AssetType: asset.Spot, Last: 90, Volume: 40, LastUpdated: now.Add(-time.Hour)},
+		{ExchangeName: "Other", Pair: currency.NewPair(currency.ETH, currency.USD), AssetType: asset.Spot, Last: 5, Volume: 40, LastUpdated: now},
+	} {
+		m.updateFromTicker(tick)
+	}
+	m.updateFromOrderbook(nil)
+
+	cp, err := m.calculate(&m.targets[0], now)
+	require.NoError(t, err)
+	require.Len(t, cp.Constituents, 4, "stale and untracked markets should be ignored")
+	assert.Equal(t, "composite", cp.Exchange)
+	assert.Equal(t, 101.5, cp.MedianPrice)
+	assert.InDelta(t, (100*10+102*30+101*20+150*40)/100.0, cp.VolumeWeightedPrice, 1e-9)
+	assert.InDelta(t, (100*10+102*30+101*20)/60.0, cp.FilteredPrice, 1e-9, "outlier should be excluded from filtered price")
+	assert.Equal(t, 100.0, cp.TotalVolume)
+	assert.Equal(t, 100.5, cp.Bid)
+	assert.Equal(t, 101.5, cp.Ask)
+	for i := range cp.Constituents {
+		assert.Equal(t, cp.Constituents[i].Exchange == "bad", cp.Constituents[i].Excluded)
+	}
+
+	_, err = m.calculate(&compositePriceTarget{asset: asset.Spot, pair: currency.NewPair(currency.LTC, currency.USD)}, now)
+	assert.ErrorIs(t, err, errNoCompositeQuotes)
+}
+
+func TestCompositeRecordCandle(t *testing.T) {
+	t.Parallel()
+	m := setupTestCompositePriceManager(t)
+	m.recordHistory = true
+	var saved []*kline.Item
+	m.candleSaver = func(i *kline.Item, _ bool) (uint64, error) {
+		saved = append(saved, i)
+		return uint64(len(i.Candles)), nil
+	}
+	var registered int
+	m.exchangeRegistrar = func(string) error {
+		registered++
+		return nil
+	}
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	pair := currency.NewPair(currency.BTC, currency.USD)
+	for i, price := range []float64{100, 105, 95, 101} {
+		m.recordCandle(&CompositePrice{Exchange: "composite", Asset: asset.Spot, Pair: pair, FilteredPrice: price, LastUpdated: start.Add(time.Duration(i) * time.Second)})
+	}
+	assert.Empty(t, saved, "candle should not be saved until the interval completes")
+
+	m.recordCandle(&CompositePrice{Exchange: "composite", Asset: asset.Spot, Pair: pair, FilteredPrice: 110, LastUpdated: start.Add(time.Minute)})
+	assert.Empty(t, saved, "candle should not be saved without a database connection")
+
+	m.database = &fakeDatabaseConnectionManager{instance: &connectedDatabase{}}
+	m.recordCandle(&CompositePrice{Exchange: "composite", Asset: asset.Spot, Pair: pair, FilteredPrice: 120, LastUpdated: start.Add(time.Minute * 2)})
+	m.recordCandle(&CompositePrice{Exchange: "composite", Asset: asset.Spot, Pair: pair, FilteredPrice: 130, LastUpdated: start.Add(time.Minute * 3)})
+	require.Len(t, saved, 2)
+	assert.Equal(t, 1, registered, "virtual exchange should only be registered once")
+	assert.Equal(t, "composite", saved[0].Exchange)
+	assert.Equal(t, kline.OneMin, saved[0].Interval)
+	require.Len(t, saved[0].Candles, 1)
+	assert.Equal(t, start.Add(time.Minute), saved[0].Candles[0].Time)
+	assert.Equal(t, 110.0, saved[0].Candles[0].Close)
+}
+
+func TestGetCompositePrices(t *testing.T) {
+	t.Parallel()
+	m := setupTestCompositePriceManager(t)
+	pair := currency.NewPair(currency.BTC, currency.USD)
+	_, err := m.GetCompositePrice(asset.Spot, pair)
+	require.ErrorIs(t, err, ErrSubSystemNotStarted)
+	_, err = m.GetCompositePrices()
+	require.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	m.started.Store(true)
+	var published []*CompositePrice
+	m.tickerPublisher = func(cp *CompositePrice) error {
+		published = append(published, cp)
+		return nil
+	}
+	now := time.Now()
+	m.updateFromTicker(&ticker.Price{ExchangeName: "Kraken", Pair: pair, AssetType: asset.Spot, Last: 102, Volume: 30, LastUpdated: now})
+	m.calculateAll(now)
+	require.Len(t, published, 1)
+
+	cp, err := m.GetCompositePrice(asset.Spot, pair)
+	require.NoError(t, err)
+	assert.Equal(t, 102.0, cp.FilteredPrice)
+
+	_, err = m.GetCompositePrice(asset.Spot, currency.NewPair(currency.LTC, currency.USD))
+	assert.ErrorIs(t, err, errCompositePriceNotFound)
+
+	prices, err := m.GetCompositePrices()
+	require.NoError(t, err)
+	require.Len(t, prices, 1)
+}
+
+func TestMedian(t *testing.T) {
+	t.Parallel()
+	assert.Zero(t, median(nil))
+	assert.Equal(t, 2.0, median([]float64{3, 1, 2}))
+	assert.Equal(t, 2.5, median([]float64{4, 1, 3, 2}))
+}
@@ -0,0 +1,111 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+// CompositePriceManagerName is an exported subsystem name
+const CompositePriceManagerName = "composite_price_manager"
+
+var (
+	errCompositePriceNotFound    = errors.New("composite price not found")
+	errNoCompositeQuotes         = errors.New("no fresh quotes available")
+	errNoConversionRate          = errors.New("no conversion rate available")
+	errVirtualExchangeNameUnset  = errors.New("virtual exchange name unset")
+	errVirtualExchangeNameExists = errors.New("virtual exchange name conflicts with a loaded exchange")
+)
+
+// CompositePriceManager combines ticker and orderbook updates from multiple
+// exchanges into a single reference price per currency pair. The composite is
+// published as a synthetic exchange ticker and optionally recorded as candles
+// under the same virtual exchange name
+type CompositePriceManager struct {
+	started             atomic.Bool
+	shutdown            chan struct{}
+	wg                  sync.WaitGroup
+	exchangeManager     iExchangeManager
+	database            iDatabaseConnectionManager
+	interval            time.Duration
+	maxQuoteAge         time.Duration
+	outlierTolerance    float64
+	virtualExchangeName string
+	recordHistory       bool
+	historyInterval     kline.Interval
+	verbose             bool
+	targets             []compositePriceTarget
+
+	subscriptionsMtx sync.Mutex
+	subscriptions    map[string]*dispatch.Pipe
+
+	quotesMtx sync.RWMutex
+	quotes    map[key.ExchangeAssetPair]*compositeQuote
+
+	m           sync.RWMutex
+	prices      map[key.PairAsset]*CompositePrice
+	candles     map[key.PairAsset]*kline.Candle
+	virtualInDB bool
+
+	tickerSubscriber    func(string) (dispatch.Pipe, error)
+	orderbookSubscriber func(string) (dispatch.Pipe, error)
+	tickerPublisher     func(*CompositePrice) error
+	volumeLookup        func(string, currency.Pair, asset.Item) float64
+	fiatRate            func(currency.Pair) (float64, error)
+	marketRate          func(currency.Pair, asset.Item) (float64, error)
+	candleSaver         func(*kline.Item, bool) (uint64, error)
+	exchangeRegistrar   func(string) error
+}
+
+// compositePriceTarget is a configured pair with its resolved constituent
+// markets
+type compositePriceTarget struct {
+	asset            asset.Item
+	pair             currency.Pair
+	exchanges        []string
+	equivalentQuotes []currency.Code
+}
+
+// compositeQuote is the latest price information received from a single
+// exchange market
+type compositeQuote struct {
+	last    float64
+	bid     float64
+	ask     float64
+	volume  float64
+	updated time.Time
+}
+
+// CompositePrice is a reference price calculated across multiple exchanges
+type CompositePrice struct {
+	Exchange            string
+	Asset               asset.Item
+	Pair                currency.Pair
+	VolumeWeightedPrice float64
+	MedianPrice         float64
+	FilteredPrice       float64
+	Bid                 float64
+	Ask                 float64
+	TotalVolume         float64
+	Constituents        []CompositeConstituent
+	LastUpdated         time.Time
+}
+
+// CompositeConstituent is a single exchange market used in a composite price
+type CompositeConstituent struct {
+	Exchange       string
+	Pair           currency.Pair
+	Price          float64
+	ConvertedPrice float64
+	ConversionRate float64
+	Volume         float64
+	Excluded       bool
+	LastUpdated    time.Time
+}
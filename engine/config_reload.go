@@ -0,0 +1,321 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
+)
+
+var errConfigReloadEncrypted = errors.New("encrypted config cannot be reloaded without an encryption key provider")
+
+// configReloadSkipFields are top level config fields which are applied by the
+// reload directly rather than reported as requiring a restart
+var configReloadSkipFields = []string{"exchanges", "communications", "logging"}
+
+// exchangeReloadSkipFields are exchange config fields which are applied by the
+// reload directly rather than reported as requiring a restart
+var exchangeReloadSkipFields = []string{"name", "enabled", "currencyPairs", "api"}
+
+// ConfigReloadResult holds the outcome of a configuration reload
+type ConfigReloadResult struct {
+	// Applied lists the config sections which were applied to the running
+	// engine
+	Applied []string
+	// RestartRequired lists the config fields which changed but cannot be
+	// applied without restarting the engine
+	RestartRequired []string
+}
+
+// ReloadConfig re-reads the config file the engine was started with, diffs it
+// against the running config and applies what it can without a restart:
+// enabled pairs and assets (with websocket resubscription and sync agent
+// removal), exchange API credentials, communication relayers and logging.
+// Changed fields which cannot be applied live are reported in the result
+func (bot *Engine) ReloadConfig(ctx context.Context) (*ConfigReloadResult, error) {
+	if bot == nil {
+		return nil, errNilBot
+	}
+	if bot.Config == nil {
+		return nil, errNilConfig
+	}
+	bot.configReloadMtx.Lock()
+	defer bot.configReloadMtx.Unlock()
+
+	filePath, _, err := config.GetFilePath(bot.Settings.ConfigFile)
+	if err != nil {
+		return nil, err
+	}
+	if config.IsFileEncrypted(filePath) && bot.Config.EncryptionKeyProvider == nil {
+		return nil, errConfigReloadEncrypted
+	}
+	newCfg := &config.Config{EncryptionKeyProvider: bot.Config.EncryptionKeyProvider}
+	if err := newCfg.ReadConfigFromFile(filePath, true); err != nil {
+		return nil, fmt.Errorf("%w %s: %w", config.ErrFailureOpeningConfig, filePath, err)
+	}
+	logPath := log.GetLogPath()
+	err = newCfg.CheckConfig()
+	// CheckConfig points the database instance, bank accounts and log path at
+	// the config being checked, which must stay on the running config until the
+	// engine is restarted
+	if dbErr := database.DB.SetConfig(&bot.Config.Database); dbErr != nil {
+		log.Errorf(log.ConfigMgr, "Config reload failed to restore database config: %v", dbErr)
+	}
+	banking.SetAccounts(bot.Config.BankAccounts...)
+	if logPath != "" {
+		if logErr := log.SetLogPath(logPath); logErr != nil {
+			log.Errorf(log.ConfigMgr, "Config reload failed to restore log path: %v", logErr)
+		}
+	}
+	if err != nil {
+		// CheckConfig also sets the global log config, which is only applied
+		// once the config being checked is accepted
+		if logErr := restoreGlobalLogConfig(&bot.Config.Logging); logErr != nil {
+			log.Errorf(log.ConfigMgr, "Config reload failed to restore logging config: %v", logErr)
+		}
+		return nil, err
+	}
+	log.Infof(log.ConfigMgr, "Reloading config file %s", filePath)
+
+	result := &ConfigReloadResult{
+		RestartRequired: diffConfigFields("", reflect.ValueOf(bot.Config).Elem(), reflect.ValueOf(newCfg).Elem(), configReloadSkipFields),
+	}
+
+	var errs error
+	if !reflect.DeepEqual(bot.Config.Logging, newCfg.Logging) {
+		bot.Config.Logging = newCfg.Logging
+		if err := bot.reloadLogging(); err != nil {
+			errs = common.AppendError(errs, err)
+		} else {
+			result.Applied = append(result.Applied, "logging")
+		}
+	}
+	if err := bot.reloadCommunications(newCfg, result); err != nil {
+		errs = common.AppendError(errs, err)
+	}
+	for i := range newCfg.Exchanges {
+		if err := bot.reloadExchangeConfig(ctx, &newCfg.Exchanges[i], result); err != nil {
+			errs = common.AppendError(errs, fmt.Errorf("%s: %w", newCfg.Exchanges[i].Name, err))
+		}
+	}
+	for i := range bot.Config.Exchanges {
+		if _, err := newCfg.GetExchangeConfig(bot.Config.Exchanges[i].Name); err != nil {
+			result.RestartRequired = append(result.RestartRequired, "exchanges."+bot.Config.Exchanges[i].Name)
+		}
+	}
+
+	for _, field := range result.RestartRequired {
+		log.Warnf(log.ConfigMgr, "Config reload: %s changed and requires a restart to take effect", field)
+	}
+	log.Infof(log.ConfigMgr, "Config reload complete. Applied: %d Restart required: %d", len(result.Applied), len(result.RestartRequired))
+	return result, errs
+}
+
+// reloadLogging sets up the global logger and sub loggers with the running
+// logging config, which CheckConfig has set as the global log config
+func (bot *Engine) reloadLogging() error {
+	if bot.Config.Logging.Enabled == nil || !*bot.Config.Logging.Enabled {
+		return nil
+	}
+	if err := log.SetupGlobalLogger(bot.Config.Name, bot.Config.Logging.AdvancedSettings.StructuredLogging); err != nil {
+		return fmt.Errorf("logging: %w", err)
+	}
+	if err := log.SetupSubLoggers(bot.Config.Logging.SubLoggers); err != nil {
+		return fmt.Errorf("logging: %w", err)
+	}
+	return nil
+}
+
+// restoreGlobalLogConfig sets the global log config back to the running
+// logging config
+func restoreGlobalLogConfig(cfg *log.Config) error {
+	log.SetFileLoggingState(cfg.LoggerFileConfig != nil)
+	return log.SetGlobalLogConfig(cfg)
+}
+
+// reloadCommunications restarts the communication relayers when their config has
+// changed
+func (bot *Engine) reloadCommunications(newCfg *config.Config, result *ConfigReloadResult) error {
+	if reflect.DeepEqual(bot.Config.Communications, newCfg.Communications) {
+		return nil
+	}
+	if !bot.CommunicationsManager.IsRunning() {
+		result.RestartRequired = append(result.RestartRequired, "communications")
+		return nil
+	}
+	comms := newCfg.GetCommunicationsConfig()
+	if err := bot.CommunicationsManager.Reload(&comms); err != nil {
+		return fmt.Errorf("communications: %w", err)
+	}
+	bot.Config.UpdateCommunicationsConfig(&comms)
+	result.Applied = append(result.Applied, "communications")
+	return nil
+}
+
+// reloadExchangeConfig applies the enabled pairs and API credentials of an
+// exchange config to the running exchange and its config
+func (bot *Engine) reloadExchangeConfig(ctx context.Context, newExchCfg *config.Exchange, result *ConfigReloadResult) error {
+	prefix := "exchanges." + newExchCfg.Name
+	exchCfg, err := bot.Config.GetExchangeConfig(newExchCfg.Name)
+	if err != nil {
+		result.RestartRequired = append(result.RestartRequired, prefix)
+		return nil
+	}
+	if exchCfg.Enabled != newExchCfg.Enabled {
+		result.RestartRequired = append(result.RestartRequired, prefix+".enabled")
+		return nil
+	}
+	exch, err := bot.GetExchangeByName(newExchCfg.Name)
+	if err != nil {
+		// The exchange is not loaded so nothing is running against its config
+		*exchCfg = *newExchCfg
+		return nil
+	}
+
+	result.RestartRequired = append(result.RestartRequired, diffConfigFields(prefix, reflect.ValueOf(exchCfg).Elem(), reflect.ValueOf(newExchCfg).Elem(), exchangeReloadSkipFields)...)
+	oldAPI, newAPI := exchCfg.API, newExchCfg.API
+	oldAPI.Credentials, newAPI.Credentials = config.APICredentialsConfig{}, config.APICredentialsConfig{}
+	oldAPI.Accounts, newAPI.Accounts = nil, nil
+	if !reflect.DeepEqual(oldAPI, newAPI) {
+		result.RestartRequired = append(result.RestartRequired, prefix+".api")
+	}
+
+	var errs error
+	changed, err := reloadExchangePairs(exch, exchCfg, newExchCfg, bot.currencyPairSyncer)
+	if err != nil {
+		errs = common.AppendError(errs, err)
+	}
+	if changed {
+		result.Applied = append(result.Applied, prefix+".currencyPairs")
+		if exch.IsWebsocketEnabled() && exch.GetBase().Websocket.IsConnected() {
+			if err := exch.FlushWebsocketChannels(); err != nil {
+				errs = common.AppendError(errs, fmt.Errorf("websocket resubscription: %w", err))
+			}
+		}
+	}
+
+	credentialsChanged := exchCfg.API.Credentials != newExchCfg.API.Credentials
+	accountsChanged := !reflect.DeepEqual(exchCfg.API.Accounts, newExchCfg.API.Accounts)
+	if credentialsChanged || accountsChanged {
+		exchCfg.API.Credentials = newExchCfg.API.Credentials
+		exchCfg.API.Accounts = newExchCfg.API.Accounts
+		if err := exch.GetBase().LoadCredentialsFromConfig(ctx); err != nil {
+			errs = common.AppendError(errs, fmt.Errorf("credentials: %w", err))
+		} else {
+			if credentialsChanged {
+				result.Applied = append(result.Applied, prefix+".api.credentials")
+			}
+			if accountsChanged {
+				result.Applied = append(result.Applied, prefix+".api.accounts")
+			}
+		}
+	}
+	return errs
+}
+
+// reloadExchangePairs enables and disables assets and pairs on the running
+// exchange and its config to match the new config, removing the sync agents
+// of pairs which are no longer enabled. It returns whether anything changed
+func reloadExchangePairs(exch exchange.IBotExchange, exchCfg, newExchCfg *config.Exchange, syncer *SyncManager) (bool, error) {
+	if newExchCfg.CurrencyPairs == nil || exchCfg.CurrencyPairs == nil {
+		return false, nil
+	}
+	b := exch.GetBase()
+	var changed bool
+	var errs error
+	for _, a := range newExchCfg.CurrencyPairs.GetAssetTypes(false) {
+		newStore, err := newExchCfg.CurrencyPairs.Get(a)
+		if err != nil {
+			errs = common.AppendError(errs, err)
+			continue
+		}
+		store, err := exchCfg.CurrencyPairs.Get(a)
+		if err != nil {
+			errs = common.AppendError(errs, fmt.Errorf("%s %w", a, err))
+			continue
+		}
+
+		if store.AssetEnabled != newStore.AssetEnabled {
+			if err := exchCfg.CurrencyPairs.SetAssetEnabled(a, newStore.AssetEnabled); err != nil {
+				errs = common.AppendError(errs, err)
+				continue
+			}
+			if err := b.CurrencyPairs.SetAssetEnabled(a, newStore.AssetEnabled); err != nil {
+				errs = common.AppendError(errs, err)
+				continue
+			}
+			changed = true
+		}
+
+		var disabled currency.Pairs
+		for _, p := range store.Enabled {
+			if newStore.Enabled.Contains(p, true) {
+				continue
+			}
+			if err := exchCfg.CurrencyPairs.DisablePair(a, p); err != nil {
+				errs = common.AppendError(errs, fmt.Errorf("%s %s %w", a, p, err))
+				continue
+			}
+			if err := b.CurrencyPairs.DisablePair(a, p); err != nil && !errors.Is(err, currency.ErrPairNotFound) {
+				errs = common.AppendError(errs, fmt.Errorf("%s %s %w", a, p, err))
+				continue
+			}
+			disabled = append(disabled, p)
+		}
+		for _, p := range newStore.Enabled {
+			if store.Enabled.Contains(p, true) {
+				continue
+			}
+			if err := exchCfg.CurrencyPairs.EnablePair(a, p); err != nil {
+				errs = common.AppendError(errs, fmt.Errorf("%s %s %w", a, p, err))
+				continue
+			}
+			if err := b.CurrencyPairs.EnablePair(a, p); err != nil && !errors.Is(err, currency.ErrPairAlreadyEnabled) {
+				errs = common.AppendError(errs, fmt.Errorf("%s %s %w", a, p, err))
+				continue
+			}
+			changed = true
+		}
+		if len(disabled) > 0 {
+			changed = true
+			syncer.removePairs(exch.GetName(), a, disabled)
+		}
+	}
+	return changed, errs
+}
+
+// diffConfigFields compares the exported fields of two config structs,
+// returning the JSON names of those which differ. Fields without a JSON name
+// and those listed in skip are ignored
+func diffConfigFields(prefix string, oldVal, newVal reflect.Value, skip []string) []string {
+	var diff []string
+	t := oldVal.Type()
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" || slices.Contains(skip, name) {
+			continue
+		}
+		if reflect.DeepEqual(oldVal.Field(i).Interface(), newVal.Field(i).Interface()) {
+			continue
+		}
+		if prefix != "" {
+			name = prefix + "." + name
+		}
+		diff = append(diff, name)
+	}
+	return diff
+}
@@ -0,0 +1,153 @@
+package engine
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
+)
+
+func TestReloadConfig(t *testing.T) {
+	t.Parallel()
+	_, err := (*Engine)(nil).ReloadConfig(t.Context())
+	assert.ErrorIs(t, err, errNilBot)
+	_, err = (&Engine{}).ReloadConfig(t.Context())
+	assert.ErrorIs(t, err, errNilConfig)
+
+	cfgFile := filepath.Join(t.TempDir(), "config.json")
+	data, err := os.ReadFile(config.TestFile)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(cfgFile, data, 0o600))
+
+	cfg := &config.Config{}
+	require.NoError(t, cfg.LoadConfig(cfgFile, true))
+	bot := &Engine{
+		Config:          cfg,
+		Settings:        Settings{ConfigFile: cfgFile},
+		ExchangeManager: NewExchangeManager(),
+	}
+	exch, err := bot.ExchangeManager.NewExchangeByName(testExchange)
+	require.NoError(t, err)
+	exchCfg, err := cfg.GetExchangeConfig(testExchange)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	require.NoError(t, exch.Setup(exchCfg))
+	require.NoError(t, bot.ExchangeManager.Add(exch))
+
+	result, err := bot.ReloadConfig(t.Context())
+	require.NoError(t, err)
+	assert.Empty(t, result.Applied, "Reloading an unchanged config should apply nothing")
+	assert.Empty(t, result.RestartRequired, "Reloading an unchanged config should require no restart")
+
+	disable := currency.NewPair(currency.XRP, currency.EUR)
+	enable := currency.NewPair(currency.ADA, currency.USD)
+	bot.currencyPairSyncer = &SyncManager{currencyPairs: map[key.ExchangeAssetPair]*currencyPairSyncAgent{}}
+	bot.currencyPairSyncer.initSyncCompleted.Store(true)
+	bot.currencyPairSyncer.add(key.NewExchangeAssetPair(testExchange, asset.Spot, disable), syncBase{})
+
+	var raw map[string]any
+	require.NoError(t, json.Unmarshal(data, &raw))
+	raw["name"] = "Reloaded"
+	for _, e := range raw["exchanges"].([]any) {
+		e := e.(map[string]any)
+		if e["name"] != testExchange {
+			continue
+		}
+		e["api"].(map[string]any)["credentials"].(map[string]any)["key"] = "NewKey"
+		e["api"].(map[string]any)["accounts"] = []any{map[string]any{
+			"name":        "hedge",
+			"credentials": map[string]any{"key": "HedgeKey", "secret": "HedgeSecret", "clientID": "HedgeClientID"},
+		}}
+		spot := e["currencyPairs"].(map[string]any)["pairs"].(map[string]any)["spot"].(map[string]any)
+		spot["enabled"] = "BTC/USD,BTC/EUR,EUR/USD,XRP/USD,ADA/USD"
+	}
+	data, err = json.Marshal(raw)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(cfgFile, data, 0o600))
+
+	result, err = bot.ReloadConfig(t.Context())
+	require.NoError(t, err)
+	prefix := "exchanges." + testExchange
+	assert.ElementsMatch(t, []string{prefix + ".currencyPairs", prefix + ".api.credentials", prefix + ".api.accounts"}, result.Applied)
+	assert.Equal(t, []string{"name"}, result.RestartRequired, "Changing the config name should require a restart")
+	assert.Equal(t, "Skynet", cfg.Name, "Fields requiring a restart should not be applied")
+
+	enabled, err := exch.GetEnabledPairs(asset.Spot)
+	require.NoError(t, err)
+	assert.True(t, enabled.Contains(enable, true), "Newly enabled pair should be enabled on the exchange")
+	assert.False(t, enabled.Contains(disable, true), "Removed pair should be disabled on the exchange")
+	assert.Nil(t, bot.currencyPairSyncer.get(key.NewExchangeAssetPair(testExchange, asset.Spot, disable)), "Sync agent for the disabled pair should be removed")
+	assert.Equal(t, "NewKey", exch.GetBase().GetDefaultCredentials().Key, "Credentials should be swapped")
+	assert.Equal(t, []string{"default", "hedge"}, exch.GetAccountNames(), "Accounts should be loaded")
+
+	require.NoError(t, os.WriteFile(cfgFile, []byte("{"), 0o600))
+	_, err = bot.ReloadConfig(t.Context())
+	assert.ErrorIs(t, err, config.ErrFailureOpeningConfig)
+}
+
+func TestReloadConfigLogging(t *testing.T) {
+	cfgFile := filepath.Join(t.TempDir(), "config.json")
+	data, err := os.ReadFile(config.TestFile)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(cfgFile, data, 0o600))
+
+	cfg := &config.Config{}
+	require.NoError(t, cfg.LoadConfig(cfgFile, true))
+	bot := &Engine{
+		Config:          cfg,
+		Settings:        Settings{ConfigFile: cfgFile},
+		ExchangeManager: NewExchangeManager(),
+	}
+	logging := cfg.Logging
+	t.Cleanup(func() {
+		assert.NoError(t, restoreGlobalLogConfig(&logging), "restoreGlobalLogConfig should not error")
+		assert.NoError(t, log.SetupGlobalLogger(cfg.Name, false), "SetupGlobalLogger should not error")
+	})
+
+	var raw map[string]any
+	require.NoError(t, json.Unmarshal(data, &raw))
+	raw["logging"].(map[string]any)["level"] = "ERROR|WARN"
+	raw["logging"].(map[string]any)["subloggers"] = []any{map[string]any{"name": "config", "level": "DEBUG", "output": "console"}}
+	raw["bankAccounts"] = []any{map[string]any{"id": "reloadTest"}}
+	data, err = json.Marshal(raw)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(cfgFile, data, 0o600))
+
+	result, err := bot.ReloadConfig(t.Context())
+	require.NoError(t, err, "ReloadConfig must not error")
+	assert.Equal(t, []string{"logging"}, result.Applied, "Logging should be applied")
+	assert.Equal(t, []string{"bankAccounts"}, result.RestartRequired, "Bank accounts should require a restart")
+	_, err = banking.GetBankAccountByID("reloadTest")
+	assert.Error(t, err, "Bank accounts requiring a restart should not be applied")
+	levels, err := log.Level("LOG")
+	require.NoError(t, err, "Level must not error")
+	assert.Equal(t, log.Levels{Warn: true, Error: true}, levels, "Global logger level should be changed")
+	levels, err = log.Level("CONFIG")
+	require.NoError(t, err, "Level must not error")
+	assert.Equal(t, log.Levels{Debug: true}, levels, "Sub logger level should be changed")
+
+	logPath := log.GetLogPath()
+	raw["logging"].(map[string]any)["level"] = "DEBUG"
+	raw["dataDirectory"] = t.TempDir()
+	raw["exchanges"] = []any{}
+	data, err = json.Marshal(raw)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(cfgFile, data, 0o600))
+
+	_, err = bot.ReloadConfig(t.Context())
+	require.Error(t, err, "ReloadConfig must error for an invalid config")
+	assert.Equal(t, logPath, log.GetLogPath(), "Log path should be restored")
+	assert.Equal(t, "ERROR|WARN", cfg.Logging.Level, "Rejected logging config should not be applied")
+	levels, err = log.Level("LOG")
+	require.NoError(t, err, "Level must not error")
+	assert.Equal(t, log.Levels{Warn: true, Error: true}, levels, "Global logger level should be unchanged")
+}
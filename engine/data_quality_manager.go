@@ -0,0 +1,595 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	gctmath "github.com/thrasher-corp/gocryptotrader/common/math"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupDataQualityManager creates a data quality manager subsystem
+func SetupDataQualityManager(em iExchangeManager, dhm iDataHistoryJobUpserter, cfg *config.DataQualityManager) (*DataQualityManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if cfg.AutoCreateRepairJobs && dhm == nil {
+		return nil, errNilDataHistoryManager
+	}
+	if cfg.CheckInterval <= 0 {
+		cfg.CheckInterval = defaultDataQualityCheckInterval
+	}
+	if cfg.VolatilityWindow <= 1 {
+		cfg.VolatilityWindow = defaultDataQualityVolatilityWindow
+	}
+	if cfg.OutlierThreshold <= 0 {
+		cfg.OutlierThreshold = defaultDataQualityOutlierThreshold
+	}
+	if cfg.DivergenceTolerancePercentage <= 0 {
+		cfg.DivergenceTolerancePercentage = defaultDataQualityDivergenceTolerance
+	}
+	if cfg.MaxReports <= 0 {
+		cfg.MaxReports = defaultDataQualityMaxReports
+	}
+	return &DataQualityManager{
+		shutdown:             make(chan struct{}),
+		exchangeManager:      em,
+		dataHistoryManager:   dhm,
+		checkInterval:        cfg.CheckInterval,
+		targets:              cfg.Targets,
+		volatilityWindow:     cfg.VolatilityWindow,
+		outlierThreshold:     cfg.OutlierThreshold,
+		divergenceTolerance:  cfg.DivergenceTolerancePercentage,
+		maxReports:           cfg.MaxReports,
+		autoCreateRepairJobs: cfg.AutoCreateRepairJobs,
+		verbose:              cfg.Verbose,
+		candleLoader:         kline.LoadFromDatabase,
+		tradeLoader:          trade.GetTradesInRange,
+	}, nil
+}
+
+// Start runs the subsystem
+func (m *DataQualityManager) Start(ctx context.Context) error {
+	if m == nil {
+		return fmt.Errorf("%s %w", DataQualityManagerName, ErrNilSubsystem)
+	}
+	if !m.started.CompareAndSwap(false, true) {
+		return fmt.Errorf("%s %w", DataQualityManagerName, ErrSubSystemAlreadyStarted)
+	}
+	m.shutdown = make(chan struct{})
+	m.wg.Add(1)
+	go m.run(ctx)
+	log.Debugf(log.DataHistory, "Data quality manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *DataQualityManager) IsRunning() bool {
+	if m == nil {
+		return false
+	}
+	return m.started.Load()
+}
+
+// Stop stops the subsystem
+func (m *DataQualityManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", DataQualityManagerName, ErrNilSubsystem)
+	}
+	if !m.started.CompareAndSwap(true, false) {
+		return fmt.Errorf("%s %w", DataQualityManagerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.DataHistory, "Data quality manager %s", MsgSubSystemShuttingDown)
+	close(m.shutdown)
+	m.wg.Wait()
+	log.Debugf(log.DataHistory, "Data quality manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// run scans all configured targets at each check interval
+func (m *DataQualityManager) run(ctx context.Context) {
+	defer m.wg.Done()
+	timer := time.NewTimer(0) // Prime firing of channel for initial check.
+	defer timer.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			m.checkTargets()
+			timer.Reset(m.checkInterval)
+		}
+	}
+}
+
+// checkTargets generates a report for every configured target
+func (m *DataQualityManager) checkTargets() {
+	end := time.Now()
+	for i := range m.targets {
+		check, err := m.targetToCheck(&m.targets[i], end)
+		if err != nil {
+			log.Errorf(log.DataHistory, "Data quality manager cannot check target %s: %v", m.targets[i].Exchange, err)
+			continue
+		}
+		report, err := m.RunCheck(check)
+		if err != nil {
+			log.Errorf(log.DataHistory, "Data quality manager %s %s %s: %v", check.Exchange, check.Asset, check.Pair, err)
+			continue
+		}
+		if m.verbose || len(report.Issues) > 0 {
+			log.Infof(log.DataHistory, "Data quality manager %s %s %s %s: %d candles checked, %d trades checked, %d issues found",
+				report.Exchange, report.Asset, report.Pair, report.Interval.Word(), report.CandlesChecked, report.TradesChecked, len(report.Issues))
+		}
+	}
+}
+
+// targetToCheck converts a configured target into a check ending at the
+// supplied time
+func (m *DataQualityManager) targetToCheck(t *config.DataQualityTarget, end time.Time) (*DataQualityCheck, error) {
+	if t == nil {
+		return nil, errNilDataQualityTarget
+	}
+	if t.Interval <= 0 {
+		return nil, kline.ErrInvalidInterval
+	}
+	lookback := t.Lookback
+	if lookback <= 0 {
+		lookback = defaultDataQualityLookback
+	}
+	// Exclude the current interval as it will not have been stored yet
+	end = end.Truncate(t.Interval.Duration())
+	return &DataQualityCheck{
+		Exchange:           t.Exchange,
+		Asset:              t.Asset,
+		Pair:               t.Pair,
+		Interval:           t.Interval,
+		StartDate:          end.Add(-lookback),
+		EndDate:            end,
+		SecondaryExchanges: t.SecondaryExchanges,
+		IncludeTrades:      t.IncludeTrades,
+		CreateRepairJobs:   m.autoCreateRepairJobs,
+	}, nil
+}
+
+// RunCheck scans stored data for the supplied check, stores the generated
+// report and creates repair jobs if requested
+func (m *DataQualityManager) RunCheck(check *DataQualityCheck) (*DataQualityReport, error) {
+	if m == nil {
+		return nil, fmt.Errorf("%s %w", DataQualityManagerName, ErrNilSubsystem)
+	}
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("%s %w", DataQualityManagerName, ErrSubSystemNotStarted)
+	}
+	if err := m.validateCheck(check); err != nil {
+		return nil, err
+	}
+	report, err := m.generateReport(check)
+	if err != nil {
+		return nil, err
+	}
+	if check.CreateRepairJobs {
+		report.RepairJobs, err = m.createRepairJobs(check, report)
+		if err != nil {
+			log.Errorf(log.DataHistory, "Data quality manager unable to create repair jobs for %s %s %s: %v", check.Exchange, check.Asset, check.Pair, err)
+		}
+	}
+	m.storeReport(report)
+	return report, nil
+}
+
+func (m *DataQualityManager) validateCheck(check *DataQualityCheck) error {
+	if check == nil {
+		return errNilDataQualityTarget
+	}
+	if check.Exchange == "" {
+		return common.ErrExchangeNameNotSet
+	}
+	if !check.Asset.IsValid() {
+		return fmt.Errorf("%w %s", asset.ErrNotSupported, check.Asset)
+	}
+	if check.Pair.IsEmpty() {
+		return errCurrencyPairUnset
+	}
+	if check.Interval <= 0 {
+		return kline.ErrInvalidInterval
+	}
+	if check.CreateRepairJobs && m.dataHistoryManager == nil {
+		return errNilDataHistoryManager
+	}
+	return common.StartEndTimeCheck(check.StartDate, check.EndDate)
+}
+
+// generateReport loads stored data and runs all data quality checks against it
+func (m *DataQualityManager) generateReport(check *DataQualityCheck) (*DataQualityReport, error) {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	report := &DataQualityReport{
+		ID:          id,
+		Exchange:    check.Exchange,
+		Asset:       check.Asset,
+		Pair:        check.Pair,
+		Interval:    check.Interval,
+		StartDate:   check.StartDate,
+		EndDate:     check.EndDate,
+		CreatedDate: time.Now(),
+	}
+
+	candles, err := m.candleLoader(check.Exchange, check.Pair, check.Asset, check.Interval, check.StartDate, check.EndDate)
+	if err != nil && !errors.Is(err, candle.ErrNoCandleDataFound) {
+		return nil, err
+	}
+	var stored []kline.Candle
+	if candles != nil {
+		stored = candles.Candles
+	}
+	sort.SliceStable(stored, func(i, j int) bool { return stored[i].Time.Before(stored[j].Time) })
+	report.CandlesChecked = int64(len(stored))
+
+	gaps, err := findCandleGaps(stored, check.Interval, check.StartDate, check.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	report.Issues = append(report.Issues, gaps...)
+	report.Issues = append(report.Issues, findDuplicateCandles(stored, check.Interval)...)
+	report.Issues = append(report.Issues, findZeroVolumeCandles(stored, check.Interval)...)
+	report.Issues = append(report.Issues, findOHLCInconsistencies(stored, check.Interval)...)
+	report.Issues = append(report.Issues, findCandleOutliers(stored, check.Interval, m.volatilityWindow, m.outlierThreshold)...)
+
+	for i := range check.SecondaryExchanges {
+		secondary, err := m.candleLoader(check.SecondaryExchanges[i], check.Pair, check.Asset, check.Interval, check.StartDate, check.EndDate)
+		if err != nil {
+			log.Warnf(log.DataHistory, "Data quality manager cannot load secondary exchange %s candles for %s %s: %v", check.SecondaryExchanges[i], check.Asset, check.Pair, err)
+			continue
+		}
+		report.Issues = append(report.Issues, findCrossExchangeDivergence(stored, secondary.Candles, check.Interval, check.SecondaryExchanges[i], m.divergenceTolerance)...)
+	}
+
+	if check.IncludeTrades {
+		trades, err := m.tradeLoader(check.Exchange, check.Asset.String(), check.Pair.Base.String(), check.Pair.Quote.String(), check.StartDate, check.EndDate)
+		if err != nil {
+			log.Warnf(log.DataHistory, "Data quality manager cannot load trades for %s %s %s: %v", check.Exchange, check.Asset, check.Pair, err)
+		} else {
+			report.TradesChecked = int64(len(trades))
+			report.Issues = append(report.Issues, findTradesOutsideCandles(stored, trades, check.Interval)...)
+		}
+	}
+	sort.SliceStable(report.Issues, func(i, j int) bool {
+		return report.Issues[i].StartDate.Before(report.Issues[j].StartDate)
+	})
+	return report, nil
+}
+
+// findCandleGaps returns an issue for every contiguous range of intervals
+// with no stored candle
+func findCandleGaps(candles []kline.Candle, interval kline.Interval, start, end time.Time) ([]DataQualityIssue, error) {
+	h, err := kline.CalculateCandleDateRanges(start, end, interval, 0)
+	if err != nil {
+		return nil, err
+	}
+	stored := make(map[int64]struct{}, len(candles))
+	for i := range candles {
+		stored[candles[i].Time.Unix()] = struct{}{}
+	}
+	var issues []DataQualityIssue
+	var gapStart, gapEnd time.Time
+	var missing int
+	flush := func() {
+		if missing == 0 {
+			return
+		}
+		issues = append(issues, DataQualityIssue{
+			Type:        dataQualityGap,
+			StartDate:   gapStart,
+			EndDate:     gapEnd,
+			Description: fmt.Sprintf("%d missing %s candles", missing, interval.Word()),
+		})
+		missing = 0
+	}
+	for x := range h.Ranges {
+		for y := range h.Ranges[x].Intervals {
+			if _, ok := stored[h.Ranges[x].Intervals[y].Start.Ticks]; ok {
+				flush()
+				continue
+			}
+			if missing == 0 {
+				gapStart = h.Ranges[x].Intervals[y].Start.Time
+			}
+			gapEnd = h.Ranges[x].Intervals[y].End.Time
+			missing++
+		}
+	}
+	flush()
+	return issues, nil
+}
+
+// findDuplicateCandles returns an issue for each timestamp stored more than
+// once
+func findDuplicateCandles(candles []kline.Candle, interval kline.Interval) []DataQualityIssue {
+	seen := make(map[int64]int, len(candles))
+	for i := range candles {
+		seen[candles[i].Time.Unix()]++
+	}
+	var issues []DataQualityIssue
+	for i := range candles {
+		count := seen[candles[i].Time.Unix()]
+		if count <= 1 {
+			continue
+		}
+		issues = append(issues, DataQualityIssue{
+			Type:        dataQualityDuplicateTimestamp,
+			StartDate:   candles[i].Time,
+			EndDate:     candles[i].Time.Add(interval.Duration()),
+			Description: fmt.Sprintf("timestamp stored %d times", count),
+		})
+		seen[candles[i].Time.Unix()] = 0
+	}
+	return issues
+}
+
+// findZeroVolumeCandles returns an issue for each candle with no volume
+func findZeroVolumeCandles(candles []kline.Candle, interval kline.Interval) []DataQualityIssue {
+	var issues []DataQualityIssue
+	for i := range candles {
+		if candles[i].Volume > 0 {
+			continue
+		}
+		issues = append(issues, DataQualityIssue{
+			Type:        dataQualityZeroVolume,
+			StartDate:   candles[i].Time,
+			EndDate:     candles[i].Time.Add(interval.Duration()),
+			Description: fmt.Sprintf("volume %v", candles[i].Volume),
+		})
+	}
+	return issues
+}
+
+// findOHLCInconsistencies returns an issue for each candle where the high and
+// low do not bound the open and close prices
+func findOHLCInconsistencies(candles []kline.Candle, interval kline.Interval) []DataQualityIssue {
+	var issues []DataQualityIssue
+	for i := range candles {
+		var problems []string
+		c := &candles[i]
+		if c.High < c.Low {
+			problems = append(problems, fmt.Sprintf("high %v < low %v", c.High, c.Low))
+		}
+		if c.Open > c.High || c.Open < c.Low {
+			problems = append(problems, fmt.Sprintf("open %v outside range %v-%v", c.Open, c.Low, c.High))
+		}
+		if c.Close > c.High || c.Close < c.Low {
+			problems = append(problems, fmt.Sprintf("close %v outside range %v-%v", c.Close, c.Low, c.High))
+		}
+		if c.Open <= 0 || c.High <= 0 || c.Low <= 0 || c.Close <= 0 {
+			problems = append(problems, "non-positive price")
+		}
+		if len(problems) == 0 {
+			continue
+		}
+		issues = append(issues, DataQualityIssue{
+			Type:        dataQualityOHLCInconsistency,
+			StartDate:   c.Time,
+			EndDate:     c.Time.Add(interval.Duration()),
+			Description: strings.Join(problems, ", "),
+		})
+	}
+	return issues
+}
+
+// findCandleOutliers returns an issue for each candle whose log return is
+// more than threshold standard deviations away from the rolling mean of the
+// preceding window of returns
+func findCandleOutliers(candles []kline.Candle, interval kline.Interval, window int, threshold float64) []DataQualityIssue {
+	if window <= 1 || len(candles) <= window {
+		return nil
+	}
+	returns := make([]float64, len(candles))
+	for i := 1; i < len(candles); i++ {
+		if candles[i-1].Close <= 0 || candles[i].Close <= 0 {
+			continue
+		}
+		returns[i] = math.Log(candles[i].Close / candles[i-1].Close)
+	}
+	var issues []DataQualityIssue
+	for i := window + 1; i < len(candles); i++ {
+		sample := returns[i-window : i]
+		mean, err := gctmath.ArithmeticMean(sample)
+		if err != nil {
+			continue
+		}
+		stdDev, err := gctmath.PopulationStandardDeviation(sample)
+		if err != nil || stdDev == 0 {
+			continue
+		}
+		score := math.Abs(returns[i]-mean) / stdDev
+		if score <= threshold {
+			continue
+		}
+		issues = append(issues, DataQualityIssue{
+			Type:        dataQualityOutlier,
+			StartDate:   candles[i].Time,
+			EndDate:     candles[i].Time.Add(interval.Duration()),
+			Description: fmt.Sprintf("close %v moved %.2f standard deviations against rolling %d interval volatility", candles[i].Close, score, window),
+		})
+	}
+	return issues
+}
+
+// findCrossExchangeDivergence returns an issue for each interval where the
+// close price differs from the secondary exchange by more than the tolerance
+func findCrossExchangeDivergence(primary, secondary []kline.Candle, interval kline.Interval, secondaryExchange string, tolerance float64) []DataQualityIssue {
+	closes := make(map[int64]float64, len(secondary))
+	for i := range secondary {
+		closes[secondary[i].Time.Unix()] = secondary[i].Close
+	}
+	var issues []DataQualityIssue
+	for i := range primary {
+		secondaryClose, ok := closes[primary[i].Time.Unix()]
+		if !ok || secondaryClose <= 0 || primary[i].Close <= 0 {
+			continue
+		}
+		diff := gctmath.PercentageDifference(primary[i].Close, secondaryClose)
+		if diff <= tolerance {
+			continue
+		}
+		issues = append(issues, DataQualityIssue{
+			Type:        dataQualityCrossExchangeDivergence,
+			StartDate:   primary[i].Time,
+			EndDate:     primary[i].Time.Add(interval.Duration()),
+			Description: fmt.Sprintf("close %v differs from %s close %v by %.4f%%", primary[i].Close, secondaryExchange, secondaryClose, diff),
+			Exchange:    secondaryExchange,
+		})
+	}
+	return issues
+}
+
+// findTradesOutsideCandles returns an issue for each candle where stored
+// trades fall outside of the candle high and low
+func findTradesOutsideCandles(candles []kline.Candle, trades []trade.Data, interval kline.Interval) []DataQualityIssue {
+	type bounds struct{ low, high float64 }
+	tradeBounds := make(map[int64]*bounds)
+	for i := range trades {
+		start := trades[i].Timestamp.Truncate(interval.Duration()).Unix()
+		b, ok := tradeBounds[start]
+		if !ok {
+			tradeBounds[start] = &bounds{low: trades[i].Price, high: trades[i].Price}
+			continue
+		}
+		b.low = math.Min(b.low, trades[i].Price)
+		b.high = math.Max(b.high, trades[i].Price)
+	}
+	var issues []DataQualityIssue
+	for i := range candles {
+		b, ok := tradeBounds[candles[i].Time.Unix()]
+		if !ok {
+			continue
+		}
+		if b.low >= candles[i].Low && b.high <= candles[i].High {
+			continue
+		}
+		issues = append(issues, DataQualityIssue{
+			Type:        dataQualityTradeOutsideCandle,
+			StartDate:   candles[i].Time,
+			EndDate:     candles[i].Time.Add(interval.Duration()),
+			Description: fmt.Sprintf("trade range %v-%v outside candle range %v-%v", b.low, b.high, candles[i].Low, candles[i].High),
+		})
+	}
+	return issues
+}
+
+// createRepairJobs creates a data history job to refetch candles for each
+// issue that can be resolved by overwriting stored data
+func (m *DataQualityManager) createRepairJobs(check *DataQualityCheck, report *DataQualityReport) ([]string, error) {
+	if !m.dataHistoryManager.IsRunning() {
+		return nil, fmt.Errorf("%s %w", dataHistoryManagerName, ErrSubSystemNotStarted)
+	}
+	var nicknames []string
+	var errs error
+	for i := range report.Issues {
+		if !report.Issues[i].Type.requiresRepair() {
+			continue
+		}
+		job := &DataHistoryJob{
+			Nickname: fmt.Sprintf("%s-%s-%s-%s-%s-%s-%d",
+				defaultDataQualityRepairNicknamePrefix,
+				strings.ToLower(check.Exchange),
+				check.Asset,
+				check.Pair.Lower().String(),
+				check.Interval.Short(),
+				report.CreatedDate.UTC().Format(defaultDataQualityRepairNicknameTimeFmt),
+				i),
+			Exchange:              check.Exchange,
+			Asset:                 check.Asset,
+			Pair:                  check.Pair,
+			StartDate:             report.Issues[i].StartDate,
+			EndDate:               report.Issues[i].EndDate,
+			Interval:              check.Interval,
+			RequestSizeLimit:      defaultDataQualityRepairRequestSize,
+			DataType:              dataHistoryCandleDataType,
+			Status:                dataHistoryStatusActive,
+			OverwriteExistingData: true,
+		}
+		if err := m.dataHistoryManager.UpsertJob(job, true); err != nil {
+			errs = common.AppendError(errs, fmt.Errorf("%s %w", job.Nickname, err))
+			continue
+		}
+		nicknames = append(nicknames, job.Nickname)
+	}
+	return nicknames, errs
+}
+
+// storeReport retains the report, purging the oldest when the report limit is
+// exceeded
+func (m *DataQualityManager) storeReport(report *DataQualityReport) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	m.reports = append(m.reports, report)
+	if len(m.reports) > m.maxReports {
+		m.reports = m.reports[len(m.reports)-m.maxReports:]
+	}
+}
+
+// GetReports returns stored reports matching the supplied filters. Empty
+// filters match all reports
+func (m *DataQualityManager) GetReports(exch string, a asset.Item, pair currency.Pair) ([]DataQualityReport, error) {
+	if m == nil {
+		return nil, fmt.Errorf("%s %w", DataQualityManagerName, ErrNilSubsystem)
+	}
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("%s %w", DataQualityManagerName, ErrSubSystemNotStarted)
+	}
+	m.m.RLock()
+	defer m.m.RUnlock()
+	resp := make([]DataQualityReport, 0, len(m.reports))
+	for i := len(m.reports) - 1; i >= 0; i-- {
+		if exch != "" && !strings.EqualFold(m.reports[i].Exchange, exch) {
+			continue
+		}
+		if a != asset.Empty && m.reports[i].Asset != a {
+			continue
+		}
+		if !pair.IsEmpty() && !m.reports[i].Pair.Equal(pair) {
+			continue
+		}
+		resp = append(resp, *m.reports[i])
+	}
+	return resp, nil
+}
+
+// GetReportByID returns a stored report by its ID
+func (m *DataQualityManager) GetReportByID(id uuid.UUID) (*DataQualityReport, error) {
+	if m == nil {
+		return nil, fmt.Errorf("%s %w", DataQualityManagerName, ErrNilSubsystem)
+	}
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("%s %w", DataQualityManagerName, ErrSubSystemNotStarted)
+	}
+	m.m.RLock()
+	defer m.m.RUnlock()
+	for i := range m.reports {
+		if m.reports[i].ID == id {
+			r := *m.reports[i]
+			return &r, nil
+		}
+	}
+	return nil, fmt.Errorf("%w %s", errDataQualityReportMissing, id)
+}
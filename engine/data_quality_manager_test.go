@@ -0,0 +1,289 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+type fakeDataHistoryJobUpserter struct {
+	running bool
+	jobs    []*DataHistoryJob
+}
+
+func (f *fakeDataHistoryJobUpserter) IsRunning() bool {
+	return f.running
+}
+
+func (f *fakeDataHistoryJobUpserter) UpsertJob(j *DataHistoryJob, _ bool) error {
+	f.jobs = append(f.jobs, j)
+	return nil
+}
+
+var dataQualityTestStart = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func dataQualityTestCandles(count int) []kline.Candle {
+	candles := make([]kline.Candle, count)
+	for i := range candles {
+		price := 100 + float64(i%2)
+		candles[i] = kline.Candle{
+			Time:   dataQualityTestStart.Add(time.Duration(i) * time.Hour),
+			Open:   price,
+			High:   price + 1,
+			Low:    price - 1,
+			Close:  price,
+			Volume: 10,
+		}
+	}
+	return candles
+}
+
+func setupTestDataQualityManager(t *testing.T, candles []kline.Candle) *DataQualityManager {
+	t.Helper()
+	m, err := SetupDataQualityManager(NewExchangeManager(), &fakeDataHistoryJobUpserter{running: true}, &config.DataQualityManager{})
+	require.NoError(t, err)
+	m.candleLoader = func(exch string, p currency.Pair, a asset.Item, i kline.Interval, _, _ time.Time) (*kline.Item, error) {
+		if exch != testExchange {
+			return nil, candle.ErrNoCandleDataFound
+		}
+		return &kline.Item{Exchange: exch, Pair: p, Asset: a, Interval: i, Candles: append([]kline.Candle(nil), candles...)}, nil
+	}
+	m.tradeLoader = func(string, string, string, string, time.Time, time.Time) ([]trade.Data, error) {
+		return nil, nil
+	}
+	require.NoError(t, m.Start(t.Context()))
+	t.Cleanup(func() {
+		if m.IsRunning() {
+			assert.NoError(t, m.Stop())
+		}
+	})
+	return m
+}
+
+func TestSetupDataQualityManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupDataQualityManager(nil, nil, nil)
+	require.ErrorIs(t, err, errNilExchangeManager)
+
+	_, err = SetupDataQualityManager(NewExchangeManager(), nil, nil)
+	require.ErrorIs(t, err, errNilConfig)
+
+	_, err = SetupDataQualityManager(NewExchangeManager(), nil, &config.DataQualityManager{AutoCreateRepairJobs: true})
+	require.ErrorIs(t, err, errNilDataHistoryManager)
+
+	m, err := SetupDataQualityManager(NewExchangeManager(), nil, &config.DataQualityManager{})
+	require.NoError(t, err)
+	assert.Equal(t, defaultDataQualityCheckInterval, m.checkInterval)
+	assert.Equal(t, defaultDataQualityVolatilityWindow, m.volatilityWindow)
+	assert.Equal(t, defaultDataQualityOutlierThreshold, m.outlierThreshold)
+	assert.Equal(t, defaultDataQualityDivergenceTolerance, m.divergenceTolerance)
+	assert.Equal(t, defaultDataQualityMaxReports, m.maxReports)
+}
+
+func TestDataQualityManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *DataQualityManager
+	assert.ErrorIs(t, m.Start(t.Context()), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	assert.False(t, m.IsRunning())
+
+	m, err := SetupDataQualityManager(NewExchangeManager(), nil, &config.DataQualityManager{})
+	require.NoError(t, err)
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, m.Start(t.Context()))
+	assert.True(t, m.IsRunning())
+	assert.ErrorIs(t, m.Start(t.Context()), ErrSubSystemAlreadyStarted)
+	require.NoError(t, m.Stop())
+	assert.False(t, m.IsRunning())
+}
+
+func TestDataQualityIssueTypeString(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "gap", dataQualityGap.String())
+	assert.Equal(t, "trade outside candle range", dataQualityTradeOutsideCandle.String())
+	assert.Empty(t, dataQualityIssueType(1337).String())
+}
+
+func TestFindCandleGaps(t *testing.T) {
+	t.Parallel()
+	candles := dataQualityTestCandles(10)
+	candles = append(candles[:3], candles[6:]...)
+	issues, err := findCandleGaps(candles, kline.OneHour, dataQualityTestStart, dataQualityTestStart.Add(time.Hour*10))
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.Equal(t, dataQualityGap, issues[0].Type)
+	assert.Equal(t, dataQualityTestStart.Add(time.Hour*3), issues[0].StartDate)
+	assert.Equal(t, dataQualityTestStart.Add(time.Hour*6), issues[0].EndDate)
+
+	_, err = findCandleGaps(candles, kline.OneHour, dataQualityTestStart, dataQualityTestStart)
+	assert.Error(t, err)
+}
+
+func TestFindDuplicateCandles(t *testing.T) {
+	t.Parallel()
+	candles := dataQualityTestCandles(5)
+	candles = append(candles, candles[2], candles[2])
+	issues := findDuplicateCandles(candles, kline.OneHour)
+	require.Len(t, issues, 1)
+	assert.Equal(t, dataQualityDuplicateTimestamp, issues[0].Type)
+	assert.Equal(t, candles[2].Time, issues[0].StartDate)
+}
+
+func TestFindZeroVolumeCandles(t *testing.T) {
+	t.Parallel()
+	candles := dataQualityTestCandles(5)
+	candles[4].Volume = 0
+	issues := findZeroVolumeCandles(candles, kline.OneHour)
+	require.Len(t, issues, 1)
+	assert.Equal(t, candles[4].Time, issues[0].StartDate)
+}
+
+func TestFindOHLCInconsistencies(t *testing.T) {
+	t.Parallel()
+	candles := dataQualityTestCandles(5)
+	candles[1].High = candles[1].Low - 1
+	candles[3].Close = candles[3].High + 5
+	issues := findOHLCInconsistencies(candles, kline.OneHour)
+	require.Len(t, issues, 2)
+	assert.Equal(t, candles[1].Time, issues[0].StartDate)
+	assert.Equal(t, candles[3].Time, issues[1].StartDate)
+}
+
+func TestFindCandleOutliers(t *testing.T) {
+	t.Parallel()
+	candles := dataQualityTestCandles(30)
+	assert.Empty(t, findCandleOutliers(candles, kline.OneHour, 5, 5), "alternating closes should not be outliers")
+	candles[25].Close = 1000
+	issues := findCandleOutliers(candles, kline.OneHour, 5, 5)
+	require.NotEmpty(t, issues)
+	assert.Equal(t, candles[25].Time, issues[0].StartDate)
+	assert.Nil(t, findCandleOutliers(candles[:3], kline.OneHour, 5, 5))
+}
+
+func TestFindCrossExchangeDivergence(t *testing.T) {
+	t.Parallel()
+	primary := dataQualityTestCandles(5)
+	secondary := dataQualityTestCandles(5)
+	secondary[2].Close *= 1.1
+	issues := findCrossExchangeDivergence(primary, secondary, kline.OneHour, "binance", 1)
+	require.Len(t, issues, 1)
+	assert.Equal(t, "binance", issues[0].Exchange)
+	assert.Equal(t, primary[2].Time, issues[0].StartDate)
+}
+
+func TestFindTradesOutsideCandles(t *testing.T) {
+	t.Parallel()
+	candles := dataQualityTestCandles(3)
+	trades := []trade.Data{
+		{Timestamp: candles[0].Time.Add(time.Minute), Price: candles[0].Low},
+		{Timestamp: candles[1].Time.Add(time.Minute), Price: candles[1].High + 10},
+	}
+	issues := findTradesOutsideCandles(candles, trades, kline.OneHour)
+	require.Len(t, issues, 1)
+	assert.Equal(t, candles[1].Time, issues[0].StartDate)
+}
+
+func TestTargetToCheck(t *testing.T) {
+	t.Parallel()
+	m := &DataQualityManager{autoCreateRepairJobs: true}
+	_, err := m.targetToCheck(nil, time.Now())
+	require.ErrorIs(t, err, errNilDataQualityTarget)
+
+	_, err = m.targetToCheck(&config.DataQualityTarget{}, time.Now())
+	require.ErrorIs(t, err, kline.ErrInvalidInterval)
+
+	end := dataQualityTestStart.Add(time.Minute * 30)
+	check, err := m.targetToCheck(&config.DataQualityTarget{Exchange: testExchange, Interval: kline.OneHour}, end)
+	require.NoError(t, err)
+	assert.Equal(t, dataQualityTestStart, check.EndDate)
+	assert.Equal(t, dataQualityTestStart.Add(-defaultDataQualityLookback), check.StartDate)
+	assert.True(t, check.CreateRepairJobs)
+}
+
+func TestRunCheck(t *testing.T) {
+	t.Parallel()
+	candles := dataQualityTestCandles(10)
+	candles[5].Volume = 0
+	candles = append(candles[:2], candles[3:]...)
+	m := setupTestDataQualityManager(t, candles)
+
+	check := &DataQualityCheck{
+		Exchange:           testExchange,
+		Asset:              asset.Spot,
+		Pair:               currency.NewPair(currency.BTC, currency.USD),
+		Interval:           kline.OneHour,
+		StartDate:          dataQualityTestStart,
+		EndDate:            dataQualityTestStart.Add(time.Hour * 10),
+		SecondaryExchanges: []string{"notstored"},
+		IncludeTrades:      true,
+		CreateRepairJobs:   true,
+	}
+	_, err := m.RunCheck(nil)
+	require.ErrorIs(t, err, errNilDataQualityTarget)
+
+	report, err := m.RunCheck(check)
+	require.NoError(t, err)
+	assert.Equal(t, int64(9), report.CandlesChecked)
+	require.Len(t, report.Issues, 2)
+	assert.Equal(t, dataQualityGap, report.Issues[0].Type)
+	assert.Equal(t, dataQualityZeroVolume, report.Issues[1].Type)
+	require.Len(t, report.RepairJobs, 1, "only the gap should be repaired")
+	upserter, ok := m.dataHistoryManager.(*fakeDataHistoryJobUpserter)
+	require.True(t, ok)
+	require.Len(t, upserter.jobs, 1)
+	assert.True(t, upserter.jobs[0].OverwriteExistingData)
+
+	m.candleLoader = func(string, currency.Pair, asset.Item, kline.Interval, time.Time, time.Time) (*kline.Item, error) {
+		return nil, errExpectedTestError
+	}
+	_, err = m.RunCheck(check)
+	assert.ErrorIs(t, err, errExpectedTestError)
+
+	require.NoError(t, m.Stop())
+	_, err = m.RunCheck(check)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+}
+
+func TestDataQualityManagerGetReports(t *testing.T) {
+	t.Parallel()
+	m := setupTestDataQualityManager(t, dataQualityTestCandles(5))
+	m.maxReports = 2
+	check := &DataQualityCheck{
+		Exchange:  testExchange,
+		Asset:     asset.Spot,
+		Pair:      currency.NewPair(currency.BTC, currency.USD),
+		Interval:  kline.OneHour,
+		StartDate: dataQualityTestStart,
+		EndDate:   dataQualityTestStart.Add(time.Hour * 5),
+	}
+	var last *DataQualityReport
+	for range 3 {
+		var err error
+		last, err = m.RunCheck(check)
+		require.NoError(t, err)
+	}
+	reports, err := m.GetReports("", asset.Empty, currency.EMPTYPAIR)
+	require.NoError(t, err)
+	require.Len(t, reports, 2, "oldest report should be purged")
+	assert.Equal(t, last.ID, reports[0].ID, "newest report should be returned first")
+
+	reports, err = m.GetReports("binance", asset.Empty, currency.EMPTYPAIR)
+	require.NoError(t, err)
+	assert.Empty(t, reports)
+
+	r, err := m.GetReportByID(last.ID)
+	require.NoError(t, err)
+	assert.Equal(t, last.ID, r.ID)
+
+	_, err = m.GetReportByID(uuid.Nil)
+	assert.ErrorIs(t, err, errDataQualityReportMissing)
+}
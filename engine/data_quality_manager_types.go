@@ -0,0 +1,145 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+// DataQualityManagerName is an exported subsystem name
+const DataQualityManagerName = "data_quality_manager"
+
+type dataQualityIssueType int64
+
+// Data quality issue descriptors
+const (
+	dataQualityGap dataQualityIssueType = iota
+	dataQualityDuplicateTimestamp
+	dataQualityZeroVolume
+	dataQualityOHLCInconsistency
+	dataQualityOutlier
+	dataQualityCrossExchangeDivergence
+	dataQualityTradeOutsideCandle
+)
+
+// String stringifies iotas to readable
+func (d dataQualityIssueType) String() string {
+	switch d {
+	case dataQualityGap:
+		return "gap"
+	case dataQualityDuplicateTimestamp:
+		return "duplicate timestamp"
+	case dataQualityZeroVolume:
+		return "zero volume"
+	case dataQualityOHLCInconsistency:
+		return "ohlc inconsistency"
+	case dataQualityOutlier:
+		return "outlier"
+	case dataQualityCrossExchangeDivergence:
+		return "cross exchange divergence"
+	case dataQualityTradeOutsideCandle:
+		return "trade outside candle range"
+	}
+	return ""
+}
+
+// requiresRepair determines whether the issue type can be resolved by
+// refetching candle data from the exchange
+func (d dataQualityIssueType) requiresRepair() bool {
+	return d == dataQualityGap ||
+		d == dataQualityDuplicateTimestamp ||
+		d == dataQualityOHLCInconsistency
+}
+
+var (
+	errNilDataQualityTarget     = errors.New("nil data quality target received")
+	errDataQualityReportMissing = errors.New("data quality report not found")
+	errNilDataHistoryManager    = errors.New("nil data history manager received")
+)
+
+const (
+	defaultDataQualityCheckInterval         = time.Hour
+	defaultDataQualityLookback              = time.Hour * 24
+	defaultDataQualityVolatilityWindow      = 20
+	defaultDataQualityOutlierThreshold      = 5.0
+	defaultDataQualityDivergenceTolerance   = 1.0
+	defaultDataQualityMaxReports            = 100
+	defaultDataQualityRepairRequestSize     = 500
+	defaultDataQualityRepairNicknamePrefix  = "dq-repair"
+	defaultDataQualityRepairNicknameTimeFmt = "20060102150405"
+)
+
+// iDataHistoryJobUpserter limits exposure of the data history manager to
+// the data quality manager so repair jobs can be created
+type iDataHistoryJobUpserter interface {
+	IsRunning() bool
+	UpsertJob(*DataHistoryJob, bool) error
+}
+
+// DataQualityManager scans stored candle and trade data for integrity issues
+// and produces reports which can be queried over gRPC
+type DataQualityManager struct {
+	started              atomic.Bool
+	shutdown             chan struct{}
+	wg                   sync.WaitGroup
+	exchangeManager      iExchangeManager
+	dataHistoryManager   iDataHistoryJobUpserter
+	checkInterval        time.Duration
+	targets              []config.DataQualityTarget
+	volatilityWindow     int
+	outlierThreshold     float64
+	divergenceTolerance  float64
+	maxReports           int
+	autoCreateRepairJobs bool
+	verbose              bool
+	candleLoader         func(string, currency.Pair, asset.Item, kline.Interval, time.Time, time.Time) (*kline.Item, error)
+	tradeLoader          func(string, string, string, string, time.Time, time.Time) ([]trade.Data, error)
+	m                    sync.RWMutex
+	reports              []*DataQualityReport
+}
+
+// DataQualityCheck holds the parameters used to scan a single data set
+type DataQualityCheck struct {
+	Exchange           string
+	Asset              asset.Item
+	Pair               currency.Pair
+	Interval           kline.Interval
+	StartDate          time.Time
+	EndDate            time.Time
+	SecondaryExchanges []string
+	IncludeTrades      bool
+	CreateRepairJobs   bool
+}
+
+// DataQualityReport is the outcome of a data quality check
+type DataQualityReport struct {
+	ID             uuid.UUID
+	Exchange       string
+	Asset          asset.Item
+	Pair           currency.Pair
+	Interval       kline.Interval
+	StartDate      time.Time
+	EndDate        time.Time
+	CreatedDate    time.Time
+	CandlesChecked int64
+	TradesChecked  int64
+	Issues         []DataQualityIssue
+	RepairJobs     []string
+}
+
+// DataQualityIssue is a single issue discovered by a data quality check
+type DataQualityIssue struct {
+	Type        dataQualityIssueType
+	StartDate   time.Time
+	EndDate     time.Time
+	Description string
+	Exchange    string
+}
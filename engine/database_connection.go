@@ -10,6 +10,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database"
 	dbpsql "github.com/thrasher-corp/gocryptotrader/database/drivers/postgres"
 	dbsqlite3 "github.com/thrasher-corp/gocryptotrader/database/drivers/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/changefeed"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
@@ -110,6 +111,11 @@ func (m *DatabaseConnectionManager) Start(wg *sync.WaitGroup) (err error) {
 			return fmt.Errorf("%w: %v Some features that utilise a database will be unavailable", database.ErrFailedToConnect, err)
 		}
 		m.dbConn.SetConnected(true)
+		if m.cfg.Driver == database.DBPostgreSQL {
+			if err := changefeed.StartListener(dbpsql.DSN(&m.cfg)); err != nil {
+				log.Errorf(log.DatabaseMgr, "Failed to start change feed listener: %v", err)
+			}
+		}
 		wg.Add(1)
 		m.wg.Add(1)
 		go m.run(wg)
@@ -132,6 +138,12 @@ func (m *DatabaseConnectionManager) Stop() error {
 		m.started.CompareAndSwap(true, false)
 	}()
 
+	if m.cfg.Driver == database.DBPostgreSQL {
+		if err := changefeed.StopListener(); err != nil {
+			log.Errorf(log.DatabaseMgr, "Failed to stop change feed listener: %v", err)
+		}
+	}
+
 	err := m.dbConn.CloseConnection()
 	if err != nil {
 		log.Errorf(log.DatabaseMgr, "Failed to close database: %v", err)
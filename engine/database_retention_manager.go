@@ -0,0 +1,400 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	exchangeDB "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupDatabaseRetentionManager creates a database retention manager subsystem
+func SetupDatabaseRetentionManager(em iExchangeManager, dcm iDatabaseConnectionManager, cfg *config.DatabaseRetentionManager) (*DatabaseRetentionManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if dcm == nil {
+		return nil, errNilDatabaseConnectionManager
+	}
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if cfg.CheckInterval <= 0 {
+		return nil, fmt.Errorf("%w check interval %v", errInvalidTimes, cfg.CheckInterval)
+	}
+	if cfg.BatchPeriod <= 0 {
+		return nil, fmt.Errorf("%w batch period %v", errInvalidTimes, cfg.BatchPeriod)
+	}
+	for i := range cfg.Rules {
+		if err := validateRetentionRule(&cfg.Rules[i]); err != nil {
+			return nil, fmt.Errorf("retention rule %d: %w", i, err)
+		}
+	}
+	return &DatabaseRetentionManager{
+		shutdown:        make(chan struct{}),
+		exchangeManager: em,
+		database:        dcm,
+		checkInterval:   cfg.CheckInterval,
+		batchPeriod:     cfg.BatchPeriod,
+		verbose:         cfg.Verbose,
+		rules:           cfg.Rules,
+		oldestCandle:    candle.Oldest,
+		candleLoader:    kline.LoadFromDatabase,
+		candleSaver:     kline.StoreInDatabase,
+		candleDeleter:   candle.DeleteCandles,
+		oldestTrade:     trade.Oldest,
+		tradeLoader:     trade.GetInRange,
+		tradeDeleter:    trade.DeleteTrades,
+		exchangeUUID:    exchangeDB.UUIDByName,
+		auditor:         audit.Event,
+	}, nil
+}
+
+// validateRetentionRule ensures a retention rule can be applied
+func validateRetentionRule(r *config.DatabaseRetentionRule) error {
+	if r == nil {
+		return errNilRetentionRule
+	}
+	if r.Exchange == "" {
+		return errRetentionExchangeUnset
+	}
+	if !r.Asset.IsValid() {
+		return fmt.Errorf("%w %s", asset.ErrNotSupported, r.Asset)
+	}
+	if r.RetentionPeriod < 0 {
+		return fmt.Errorf("%w retention period %v", errInvalidTimes, r.RetentionPeriod)
+	}
+	switch r.DataType {
+	case retentionDataTypeCandle:
+		if r.Interval <= 0 {
+			return kline.ErrInvalidInterval
+		}
+		if r.DownsampleInterval != 0 && (r.DownsampleInterval <= r.Interval || r.DownsampleInterval%r.Interval != 0) {
+			return fmt.Errorf("%w %s to %s", errInvalidDownsampleInterval, r.Interval, r.DownsampleInterval)
+		}
+	case retentionDataTypeTrade:
+	default:
+		return fmt.Errorf("%w %q", errUnknownRetentionDataType, r.DataType)
+	}
+	return nil
+}
+
+// Start runs the subsystem
+func (m *DatabaseRetentionManager) Start(ctx context.Context) error {
+	if m == nil {
+		return fmt.Errorf("%s %w", DatabaseRetentionManagerName, ErrNilSubsystem)
+	}
+	if !m.started.CompareAndSwap(false, true) {
+		return fmt.Errorf("%s %w", DatabaseRetentionManagerName, ErrSubSystemAlreadyStarted)
+	}
+	m.shutdown = make(chan struct{})
+	m.wg.Add(1)
+	go m.run(ctx)
+	log.Debugf(log.DatabaseMgr, "Database retention manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *DatabaseRetentionManager) IsRunning() bool {
+	if m == nil {
+		return false
+	}
+	return m.started.Load()
+}
+
+// Stop stops the subsystem
+func (m *DatabaseRetentionManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", DatabaseRetentionManagerName, ErrNilSubsystem)
+	}
+	if !m.started.CompareAndSwap(true, false) {
+		return fmt.Errorf("%s %w", DatabaseRetentionManagerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.DatabaseMgr, "Database retention manager %s", MsgSubSystemShuttingDown)
+	close(m.shutdown)
+	m.wg.Wait()
+	log.Debugf(log.DatabaseMgr, "Database retention manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// run applies all retention rules at each check interval
+func (m *DatabaseRetentionManager) run(ctx context.Context) {
+	defer m.wg.Done()
+	timer := time.NewTimer(0) // Prime firing of channel for initial check.
+	defer timer.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			if _, err := m.ApplyRules(); err != nil {
+				log.Errorf(log.DatabaseMgr, "Database retention manager: %v", err)
+			}
+			timer.Reset(m.checkInterval)
+		}
+	}
+}
+
+// ApplyRules applies every configured retention rule against the database
+// and returns the work done for each currency pair. Errors for individual
+// pairs are logged and do not prevent other pairs from being processed
+func (m *DatabaseRetentionManager) ApplyRules() ([]DatabaseRetentionResult, error) {
+	if m == nil {
+		return nil, fmt.Errorf("%s %w", DatabaseRetentionManagerName, ErrNilSubsystem)
+	}
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("%s %w", DatabaseRetentionManagerName, ErrSubSystemNotStarted)
+	}
+	if db := m.database.GetInstance(); db == nil || !db.IsConnected() {
+		return nil, database.ErrDatabaseNotConnected
+	}
+	now := time.Now().UTC()
+	var results []DatabaseRetentionResult
+	for i := range m.rules {
+		if m.rules[i].RetentionPeriod == 0 {
+			// Data is kept forever
+			continue
+		}
+		pairs, err := m.rulePairs(&m.rules[i])
+		if err != nil {
+			log.Errorf(log.DatabaseMgr, "Database retention manager cannot apply %s rule for %s %s: %v", m.rules[i].DataType, m.rules[i].Exchange, m.rules[i].Asset, err)
+			continue
+		}
+		for j := range pairs {
+			result, err := m.applyRule(&m.rules[i], pairs[j], now)
+			if err != nil {
+				log.Errorf(log.DatabaseMgr, "Database retention manager %s %s %s %s: %v", result.DataType, result.Exchange, result.Asset, result.Pair, err)
+			}
+			if result.Deleted == 0 && result.Downsampled == 0 {
+				continue
+			}
+			m.recordResult(&result)
+			results = append(results, result)
+		}
+	}
+	return results, nil
+}
+
+// rulePairs returns the configured pairs for a rule, or the exchange's enabled
+// pairs when none are configured
+func (m *DatabaseRetentionManager) rulePairs(r *config.DatabaseRetentionRule) (currency.Pairs, error) {
+	if len(r.Pairs) > 0 {
+		return r.Pairs, nil
+	}
+	exch, err := m.exchangeManager.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	return exch.GetEnabledPairs(r.Asset)
+}
+
+// applyRule removes expired data for a single currency pair
+func (m *DatabaseRetentionManager) applyRule(r *config.DatabaseRetentionRule, pair currency.Pair, now time.Time) (DatabaseRetentionResult, error) {
+	result := DatabaseRetentionResult{
+		DataType:           r.DataType,
+		Exchange:           strings.ToLower(r.Exchange),
+		Asset:              r.Asset,
+		Pair:               pair,
+		Interval:           r.Interval,
+		DownsampleInterval: r.DownsampleInterval,
+	}
+	if r.DataType == retentionDataTypeTrade {
+		result.Cutoff = now.Add(-r.RetentionPeriod).Truncate(time.Second)
+		return result, m.pruneTrades(&result)
+	}
+	// Only whole intervals are removed so a downsampled candle is never built
+	// from a partial set of candles
+	step := r.Interval.Duration()
+	if r.DownsampleInterval > 0 {
+		step = r.DownsampleInterval.Duration()
+	}
+	result.Cutoff = now.Add(-r.RetentionPeriod).Truncate(step)
+	return result, m.pruneCandles(&result, step)
+}
+
+// batchWindow returns the batch period rounded down to a whole multiple of
+// the step, but never less than a single step
+func (m *DatabaseRetentionManager) batchWindow(step time.Duration) time.Duration {
+	if m.batchPeriod <= step {
+		return step
+	}
+	return m.batchPeriod - m.batchPeriod%step
+}
+
+// pruneCandles downsamples, if required, and deletes candles older than the
+// result cutoff in batches
+func (m *DatabaseRetentionManager) pruneCandles(result *DatabaseRetentionResult, step time.Duration) error {
+	seconds := int64(result.Interval.Duration().Seconds())
+	oldest, err := m.oldestCandle(result.Exchange, result.Pair.Base.String(), result.Pair.Quote.String(), seconds, result.Asset.String())
+	if err != nil {
+		if errors.Is(err, candle.ErrNoCandleDataFound) || errors.Is(err, exchangeDB.ErrNoExchangeFound) {
+			return nil
+		}
+		return err
+	}
+	exchangeID, err := m.exchangeUUID(result.Exchange)
+	if err != nil {
+		return err
+	}
+	window := m.batchWindow(step)
+	for start := oldest.Truncate(step); start.Before(result.Cutoff); start = start.Add(window) {
+		end := start.Add(window)
+		if end.After(result.Cutoff) {
+			end = result.Cutoff
+		}
+		// Range queries are inclusive so the final candle is excluded to
+		// prevent it being processed in two batches
+		item, err := m.candleLoader(result.Exchange, result.Pair, result.Asset, result.Interval, start, end.Add(-time.Nanosecond))
+		if err != nil {
+			if errors.Is(err, candle.ErrNoCandleDataFound) {
+				continue
+			}
+			return err
+		}
+		if len(item.Candles) == 0 {
+			continue
+		}
+		if result.DownsampleInterval > 0 {
+			inserted, err := m.downsample(item, result.DownsampleInterval, start, end)
+			if err != nil {
+				return err
+			}
+			result.Downsampled += inserted
+		}
+		deleted, err := m.candleDeleter(&candle.Item{
+			ExchangeID: exchangeID.String(),
+			Base:       result.Pair.Base.Upper().String(),
+			Quote:      result.Pair.Quote.Upper().String(),
+			Interval:   seconds,
+			Asset:      result.Asset.String(),
+			Candles: []candle.Candle{
+				{Timestamp: item.Candles[0].Time},
+				{Timestamp: item.Candles[len(item.Candles)-1].Time},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		result.Deleted += deleted
+	}
+	return nil
+}
+
+// downsample converts candles within an interval aligned window to the coarser
+// interval and stores any that do not already exist
+func (m *DatabaseRetentionManager) downsample(item *kline.Item, newInterval kline.Interval, start, end time.Time) (uint64, error) {
+	converted, err := padRetentionCandles(item, start, end).ConvertToNewInterval(newInterval)
+	if err != nil {
+		return 0, err
+	}
+	existing, err := m.candleLoader(item.Exchange, item.Pair, item.Asset, newInterval, start, end.Add(-time.Nanosecond))
+	if err != nil && !errors.Is(err, candle.ErrNoCandleDataFound) {
+		return 0, err
+	}
+	stored := make(map[int64]struct{})
+	if existing != nil {
+		for i := range existing.Candles {
+			stored[existing.Candles[i].Time.Unix()] = struct{}{}
+		}
+	}
+	candles := make([]kline.Candle, 0, len(converted.Candles))
+	for i := range converted.Candles {
+		if converted.Candles[i].Time.IsZero() {
+			// No underlying candle data for this period
+			continue
+		}
+		converted.Candles[i].Time = converted.Candles[i].Time.Truncate(newInterval.Duration())
+		if _, ok := stored[converted.Candles[i].Time.Unix()]; ok {
+			continue
+		}
+		candles = append(candles, converted.Candles[i])
+	}
+	if len(candles) == 0 {
+		return 0, nil
+	}
+	converted.Candles = candles
+	return m.candleSaver(converted, false)
+}
+
+// padRetentionCandles returns a copy of the item with zero value candles
+// inserted for every missing interval between start and the exclusive end
+func padRetentionCandles(item *kline.Item, start, end time.Time) *kline.Item {
+	existing := make(map[int64]kline.Candle, len(item.Candles))
+	for i := range item.Candles {
+		existing[item.Candles[i].Time.Unix()] = item.Candles[i]
+	}
+	padded := *item
+	padded.Candles = make([]kline.Candle, 0, int(end.Sub(start)/item.Interval.Duration()))
+	for t := start; t.Before(end); t = t.Add(item.Interval.Duration()) {
+		c, ok := existing[t.Unix()]
+		if !ok {
+			c = kline.Candle{Time: t}
+		}
+		padded.Candles = append(padded.Candles, c)
+	}
+	return &padded
+}
+
+// pruneTrades deletes trades older than the result cutoff in batches
+func (m *DatabaseRetentionManager) pruneTrades(result *DatabaseRetentionResult) error {
+	oldest, err := m.oldestTrade(result.Exchange, result.Asset.String(), result.Pair.Base.String(), result.Pair.Quote.String())
+	if err != nil {
+		if errors.Is(err, trade.ErrNoTradesFound) || errors.Is(err, exchangeDB.ErrNoExchangeFound) {
+			return nil
+		}
+		return err
+	}
+	for start := oldest.Truncate(time.Second); start.Before(result.Cutoff); start = start.Add(m.batchPeriod) {
+		end := start.Add(m.batchPeriod)
+		if end.After(result.Cutoff) {
+			end = result.Cutoff
+		}
+		trades, err := m.tradeLoader(result.Exchange, result.Asset.String(), result.Pair.Base.String(), result.Pair.Quote.String(), start, end.Add(-time.Nanosecond))
+		if err != nil {
+			return err
+		}
+		for len(trades) > 0 {
+			batch := trades[:min(len(trades), defaultRetentionDeleteBatchSize)]
+			if err := m.tradeDeleter(batch...); err != nil {
+				return err
+			}
+			result.Deleted += int64(len(batch))
+			trades = trades[len(batch):]
+		}
+	}
+	return nil
+}
+
+// recordResult logs the work done by a retention rule and records it in the
+// audit repository
+func (m *DatabaseRetentionManager) recordResult(result *DatabaseRetentionResult) {
+	series := fmt.Sprintf("%s %s %s", result.Exchange, result.Asset, result.Pair)
+	if result.DataType == retentionDataTypeCandle {
+		series += " " + result.Interval.Word()
+	}
+	msg := fmt.Sprintf("%s: deleted %d %s records older than %s",
+		series,
+		result.Deleted,
+		result.DataType,
+		result.Cutoff.Format(time.DateTime))
+	if result.DownsampleInterval > 0 {
+		msg += fmt.Sprintf(", stored %d %s candles", result.Downsampled, result.DownsampleInterval.Word())
+	}
+	if m.verbose {
+		log.Infof(log.DatabaseMgr, "Database retention manager %s", msg)
+	}
+	m.auditor(DatabaseRetentionManagerName, retentionAuditEventType, msg)
+}
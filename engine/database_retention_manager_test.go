@@ -0,0 +1,230 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+func retentionTestConfig(rules ...config.DatabaseRetentionRule) *config.DatabaseRetentionManager {
+	return &config.DatabaseRetentionManager{
+		CheckInterval: time.Hour,
+		BatchPeriod:   time.Hour,
+		Rules:         rules,
+	}
+}
+
+func setupTestDatabaseRetentionManager(t *testing.T, rules ...config.DatabaseRetentionRule) *DatabaseRetentionManager {
+	t.Helper()
+	m, err := SetupDatabaseRetentionManager(NewExchangeManager(), &fakeDatabaseConnectionManager{instance: &connectedDatabase{}}, retentionTestConfig(rules...))
+	require.NoError(t, err)
+	m.exchangeUUID = func(string) (uuid.UUID, error) { return uuid.Must(uuid.NewV4()), nil }
+	m.auditor = func(string, string, string) {}
+	m.started.Store(true)
+	return m
+}
+
+func TestSetupDatabaseRetentionManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupDatabaseRetentionManager(nil, nil, nil)
+	assert.ErrorIs(t, err, errNilExchangeManager)
+
+	_, err = SetupDatabaseRetentionManager(NewExchangeManager(), nil, nil)
+	assert.ErrorIs(t, err, errNilDatabaseConnectionManager)
+
+	_, err = SetupDatabaseRetentionManager(NewExchangeManager(), &fakeDatabaseConnectionManager{}, nil)
+	assert.ErrorIs(t, err, errNilConfig)
+
+	_, err = SetupDatabaseRetentionManager(NewExchangeManager(), &fakeDatabaseConnectionManager{}, &config.DatabaseRetentionManager{})
+	assert.ErrorIs(t, err, errInvalidTimes)
+
+	_, err = SetupDatabaseRetentionManager(NewExchangeManager(), &fakeDatabaseConnectionManager{}, retentionTestConfig(config.DatabaseRetentionRule{DataType: "orderbook", Exchange: testExchange, Asset: asset.Spot}))
+	assert.ErrorIs(t, err, errUnknownRetentionDataType)
+
+	m, err := SetupDatabaseRetentionManager(NewExchangeManager(), &fakeDatabaseConnectionManager{}, retentionTestConfig())
+	require.NoError(t, err)
+	assert.NotNil(t, m)
+}
+
+func TestValidateRetentionRule(t *testing.T) {
+	t.Parallel()
+	assert.ErrorIs(t, validateRetentionRule(nil), errNilRetentionRule)
+	assert.ErrorIs(t, validateRetentionRule(&config.DatabaseRetentionRule{}), errRetentionExchangeUnset)
+	assert.ErrorIs(t, validateRetentionRule(&config.DatabaseRetentionRule{Exchange: testExchange}), asset.ErrNotSupported)
+	assert.ErrorIs(t, validateRetentionRule(&config.DatabaseRetentionRule{Exchange: testExchange, Asset: asset.Spot, RetentionPeriod: -1}), errInvalidTimes)
+	assert.ErrorIs(t, validateRetentionRule(&config.DatabaseRetentionRule{Exchange: testExchange, Asset: asset.Spot}), errUnknownRetentionDataType)
+	assert.ErrorIs(t, validateRetentionRule(&config.DatabaseRetentionRule{DataType: retentionDataTypeCandle, Exchange: testExchange, Asset: asset.Spot}), kline.ErrInvalidInterval)
+	assert.ErrorIs(t, validateRetentionRule(&config.DatabaseRetentionRule{DataType: retentionDataTypeCandle, Exchange: testExchange, Asset: asset.Spot, Interval: kline.OneHour, DownsampleInterval: kline.OneMin}), errInvalidDownsampleInterval)
+	assert.ErrorIs(t, validateRetentionRule(&config.DatabaseRetentionRule{DataType: retentionDataTypeCandle, Exchange: testExchange, Asset: asset.Spot, Interval: kline.FifteenMin, DownsampleInterval: kline.Interval(time.Minute * 40)}), errInvalidDownsampleInterval)
+	assert.NoError(t, validateRetentionRule(&config.DatabaseRetentionRule{DataType: retentionDataTypeCandle, Exchange: testExchange, Asset: asset.Spot, Interval: kline.OneMin, DownsampleInterval: kline.OneHour}))
+	assert.NoError(t, validateRetentionRule(&config.DatabaseRetentionRule{DataType: retentionDataTypeTrade, Exchange: testExchange, Asset: asset.Spot}))
+}
+
+func TestDatabaseRetentionManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *DatabaseRetentionManager
+	assert.ErrorIs(t, m.Start(t.Context()), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	assert.False(t, m.IsRunning())
+
+	m, err := SetupDatabaseRetentionManager(NewExchangeManager(), &fakeDatabaseConnectionManager{}, retentionTestConfig())
+	require.NoError(t, err)
+	require.NoError(t, m.Start(t.Context()))
+	assert.True(t, m.IsRunning())
+	assert.ErrorIs(t, m.Start(t.Context()), ErrSubSystemAlreadyStarted)
+	require.NoError(t, m.Stop())
+	assert.False(t, m.IsRunning())
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+}
+
+func TestApplyRulesCandles(t *testing.T) {
+	t.Parallel()
+	pair := currency.NewPair(currency.BTC, currency.USDT)
+	m := setupTestDatabaseRetentionManager(t,
+		config.DatabaseRetentionRule{DataType: retentionDataTypeCandle, Exchange: testExchange, Asset: asset.Spot, Pairs: currency.Pairs{pair}, Interval: kline.OneMin, RetentionPeriod: time.Hour, DownsampleInterval: kline.OneHour},
+		config.DatabaseRetentionRule{DataType: retentionDataTypeCandle, Exchange: testExchange, Asset: asset.Spot, Pairs: currency.Pairs{pair}, Interval: kline.OneHour},
+	)
+	oldest := time.Now().UTC().Truncate(time.Hour).Add(-time.Hour * 3)
+	m.oldestCandle = func(_, _, _ string, interval int64, _ string) (time.Time, error) {
+		assert.Equal(t, int64(60), interval, "only the 1m rule should be applied as the 1h rule keeps data forever")
+		return oldest.Add(time.Minute * 5), nil
+	}
+	m.candleLoader = func(exch string, p currency.Pair, a asset.Item, interval kline.Interval, start, end time.Time) (*kline.Item, error) {
+		if interval == kline.OneHour {
+			if start.Equal(oldest.Add(time.Hour)) {
+				// An existing hourly candle must not be overwritten
+				return &kline.Item{Exchange: exch, Pair: p, Asset: a, Interval: interval, Candles: []kline.Candle{{Time: start}}}, nil
+			}
+			return nil, candle.ErrNoCandleDataFound
+		}
+		assert.True(t, end.Before(start.Add(time.Hour)), "candle batches should exclude the end of the window")
+		item := &kline.Item{Exchange: exch, Pair: p, Asset: a, Interval: interval}
+		// Every second minute is missing to ensure padding is applied
+		for x := start; x.Before(start.Add(time.Hour)); x = x.Add(time.Minute * 2) {
+			item.Candles = append(item.Candles, kline.Candle{Time: x, Open: 1, High: 2, Low: 0.5, Close: 1.5, Volume: 1})
+		}
+		return item, nil
+	}
+	var saved []*kline.Item
+	m.candleSaver = func(k *kline.Item, force bool) (uint64, error) {
+		assert.False(t, force, "downsampled candles should not overwrite existing candles")
+		saved = append(saved, k)
+		return uint64(len(k.Candles)), nil
+	}
+	var deleted []*candle.Item
+	m.candleDeleter = func(c *candle.Item) (int64, error) {
+		deleted = append(deleted, c)
+		return 30, nil
+	}
+	var audits []string
+	m.auditor = func(id, msgType, msg string) {
+		assert.Equal(t, DatabaseRetentionManagerName, id)
+		assert.Equal(t, retentionAuditEventType, msgType)
+		audits = append(audits, msg)
+	}
+
+	results, err := m.ApplyRules()
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, int64(60), results[0].Deleted)
+	assert.Equal(t, uint64(1), results[0].Downsampled)
+	assert.Equal(t, oldest.Add(time.Hour*2), results[0].Cutoff)
+	require.Len(t, deleted, 2)
+	assert.Equal(t, oldest, deleted[0].Candles[0].Timestamp)
+	assert.Equal(t, oldest.Add(time.Minute*58), deleted[0].Candles[1].Timestamp)
+	assert.Equal(t, int64(60), deleted[0].Interval)
+	require.Len(t, saved, 1)
+	require.Len(t, saved[0].Candles, 1)
+	assert.Equal(t, kline.OneHour, saved[0].Interval)
+	assert.Equal(t, oldest, saved[0].Candles[0].Time)
+	assert.Equal(t, 1.0, saved[0].Candles[0].Open)
+	assert.Equal(t, 1.5, saved[0].Candles[0].Close)
+	assert.Equal(t, 30.0, saved[0].Candles[0].Volume)
+	require.Len(t, audits, 1)
+	assert.Contains(t, audits[0], "deleted 60 candle records")
+}
+
+func TestApplyRulesTrades(t *testing.T) {
+	t.Parallel()
+	pair := currency.NewPair(currency.BTC, currency.USD)
+	m := setupTestDatabaseRetentionManager(t,
+		config.DatabaseRetentionRule{DataType: retentionDataTypeTrade, Exchange: testExchange, Asset: asset.Spot, Pairs: currency.Pairs{pair}, RetentionPeriod: time.Hour * 24 * 30},
+	)
+	m.oldestTrade = func(string, string, string, string) (time.Time, error) {
+		return time.Now().Add(-time.Hour * 24 * 31), nil
+	}
+	var loads int
+	m.tradeLoader = func(_, _, _, _ string, _, _ time.Time) ([]trade.Data, error) {
+		loads++
+		if loads > 1 {
+			return nil, nil
+		}
+		return make([]trade.Data, defaultRetentionDeleteBatchSize+1), nil
+	}
+	var batches []int
+	m.tradeDeleter = func(trades ...trade.Data) error {
+		batches = append(batches, len(trades))
+		return nil
+	}
+
+	results, err := m.ApplyRules()
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, int64(defaultRetentionDeleteBatchSize+1), results[0].Deleted)
+	assert.Equal(t, []int{defaultRetentionDeleteBatchSize, 1}, batches)
+	assert.Equal(t, 24, loads, "a day of expired trades should be loaded in hourly batches")
+
+	m.oldestTrade = func(string, string, string, string) (time.Time, error) {
+		return time.Time{}, trade.ErrNoTradesFound
+	}
+	results, err = m.ApplyRules()
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+func TestApplyRulesErrors(t *testing.T) {
+	t.Parallel()
+	var m *DatabaseRetentionManager
+	_, err := m.ApplyRules()
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	m = setupTestDatabaseRetentionManager(t)
+	m.started.Store(false)
+	_, err = m.ApplyRules()
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	m.started.Store(true)
+	m.database = &fakeDatabaseConnectionManager{instance: &dataBaseConnection{}}
+	_, err = m.ApplyRules()
+	assert.ErrorIs(t, err, database.ErrDatabaseNotConnected)
+}
+
+func TestPadRetentionCandles(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	item := &kline.Item{Interval: kline.OneMin, Candles: []kline.Candle{{Time: start.Add(time.Minute), Close: 1}}}
+	padded := padRetentionCandles(item, start, start.Add(time.Minute*3))
+	require.Len(t, padded.Candles, 3)
+	assert.Equal(t, start, padded.Candles[0].Time)
+	assert.Zero(t, padded.Candles[0].Close)
+	assert.Equal(t, 1.0, padded.Candles[1].Close)
+	assert.Equal(t, start.Add(time.Minute*2), padded.Candles[2].Time)
+	assert.Len(t, item.Candles, 1, "original item should not be modified")
+}
+
+func TestRetentionBatchWindow(t *testing.T) {
+	t.Parallel()
+	m := &DatabaseRetentionManager{batchPeriod: time.Hour * 25}
+	assert.Equal(t, time.Hour*24, m.batchWindow(time.Hour*12))
+	assert.Equal(t, time.Hour*24*7, m.batchWindow(time.Hour*24*7))
+}
@@ -0,0 +1,76 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+// DatabaseRetentionManagerName is an exported subsystem name
+const DatabaseRetentionManagerName = "database_retention_manager"
+
+// Retention rule data types
+const (
+	retentionDataTypeCandle = "candle"
+	retentionDataTypeTrade  = "trade"
+)
+
+const (
+	defaultRetentionDeleteBatchSize = 500
+	retentionAuditEventType         = "retention"
+)
+
+var (
+	errNilRetentionRule          = errors.New("nil retention rule received")
+	errUnknownRetentionDataType  = errors.New("unknown retention data type")
+	errRetentionExchangeUnset    = errors.New("retention rule exchange unset")
+	errInvalidDownsampleInterval = errors.New("downsample interval must be a multiple of and greater than the rule interval")
+)
+
+// DatabaseRetentionManager periodically prunes stored candle and trade data
+// which has exceeded its configured retention period. Candles can be
+// downsampled to a coarser interval before they are removed
+type DatabaseRetentionManager struct {
+	started         atomic.Bool
+	shutdown        chan struct{}
+	wg              sync.WaitGroup
+	exchangeManager iExchangeManager
+	database        iDatabaseConnectionManager
+	checkInterval   time.Duration
+	batchPeriod     time.Duration
+	verbose         bool
+	rules           []config.DatabaseRetentionRule
+
+	oldestCandle  func(exchangeName, base, quote string, interval int64, asset string) (time.Time, error)
+	candleLoader  func(string, currency.Pair, asset.Item, kline.Interval, time.Time, time.Time) (*kline.Item, error)
+	candleSaver   func(*kline.Item, bool) (uint64, error)
+	candleDeleter func(*candle.Item) (int64, error)
+	oldestTrade   func(exchangeName, assetType, base, quote string) (time.Time, error)
+	tradeLoader   func(exchangeName, assetType, base, quote string, start, end time.Time) ([]trade.Data, error)
+	tradeDeleter  func(...trade.Data) error
+	exchangeUUID  func(string) (uuid.UUID, error)
+	auditor       func(id, msgtype, message string)
+}
+
+// DatabaseRetentionResult summarises the data removed and created when a
+// retention rule is applied to a single currency pair
+type DatabaseRetentionResult struct {
+	DataType           string
+	Exchange           string
+	Asset              asset.Item
+	Pair               currency.Pair
+	Interval           kline.Interval
+	DownsampleInterval kline.Interval
+	Cutoff             time.Time
+	Deleted            int64
+	Downsampled        uint64
+}
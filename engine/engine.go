@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/tracing"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
@@ -22,6 +23,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/alert"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request/sharedlimit"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	gctlog "github.com/thrasher-corp/gocryptotrader/log"
@@ -47,6 +49,13 @@ type Engine struct {
 	WebsocketRoutineManager  *WebsocketRoutineManager
 	WithdrawManager          *WithdrawManager
 	dataHistoryManager       *DataHistoryManager
+	dataQualityManager       *DataQualityManager
+	compositePriceManager    *CompositePriceManager
+	databaseRetentionManager *DatabaseRetentionManager
+	metricsManager           *MetricsManager
+	healthManager            *HealthManager
+	tracingShutdown          tracing.Shutdown
+	sharedRateLimiter        *sharedlimit.SQLiteStore
 	currencyStateManager     *CurrencyStateManager
 	Settings                 Settings
 	uptime                   time.Time
@@ -55,6 +64,7 @@ type Engine struct {
 	runtimeCancel            context.CancelFunc
 	runtimeShutdownRequested bool
 	runtimeMu                sync.RWMutex
+	configReloadMtx          sync.Mutex
 	ServicesWG               sync.WaitGroup
 }
 
@@ -178,6 +188,11 @@ func validateSettings(b *Engine, s *Settings, flagSet FlagSet) {
 	flagSet.WithBool("openexchangerates", &b.Settings.EnableOpenExchangeRates, b.Config.Currency.ForexProviders.IsEnabled("openexchangerates"))
 
 	flagSet.WithBool("datahistorymanager", &b.Settings.EnableDataHistoryManager, b.Config.DataHistoryManager.Enabled)
+	flagSet.WithBool("dataqualitymanager", &b.Settings.EnableDataQualityManager, b.Config.DataQualityManager.Enabled)
+	flagSet.WithBool("compositepricemanager", &b.Settings.EnableCompositePriceManager, b.Config.CompositePriceManager.Enabled)
+	flagSet.WithBool("databaseretentionmanager", &b.Settings.EnableDatabaseRetention, b.Config.DatabaseRetention.Enabled)
+	flagSet.WithBool("metricsmanager", &b.Settings.EnableMetricsManager, b.Config.Metrics.Enabled)
+	flagSet.WithBool("healthmanager", &b.Settings.EnableHealthManager, b.Config.Health.Enabled)
 	flagSet.WithBool("currencystatemanager", &b.Settings.EnableCurrencyStateManager, b.Config.CurrencyStateManager.Enabled != nil && *b.Config.CurrencyStateManager.Enabled)
 	flagSet.WithBool("gctscriptmanager", &b.Settings.EnableGCTScriptManager, b.Config.GCTScript.Enabled)
 
@@ -320,6 +335,25 @@ func (bot *Engine) Start() error {
 		}
 	}
 
+	if bot.Config.Tracing.Enabled {
+		if shutdown, err := tracing.Setup(runtimeCtx, &bot.Config.Tracing); err != nil {
+			gctlog.Errorf(gctlog.Global, "Failed to setup tracing: %v", err)
+		} else {
+			bot.tracingShutdown = shutdown
+		}
+	}
+
+	if bot.Settings.EnableMetricsManager {
+		if m, err := SetupMetricsManager(&bot.Config.Metrics, bot); err != nil {
+			gctlog.Errorf(gctlog.Global, "Metrics manager unable to setup: %v", err)
+		} else {
+			bot.metricsManager = m
+			if err := bot.metricsManager.Start(runtimeCtx); err != nil {
+				gctlog.Errorf(gctlog.Global, "Metrics manager unable to start: %v", err)
+			}
+		}
+	}
+
 	if bot.Settings.EnableDatabaseManager {
 		if d, err := SetupDatabaseConnectionManager(&bot.Config.Database); err != nil {
 			gctlog.Errorf(gctlog.Global, "Database manager unable to setup: %v", err)
@@ -392,6 +426,20 @@ func (bot *Engine) Start() error {
 		bot.Config.PurgeExchangeAPICredentials()
 	}
 
+	if bot.Config.SharedRateLimiter.Enabled {
+		path := bot.Config.SharedRateLimiter.Path
+		if path == "" {
+			path = filepath.Join(bot.Settings.DataDir, sharedRateLimiterFile)
+		}
+		if s, err := sharedlimit.NewSQLiteStore(path); err != nil {
+			gctlog.Errorf(gctlog.Global, "Shared rate limiter unable to setup: %v", err)
+		} else {
+			gctlog.Debugf(gctlog.Global, "Using shared rate limiter: %s\n", path)
+			bot.sharedRateLimiter = s
+			request.SetupGlobalSharedLimiter(s)
+		}
+	}
+
 	gctlog.Debugln(gctlog.Global, "Setting up exchanges..")
 	if err := bot.SetupExchanges(); err != nil {
 		return err
@@ -453,7 +501,40 @@ func (bot *Engine) Start() error {
 		}
 	}
 
-	if w, err := SetupWithdrawManager(bot.ExchangeManager, bot.portfolioManager, bot.Settings.EnableDryRun); err != nil {
+	if bot.Settings.EnableDataQualityManager {
+		if d, err := SetupDataQualityManager(bot.ExchangeManager, bot.dataHistoryManager, &bot.Config.DataQualityManager); err != nil {
+			gctlog.Errorf(gctlog.Global, "data quality manager unable to setup: %s", err)
+		} else {
+			bot.dataQualityManager = d
+			if err := bot.dataQualityManager.Start(runtimeCtx); err != nil {
+				gctlog.Errorf(gctlog.Global, "data quality manager unable to start: %s", err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableCompositePriceManager {
+		if c, err := SetupCompositePriceManager(bot.ExchangeManager, bot.DatabaseManager, &bot.Config.CompositePriceManager); err != nil {
+			gctlog.Errorf(gctlog.Global, "composite price manager unable to setup: %s", err)
+		} else {
+			bot.compositePriceManager = c
+			if err := bot.compositePriceManager.Start(runtimeCtx); err != nil {
+				gctlog.Errorf(gctlog.Global, "composite price manager unable to start: %s", err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableDatabaseRetention {
+		if d, err := SetupDatabaseRetentionManager(bot.ExchangeManager, bot.DatabaseManager, &bot.Config.DatabaseRetention); err != nil {
+			gctlog.Errorf(gctlog.Global, "database retention manager unable to setup: %s", err)
+		} else {
+			bot.databaseRetentionManager = d
+			if err := bot.databaseRetentionManager.Start(runtimeCtx); err != nil {
+				gctlog.Errorf(gctlog.Global, "database retention manager unable to start: %s", err)
+			}
+		}
+	}
+
+	if w, err := SetupWithdrawManager(bot.ExchangeManager, bot.portfolioManager, bot.DatabaseManager, bot.Settings.EnableDryRun, &bot.Config.WithdrawalPolicy); err != nil {
 		return err
 	} else { //nolint:revive // TODO: revive false positive, see https://github.com/mgechev/revive/pull/832 for more information
 		bot.WithdrawManager = w
@@ -573,6 +654,17 @@ func (bot *Engine) Start() error {
 		}
 	}
 
+	if bot.Settings.EnableHealthManager {
+		if h, err := SetupHealthManager(&bot.Config.Health, bot); err != nil {
+			gctlog.Errorf(gctlog.Global, "Health manager unable to setup: %v", err)
+		} else {
+			bot.healthManager = h
+			if err := bot.healthManager.Start(runtimeCtx); err != nil {
+				gctlog.Errorf(gctlog.Global, "Health manager unable to start: %v", err)
+			}
+		}
+	}
+
 	startSuccessful = true
 	return nil
 }
@@ -684,6 +776,12 @@ func (bot *Engine) Stop() {
 	bot.cancelRuntimeContext()
 	bot.clearRuntimeContext()
 
+	if bot.healthManager.IsRunning() {
+		if err := bot.healthManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Health manager unable to stop. Error: %v", err)
+		}
+	}
+
 	if bot.portfolioManager != nil && len(bot.portfolioManager.GetAddresses()) != 0 {
 		bot.Config.Portfolio = bot.portfolioManager.GetPortfolio()
 	}
@@ -723,6 +821,21 @@ func (bot *Engine) Stop() {
 			gctlog.Errorf(gctlog.Global, "Connection manager unable to stop. Error: %v", err)
 		}
 	}
+	if bot.databaseRetentionManager.IsRunning() {
+		if err := bot.databaseRetentionManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.DatabaseMgr, "database retention manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.compositePriceManager.IsRunning() {
+		if err := bot.compositePriceManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "composite price manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.dataQualityManager.IsRunning() {
+		if err := bot.dataQualityManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.DataHistory, "data quality manager unable to stop. Error: %v", err)
+		}
+	}
 	if bot.dataHistoryManager.IsRunning() {
 		if err := bot.dataHistoryManager.Stop(); err != nil {
 			gctlog.Errorf(gctlog.DataHistory, "data history manager unable to stop. Error: %v", err)
@@ -755,6 +868,27 @@ func (bot *Engine) Stop() {
 	if err != nil {
 		gctlog.Errorf(gctlog.Global, "Exchange manager unable to stop. Error: %v", err)
 	}
+	if bot.sharedRateLimiter != nil {
+		request.SetupGlobalSharedLimiter(nil)
+		if err := bot.sharedRateLimiter.Close(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Shared rate limiter unable to close. Error: %v", err)
+		}
+		bot.sharedRateLimiter = nil
+	}
+	if bot.metricsManager.IsRunning() {
+		if err := bot.metricsManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Metrics manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.tracingShutdown != nil {
+		// Flush spans recorded during shutdown
+		ctx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+		if err := bot.tracingShutdown(ctx); err != nil {
+			gctlog.Errorf(gctlog.Global, "Tracing unable to shutdown. Error: %v", err)
+		}
+		cancel()
+		bot.tracingShutdown = nil
+	}
 
 	err = currency.ShutdownStorageUpdater()
 	if err != nil {
@@ -37,6 +37,11 @@ type CoreSettings struct {
 	EnableCoinmarketcapAnalysis bool
 	EnablePortfolioManager      bool
 	EnableDataHistoryManager    bool
+	EnableDataQualityManager    bool
+	EnableCompositePriceManager bool
+	EnableDatabaseRetention     bool
+	EnableMetricsManager        bool
+	EnableHealthManager         bool
 	PortfolioManagerDelay       time.Duration
 	EnableGRPC                  bool
 	EnableGRPCProxy             bool
@@ -128,3 +133,11 @@ const (
 // as engine modifies global files, this protects the main bot creation
 // functions from interfering with each other
 var newEngineMutex sync.Mutex
+
+// tracingShutdownTimeout limits how long the engine waits for pending spans to
+// be exported on shutdown
+const tracingShutdownTimeout = 5 * time.Second
+
+// sharedRateLimiterFile is the default shared rate limit store filename within
+// the data directory
+const sharedRateLimiterFile = "ratelimit.db"
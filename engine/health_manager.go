@@ -0,0 +1,299 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupHealthManager creates a health manager subsystem. Subsystem health is
+// collected from the supplied engine when a report is requested, a nil engine
+// only reports liveness and readiness
+func SetupHealthManager(cfg *config.HealthConfig, bot *Engine) (*HealthManager, error) {
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if cfg.ListenAddress == "" {
+		return nil, errHealthListenAddressUnset
+	}
+	if cfg.SyncStaleThreshold <= 0 || cfg.OrderPollStaleThreshold <= 0 {
+		return nil, errHealthThresholdUnset
+	}
+	m := &HealthManager{
+		listenAddress:           cfg.ListenAddress,
+		syncStaleThreshold:      cfg.SyncStaleThreshold,
+		orderPollStaleThreshold: cfg.OrderPollStaleThreshold,
+		waitForSync:             func() error { return nil },
+	}
+	if bot == nil {
+		return m, nil
+	}
+	m.subsystems = bot.GetSubsystemsStatus
+	m.websockets = func() []WebsocketHealth {
+		return websocketHealth(bot.ExchangeManager)
+	}
+	m.syncStatus = func() []SyncItemStatus {
+		if !bot.currencyPairSyncer.IsRunning() {
+			return nil
+		}
+		return bot.currencyPairSyncer.SyncStatus()
+	}
+	m.waitForSync = func() error {
+		if !bot.currencyPairSyncer.IsRunning() {
+			return nil
+		}
+		return bot.currencyPairSyncer.WaitForInitialSync()
+	}
+	m.database = func() *DatabaseHealth {
+		if !bot.DatabaseManager.IsRunning() {
+			return nil
+		}
+		connected := bot.DatabaseManager.IsConnected()
+		return &DatabaseHealth{Connected: connected, Healthy: connected}
+	}
+	m.ntp = func() *NTPHealth {
+		drift, checked, err := bot.ntpManager.GetDrift()
+		if err != nil {
+			return nil
+		}
+		return &NTPHealth{
+			DriftSeconds: drift.Seconds(),
+			LastChecked:  checked,
+			Healthy:      !checked.IsZero() && bot.ntpManager.isDriftAllowed(drift),
+		}
+	}
+	m.internet = func() *InternetHealth {
+		if !bot.connectionManager.IsRunning() {
+			return nil
+		}
+		online := bot.connectionManager.IsOnline()
+		return &InternetHealth{Online: online, Healthy: online}
+	}
+	m.lastPoll = func() (time.Time, bool) {
+		return bot.OrderManager.LastPoll(), bot.OrderManager.IsRunning()
+	}
+	return m, nil
+}
+
+// Start serves the health endpoints on the configured listen address and waits
+// for the initial currency pair sync before reporting ready
+func (m *HealthManager) Start(ctx context.Context) error {
+	if m == nil {
+		return fmt.Errorf("%s %w", HealthManagerName, ErrNilSubsystem)
+	}
+	if !m.started.CompareAndSwap(false, true) {
+		return fmt.Errorf("%s %w", HealthManagerName, ErrSubSystemAlreadyStarted)
+	}
+	lc := net.ListenConfig{}
+	ln, err := lc.Listen(ctx, "tcp", m.listenAddress)
+	if err != nil {
+		m.started.Store(false)
+		return fmt.Errorf("%s listen error: %w", HealthManagerName, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", m.serveLiveness)
+	mux.HandleFunc("/readyz", m.serveReadiness)
+	mux.HandleFunc("/health", m.serveReport)
+	m.server = &http.Server{
+		Addr:         ln.Addr().String(),
+		ReadTimeout:  10 * time.Second,
+		WriteTimeout: 10 * time.Second,
+		Handler:      mux,
+	}
+	m.wg.Add(1)
+	go func(srv *http.Server) {
+		defer m.wg.Done()
+		if err := srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Errorf(log.Global, "Health manager serve error: %v", err)
+		}
+	}(m.server)
+
+	// The initial sync wait cannot be cancelled, so this routine is not
+	// tracked by the wait group and exits once the sync completes
+	go func() {
+		if err := m.waitForSync(); err != nil {
+			log.Errorf(log.Global, "Health manager unable to wait for initial sync: %v", err)
+			return
+		}
+		if m.started.Load() {
+			m.ready.Store(true)
+			log.Debugln(log.Global, "Health manager initial sync complete, reporting ready")
+		}
+	}()
+	log.Infof(log.Global, "Health manager listening on http://%s", m.server.Addr)
+	log.Debugf(log.Global, "Health manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *HealthManager) IsRunning() bool {
+	if m == nil {
+		return false
+	}
+	return m.started.Load()
+}
+
+// Stop stops serving the health endpoints
+func (m *HealthManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", HealthManagerName, ErrNilSubsystem)
+	}
+	if !m.started.CompareAndSwap(true, false) {
+		return fmt.Errorf("%s %w", HealthManagerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.Global, "Health manager %s", MsgSubSystemShuttingDown)
+	m.ready.Store(false)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err := m.server.Shutdown(ctx)
+	m.wg.Wait()
+	log.Debugf(log.Global, "Health manager %s", MsgSubSystemShutdown)
+	return err
+}
+
+// serveLiveness reports the engine is alive while the subsystem is serving
+func (m *HealthManager) serveLiveness(w http.ResponseWriter, _ *http.Request) {
+	writeHealthStatus(w, http.StatusOK, healthStatusOK)
+}
+
+// serveReadiness reports the engine is ready once the initial sync completes
+func (m *HealthManager) serveReadiness(w http.ResponseWriter, _ *http.Request) {
+	if !m.ready.Load() {
+		writeHealthStatus(w, http.StatusServiceUnavailable, healthStatusNotReady)
+		return
+	}
+	writeHealthStatus(w, http.StatusOK, healthStatusOK)
+}
+
+// serveReport writes the detailed health report. Service unavailable is
+// returned when any subsystem is unhealthy
+func (m *HealthManager) serveReport(w http.ResponseWriter, _ *http.Request) {
+	report := m.report(time.Now())
+	status := http.StatusOK
+	if report.Status != healthStatusOK {
+		status = http.StatusServiceUnavailable
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		log.Errorf(log.Global, "Health manager unable to write report: %v", err)
+	}
+}
+
+// report collects the health of each running subsystem
+func (m *HealthManager) report(now time.Time) *HealthReport {
+	r := &HealthReport{
+		Status:    healthStatusOK,
+		Ready:     m.ready.Load(),
+		Timestamp: now,
+	}
+	healthy := true
+	if m.subsystems != nil {
+		r.Subsystems = m.subsystems()
+	}
+	if m.websockets != nil {
+		r.Websockets = m.websockets()
+		for i := range r.Websockets {
+			healthy = healthy && r.Websockets[i].Healthy
+		}
+	}
+	if m.syncStatus != nil {
+		items := m.syncStatus()
+		r.Sync = make([]SyncHealth, len(items))
+		for i := range items {
+			age := now.Sub(items[i].LastUpdated)
+			r.Sync[i] = SyncHealth{
+				Exchange:    items[i].Exchange,
+				Asset:       items[i].Asset.String(),
+				Pair:        items[i].Pair.String(),
+				Item:        items[i].Item,
+				LastUpdated: items[i].LastUpdated,
+				NumErrors:   items[i].NumErrors,
+				Healthy:     items[i].HaveData && age <= m.syncStaleThreshold,
+			}
+			if items[i].HaveData {
+				r.Sync[i].AgeSeconds = age.Seconds()
+			}
+			healthy = healthy && r.Sync[i].Healthy
+		}
+	}
+	if m.database != nil {
+		if r.Database = m.database(); r.Database != nil {
+			healthy = healthy && r.Database.Healthy
+		}
+	}
+	if m.ntp != nil {
+		if r.NTP = m.ntp(); r.NTP != nil {
+			healthy = healthy && r.NTP.Healthy
+		}
+	}
+	if m.internet != nil {
+		if r.Internet = m.internet(); r.Internet != nil {
+			healthy = healthy && r.Internet.Healthy
+		}
+	}
+	if m.lastPoll != nil {
+		if last, running := m.lastPoll(); running {
+			r.OrderManager = &OrderManagerHealth{LastPoll: last}
+			if !last.IsZero() {
+				r.OrderManager.AgeSeconds = now.Sub(last).Seconds()
+				r.OrderManager.Healthy = now.Sub(last) <= m.orderPollStaleThreshold
+			}
+			healthy = healthy && r.OrderManager.Healthy
+		}
+	}
+	if !healthy {
+		r.Status = healthStatusDegraded
+	}
+	return r
+}
+
+// websocketHealth returns the websocket connection state of each exchange
+// which supports websocket
+func websocketHealth(em iExchangeManager) []WebsocketHealth {
+	if em == nil {
+		return nil
+	}
+	exchanges, err := em.GetExchanges()
+	if err != nil {
+		return nil
+	}
+	resp := make([]WebsocketHealth, 0, len(exchanges))
+	for _, exch := range exchanges {
+		ws, err := exch.GetWebsocket()
+		if err != nil || ws == nil {
+			continue
+		}
+		h := WebsocketHealth{
+			Exchange:   exch.GetName(),
+			Enabled:    ws.IsEnabled(),
+			Connected:  ws.IsConnected(),
+			Connecting: ws.IsConnecting(),
+		}
+		h.Healthy = !h.Enabled || h.Connected
+		resp = append(resp, h)
+	}
+	slices.SortFunc(resp, func(a, b WebsocketHealth) int {
+		return strings.Compare(a.Exchange, b.Exchange)
+	})
+	return resp
+}
+
+// writeHealthStatus writes a plain text health status
+func writeHealthStatus(w http.ResponseWriter, code int, status string) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(code)
+	if _, err := w.Write([]byte(status + "\n")); err != nil {
+		log.Errorf(log.Global, "Health manager unable to write status: %v", err)
+	}
+}
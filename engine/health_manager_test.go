@@ -0,0 +1,166 @@
+package engine
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
+)
+
+func newTestHealthManager(t *testing.T) *HealthManager {
+	t.Helper()
+	m, err := SetupHealthManager(&config.HealthConfig{
+		ListenAddress:           "localhost:0",
+		SyncStaleThreshold:      time.Minute,
+		OrderPollStaleThreshold: time.Minute,
+	}, nil)
+	require.NoError(t, err, "SetupHealthManager must not error")
+	return m
+}
+
+func getHealth(t *testing.T, m *HealthManager, path string) *http.Response {
+	t.Helper()
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "http://"+m.server.Addr+path, http.NoBody)
+	require.NoError(t, err, "NewRequestWithContext must not error")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err, "Do must not error")
+	t.Cleanup(func() { assert.NoError(t, resp.Body.Close(), "Close should not error") })
+	return resp
+}
+
+func TestSetupHealthManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupHealthManager(nil, nil)
+	require.ErrorIs(t, err, errNilConfig)
+
+	_, err = SetupHealthManager(&config.HealthConfig{}, nil)
+	require.ErrorIs(t, err, errHealthListenAddressUnset)
+
+	_, err = SetupHealthManager(&config.HealthConfig{ListenAddress: "localhost:0", SyncStaleThreshold: time.Minute}, nil)
+	require.ErrorIs(t, err, errHealthThresholdUnset)
+
+	m, err := SetupHealthManager(&config.HealthConfig{ListenAddress: "localhost:0", SyncStaleThreshold: time.Minute, OrderPollStaleThreshold: time.Minute}, &Engine{})
+	require.NoError(t, err, "SetupHealthManager must not error")
+	require.NoError(t, m.waitForSync(), "waitForSync must not error without a sync manager")
+	r := m.report(time.Now())
+	assert.Equal(t, healthStatusOK, r.Status, "engine without subsystems should be healthy")
+	assert.Len(t, r.Subsystems, 18)
+	assert.Empty(t, r.Websockets)
+	assert.Nil(t, r.Database)
+	assert.Nil(t, r.NTP)
+	assert.Nil(t, r.Internet)
+	assert.Nil(t, r.OrderManager)
+}
+
+func TestHealthManagerStartStop(t *testing.T) {
+	var m *HealthManager
+	require.ErrorIs(t, m.Start(t.Context()), ErrNilSubsystem)
+	require.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	assert.False(t, m.IsRunning())
+
+	m = newTestHealthManager(t)
+	require.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, m.Start(t.Context()), "Start must not error")
+	assert.True(t, m.IsRunning())
+	require.ErrorIs(t, m.Start(t.Context()), ErrSubSystemAlreadyStarted)
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.False(t, m.IsRunning())
+	assert.False(t, m.ready.Load(), "ready should be reset when stopped")
+}
+
+func TestHealthEndpoints(t *testing.T) {
+	m := newTestHealthManager(t)
+	syncDone := make(chan struct{})
+	m.waitForSync = func() error {
+		<-syncDone
+		return nil
+	}
+	m.internet = func() *InternetHealth { return &InternetHealth{} }
+	require.NoError(t, m.Start(t.Context()), "Start must not error")
+	t.Cleanup(func() { assert.NoError(t, m.Stop(), "Stop should not error") })
+
+	assert.Equal(t, http.StatusOK, getHealth(t, m, "/healthz").StatusCode)
+	assert.Equal(t, http.StatusServiceUnavailable, getHealth(t, m, "/readyz").StatusCode, "readiness should fail before the initial sync")
+
+	close(syncDone)
+	assert.Eventually(t, func() bool {
+		return getHealth(t, m, "/readyz").StatusCode == http.StatusOK
+	}, time.Second*5, time.Millisecond*10, "readiness should pass after the initial sync")
+
+	resp := getHealth(t, m, "/health")
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode, "report should be unavailable when a subsystem is unhealthy")
+	assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+	var r HealthReport
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&r), "Decode must not error")
+	assert.Equal(t, healthStatusDegraded, r.Status)
+	assert.True(t, r.Ready)
+	require.NotNil(t, r.Internet)
+	assert.False(t, r.Internet.Online)
+
+	m.internet = nil
+	assert.Equal(t, http.StatusOK, getHealth(t, m, "/health").StatusCode)
+}
+
+func TestHealthReport(t *testing.T) {
+	t.Parallel()
+	m := newTestHealthManager(t)
+	now := time.Now()
+	m.syncStatus = func() []SyncItemStatus {
+		return []SyncItemStatus{
+			{Exchange: testExchange, Asset: asset.Spot, Pair: currency.NewBTCUSDT(), Item: "Ticker", HaveData: true, LastUpdated: now.Add(-time.Second)},
+			{Exchange: testExchange, Asset: asset.Spot, Pair: currency.NewBTCUSDT(), Item: "Orderbook", HaveData: true, LastUpdated: now.Add(-time.Hour)},
+			{Exchange: testExchange, Asset: asset.Spot, Pair: currency.NewBTCUSDT(), Item: "Trades"},
+		}
+	}
+	m.websockets = func() []WebsocketHealth {
+		return []WebsocketHealth{{Exchange: testExchange, Enabled: true, Connected: true, Healthy: true}}
+	}
+	m.database = func() *DatabaseHealth { return &DatabaseHealth{Connected: true, Healthy: true} }
+	m.ntp = func() *NTPHealth { return &NTPHealth{LastChecked: now, Healthy: true} }
+	m.lastPoll = func() (time.Time, bool) { return now.Add(-time.Second), true }
+
+	r := m.report(now)
+	assert.Equal(t, healthStatusDegraded, r.Status, "stale sync items should degrade health")
+	require.Len(t, r.Sync, 3)
+	assert.True(t, r.Sync[0].Healthy, "recently updated item should be healthy")
+	assert.Equal(t, float64(1), r.Sync[0].AgeSeconds)
+	assert.Equal(t, "BTCUSDT", r.Sync[0].Pair)
+	assert.Equal(t, "spot", r.Sync[0].Asset)
+	assert.False(t, r.Sync[1].Healthy, "stale item should be unhealthy")
+	assert.False(t, r.Sync[2].Healthy, "item without data should be unhealthy")
+	assert.Zero(t, r.Sync[2].AgeSeconds, "item without data should not have an age")
+	require.NotNil(t, r.OrderManager)
+	assert.True(t, r.OrderManager.Healthy)
+
+	m.syncStatus = nil
+	assert.Equal(t, healthStatusOK, m.report(now).Status)
+
+	m.lastPoll = func() (time.Time, bool) { return time.Time{}, true }
+	r = m.report(now)
+	assert.Equal(t, healthStatusDegraded, r.Status, "order manager which has not polled should degrade health")
+	assert.False(t, r.OrderManager.Healthy)
+
+	m.lastPoll = func() (time.Time, bool) { return now.Add(-time.Hour), true }
+	assert.Equal(t, healthStatusDegraded, m.report(now).Status, "stale order poll should degrade health")
+
+	m.lastPoll = func() (time.Time, bool) { return time.Time{}, false }
+	r = m.report(now)
+	assert.Equal(t, healthStatusOK, r.Status)
+	assert.Nil(t, r.OrderManager, "stopped order manager should not be reported")
+}
+
+func TestWebsocketHealth(t *testing.T) {
+	t.Parallel()
+	assert.Nil(t, websocketHealth(nil))
+
+	em := NewExchangeManager()
+	require.NoError(t, em.Add(&sharedtestvalues.CustomEx{}), "Add must not error")
+	assert.Empty(t, websocketHealth(em), "exchanges without websocket support should not be reported")
+}
@@ -0,0 +1,108 @@
+package engine
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// HealthManagerName is an exported subsystem name
+const HealthManagerName = "health_manager"
+
+const (
+	healthStatusOK       = "ok"
+	healthStatusDegraded = "degraded"
+	healthStatusNotReady = "not ready"
+)
+
+var (
+	errHealthListenAddressUnset = errors.New("health listen address unset")
+	errHealthThresholdUnset     = errors.New("health stale threshold must be greater than zero")
+)
+
+// HealthManager serves liveness, readiness and detailed health reports over
+// HTTP. Liveness is reported while the subsystem is serving, readiness once
+// the initial currency pair sync has completed
+type HealthManager struct {
+	started                 atomic.Bool
+	ready                   atomic.Bool
+	listenAddress           string
+	syncStaleThreshold      time.Duration
+	orderPollStaleThreshold time.Duration
+	server                  *http.Server
+	wg                      sync.WaitGroup
+
+	subsystems  func() map[string]bool
+	websockets  func() []WebsocketHealth
+	syncStatus  func() []SyncItemStatus
+	waitForSync func() error
+	database    func() *DatabaseHealth
+	ntp         func() *NTPHealth
+	internet    func() *InternetHealth
+	lastPoll    func() (time.Time, bool)
+}
+
+// HealthReport is the detailed health of the engine and its subsystems. Only
+// running subsystems are included
+type HealthReport struct {
+	Status       string              `json:"status"`
+	Ready        bool                `json:"ready"`
+	Timestamp    time.Time           `json:"timestamp"`
+	Subsystems   map[string]bool     `json:"subsystems"`
+	Websockets   []WebsocketHealth   `json:"websockets,omitempty"`
+	Sync         []SyncHealth        `json:"sync,omitempty"`
+	Database     *DatabaseHealth     `json:"database,omitempty"`
+	NTP          *NTPHealth          `json:"ntp,omitempty"`
+	Internet     *InternetHealth     `json:"internet,omitempty"`
+	OrderManager *OrderManagerHealth `json:"orderManager,omitempty"`
+}
+
+// WebsocketHealth holds the websocket connection state of an exchange
+type WebsocketHealth struct {
+	Exchange   string `json:"exchange"`
+	Enabled    bool   `json:"enabled"`
+	Connected  bool   `json:"connected"`
+	Connecting bool   `json:"connecting"`
+	Healthy    bool   `json:"healthy"`
+}
+
+// SyncHealth holds when a currency pair sync item was last updated
+type SyncHealth struct {
+	Exchange    string    `json:"exchange"`
+	Asset       string    `json:"asset"`
+	Pair        string    `json:"pair"`
+	Item        string    `json:"item"`
+	LastUpdated time.Time `json:"lastUpdated"`
+	AgeSeconds  float64   `json:"ageSeconds"`
+	NumErrors   int       `json:"numErrors"`
+	Healthy     bool      `json:"healthy"`
+}
+
+// DatabaseHealth holds the database connection state
+type DatabaseHealth struct {
+	Connected bool `json:"connected"`
+	Healthy   bool `json:"healthy"`
+}
+
+// NTPHealth holds the difference between NTP and system time found by the
+// last NTP check
+type NTPHealth struct {
+	DriftSeconds float64   `json:"driftSeconds"`
+	LastChecked  time.Time `json:"lastChecked"`
+	Healthy      bool      `json:"healthy"`
+}
+
+// InternetHealth holds the internet connectivity state
+type InternetHealth struct {
+	Online  bool `json:"online"`
+	Healthy bool `json:"healthy"`
+}
+
+// OrderManagerHealth holds when exchange orders were last processed
+type OrderManagerHealth struct {
+	LastPoll   time.Time `json:"lastPoll"`
+	AgeSeconds float64   `json:"ageSeconds"`
+	Healthy    bool      `json:"healthy"`
+}
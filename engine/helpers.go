@@ -25,6 +25,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/file"
 	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/config/secrets"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/dispatch"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -88,6 +89,11 @@ func (bot *Engine) GetSubsystemsStatus() map[string]bool {
 		vm.Name:                       bot.gctScriptManager.IsRunning(),
 		dispatch.Name:                 dispatch.IsRunning(),
 		dataHistoryManagerName:        bot.dataHistoryManager.IsRunning(),
+		DataQualityManagerName:        bot.dataQualityManager.IsRunning(),
+		CompositePriceManagerName:     bot.compositePriceManager.IsRunning(),
+		DatabaseRetentionManagerName:  bot.databaseRetentionManager.IsRunning(),
+		MetricsManagerName:            bot.metricsManager.IsRunning(),
+		HealthManagerName:             bot.healthManager.IsRunning(),
 		CurrencyStateManagementName:   bot.currencyStateManager.IsRunning(),
 	}
 }
@@ -257,6 +263,61 @@ func (bot *Engine) SetSubsystem(subSystemName string, enable bool) error {
 			return bot.dataHistoryManager.Start(runtimeCtx)
 		}
 		return bot.dataHistoryManager.Stop()
+	case DataQualityManagerName:
+		if enable {
+			if bot.dataQualityManager == nil {
+				bot.dataQualityManager, err = SetupDataQualityManager(bot.ExchangeManager, bot.dataHistoryManager, &bot.Config.DataQualityManager)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.dataQualityManager.Start(runtimeCtx)
+		}
+		return bot.dataQualityManager.Stop()
+	case CompositePriceManagerName:
+		if enable {
+			if bot.compositePriceManager == nil {
+				bot.compositePriceManager, err = SetupCompositePriceManager(bot.ExchangeManager, bot.DatabaseManager, &bot.Config.CompositePriceManager)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.compositePriceManager.Start(runtimeCtx)
+		}
+		return bot.compositePriceManager.Stop()
+	case DatabaseRetentionManagerName:
+		if enable {
+			if bot.databaseRetentionManager == nil {
+				bot.databaseRetentionManager, err = SetupDatabaseRetentionManager(bot.ExchangeManager, bot.DatabaseManager, &bot.Config.DatabaseRetention)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.databaseRetentionManager.Start(runtimeCtx)
+		}
+		return bot.databaseRetentionManager.Stop()
+	case MetricsManagerName:
+		if enable {
+			if bot.metricsManager == nil {
+				bot.metricsManager, err = SetupMetricsManager(&bot.Config.Metrics, bot)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.metricsManager.Start(runtimeCtx)
+		}
+		return bot.metricsManager.Stop()
+	case HealthManagerName:
+		if enable {
+			if bot.healthManager == nil {
+				bot.healthManager, err = SetupHealthManager(&bot.Config.Health, bot)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.healthManager.Start(runtimeCtx)
+		}
+		return bot.healthManager.Stop()
 	case vm.Name:
 		if enable {
 			if bot.gctScriptManager == nil {
@@ -292,6 +353,12 @@ func (bot *Engine) GetExchangeOTPs() (map[string]string, error) {
 	for x := range bot.Config.Exchanges {
 		if otpSecret := bot.Config.Exchanges[x].API.Credentials.OTPSecret; otpSecret != "" {
 			exchName := bot.Config.Exchanges[x].Name
+			otpSecret, err := secrets.Resolve(context.TODO(), otpSecret)
+			if err != nil {
+				log.Errorf(log.Global, "Unable to resolve OTP secret for exchange %s. Err: %s\n",
+					exchName, err)
+				continue
+			}
 			o, err := totp.GenerateCode(otpSecret, time.Now())
 			if err != nil {
 				log.Errorf(log.Global, "Unable to generate OTP code for exchange %s. Err: %s\n",
@@ -318,6 +385,10 @@ func (bot *Engine) GetExchangeOTPByName(exchName string) (string, error) {
 		}
 
 		if otpSecret := bot.Config.Exchanges[x].API.Credentials.OTPSecret; otpSecret != "" {
+			otpSecret, err := secrets.Resolve(context.TODO(), otpSecret)
+			if err != nil {
+				return "", err
+			}
 			return totp.GenerateCode(otpSecret, time.Now())
 		}
 	}
@@ -338,6 +409,31 @@ func (bot *Engine) GetAuthAPISupportedExchanges() []string {
 	return exchangeNames
 }
 
+// ReloadExchangeCredentials resolves the API credentials of an exchange, or all
+// loaded exchanges when the name is empty, from config and its secret
+// providers, rotating credentials without a restart
+func (bot *Engine) ReloadExchangeCredentials(ctx context.Context, exchName string) error {
+	var exchanges []exchange.IBotExchange
+	if exchName == "" {
+		exchanges = bot.GetExchanges()
+	} else {
+		exch, err := bot.GetExchangeByName(exchName)
+		if err != nil {
+			return err
+		}
+		exchanges = []exchange.IBotExchange{exch}
+	}
+	var errs error
+	for _, exch := range exchanges {
+		if err := exch.GetBase().LoadCredentialsFromConfig(ctx); err != nil {
+			errs = common.AppendError(errs, err)
+			continue
+		}
+		log.Infof(log.ExchangeSys, "%s API credentials reloaded", exch.GetName())
+	}
+	return errs
+}
+
 // IsOnline returns whether or not the engine has Internet connectivity
 func (bot *Engine) IsOnline() bool {
 	return bot.connectionManager.IsOnline()
@@ -100,7 +100,7 @@ func CreateTestBot(tb testing.TB) *Engine {
 }
 
 func TestGetSubsystemsStatus(t *testing.T) {
-	assert.Len(t, (&Engine{}).GetSubsystemsStatus(), 13, "GetSubsystemStatus should return the correct number of subsystems")
+	assert.Len(t, (&Engine{}).GetSubsystemsStatus(), 18, "GetSubsystemStatus should return the correct number of subsystems")
 }
 
 func TestGetRPCEndpoints(t *testing.T) {
@@ -188,6 +188,36 @@ func TestSetSubsystem(t *testing.T) { //nolint // TO-DO: Fix race t.Parallel() u
 			EnableError:  database.ErrNilInstance,
 			DisableError: ErrNilSubsystem,
 		},
+		{
+			Subsystem:    DataQualityManagerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  nil,
+			DisableError: nil,
+		},
+		{
+			Subsystem:    CompositePriceManagerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  errVirtualExchangeNameUnset,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    DatabaseRetentionManagerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  errInvalidTimes,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    MetricsManagerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  errMetricsListenAddressUnset,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    HealthManagerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  errHealthListenAddressUnset,
+			DisableError: ErrNilSubsystem,
+		},
 		{
 			Subsystem:    vm.Name,
 			Engine:       &Engine{Config: &config.Config{}},
@@ -304,6 +334,46 @@ func TestGetAuthAPISupportedExchanges(t *testing.T) {
 	}
 }
 
+func TestReloadExchangeCredentials(t *testing.T) {
+	t.Parallel()
+	keyFile := filepath.Join(t.TempDir(), "key")
+	require.NoError(t, os.WriteFile(keyFile, []byte("filekey"), 0o600))
+	bot := &Engine{
+		ExchangeManager: NewExchangeManager(),
+		Config: &config.Config{Exchanges: []config.Exchange{{
+			Name: testExchange,
+			API: config.APIConfig{Credentials: config.APICredentialsConfig{
+				Key:    "file://" + filepath.ToSlash(keyFile),
+				Secret: "secret",
+			}},
+		}}},
+	}
+	exch, err := bot.ExchangeManager.NewExchangeByName(testExchange)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	bCfg, err := bot.Config.GetExchangeConfig(testExchange)
+	require.NoError(t, err)
+	exch.GetBase().Config = bCfg
+	require.NoError(t, bot.ExchangeManager.Add(exch))
+
+	require.ErrorIs(t, bot.ReloadExchangeCredentials(t.Context(), "bogus"), ErrExchangeNotFound)
+	require.NoError(t, bot.ReloadExchangeCredentials(t.Context(), testExchange))
+	creds := exch.GetBase().GetDefaultCredentials()
+	require.NotNil(t, creds)
+	assert.Equal(t, "filekey", creds.Key, "Key should be resolved from the secret reference")
+
+	require.NoError(t, os.WriteFile(keyFile, []byte("rotatedkey"), 0o600))
+	require.NoError(t, bot.ReloadExchangeCredentials(t.Context(), ""))
+	assert.Equal(t, "rotatedkey", exch.GetBase().GetDefaultCredentials().Key, "Reloading all exchanges should rotate the key")
+
+	require.NoError(t, os.Remove(keyFile))
+	assert.ErrorIs(t, bot.ReloadExchangeCredentials(t.Context(), ""), os.ErrNotExist)
+
+	bCfg.API.Credentials.OTPSecret = "env:GCT_ENGINE_TEST_UNSET_OTP"
+	_, err = bot.GetExchangeOTPByName(testExchange)
+	assert.Error(t, err, "GetExchangeOTPByName should error when the OTP secret reference cannot be resolved")
+}
+
 func TestIsOnline(t *testing.T) {
 	t.Parallel()
 	e := CreateTestBot(t)
@@ -0,0 +1,398 @@
+package engine
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchange/websocket"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupMetricsManager creates a metrics manager subsystem. Engine state is
+// collected from the supplied engine when metrics are scraped, a nil engine
+// only exposes request, websocket and orderbook metrics
+func SetupMetricsManager(cfg *config.MetricsConfig, bot *Engine) (*MetricsManager, error) {
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if cfg.ListenAddress == "" {
+		return nil, errMetricsListenAddressUnset
+	}
+	if cfg.Path == "" {
+		return nil, errMetricsPathUnset
+	}
+	m := &MetricsManager{
+		listenAddress: cfg.ListenAddress,
+		path:          cfg.Path,
+	}
+	m.restLatency = m.register("gct_rest_request_duration_seconds", "Exchange REST request round trip time", metricHistogram, defaultLatencyBuckets, "exchange", "method", "endpoint")
+	m.rateLimitWait = m.register("gct_rate_limiter_wait_seconds", "Time spent waiting on exchange rate limiters before sending a request", metricHistogram, defaultLatencyBuckets, "exchange")
+	m.websocketLatency = m.register("gct_websocket_request_duration_seconds", "Exchange websocket request round trip time", metricHistogram, defaultLatencyBuckets, "exchange")
+	m.websocketMessages = m.register("gct_websocket_messages_received", "Messages read from exchange websocket connections", metricCounter, nil, "exchange")
+	m.websocketReconnects = m.register("gct_websocket_reconnects", "Exchange websocket connections re-established by the connection monitor", metricCounter, nil, "exchange")
+	m.orderbookInvalidations = m.register("gct_orderbook_invalidations", "Orderbook depths invalidated due to failed updates", metricCounter, nil, "exchange", "asset", "pair")
+	m.orderbookChecksumMismatches = m.register("gct_orderbook_checksum_mismatches", "Orderbook updates which failed exchange checksum verification", metricCounter, nil, "exchange", "asset", "pair")
+	m.syncStaleness = m.register("gct_sync_staleness_seconds", "Time since a synchronised item was last updated", metricGauge, nil, "exchange", "asset", "pair", "item")
+	m.orders = m.register("gct_orders", "Orders stored by the order manager", metricGauge, nil, "exchange", "status")
+	m.dispatchQueueDepth = m.register("gct_dispatch_queue_depth", "Jobs waiting in the dispatch queue", metricGauge, nil)
+	m.dispatchQueueCapacity = m.register("gct_dispatch_queue_capacity", "Maximum jobs the dispatch queue can hold", metricGauge, nil)
+	m.virtualMachines = m.register("gct_gctscript_virtual_machines", "Running GCTScript virtual machines", metricGauge, nil)
+	m.virtualMachinesMax = m.register("gct_gctscript_virtual_machines_max", "Maximum allowed GCTScript virtual machines", metricGauge, nil)
+
+	m.dispatchQueue = dispatch.QueueDepth
+	if bot != nil {
+		m.syncStatus = func() []SyncItemStatus {
+			return bot.currencyPairSyncer.SyncStatus()
+		}
+		m.orderCounts = func() map[string]map[order.Status]int {
+			return bot.OrderManager.GetOrderStatusCounts()
+		}
+		m.virtualMachineUse = func() (running, maximum uint64) {
+			if bot.gctScriptManager == nil {
+				return 0, 0
+			}
+			return gctscript.VMSCount.Len(), bot.gctScriptManager.GetMaxVirtualMachines()
+		}
+	}
+	return m, nil
+}
+
+// Start installs the metric reporters and serves metrics on the configured
+// listen address. Reporters are captured by exchange requesters and websocket
+// connections when they are created, so the subsystem must be started before
+// exchanges are loaded to record their metrics
+func (m *MetricsManager) Start(ctx context.Context) error {
+	if m == nil {
+		return fmt.Errorf("%s %w", MetricsManagerName, ErrNilSubsystem)
+	}
+	if !m.started.CompareAndSwap(false, true) {
+		return fmt.Errorf("%s %w", MetricsManagerName, ErrSubSystemAlreadyStarted)
+	}
+	lc := net.ListenConfig{}
+	ln, err := lc.Listen(ctx, "tcp", m.listenAddress)
+	if err != nil {
+		m.started.Store(false)
+		return fmt.Errorf("%s listen error: %w", MetricsManagerName, err)
+	}
+
+	request.SetupGlobalReporter(&metricsRESTReporter{m: m})
+	websocket.SetupGlobalReporter(&metricsWebsocketReporter{m: m})
+	orderbook.SetupGlobalReporter(&metricsOrderbookReporter{m: m})
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(m.path, m.serveMetrics)
+	m.server = &http.Server{
+		Addr:         ln.Addr().String(),
+		ReadTimeout:  10 * time.Second,
+		WriteTimeout: 10 * time.Second,
+		Handler:      mux,
+	}
+	m.wg.Add(1)
+	go func(srv *http.Server) {
+		defer m.wg.Done()
+		if err := srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Errorf(log.Global, "Metrics manager serve error: %v", err)
+		}
+	}(m.server)
+	log.Infof(log.Global, "Metrics manager listening on http://%s%s", m.server.Addr, m.path)
+	log.Debugf(log.Global, "Metrics manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *MetricsManager) IsRunning() bool {
+	if m == nil {
+		return false
+	}
+	return m.started.Load()
+}
+
+// Stop stops serving metrics. Reporters already attached to exchanges keep
+// recording so metrics are retained if the subsystem is restarted
+func (m *MetricsManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", MetricsManagerName, ErrNilSubsystem)
+	}
+	if !m.started.CompareAndSwap(true, false) {
+		return fmt.Errorf("%s %w", MetricsManagerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.Global, "Metrics manager %s", MsgSubSystemShuttingDown)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	err := m.server.Shutdown(ctx)
+	m.wg.Wait()
+	log.Debugf(log.Global, "Metrics manager %s", MsgSubSystemShutdown)
+	return err
+}
+
+func (m *MetricsManager) serveMetrics(w http.ResponseWriter, _ *http.Request) {
+	var buf bytes.Buffer
+	if err := m.writeMetrics(&buf); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", openMetricsContentType)
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		log.Errorf(log.Global, "Metrics manager unable to write response: %v", err)
+	}
+}
+
+// writeMetrics collects the current engine state and writes all metric
+// families in the OpenMetrics text format
+func (m *MetricsManager) writeMetrics(w io.Writer) error {
+	m.scrapeMtx.Lock()
+	defer m.scrapeMtx.Unlock()
+	m.collect()
+	for _, f := range m.families {
+		if err := f.write(w); err != nil {
+			return err
+		}
+	}
+	_, err := io.WriteString(w, "# EOF\n")
+	return err
+}
+
+// collect refreshes the gauges which are derived from engine state
+func (m *MetricsManager) collect() {
+	if m.syncStatus != nil {
+		m.syncStaleness.reset()
+		now := time.Now()
+		for _, s := range m.syncStatus() {
+			if s.LastUpdated.IsZero() {
+				continue
+			}
+			m.syncStaleness.set(now.Sub(s.LastUpdated).Seconds(), s.Exchange, s.Asset.String(), s.Pair.String(), s.Item)
+		}
+	}
+	if m.orderCounts != nil {
+		m.orders.reset()
+		for exch, statuses := range m.orderCounts() {
+			for status, count := range statuses {
+				m.orders.set(float64(count), exch, status.String())
+			}
+		}
+	}
+	if m.dispatchQueue != nil {
+		depth, capacity := m.dispatchQueue()
+		m.dispatchQueueDepth.set(float64(depth))
+		m.dispatchQueueCapacity.set(float64(capacity))
+	}
+	if m.virtualMachineUse != nil {
+		running, maximum := m.virtualMachineUse()
+		m.virtualMachines.set(float64(running))
+		m.virtualMachinesMax.set(float64(maximum))
+	}
+}
+
+func (m *MetricsManager) register(name, help string, kind metricKind, buckets []float64, labels ...string) *metricFamily {
+	f := &metricFamily{
+		name:    name,
+		help:    help,
+		kind:    kind,
+		labels:  labels,
+		buckets: buckets,
+		series:  make(map[string]*metricSeries),
+	}
+	m.families = append(m.families, f)
+	return f
+}
+
+// get returns the series for the label values, creating it if required. The
+// family lock must be held
+func (f *metricFamily) get(labelValues []string) (*metricSeries, error) {
+	if len(labelValues) != len(f.labels) {
+		return nil, fmt.Errorf("%w %s: %d values for %d labels", errMetricLabelMismatch, f.name, len(labelValues), len(f.labels))
+	}
+	k := strings.Join(labelValues, "\xff")
+	s, ok := f.series[k]
+	if !ok {
+		s = &metricSeries{labelValues: slices.Clone(labelValues)}
+		if f.kind == metricHistogram {
+			s.bucketCount = make([]uint64, len(f.buckets))
+		}
+		f.series[k] = s
+	}
+	return s, nil
+}
+
+// add increments a counter or gauge series
+func (f *metricFamily) add(v float64, labelValues ...string) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	s, err := f.get(labelValues)
+	if err != nil {
+		log.Errorln(log.Global, err)
+		return
+	}
+	s.value += v
+}
+
+// set sets a gauge series
+func (f *metricFamily) set(v float64, labelValues ...string) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	s, err := f.get(labelValues)
+	if err != nil {
+		log.Errorln(log.Global, err)
+		return
+	}
+	s.value = v
+}
+
+// observe records a value in a histogram series
+func (f *metricFamily) observe(v float64, labelValues ...string) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	s, err := f.get(labelValues)
+	if err != nil {
+		log.Errorln(log.Global, err)
+		return
+	}
+	for i := range f.buckets {
+		if v <= f.buckets[i] {
+			s.bucketCount[i]++
+		}
+	}
+	s.sum += v
+	s.count++
+}
+
+// reset removes all series so gauges no longer present are not exposed
+func (f *metricFamily) reset() {
+	f.m.Lock()
+	clear(f.series)
+	f.m.Unlock()
+}
+
+// write writes the family in the OpenMetrics text format. Series are sorted
+// by label values so output is stable between scrapes
+func (f *metricFamily) write(w io.Writer) error {
+	f.m.Lock()
+	defer f.m.Unlock()
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# TYPE %s %s\n# HELP %s %s\n", f.name, f.kind, f.name, f.help)
+	for _, k := range slices.Sorted(maps.Keys(f.series)) {
+		s := f.series[k]
+		switch f.kind {
+		case metricCounter:
+			writeSample(&sb, f.name+"_total", f.labels, s.labelValues, s.value)
+		case metricHistogram:
+			labels := append(slices.Clone(f.labels), "le")
+			values := append(slices.Clone(s.labelValues), "")
+			for i := range f.buckets {
+				values[len(values)-1] = formatMetricValue(f.buckets[i])
+				writeSample(&sb, f.name+"_bucket", labels, values, float64(s.bucketCount[i]))
+			}
+			values[len(values)-1] = "+Inf"
+			writeSample(&sb, f.name+"_bucket", labels, values, float64(s.count))
+			writeSample(&sb, f.name+"_sum", f.labels, s.labelValues, s.sum)
+			writeSample(&sb, f.name+"_count", f.labels, s.labelValues, float64(s.count))
+		default:
+			writeSample(&sb, f.name, f.labels, s.labelValues, s.value)
+		}
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+func writeSample(sb *strings.Builder, name string, labels, values []string, v float64) {
+	sb.WriteString(name)
+	if len(labels) > 0 {
+		sb.WriteByte('{')
+		for i := range labels {
+			if i > 0 {
+				sb.WriteByte(',')
+			}
+			sb.WriteString(labels[i])
+			sb.WriteString(`="`)
+			sb.WriteString(escapeLabelValue(values[i]))
+			sb.WriteByte('"')
+		}
+		sb.WriteByte('}')
+	}
+	sb.WriteByte(' ')
+	sb.WriteString(formatMetricValue(v))
+	sb.WriteByte('\n')
+}
+
+var labelValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabelValue(v string) string {
+	return labelValueReplacer.Replace(v)
+}
+
+func formatMetricValue(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// requestEndpoint strips the query string from a request path so that request
+// parameters do not create a new series for every request
+func requestEndpoint(path string) string {
+	u, err := url.Parse(path)
+	if err != nil {
+		if i := strings.IndexByte(path, '?'); i != -1 {
+			return path[:i]
+		}
+		return path
+	}
+	return u.Host + u.Path
+}
+
+// Latency records the round trip time of an exchange REST request
+func (r *metricsRESTReporter) Latency(name, method, path string, t time.Duration) {
+	r.m.restLatency.observe(t.Seconds(), name, method, requestEndpoint(path))
+}
+
+// RateLimitWait records the time spent waiting on an exchange rate limiter
+func (r *metricsRESTReporter) RateLimitWait(name string, t time.Duration) {
+	r.m.rateLimitWait.observe(t.Seconds(), name)
+}
+
+// Latency records the round trip time of an exchange websocket request
+func (r *metricsWebsocketReporter) Latency(name string, _ []byte, t time.Duration) {
+	r.m.websocketLatency.observe(t.Seconds(), name)
+}
+
+// MessageReceived counts a message read from an exchange websocket connection
+func (r *metricsWebsocketReporter) MessageReceived(name string) {
+	r.m.websocketMessages.add(1, name)
+}
+
+// Reconnected counts a re-established exchange websocket connection
+func (r *metricsWebsocketReporter) Reconnected(name string) {
+	r.m.websocketReconnects.add(1, name)
+}
+
+// RateLimitWait records the time spent waiting on a websocket rate limiter
+func (r *metricsWebsocketReporter) RateLimitWait(name string, t time.Duration) {
+	r.m.rateLimitWait.observe(t.Seconds(), name)
+}
+
+// Invalidated counts an invalidated orderbook depth
+func (r *metricsOrderbookReporter) Invalidated(exchange string, p currency.Pair, a asset.Item) {
+	r.m.orderbookInvalidations.add(1, exchange, a.String(), p.String())
+}
+
+// ChecksumMismatch counts an orderbook update which failed checksum verification
+func (r *metricsOrderbookReporter) ChecksumMismatch(exchange string, p currency.Pair, a asset.Item) {
+	r.m.orderbookChecksumMismatches.add(1, exchange, a.String(), p.String())
+}
@@ -0,0 +1,160 @@
+package engine
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func newTestMetricsManager(t *testing.T) *MetricsManager {
+	t.Helper()
+	m, err := SetupMetricsManager(&config.MetricsConfig{ListenAddress: "localhost:0", Path: "/metrics"}, nil)
+	require.NoError(t, err, "SetupMetricsManager must not error")
+	return m
+}
+
+func TestSetupMetricsManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupMetricsManager(nil, nil)
+	require.ErrorIs(t, err, errNilConfig)
+
+	_, err = SetupMetricsManager(&config.MetricsConfig{}, nil)
+	require.ErrorIs(t, err, errMetricsListenAddressUnset)
+
+	_, err = SetupMetricsManager(&config.MetricsConfig{ListenAddress: "localhost:0"}, nil)
+	require.ErrorIs(t, err, errMetricsPathUnset)
+
+	m, err := SetupMetricsManager(&config.MetricsConfig{ListenAddress: "localhost:0", Path: "/metrics"}, &Engine{})
+	require.NoError(t, err, "SetupMetricsManager must not error")
+	assert.NotNil(t, m.syncStatus, "syncStatus should be set when an engine is supplied")
+	assert.NotNil(t, m.orderCounts, "orderCounts should be set when an engine is supplied")
+	assert.NotNil(t, m.virtualMachineUse, "virtualMachineUse should be set when an engine is supplied")
+	assert.NotPanics(t, func() { m.collect() }, "collect should not panic on an engine without subsystems")
+}
+
+func TestMetricsManagerStartStop(t *testing.T) {
+	var m *MetricsManager
+	require.ErrorIs(t, m.Start(t.Context()), ErrNilSubsystem)
+	require.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	assert.False(t, m.IsRunning())
+
+	m = newTestMetricsManager(t)
+	require.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, m.Start(t.Context()), "Start must not error")
+	assert.True(t, m.IsRunning())
+	require.ErrorIs(t, m.Start(t.Context()), ErrSubSystemAlreadyStarted)
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.False(t, m.IsRunning())
+}
+
+func TestServeMetrics(t *testing.T) {
+	m := newTestMetricsManager(t)
+	require.NoError(t, m.Start(t.Context()), "Start must not error")
+	t.Cleanup(func() { assert.NoError(t, m.Stop(), "Stop should not error") })
+
+	(&metricsRESTReporter{m: m}).Latency(testExchange, http.MethodGet, "https://api.test.com/v1/ticker?symbol=BTCUSDT", time.Millisecond*20)
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "http://"+m.server.Addr+m.path, http.NoBody)
+	require.NoError(t, err, "NewRequestWithContext must not error")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err, "Do must not error")
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err, "ReadAll must not error")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, openMetricsContentType, resp.Header.Get("Content-Type"))
+	assert.Contains(t, string(body), `gct_rest_request_duration_seconds_count{exchange="`+testExchange+`",method="GET",endpoint="api.test.com/v1/ticker"} 1`)
+}
+
+func TestWriteMetrics(t *testing.T) {
+	t.Parallel()
+	m := newTestMetricsManager(t)
+	m.dispatchQueue = func() (int, int) { return 3, 10 }
+	m.virtualMachineUse = func() (uint64, uint64) { return 1, 10 }
+	m.orderCounts = func() map[string]map[order.Status]int {
+		return map[string]map[order.Status]int{testExchange: {order.Open: 2}}
+	}
+	m.syncStatus = func() []SyncItemStatus {
+		return []SyncItemStatus{
+			{Exchange: testExchange, Asset: asset.Spot, Pair: currency.NewBTCUSDT(), Item: "Ticker", LastUpdated: time.Now().Add(-time.Minute)},
+			{Exchange: testExchange, Asset: asset.Spot, Pair: currency.NewBTCUSDT(), Item: "Orderbook"},
+		}
+	}
+
+	rest := &metricsRESTReporter{m: m}
+	rest.Latency(testExchange, http.MethodGet, "https://api.test.com/v1/ticker?symbol=BTCUSDT", time.Millisecond*20)
+	rest.Latency(testExchange, http.MethodGet, "https://api.test.com/v1/ticker?symbol=ETHUSDT", time.Second*20)
+	rest.RateLimitWait(testExchange, time.Millisecond*100)
+	ws := &metricsWebsocketReporter{m: m}
+	ws.Latency(testExchange, nil, time.Millisecond)
+	ws.MessageReceived(testExchange)
+	ws.MessageReceived(testExchange)
+	ws.Reconnected(testExchange)
+	ws.RateLimitWait(testExchange, time.Millisecond)
+	(&metricsOrderbookReporter{m: m}).Invalidated(testExchange, currency.NewBTCUSDT(), asset.Spot)
+	(&metricsOrderbookReporter{m: m}).ChecksumMismatch(testExchange, currency.NewBTCUSDT(), asset.Spot)
+
+	var buf bytes.Buffer
+	require.NoError(t, m.writeMetrics(&buf), "writeMetrics must not error")
+	out := buf.String()
+	for _, line := range []string{
+		"# TYPE gct_rest_request_duration_seconds histogram",
+		`gct_rest_request_duration_seconds_bucket{exchange="` + testExchange + `",method="GET",endpoint="api.test.com/v1/ticker",le="0.025"} 1`,
+		`gct_rest_request_duration_seconds_bucket{exchange="` + testExchange + `",method="GET",endpoint="api.test.com/v1/ticker",le="+Inf"} 2`,
+		`gct_rest_request_duration_seconds_count{exchange="` + testExchange + `",method="GET",endpoint="api.test.com/v1/ticker"} 2`,
+		`gct_rate_limiter_wait_seconds_count{exchange="` + testExchange + `"} 2`,
+		`gct_websocket_request_duration_seconds_count{exchange="` + testExchange + `"} 1`,
+		"# TYPE gct_websocket_messages_received counter",
+		`gct_websocket_messages_received_total{exchange="` + testExchange + `"} 2`,
+		`gct_websocket_reconnects_total{exchange="` + testExchange + `"} 1`,
+		`gct_orderbook_invalidations_total{exchange="` + testExchange + `",asset="spot",pair="BTCUSDT"} 1`,
+		`gct_orderbook_checksum_mismatches_total{exchange="` + testExchange + `",asset="spot",pair="BTCUSDT"} 1`,
+		`gct_orders{exchange="` + testExchange + `",status="OPEN"} 2`,
+		"gct_dispatch_queue_depth 3",
+		"gct_dispatch_queue_capacity 10",
+		"gct_gctscript_virtual_machines 1",
+		"gct_gctscript_virtual_machines_max 10",
+	} {
+		assert.Contains(t, out, line+"\n")
+	}
+	assert.Contains(t, out, `gct_sync_staleness_seconds{exchange="`+testExchange+`",asset="spot",pair="BTCUSDT",item="Ticker"} `)
+	assert.NotContains(t, out, `item="Orderbook"`, "items which have never been updated should not be exposed")
+	assert.True(t, strings.HasSuffix(out, "# EOF\n"), "output should be terminated with EOF")
+
+	m.orderCounts = func() map[string]map[order.Status]int { return nil }
+	buf.Reset()
+	require.NoError(t, m.writeMetrics(&buf), "writeMetrics must not error")
+	assert.NotContains(t, buf.String(), "gct_orders{", "removed gauge series should not be exposed")
+}
+
+func TestMetricFamilyLabelMismatch(t *testing.T) {
+	t.Parallel()
+	m := newTestMetricsManager(t)
+	m.websocketMessages.add(1)
+	m.websocketMessages.add(1, "a", "b")
+	assert.Empty(t, m.websocketMessages.series, "series should not be created with mismatched labels")
+	_, err := m.websocketMessages.get(nil)
+	assert.ErrorIs(t, err, errMetricLabelMismatch)
+}
+
+func TestEscapeLabelValue(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, `a\\b\"c\nd`, escapeLabelValue("a\\b\"c\nd"))
+}
+
+func TestRequestEndpoint(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "api.test.com/v1/ticker", requestEndpoint("https://api.test.com/v1/ticker?symbol=BTCUSDT"))
+	assert.Equal(t, "/v1/ticker", requestEndpoint("/v1/ticker?symbol=BTCUSDT"))
+	assert.Equal(t, "%zz", requestEndpoint("%zz?a=b"))
+}
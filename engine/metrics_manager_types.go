@@ -0,0 +1,104 @@
+package engine
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// MetricsManagerName is an exported subsystem name
+const MetricsManagerName = "metrics_manager"
+
+const openMetricsContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+
+var (
+	// defaultLatencyBuckets are the upper bounds in seconds used for request
+	// latency and rate limiter wait histograms
+	defaultLatencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+	errMetricsListenAddressUnset = errors.New("metrics listen address unset")
+	errMetricsPathUnset          = errors.New("metrics path unset")
+	errMetricLabelMismatch       = errors.New("metric label values do not match label names")
+)
+
+// MetricsManager exposes request, websocket, orderbook and engine metrics in
+// the OpenMetrics text format. Request and websocket metrics are recorded as
+// they occur via the global reporters, engine state is collected when scraped
+type MetricsManager struct {
+	started       atomic.Bool
+	listenAddress string
+	path          string
+	server        *http.Server
+	wg            sync.WaitGroup
+	scrapeMtx     sync.Mutex
+	families      []*metricFamily
+
+	restLatency                 *metricFamily
+	rateLimitWait               *metricFamily
+	websocketLatency            *metricFamily
+	websocketMessages           *metricFamily
+	websocketReconnects         *metricFamily
+	orderbookInvalidations      *metricFamily
+	orderbookChecksumMismatches *metricFamily
+	syncStaleness               *metricFamily
+	orders                      *metricFamily
+	dispatchQueueDepth          *metricFamily
+	dispatchQueueCapacity       *metricFamily
+	virtualMachines             *metricFamily
+	virtualMachinesMax          *metricFamily
+
+	syncStatus        func() []SyncItemStatus
+	orderCounts       func() map[string]map[order.Status]int
+	dispatchQueue     func() (depth, capacity int)
+	virtualMachineUse func() (running, maximum uint64)
+}
+
+type metricKind string
+
+const (
+	metricCounter   metricKind = "counter"
+	metricGauge     metricKind = "gauge"
+	metricHistogram metricKind = "histogram"
+)
+
+// metricFamily is a named metric with a fixed set of label names. Each unique
+// set of label values is stored as a separate series
+type metricFamily struct {
+	name    string
+	help    string
+	kind    metricKind
+	labels  []string
+	buckets []float64
+
+	m      sync.Mutex
+	series map[string]*metricSeries
+}
+
+// metricSeries holds the value of a counter or gauge, or the cumulative bucket
+// counts of a histogram
+type metricSeries struct {
+	labelValues []string
+	value       float64
+	bucketCount []uint64
+	sum         float64
+	count       uint64
+}
+
+// metricsRESTReporter records exchange REST request metrics
+type metricsRESTReporter struct {
+	m *MetricsManager
+}
+
+// metricsWebsocketReporter records exchange websocket metrics
+type metricsWebsocketReporter struct {
+	m *MetricsManager
+}
+
+// metricsOrderbookReporter records orderbook depth invalidations and checksum
+// mismatches
+type metricsOrderbookReporter struct {
+	m *MetricsManager
+}
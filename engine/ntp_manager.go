@@ -137,10 +137,14 @@ func (m *ntpManager) processTime() error {
 	}
 	currentTime := time.Now()
 	diff := NTPTime.Sub(currentTime)
+	m.driftMtx.Lock()
+	m.drift = diff
+	m.driftChecked = currentTime
+	m.driftMtx.Unlock()
 	configNTPTime := m.allowedDifference
 	negDiff := m.allowedNegativeDifference
 	configNTPNegativeTime := -negDiff
-	if diff > configNTPTime || diff < configNTPNegativeTime {
+	if !m.isDriftAllowed(diff) {
 		log.Warnf(log.TimeMgr, "NTP manager: Time out of sync (NTP): %v | (time.Now()): %v | (Difference): %v | (Allowed): +%v / %v\n",
 			NTPTime,
 			currentTime,
@@ -151,6 +155,26 @@ func (m *ntpManager) processTime() error {
 	return nil
 }
 
+// GetDrift returns the difference between NTP and system time found by the
+// last check and when the check occurred
+func (m *ntpManager) GetDrift() (drift time.Duration, checked time.Time, err error) {
+	if m == nil {
+		return 0, time.Time{}, fmt.Errorf("ntp manager %w", ErrNilSubsystem)
+	}
+	if !m.started.Load() {
+		return 0, time.Time{}, fmt.Errorf("NTP manager %w", ErrSubSystemNotStarted)
+	}
+	m.driftMtx.Lock()
+	defer m.driftMtx.Unlock()
+	return m.drift, m.driftChecked, nil
+}
+
+// isDriftAllowed returns whether the drift is within the configured allowed
+// differences
+func (m *ntpManager) isDriftAllowed(drift time.Duration) bool {
+	return drift <= m.allowedDifference && drift >= -m.allowedNegativeDifference
+}
+
 // checkTimeInPools returns local based on ntp servers provided timestamp
 // if no server can be reached will return local time in UTC()
 func (m *ntpManager) checkTimeInPools() time.Time {
@@ -165,3 +165,26 @@ func TestProcessTime(t *testing.T) {
 	err = m.processTime()
 	assert.NoError(t, err)
 }
+
+func TestGetDrift(t *testing.T) {
+	t.Parallel()
+	var m *ntpManager
+	_, _, err := m.GetDrift()
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	sec := time.Second
+	m, err = setupNTPManager(&config.NTPClientConfig{AllowedDifference: &sec, AllowedNegativeDifference: &sec, Level: 1}, false)
+	assert.NoError(t, err)
+	_, _, err = m.GetDrift()
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	m.started.Store(true)
+	m.pools = nil
+	assert.NoError(t, m.processTime())
+	drift, checked, err := m.GetDrift()
+	assert.NoError(t, err)
+	assert.False(t, checked.IsZero(), "checked should be set after processing time")
+	assert.True(t, m.isDriftAllowed(drift), "drift against system time should be allowed")
+	assert.False(t, m.isDriftAllowed(2*time.Second))
+	assert.False(t, m.isDriftAllowed(-2*time.Second))
+}
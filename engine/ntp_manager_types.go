@@ -2,6 +2,7 @@ package engine
 
 import (
 	"errors"
+	"sync"
 	"sync/atomic"
 	"time"
 )
@@ -29,6 +30,9 @@ type ntpManager struct {
 	checkInterval             time.Duration
 	retryLimit                int
 	loggingEnabled            bool
+	driftMtx                  sync.Mutex
+	drift                     time.Duration
+	driftChecked              time.Time
 }
 
 type ntpPacket struct {
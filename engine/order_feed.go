@@ -0,0 +1,198 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// orderFeedBufferSize is the number of events a subscriber can fall behind by
+// before events are dropped
+const orderFeedBufferSize = 100
+
+var errOrderFeedSubscriptionClosed = errors.New("order feed subscription already closed")
+
+// OrderFeedFilter restricts the events an order feed subscription receives.
+// Empty fields match everything
+type OrderFeedFilter struct {
+	Exchange string
+	Account  string
+	Asset    asset.Item
+	Pair     currency.Pair
+}
+
+// OrderFeedSubscription receives order updates or fills matching its filter
+type OrderFeedSubscription[T order.Detail | fill.Data] struct {
+	ch     chan T
+	filter OrderFeedFilter
+	feed   *orderFeedSubscribers[T]
+}
+
+// orderFeedSubscribers distributes events of one type to in-process
+// subscribers. Slow subscribers do not block the order manager and miss events
+// once their buffer is full
+type orderFeedSubscribers[T order.Detail | fill.Data] struct {
+	m    sync.RWMutex
+	subs map[*OrderFeedSubscription[T]]struct{}
+}
+
+// orderFeed holds the order update and fill subscribers of the order manager.
+// Its zero value is ready to use
+type orderFeed struct {
+	orders orderFeedSubscribers[order.Detail]
+	fills  orderFeedSubscribers[fill.Data]
+}
+
+// Channel returns the channel events are delivered on, which is closed when the
+// subscription is closed
+func (s *OrderFeedSubscription[T]) Channel() <-chan T {
+	return s.ch
+}
+
+// Close removes the subscription from the feed
+func (s *OrderFeedSubscription[T]) Close() error {
+	return s.feed.unsubscribe(s)
+}
+
+// match returns whether an event for the exchange, account, asset and pair
+// passes the filter
+func (f *OrderFeedFilter) match(exch, account string, a asset.Item, p currency.Pair) bool {
+	if f.Exchange != "" && !strings.EqualFold(f.Exchange, exch) {
+		return false
+	}
+	if f.Account != "" && f.Account != account {
+		return false
+	}
+	if f.Asset != asset.Empty && f.Asset != a {
+		return false
+	}
+	return f.Pair.IsEmpty() || f.Pair.Equal(p)
+}
+
+func (f *orderFeedSubscribers[T]) subscribe(filter OrderFeedFilter) *OrderFeedSubscription[T] {
+	s := &OrderFeedSubscription[T]{
+		ch:     make(chan T, orderFeedBufferSize),
+		filter: filter,
+		feed:   f,
+	}
+	f.m.Lock()
+	if f.subs == nil {
+		f.subs = make(map[*OrderFeedSubscription[T]]struct{})
+	}
+	f.subs[s] = struct{}{}
+	f.m.Unlock()
+	return s
+}
+
+func (f *orderFeedSubscribers[T]) unsubscribe(s *OrderFeedSubscription[T]) error {
+	f.m.Lock()
+	defer f.m.Unlock()
+	if _, ok := f.subs[s]; !ok {
+		return errOrderFeedSubscriptionClosed
+	}
+	delete(f.subs, s)
+	close(s.ch)
+	return nil
+}
+
+func (f *orderFeedSubscribers[T]) broadcast(exch, account string, a asset.Item, p currency.Pair, event T) {
+	f.m.RLock()
+	defer f.m.RUnlock()
+	for s := range f.subs {
+		if !s.filter.match(exch, account, a, p) {
+			continue
+		}
+		select {
+		case s.ch <- event:
+		default:
+			log.Warnf(log.OrderMgr, "Order feed subscriber buffer full, dropping %s %s %s event", exch, a, p)
+		}
+	}
+}
+
+// SubscribeOrderUpdates returns a subscription receiving a copy of each order
+// matching the filter whenever it is added to or changed in the order store
+func (m *OrderManager) SubscribeOrderUpdates(filter OrderFeedFilter) (*OrderFeedSubscription[order.Detail], error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	return m.orderStore.feed.orders.subscribe(filter), nil
+}
+
+// SubscribeFills returns a subscription receiving the fills of orders matching
+// the filter. Fills are taken from exchanges with their fills feed enabled, or
+// otherwise derived from increases in an order's executed amount
+func (m *OrderManager) SubscribeFills(filter OrderFeedFilter) (*OrderFeedSubscription[fill.Data], error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	return m.orderStore.feed.fills.subscribe(filter), nil
+}
+
+// PublishFills relays fills received from an exchange's fills feed to fill
+// subscribers. Exchange fills feeds use the default account's credentials
+func (m *OrderManager) PublishFills(fills ...fill.Data) error {
+	if m == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	for i := range fills {
+		m.orderStore.feed.fills.broadcast(fills[i].Exchange, accounts.DefaultAccount, fills[i].AssetType, fills[i].CurrencyPair, fills[i])
+	}
+	return nil
+}
+
+// publishOrderUpdate relays a changed order to order update subscribers and,
+// when its executed amount increased and the exchange does not provide a
+// fills feed, a derived fill to fill subscribers. prev is nil for new orders
+func (s *store) publishOrderUpdate(prev, od *order.Detail) {
+	if prev != nil && prev.LastUpdated.Equal(od.LastUpdated) {
+		// Polling re-upserts unchanged orders
+		return
+	}
+	s.feed.orders.broadcast(od.Exchange, od.Account, od.AssetType, od.Pair, od.Copy())
+
+	var prevExecuted, prevCost float64
+	if prev != nil {
+		prevExecuted, prevCost = prev.ExecutedAmount, prev.Cost
+	}
+	executed := od.ExecutedAmount - prevExecuted
+	if executed <= 0 {
+		return
+	}
+	if s.exchangeManager == nil {
+		return
+	}
+	if exch, err := s.exchangeManager.GetExchangeByName(od.Exchange); err == nil && exch.GetBase().IsFillsFeedEnabled() {
+		return
+	}
+	price := od.AverageExecutedPrice
+	if cost := od.Cost - prevCost; cost > 0 {
+		price = cost / executed
+	} else if price == 0 {
+		price = od.Price
+	}
+	timestamp := od.LastUpdated
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+	s.feed.fills.broadcast(od.Exchange, od.Account, od.AssetType, od.Pair, fill.Data{
+		Timestamp:     timestamp,
+		Exchange:      od.Exchange,
+		AssetType:     od.AssetType,
+		CurrencyPair:  od.Pair,
+		Side:          od.Side,
+		OrderID:       od.OrderID,
+		ClientOrderID: od.ClientOrderID,
+		Price:         price,
+		Amount:        executed,
+	})
+}
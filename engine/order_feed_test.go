@@ -0,0 +1,132 @@
+package engine
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// orderFeedSetup returns a started order manager with the test exchange loaded
+// from the test config, without requiring network access
+func orderFeedSetup(t *testing.T) *OrderManager {
+	t.Helper()
+	cfg := &config.Config{}
+	require.NoError(t, cfg.LoadConfig(config.TestFile, true))
+	exchCfg, err := cfg.GetExchangeConfig(testExchange)
+	require.NoError(t, err)
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	require.NoError(t, exch.Setup(exchCfg))
+	require.NoError(t, em.Add(exch))
+	m, err := SetupOrderManager(em, &CommunicationManager{}, &sync.WaitGroup{}, &config.OrderManager{})
+	require.NoError(t, err)
+	m.started.Store(true)
+	return m
+}
+
+func TestOrderFeedFilterMatch(t *testing.T) {
+	t.Parallel()
+	pair := currency.NewBTCUSD()
+	for _, tc := range []struct {
+		filter OrderFeedFilter
+		match  bool
+	}{
+		{OrderFeedFilter{}, true},
+		{OrderFeedFilter{Exchange: "bitstamp"}, true},
+		{OrderFeedFilter{Exchange: "Kraken"}, false},
+		{OrderFeedFilter{Account: "default"}, true},
+		{OrderFeedFilter{Account: "hedge"}, false},
+		{OrderFeedFilter{Asset: asset.Spot}, true},
+		{OrderFeedFilter{Asset: asset.Futures}, false},
+		{OrderFeedFilter{Pair: currency.NewPairWithDelimiter("BTC", "USD", "-")}, true},
+		{OrderFeedFilter{Pair: currency.NewBTCUSDT()}, false},
+	} {
+		assert.Equalf(t, tc.match, tc.filter.match(testExchange, "default", asset.Spot, pair), "match should return correctly for %+v", tc.filter)
+	}
+}
+
+func TestSubscribeOrderUpdates(t *testing.T) {
+	t.Parallel()
+	_, err := (*OrderManager)(nil).SubscribeOrderUpdates(OrderFeedFilter{})
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+	_, err = (*OrderManager)(nil).SubscribeFills(OrderFeedFilter{})
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	m := orderFeedSetup(t)
+	pair := currency.NewBTCUSD()
+	orders, err := m.SubscribeOrderUpdates(OrderFeedFilter{Exchange: testExchange, Asset: asset.Spot})
+	require.NoError(t, err)
+	fills, err := m.SubscribeFills(OrderFeedFilter{Pair: pair})
+	require.NoError(t, err)
+	other, err := m.SubscribeOrderUpdates(OrderFeedFilter{Asset: asset.Futures})
+	require.NoError(t, err)
+
+	od := &order.Detail{
+		Exchange:    testExchange,
+		OrderID:     "feed",
+		AssetType:   asset.Spot,
+		Pair:        pair,
+		Side:        order.Buy,
+		Type:        order.Limit,
+		Status:      order.New,
+		Price:       100,
+		Amount:      2,
+		LastUpdated: time.Now().Add(-time.Minute),
+	}
+	_, err = m.UpsertOrder(od)
+	require.NoError(t, err)
+	require.Len(t, orders.Channel(), 1, "New orders must be published")
+	assert.Equal(t, order.New, (<-orders.Channel()).Status)
+	assert.Empty(t, fills.Channel(), "Orders without executed amounts should not publish fills")
+
+	_, err = m.UpsertOrder(od.CopyToPointer())
+	require.NoError(t, err)
+	assert.Empty(t, orders.Channel(), "Unchanged orders should not be published")
+
+	update := od.Copy()
+	update.Status = order.PartiallyFilled
+	update.ExecutedAmount = 0.5
+	update.RemainingAmount = 1.5
+	update.Cost = 49
+	update.LastUpdated = time.Now()
+	_, err = m.UpsertOrder(&update)
+	require.NoError(t, err)
+	require.Len(t, orders.Channel(), 1, "Changed orders must be published")
+	assert.Equal(t, order.PartiallyFilled, (<-orders.Channel()).Status)
+	require.Len(t, fills.Channel(), 1, "An increase in executed amount must publish a fill")
+	f := <-fills.Channel()
+	assert.Equal(t, "feed", f.OrderID)
+	assert.Equal(t, 0.5, f.Amount)
+	assert.Equal(t, 98.0, f.Price, "Fill price should be derived from the change in cost")
+	assert.Empty(t, other.Channel(), "Filtered subscriptions should not receive other orders")
+
+	require.NoError(t, orders.Close())
+	assert.ErrorIs(t, orders.Close(), errOrderFeedSubscriptionClosed)
+	_, ok := <-orders.Channel()
+	assert.False(t, ok, "Channel should be closed")
+}
+
+func TestPublishFills(t *testing.T) {
+	t.Parallel()
+	assert.ErrorIs(t, (*OrderManager)(nil).PublishFills(), ErrNilSubsystem)
+
+	m := orderFeedSetup(t)
+	sub, err := m.SubscribeFills(OrderFeedFilter{Exchange: testExchange})
+	require.NoError(t, err)
+	require.NoError(t, m.PublishFills(
+		fill.Data{Exchange: testExchange, AssetType: asset.Spot, CurrencyPair: currency.NewBTCUSD(), TradeID: "1"},
+		fill.Data{Exchange: "Kraken", AssetType: asset.Spot, CurrencyPair: currency.NewBTCUSD(), TradeID: "2"},
+	))
+	require.Len(t, sub.Channel(), 1, "Only fills matching the filter must be published")
+	assert.Equal(t, "1", (<-sub.Channel()).TradeID)
+}
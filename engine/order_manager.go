@@ -12,9 +12,11 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/tracing"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
@@ -22,6 +24,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/log"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // SetupOrderManager will boot up the OrderManager
@@ -141,7 +144,8 @@ func (m *OrderManager) run(ctx context.Context) {
 	}
 }
 
-// CancelAllOrders iterates and cancels all orders for each exchange provided
+// CancelAllOrders iterates and cancels all orders for each exchange provided,
+// using the account each order was placed with
 func (m *OrderManager) CancelAllOrders(ctx context.Context, exchanges []exchange.IBotExchange) {
 	m.cancelAllOrders(ctx, exchanges, true)
 }
@@ -168,12 +172,12 @@ func (m *OrderManager) cancelAllOrders(ctx context.Context, exchanges []exchange
 			log.Debugf(log.OrderMgr, "Cancelling order(s) for exchange %s.", exchanges[i].GetName())
 			cancel, err := orders[j].DeriveCancel()
 			if err != nil {
-				log.Errorln(log.OrderMgr, err)
+				log.ErrorlnWithFields(log.OrderMgr, orderLogFields(tracing.LogFields(ctx), orders[j]).WithError(err), err)
 				continue
 			}
-			err = m.cancel(ctx, cancel, requireRunning)
+			err = m.cancel(accounts.DeployAccountToContext(ctx, orders[j].Account), cancel, requireRunning)
 			if err != nil {
-				log.Errorln(log.OrderMgr, err)
+				log.ErrorlnWithFields(log.OrderMgr, orderLogFields(tracing.LogFields(ctx), orders[j]).WithError(err), err)
 			}
 		}
 	}
@@ -224,7 +228,9 @@ func (m *OrderManager) cancel(ctx context.Context, cancel *order.Cancel, require
 		return fmt.Errorf("%w %v", asset.ErrNotSupported, cancel.AssetType)
 	}
 
-	log.Debugf(log.OrderMgr, "Cancelling order ID %v [%+v]",
+	log.DebugWithFieldsf(log.OrderMgr,
+		tracing.LogFields(ctx).WithSubsystem(OrderManagerName).WithExchange(cancel.Exchange, cancel.AssetType, cancel.Pair).WithOrderID(cancel.OrderID),
+		"Cancelling order ID %v [%+v]",
 		cancel.OrderID, cancel)
 
 	err = exch.CancelOrder(ctx, cancel)
@@ -247,14 +253,15 @@ func (m *OrderManager) cancel(ctx context.Context, cancel *order.Cancel, require
 
 	msg := fmt.Sprintf("Exchange %s order ID=%v cancelled.",
 		od.Exchange, od.OrderID)
-	log.Debugln(log.OrderMgr, msg)
+	log.DebuglnWithFields(log.OrderMgr, orderLogFields(tracing.LogFields(ctx), od), msg)
 	m.orderStore.commsManager.PushEvent(base.Event{Type: "order", Message: msg})
 	return nil
 }
 
-// GetFuturesPositionsForExchange returns futures positions stored within
-// the order manager's futures position tracker that match the provided params
-func (m *OrderManager) GetFuturesPositionsForExchange(exch string, item asset.Item, pair currency.Pair) ([]futures.Position, error) {
+// GetFuturesPositionsForExchange returns futures positions of an exchange
+// account stored within the order manager's futures position tracker that
+// match the provided params
+func (m *OrderManager) GetFuturesPositionsForExchange(exch, account string, item asset.Item, pair currency.Pair) ([]futures.Position, error) {
 	if m == nil {
 		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
 	}
@@ -265,12 +272,13 @@ func (m *OrderManager) GetFuturesPositionsForExchange(exch string, item asset.It
 		return nil, fmt.Errorf("%v %w", item, futures.ErrNotFuturesAsset)
 	}
 
-	return m.orderStore.futuresPositionController.GetPositionsForExchange(exch, item, pair)
+	return m.orderStore.futuresPositionController.GetPositionsForExchange(exch, account, item, pair)
 }
 
-// GetOpenFuturesPosition returns an open futures position stored within
-// the order manager's futures position tracker that match the provided params
-func (m *OrderManager) GetOpenFuturesPosition(exch string, item asset.Item, pair currency.Pair) (*futures.Position, error) {
+// GetOpenFuturesPosition returns an open futures position of an exchange
+// account stored within the order manager's futures position tracker that
+// match the provided params
+func (m *OrderManager) GetOpenFuturesPosition(exch, account string, item asset.Item, pair currency.Pair) (*futures.Position, error) {
 	if m == nil {
 		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
 	}
@@ -283,7 +291,7 @@ func (m *OrderManager) GetOpenFuturesPosition(exch string, item asset.Item, pair
 	if !m.activelyTrackFuturesPositions {
 		return nil, errFuturesTrackingDisabled
 	}
-	return m.orderStore.futuresPositionController.GetOpenPosition(exch, item, pair)
+	return m.orderStore.futuresPositionController.GetOpenPosition(exch, account, item, pair)
 }
 
 // GetAllOpenFuturesPositions returns all open futures positions stored within
@@ -301,9 +309,10 @@ func (m *OrderManager) GetAllOpenFuturesPositions() ([]futures.Position, error)
 	return m.orderStore.futuresPositionController.GetAllOpenPositions()
 }
 
-// ClearFuturesTracking will clear existing futures positions for a given exchange,
-// asset, pair for the event that positions have not been tracked accurately
-func (m *OrderManager) ClearFuturesTracking(exch string, item asset.Item, pair currency.Pair) error {
+// ClearFuturesTracking will clear existing futures positions for a given exchange
+// account, asset, pair for the event that positions have not been tracked
+// accurately
+func (m *OrderManager) ClearFuturesTracking(exch, account string, item asset.Item, pair currency.Pair) error {
 	if m == nil {
 		return fmt.Errorf("order manager %w", ErrNilSubsystem)
 	}
@@ -314,13 +323,13 @@ func (m *OrderManager) ClearFuturesTracking(exch string, item asset.Item, pair c
 		return fmt.Errorf("%v %w", item, futures.ErrNotFuturesAsset)
 	}
 
-	return m.orderStore.futuresPositionController.ClearPositionsForExchange(exch, item, pair)
+	return m.orderStore.futuresPositionController.ClearPositionsForExchange(exch, account, item, pair)
 }
 
 // UpdateOpenPositionUnrealisedPNL finds an open position from
-// an exchange asset pair, then calculates the unrealisedPNL
+// an exchange account asset pair, then calculates the unrealisedPNL
 // using the latest ticker data
-func (m *OrderManager) UpdateOpenPositionUnrealisedPNL(e string, item asset.Item, pair currency.Pair, last float64, updated time.Time) (decimal.Decimal, error) {
+func (m *OrderManager) UpdateOpenPositionUnrealisedPNL(e, account string, item asset.Item, pair currency.Pair, last float64, updated time.Time) (decimal.Decimal, error) {
 	if m == nil {
 		return decimal.Zero, fmt.Errorf("order manager %w", ErrNilSubsystem)
 	}
@@ -331,7 +340,7 @@ func (m *OrderManager) UpdateOpenPositionUnrealisedPNL(e string, item asset.Item
 		return decimal.Zero, fmt.Errorf("%v %w", item, futures.ErrNotFuturesAsset)
 	}
 
-	return m.orderStore.futuresPositionController.UpdateOpenPositionUnrealisedPNL(e, item, pair, last, updated)
+	return m.orderStore.futuresPositionController.UpdateOpenPositionUnrealisedPNL(e, account, item, pair, last, updated)
 }
 
 // GetOrderInfo calls the exchange's wrapper GetOrderInfo function
@@ -356,6 +365,7 @@ func (m *OrderManager) GetOrderInfo(ctx context.Context, exchangeName, orderID s
 	if err != nil {
 		return order.Detail{}, err
 	}
+	result.Account = accounts.AccountFromContext(ctx)
 
 	upsertResponse, err := m.orderStore.upsert(result)
 	if err != nil {
@@ -482,14 +492,46 @@ func (m *OrderManager) Submit(ctx context.Context, newOrder *order.Submit) (*Ord
 	if newOrder == nil {
 		return nil, errNilOrder
 	}
+	ctx, span := tracing.Start(ctx, "OrderManager.Submit",
+		attribute.String("exchange", newOrder.Exchange),
+		attribute.String("asset", newOrder.AssetType.String()),
+		attribute.String("pair", newOrder.Pair.String()),
+		attribute.String("side", newOrder.Side.String()),
+		attribute.String("type", newOrder.Type.String()))
+	resp, err := m.submit(ctx, newOrder)
+	tracing.End(span, err)
+	return resp, err
+}
+
+func (m *OrderManager) submit(ctx context.Context, newOrder *order.Submit) (*OrderSubmitResponse, error) {
 	exch, err := m.orderStore.exchangeManager.GetExchangeByName(newOrder.Exchange)
 	if err != nil {
 		return nil, err
 	}
-	err = m.validate(exch, newOrder)
+	_, validateSpan := tracing.Start(ctx, "OrderManager.Validate")
+	err = m.validateSubmission(exch, newOrder)
+	tracing.End(validateSpan, err)
+	if err != nil {
+		return nil, err
+	}
+
+	exchCtx, exchSpan := tracing.Start(ctx, "exchange.SubmitOrder")
+	result, err := exch.SubmitOrder(exchCtx, newOrder)
+	tracing.End(exchSpan, err)
 	if err != nil {
 		return nil, err
 	}
+
+	return m.processSubmittedOrder(ctx, result)
+}
+
+// validateSubmission validates the order along with exchange execution limits
+// and trading status for the pair
+func (m *OrderManager) validateSubmission(exch exchange.IBotExchange, newOrder *order.Submit) error {
+	err := m.validate(exch, newOrder)
+	if err != nil {
+		return err
+	}
 	// Checks for exchange min max limits for order amounts before order
 	// execution can occur
 	err = exch.CheckOrderExecutionLimits(newOrder.AssetType,
@@ -498,7 +540,7 @@ func (m *OrderManager) Submit(ctx context.Context, newOrder *order.Submit) (*Ord
 		newOrder.Amount,
 		newOrder.Type)
 	if err != nil && errors.Is(err, currencystate.ErrCurrencyStateNotFound) {
-		return nil, fmt.Errorf("order manager: exchange %s unable to place order: %w",
+		return fmt.Errorf("order manager: exchange %s unable to place order: %w",
 			newOrder.Exchange,
 			err)
 	}
@@ -506,19 +548,13 @@ func (m *OrderManager) Submit(ctx context.Context, newOrder *order.Submit) (*Ord
 	// the currency pair
 	err = exch.CanTradePair(newOrder.Pair, newOrder.AssetType)
 	if err != nil {
-		return nil, fmt.Errorf("order manager: exchange %s cannot trade pair %s %s: %w",
+		return fmt.Errorf("order manager: exchange %s cannot trade pair %s %s: %w",
 			newOrder.Exchange,
 			newOrder.Pair,
 			newOrder.AssetType,
 			err)
 	}
-
-	result, err := exch.SubmitOrder(ctx, newOrder)
-	if err != nil {
-		return nil, err
-	}
-
-	return m.processSubmittedOrder(result)
+	return nil
 }
 
 // SubmitFakeOrder runs through the same process as order submission
@@ -555,7 +591,7 @@ func (m *OrderManager) SubmitFakeOrder(newOrder *order.Submit, resultingOrder *o
 				err)
 		}
 	}
-	return m.processSubmittedOrder(resultingOrder)
+	return m.processSubmittedOrder(context.Background(), resultingOrder)
 }
 
 // GetOrdersSnapshot returns a snapshot of all orders in the orderstore. It optionally filters any orders that do not match the status
@@ -578,6 +614,25 @@ func (m *OrderManager) GetOrdersSnapshot(s order.Status) []order.Detail {
 	return os
 }
 
+// GetOrderStatusCounts returns the number of stored orders for each exchange
+// and order status
+func (m *OrderManager) GetOrderStatusCounts() map[string]map[order.Status]int {
+	if m == nil || !m.started.Load() {
+		return nil
+	}
+	m.orderStore.m.RLock()
+	defer m.orderStore.m.RUnlock()
+	counts := make(map[string]map[order.Status]int, len(m.orderStore.Orders))
+	for exch, orders := range m.orderStore.Orders {
+		statuses := make(map[order.Status]int)
+		for i := range orders {
+			statuses[orders[i].Status]++
+		}
+		counts[exch] = statuses
+	}
+	return counts
+}
+
 // GetOrdersFiltered returns a snapshot of all orders in the order store.
 // Filtering is applied based on the order.Filter unless entries are empty
 func (m *OrderManager) GetOrdersFiltered(f *order.Filter) ([]order.Detail, error) {
@@ -603,7 +658,7 @@ func (m *OrderManager) GetOrdersActive(f *order.Filter) ([]order.Detail, error)
 }
 
 // processSubmittedOrder adds a new order to the manager
-func (m *OrderManager) processSubmittedOrder(newOrderResp *order.SubmitResponse) (*OrderSubmitResponse, error) {
+func (m *OrderManager) processSubmittedOrder(ctx context.Context, newOrderResp *order.SubmitResponse) (*OrderSubmitResponse, error) {
 	if newOrderResp == nil {
 		return nil, order.ErrOrderDetailIsNil
 	}
@@ -617,13 +672,14 @@ func (m *OrderManager) processSubmittedOrder(newOrderResp *order.SubmitResponse)
 	if err != nil {
 		return nil, err
 	}
+	detail.Account = accounts.AccountFromContext(ctx)
 
 	if err := m.orderStore.add(detail.CopyToPointer()); errors.Is(err, ErrOrdersAlreadyExists) {
 		// Streamed by ws before we got here. Details from ws supersede since they are more recent.
 		detail = m.orderStore.getByDetail(detail)
 	} else if err != nil {
 		// Non-fatal error: Unable to store order, but error does not need to be returned to caller
-		log.Errorf(log.OrderMgr, "Unable to add %v order %v to orderStore: %s", detail.Exchange, detail.OrderID, err)
+		log.ErrorWithFieldsf(log.OrderMgr, orderLogFields(tracing.LogFields(ctx), detail).WithError(err), "Unable to add %v order %v to orderStore: %s", detail.Exchange, detail.OrderID, err)
 	}
 
 	msg := fmt.Sprintf("Exchange %s submitted order ID=%v [Ours: %v] pair=%v price=%v amount=%v quoteAmount=%v side=%v type=%v for time %v.",
@@ -638,7 +694,7 @@ func (m *OrderManager) processSubmittedOrder(newOrderResp *order.SubmitResponse)
 		detail.Type,
 		detail.Date)
 
-	log.Debugln(log.OrderMgr, msg)
+	log.DebuglnWithFields(log.OrderMgr, orderLogFields(tracing.LogFields(ctx), detail), msg)
 	if m.orderStore.commsManager != nil {
 		m.orderStore.commsManager.PushEvent(base.Event{Type: "order", Message: msg})
 	}
@@ -663,118 +719,148 @@ func (m *OrderManager) processOrders(ctx context.Context) {
 		if !exchanges[x].IsRESTAuthenticationSupported() {
 			continue
 		}
-		if m.verbose {
-			log.Debugf(log.OrderMgr,
-				"Processing orders for exchange %v",
-				exchanges[x].GetName())
-		}
-		enabledAssets := exchanges[x].GetAssetTypes(true)
-		for y := range enabledAssets {
-			var pairs currency.Pairs
-			pairs, err = exchanges[x].GetEnabledPairs(enabledAssets[y])
-			if err != nil {
-				log.Errorf(log.OrderMgr,
-					"Unable to get enabled pairs for %s and asset type %s: %s",
+		for _, account := range exchanges[x].GetAccountNames() {
+			if m.verbose {
+				log.Debugf(log.OrderMgr,
+					"Processing orders for exchange %v account %v",
 					exchanges[x].GetName(),
-					enabledAssets[y],
-					err)
-				continue
+					account)
 			}
+			m.processAccountOrders(accounts.DeployAccountToContext(ctx, account), exchanges[x], account, &wg)
+		}
+	}
+	wg.Wait()
+	m.lastPoll.Store(time.Now().UnixNano())
+	if m.verbose {
+		log.Debugf(log.OrderMgr, "Finished processing orders")
+	}
+}
 
-			if len(pairs) == 0 {
-				if m.verbose {
-					log.Debugf(log.OrderMgr,
-						"No pairs enabled for %s and asset type %s, skipping...",
-						exchanges[x].GetName(),
-						enabledAssets[y])
-				}
-				continue
+// processAccountOrders fetches the active orders and futures positions of an
+// exchange account, with its credentials set on the context, and adds them to
+// the internal order store
+func (m *OrderManager) processAccountOrders(ctx context.Context, exch exchange.IBotExchange, account string, wg *sync.WaitGroup) {
+	var err error
+	enabledAssets := exch.GetAssetTypes(true)
+	for y := range enabledAssets {
+		var pairs currency.Pairs
+		pairs, err = exch.GetEnabledPairs(enabledAssets[y])
+		if err != nil {
+			log.ErrorWithFieldsf(log.OrderMgr,
+				log.ExtraFields{}.WithSubsystem(OrderManagerName).WithExchange(exch.GetName(), enabledAssets[y], nil).WithError(err),
+				"Unable to get enabled pairs for %s and asset type %s: %s",
+				exch.GetName(),
+				enabledAssets[y],
+				err)
+			continue
+		}
+
+		if len(pairs) == 0 {
+			if m.verbose {
+				log.Debugf(log.OrderMgr,
+					"No pairs enabled for %s and asset type %s, skipping...",
+					exch.GetName(),
+					enabledAssets[y])
 			}
+			continue
+		}
 
-			filter := &order.Filter{Exchange: exchanges[x].GetName()}
-			orders := m.orderStore.getActiveOrders(filter)
-			order.FilterOrdersByPairs(&orders, pairs)
-			var result []order.Detail
-			result, err = exchanges[x].GetActiveOrders(ctx, &order.MultiOrderRequest{
-				Side:      order.AnySide,
-				Type:      order.AnyType,
-				Pairs:     pairs,
-				AssetType: enabledAssets[y],
-			})
+		filter := &order.Filter{Exchange: exch.GetName(), Account: account}
+		orders := m.orderStore.getActiveOrders(filter)
+		order.FilterOrdersByPairs(&orders, pairs)
+		var result []order.Detail
+		result, err = exch.GetActiveOrders(ctx, &order.MultiOrderRequest{
+			Side:      order.AnySide,
+			Type:      order.AnyType,
+			Pairs:     pairs,
+			AssetType: enabledAssets[y],
+		})
+		if err != nil {
+			log.ErrorWithFieldsf(log.OrderMgr,
+				log.ExtraFields{}.WithSubsystem(OrderManagerName).WithExchange(exch.GetName(), enabledAssets[y], nil).WithError(err),
+				"Unable to get active orders for %s and asset type %s: %s",
+				exch.GetName(),
+				enabledAssets[y],
+				err)
+			continue
+		}
+		for z := range result {
+			result[z].Account = account
+			var upsertResponse *OrderUpsertResponse
+			upsertResponse, err = m.UpsertOrder(&result[z])
 			if err != nil {
-				log.Errorf(log.OrderMgr,
-					"Unable to get active orders for %s and asset type %s: %s",
-					exchanges[x].GetName(),
-					enabledAssets[y],
-					err)
+				log.ErrorlnWithFields(log.OrderMgr, orderLogFields(nil, &result[z]).WithError(err), err)
 				continue
 			}
-			for z := range result {
-				var upsertResponse *OrderUpsertResponse
-				upsertResponse, err = m.UpsertOrder(&result[z])
-				if err != nil {
-					log.Errorln(log.OrderMgr, err)
+			for i := range orders {
+				if orders[i].InternalOrderID != upsertResponse.OrderDetails.InternalOrderID {
 					continue
 				}
-				for i := range orders {
-					if orders[i].InternalOrderID != upsertResponse.OrderDetails.InternalOrderID {
-						continue
-					}
-					orders[i] = orders[len(orders)-1]
-					orders = orders[:len(orders)-1]
-					break
-				}
+				orders[i] = orders[len(orders)-1]
+				orders = orders[:len(orders)-1]
+				break
 			}
+		}
 
-			if exchanges[x].GetBase().GetSupportedFeatures().RESTCapabilities.GetOrder {
-				wg.Add(1)
-				go m.processMatchingOrders(ctx, exchanges[x], orders, &wg)
-			}
+		if exch.GetBase().GetSupportedFeatures().RESTCapabilities.GetOrder {
+			wg.Add(1)
+			go m.processMatchingOrders(ctx, exch, orders, wg)
+		}
 
-			supportedFeatures := exchanges[x].GetSupportedFeatures()
-			if m.activelyTrackFuturesPositions && enabledAssets[y].IsFutures() && supportedFeatures.FuturesCapabilities.OrderManagerPositionTracking {
-				var positions []futures.PositionResponse
-				var sd time.Time
-				sd, err = m.orderStore.futuresPositionController.LastUpdated()
-				if err != nil {
-					log.Errorln(log.OrderMgr, err)
-					return
+		supportedFeatures := exch.GetSupportedFeatures()
+		if m.activelyTrackFuturesPositions && enabledAssets[y].IsFutures() && supportedFeatures.FuturesCapabilities.OrderManagerPositionTracking {
+			var positions []futures.PositionResponse
+			var sd time.Time
+			sd, err = m.orderStore.futuresPositionController.LastUpdated()
+			if err != nil {
+				log.Errorln(log.OrderMgr, err)
+				return
+			}
+			if sd.IsZero() {
+				sd = time.Now().Add(-m.futuresPositionSeekDuration)
+			}
+			positions, err = exch.GetFuturesPositionOrders(ctx, &futures.PositionsRequest{
+				Asset:                     enabledAssets[y],
+				Pairs:                     pairs,
+				StartDate:                 sd,
+				RespectOrderHistoryLimits: m.respectOrderHistoryLimits,
+			})
+			if err != nil {
+				if !errors.Is(err, common.ErrNotYetImplemented) {
+					log.ErrorlnWithFields(log.OrderMgr, log.ExtraFields{}.WithSubsystem(OrderManagerName).WithExchange(exch.GetName(), enabledAssets[y], nil).WithError(err), err)
 				}
-				if sd.IsZero() {
-					sd = time.Now().Add(-m.futuresPositionSeekDuration)
+				return
+			}
+			for z := range positions {
+				if len(positions[z].Orders) == 0 {
+					continue
 				}
-				positions, err = exchanges[x].GetFuturesPositionOrders(ctx, &futures.PositionsRequest{
-					Asset:                     enabledAssets[y],
-					Pairs:                     pairs,
-					StartDate:                 sd,
-					RespectOrderHistoryLimits: m.respectOrderHistoryLimits,
-				})
+				err = m.processFuturesPositions(ctx, exch, account, &positions[z])
 				if err != nil {
-					if !errors.Is(err, common.ErrNotYetImplemented) {
-						log.Errorln(log.OrderMgr, err)
-					}
-					return
-				}
-				for z := range positions {
-					if len(positions[z].Orders) == 0 {
-						continue
-					}
-					err = m.processFuturesPositions(ctx, exchanges[x], &positions[z])
-					if err != nil {
-						log.Errorf(log.OrderMgr, "unable to process future positions for %v %v %v. err: %v", exchanges[x].GetName(), positions[z].Asset, positions[z].Pair, err)
-					}
+					log.ErrorWithFieldsf(log.OrderMgr,
+						log.ExtraFields{}.WithSubsystem(OrderManagerName).WithExchange(exch.GetName(), positions[z].Asset, positions[z].Pair).WithError(err),
+						"unable to process future positions for %v %v %v. err: %v", exch.GetName(), positions[z].Asset, positions[z].Pair, err)
 				}
 			}
 		}
 	}
-	wg.Wait()
-	if m.verbose {
-		log.Debugf(log.OrderMgr, "Finished processing orders")
+}
+
+// LastPoll returns when exchange orders were last processed. A zero time is
+// returned if orders have not been processed since the subsystem started
+func (m *OrderManager) LastPoll() time.Time {
+	if m == nil {
+		return time.Time{}
+	}
+	if last := m.lastPoll.Load(); last != 0 {
+		return time.Unix(0, last)
 	}
+	return time.Time{}
 }
 
-// processFuturesPositions ensures any open position found is kept up to date in the order manager
-func (m *OrderManager) processFuturesPositions(ctx context.Context, exch exchange.IBotExchange, position *futures.PositionResponse) error {
+// processFuturesPositions ensures any open position found for an exchange
+// account is kept up to date in the order manager
+func (m *OrderManager) processFuturesPositions(ctx context.Context, exch exchange.IBotExchange, account string, position *futures.PositionResponse) error {
 	if !m.activelyTrackFuturesPositions {
 		return errFuturesTrackingDisabled
 	}
@@ -793,12 +879,13 @@ func (m *OrderManager) processFuturesPositions(ctx context.Context, exch exchang
 	feat := exch.GetSupportedFeatures()
 	var err error
 	for i := range position.Orders {
+		position.Orders[i].Account = account
 		err = m.orderStore.futuresPositionController.TrackNewOrder(&position.Orders[i])
 		if err != nil {
 			return err
 		}
 	}
-	_, err = m.orderStore.futuresPositionController.GetOpenPosition(exch.GetName(), position.Asset, position.Pair)
+	_, err = m.orderStore.futuresPositionController.GetOpenPosition(exch.GetName(), account, position.Asset, position.Pair)
 	if err != nil {
 		if errors.Is(err, futures.ErrPositionNotFound) {
 			return nil
@@ -809,7 +896,7 @@ func (m *OrderManager) processFuturesPositions(ctx context.Context, exch exchang
 	if err != nil {
 		return fmt.Errorf("%w when fetching ticker data for %v %v %v", err, exch.GetName(), position.Asset, position.Pair)
 	}
-	_, err = m.UpdateOpenPositionUnrealisedPNL(exch.GetName(), position.Asset, position.Pair, tick.Last, tick.LastUpdated)
+	_, err = m.UpdateOpenPositionUnrealisedPNL(exch.GetName(), account, position.Asset, position.Pair, tick.Last, tick.LastUpdated)
 	if err != nil {
 		return fmt.Errorf("%w when updating unrealised PNL for %v %v %v", err, exch.GetName(), position.Asset, position.Pair)
 	}
@@ -835,7 +922,7 @@ func (m *OrderManager) processFuturesPositions(ctx context.Context, exch exchang
 		return err
 	}
 
-	return m.orderStore.futuresPositionController.TrackFundingDetails(frp)
+	return m.orderStore.futuresPositionController.TrackFundingDetails(account, frp)
 }
 
 func (m *OrderManager) processMatchingOrders(ctx context.Context, exch exchange.IBotExchange, orders []order.Detail, wg *sync.WaitGroup) {
@@ -845,7 +932,7 @@ func (m *OrderManager) processMatchingOrders(ctx context.Context, exch exchange.
 		}
 		err := m.FetchAndUpdateExchangeOrder(ctx, exch, &orders[x], orders[x].AssetType)
 		if err != nil {
-			log.Errorln(log.OrderMgr, err)
+			log.ErrorlnWithFields(log.OrderMgr, orderLogFields(tracing.LogFields(ctx), &orders[x]).WithError(err), err)
 		}
 	}
 	if wg != nil {
@@ -864,6 +951,7 @@ func (m *OrderManager) FetchAndUpdateExchangeOrder(ctx context.Context, exch exc
 		return err
 	}
 	fetchedOrder.LastUpdated = time.Now()
+	fetchedOrder.Account = ord.Account
 	_, err = m.UpsertOrder(fetchedOrder)
 	return err
 }
@@ -947,13 +1035,19 @@ func (m *OrderManager) UpsertOrder(od *order.Detail) (resp *OrderUpsertResponse,
 		upsertResponse.OrderDetails.Pair, upsertResponse.OrderDetails.Price, upsertResponse.OrderDetails.Amount,
 		upsertResponse.OrderDetails.Side, upsertResponse.OrderDetails.Type, upsertResponse.OrderDetails.Status)
 	if upsertResponse.IsNewOrder {
-		log.Infoln(log.OrderMgr, msg)
+		log.InfolnWithFields(log.OrderMgr, orderLogFields(nil, &upsertResponse.OrderDetails), msg)
 		return upsertResponse, nil
 	}
-	log.Debugln(log.OrderMgr, msg)
+	log.DebuglnWithFields(log.OrderMgr, orderLogFields(nil, &upsertResponse.OrderDetails), msg)
 	return upsertResponse, nil
 }
 
+// orderLogFields adds the order manager subsystem and an order's exchange,
+// asset, pair and ID to structured logging fields
+func orderLogFields(fields log.ExtraFields, d *order.Detail) log.ExtraFields {
+	return fields.WithSubsystem(OrderManagerName).WithExchange(d.Exchange, d.AssetType, d.Pair).WithOrderID(d.OrderID)
+}
+
 // get returns a copy of all orders for all exchanges.
 func (s *store) get() map[string][]*order.Detail {
 	orders := make(map[string][]*order.Detail)
@@ -998,10 +1092,12 @@ func (s *store) updateExisting(od *order.Detail) error {
 		if r[x].OrderID != od.OrderID {
 			continue
 		}
+		prev := r[x].Copy()
 		err := r[x].UpdateOrderFromDetail(od)
 		if err != nil {
 			return err
 		}
+		s.publishOrderUpdate(&prev, r[x])
 		if !r[x].AssetType.IsFutures() {
 			return nil
 		}
@@ -1027,7 +1123,9 @@ func (s *store) modifyExisting(id string, mod *order.ModifyResponse) error {
 		if r[x].OrderID != id {
 			continue
 		}
+		prev := r[x].Copy()
 		r[x].UpdateOrderFromModifyResponse(mod)
+		s.publishOrderUpdate(&prev, r[x])
 		if !r[x].AssetType.IsFutures() {
 			return nil
 		}
@@ -1053,6 +1151,7 @@ func (s *store) upsert(od *order.Detail) (*OrderUpsertResponse, error) {
 	}
 	s.m.Lock()
 	defer s.m.Unlock()
+	s.setAccount(lName, od)
 	if od.AssetType.IsFutures() {
 		err = s.futuresPositionController.TrackNewOrder(od)
 		if err != nil && !errors.Is(err, futures.ErrPositionClosed) {
@@ -1066,10 +1165,12 @@ func (s *store) upsert(od *order.Detail) (*OrderUpsertResponse, error) {
 		if exchangeOrders[x].OrderID != od.OrderID {
 			continue
 		}
+		prev := exchangeOrders[x].Copy()
 		err := exchangeOrders[x].UpdateOrderFromDetail(od)
 		if err != nil {
 			return nil, err
 		}
+		s.publishOrderUpdate(&prev, exchangeOrders[x])
 		return &OrderUpsertResponse{
 			OrderDetails: exchangeOrders[x].Copy(),
 			IsNewOrder:   false,
@@ -1078,9 +1179,26 @@ func (s *store) upsert(od *order.Detail) (*OrderUpsertResponse, error) {
 	// Untracked websocket orders will not have internalIDs yet
 	od.GenerateInternalOrderID()
 	s.Orders[lName] = append(s.Orders[lName], od)
+	s.publishOrderUpdate(nil, od)
 	return &OrderUpsertResponse{OrderDetails: od.Copy(), IsNewOrder: true}, nil
 }
 
+// setAccount sets the account of an order without one to the account of the
+// stored order, or else the default account, as orders streamed by websocket
+// are not labelled. The caller must hold the lock
+func (s *store) setAccount(exch string, od *order.Detail) {
+	if od.Account != "" {
+		return
+	}
+	od.Account = accounts.DefaultAccount
+	for _, o := range s.Orders[exch] {
+		if o.OrderID == od.OrderID {
+			od.Account = o.Account
+			return
+		}
+	}
+}
+
 // exists verifies if the orderstore contains the provided order
 func (s *store) exists(det *order.Detail) bool {
 	return s.getByDetail(det) != nil
@@ -1126,7 +1244,9 @@ func (s *store) add(det *order.Detail) error {
 
 	// Untracked websocket orders will not have internalIDs yet
 	det.GenerateInternalOrderID()
+	s.setAccount(name, det)
 	s.Orders[name] = append(s.Orders[name], det)
+	s.publishOrderUpdate(nil, det)
 	if !det.AssetType.IsFutures() {
 		return nil
 	}
@@ -15,12 +15,20 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 // omfExchange aka order manager fake exchange overrides exchange functions
@@ -722,6 +730,54 @@ func TestSubmit(t *testing.T) {
 	}
 }
 
+// submitTracingExchange allows any pair to be traded so that order submission
+// reaches the exchange without currency states being set up
+type submitTracingExchange struct {
+	*sharedtestvalues.CustomEx
+}
+
+func (submitTracingExchange) CanTradePair(currency.Pair, asset.Item) error {
+	return nil
+}
+
+func TestSubmitTracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	em := NewExchangeManager()
+	exch := submitTracingExchange{&sharedtestvalues.CustomEx{}}
+	require.NoError(t, em.Add(exch), "Add must not error")
+	m, err := SetupOrderManager(em, &CommunicationManager{}, &sync.WaitGroup{}, &config.OrderManager{})
+	require.NoError(t, err, "SetupOrderManager must not error")
+	m.started.Store(true)
+
+	_, err = m.Submit(t.Context(), &order.Submit{
+		Type:      order.Market,
+		Pair:      btcusdPair,
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Amount:    1,
+		Exchange:  exch.GetName(),
+	})
+	require.ErrorIs(t, err, order.ErrOrderDetailIsNil)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+	assert.Equal(t, "OrderManager.Validate", spans[0].Name())
+	assert.Equal(t, codes.Unset, spans[0].Status().Code)
+	assert.Equal(t, "exchange.SubmitOrder", spans[1].Name())
+	assert.Equal(t, codes.Unset, spans[1].Status().Code)
+	assert.Equal(t, "OrderManager.Submit", spans[2].Name())
+	assert.Equal(t, codes.Error, spans[2].Status().Code)
+	assert.Contains(t, spans[2].Attributes(), attribute.String("exchange", exch.GetName()))
+	assert.Contains(t, spans[2].Attributes(), attribute.String("pair", btcusdPair.String()))
+	for _, s := range spans[:2] {
+		assert.Equal(t, spans[2].SpanContext().SpanID(), s.Parent().SpanID(), "%s should be a child of OrderManager.Submit", s.Name())
+	}
+}
+
 // TestSubmitOrderAlreadyInStore ensures that if an order is submitted, but the WS sees the conf before processSubmittedOrder
 // then we don't error that it was there already
 func TestSubmitOrderAlreadyInStore(t *testing.T) {
@@ -1083,6 +1139,28 @@ func TestGetFilteredOrders(t *testing.T) {
 	}
 }
 
+func TestOrderStoreAccounts(t *testing.T) {
+	t.Parallel()
+	m := orderFeedSetup(t)
+	require.NoError(t, m.orderStore.add(&order.Detail{Exchange: testExchange, OrderID: "Test1"}))
+	require.NoError(t, m.orderStore.add(&order.Detail{Exchange: testExchange, OrderID: "Test2", Account: "hedge"}))
+
+	od, err := m.orderStore.getByExchangeAndID(testExchange, "Test1")
+	require.NoError(t, err)
+	assert.Equal(t, accounts.DefaultAccount, od.Account, "order without an account should be stored under the default account")
+
+	_, err = m.orderStore.upsert(&order.Detail{Exchange: testExchange, OrderID: "Test2", Status: order.Filled})
+	require.NoError(t, err)
+	od, err = m.orderStore.getByExchangeAndID(testExchange, "Test2")
+	require.NoError(t, err)
+	assert.Equal(t, "hedge", od.Account, "upsert without an account should keep the stored account")
+
+	res, err := m.GetOrdersFiltered(&order.Filter{Exchange: testExchange, Account: "hedge"})
+	require.NoError(t, err)
+	require.Len(t, res, 1, "must return only orders of the account")
+	assert.Equal(t, "Test2", res[0].OrderID)
+}
+
 func TestGetOrdersActive(t *testing.T) {
 	m := OrdersSetup(t)
 	var err error
@@ -1252,15 +1330,15 @@ func TestGetFuturesPositionsForExchange(t *testing.T) {
 	t.Parallel()
 	o := &OrderManager{}
 	cp := currency.NewBTCUSDT()
-	_, err := o.GetFuturesPositionsForExchange("test", asset.Spot, cp)
+	_, err := o.GetFuturesPositionsForExchange("test", "", asset.Spot, cp)
 	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
 
 	o.started.Store(true)
 	o.orderStore.futuresPositionController = futures.SetupPositionController()
-	_, err = o.GetFuturesPositionsForExchange("test", asset.Spot, cp)
+	_, err = o.GetFuturesPositionsForExchange("test", "", asset.Spot, cp)
 	assert.ErrorIs(t, err, futures.ErrNotFuturesAsset)
 
-	_, err = o.GetFuturesPositionsForExchange("test", asset.Futures, cp)
+	_, err = o.GetFuturesPositionsForExchange("test", "", asset.Futures, cp)
 	assert.ErrorIs(t, err, futures.ErrPositionNotFound)
 
 	err = o.orderStore.futuresPositionController.TrackNewOrder(&order.Detail{
@@ -1275,7 +1353,7 @@ func TestGetFuturesPositionsForExchange(t *testing.T) {
 	})
 	assert.NoError(t, err)
 
-	resp, err := o.GetFuturesPositionsForExchange("test", asset.Futures, cp)
+	resp, err := o.GetFuturesPositionsForExchange("test", "", asset.Futures, cp)
 	assert.NoError(t, err)
 
 	if len(resp) != 1 {
@@ -1283,7 +1361,7 @@ func TestGetFuturesPositionsForExchange(t *testing.T) {
 	}
 
 	o = nil
-	_, err = o.GetFuturesPositionsForExchange("test", asset.Futures, cp)
+	_, err = o.GetFuturesPositionsForExchange("test", "", asset.Futures, cp)
 	assert.ErrorIs(t, err, ErrNilSubsystem)
 }
 
@@ -1291,15 +1369,15 @@ func TestClearFuturesPositionsForExchange(t *testing.T) {
 	t.Parallel()
 	o := &OrderManager{}
 	cp := currency.NewBTCUSDT()
-	err := o.ClearFuturesTracking("test", asset.Spot, cp)
+	err := o.ClearFuturesTracking("test", "", asset.Spot, cp)
 	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
 
 	o.started.Store(true)
 	o.orderStore.futuresPositionController = futures.SetupPositionController()
-	err = o.ClearFuturesTracking("test", asset.Spot, cp)
+	err = o.ClearFuturesTracking("test", "", asset.Spot, cp)
 	assert.ErrorIs(t, err, futures.ErrNotFuturesAsset)
 
-	err = o.ClearFuturesTracking("test", asset.Futures, cp)
+	err = o.ClearFuturesTracking("test", "", asset.Futures, cp)
 	assert.ErrorIs(t, err, futures.ErrPositionNotFound)
 
 	err = o.orderStore.futuresPositionController.TrackNewOrder(&order.Detail{
@@ -1314,10 +1392,10 @@ func TestClearFuturesPositionsForExchange(t *testing.T) {
 	})
 	assert.NoError(t, err)
 
-	err = o.ClearFuturesTracking("test", asset.Futures, cp)
+	err = o.ClearFuturesTracking("test", "", asset.Futures, cp)
 	assert.NoError(t, err)
 
-	resp, err := o.GetFuturesPositionsForExchange("test", asset.Futures, cp)
+	resp, err := o.GetFuturesPositionsForExchange("test", "", asset.Futures, cp)
 	assert.NoError(t, err)
 
 	if len(resp) != 0 {
@@ -1325,7 +1403,7 @@ func TestClearFuturesPositionsForExchange(t *testing.T) {
 	}
 
 	o = nil
-	err = o.ClearFuturesTracking("test", asset.Futures, cp)
+	err = o.ClearFuturesTracking("test", "", asset.Futures, cp)
 	assert.ErrorIs(t, err, ErrNilSubsystem)
 }
 
@@ -1333,15 +1411,15 @@ func TestUpdateOpenPositionUnrealisedPNL(t *testing.T) {
 	t.Parallel()
 	o := &OrderManager{}
 	cp := currency.NewBTCUSDT()
-	_, err := o.UpdateOpenPositionUnrealisedPNL("test", asset.Spot, cp, 1, time.Now())
+	_, err := o.UpdateOpenPositionUnrealisedPNL("test", "", asset.Spot, cp, 1, time.Now())
 	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
 
 	o.started.Store(true)
 	o.orderStore.futuresPositionController = futures.SetupPositionController()
-	_, err = o.UpdateOpenPositionUnrealisedPNL("test", asset.Spot, cp, 1, time.Now())
+	_, err = o.UpdateOpenPositionUnrealisedPNL("test", "", asset.Spot, cp, 1, time.Now())
 	assert.ErrorIs(t, err, futures.ErrNotFuturesAsset)
 
-	_, err = o.UpdateOpenPositionUnrealisedPNL("test", asset.Futures, cp, 1, time.Now())
+	_, err = o.UpdateOpenPositionUnrealisedPNL("test", "", asset.Futures, cp, 1, time.Now())
 	assert.ErrorIs(t, err, futures.ErrPositionNotFound)
 
 	err = o.orderStore.futuresPositionController.TrackNewOrder(&order.Detail{
@@ -1356,7 +1434,7 @@ func TestUpdateOpenPositionUnrealisedPNL(t *testing.T) {
 	})
 	assert.NoError(t, err)
 
-	unrealised, err := o.UpdateOpenPositionUnrealisedPNL("test", asset.Futures, cp, 2, time.Now())
+	unrealised, err := o.UpdateOpenPositionUnrealisedPNL("test", "", asset.Futures, cp, 2, time.Now())
 	assert.NoError(t, err)
 
 	if !unrealised.Equal(decimal.NewFromInt(1)) {
@@ -1364,7 +1442,7 @@ func TestUpdateOpenPositionUnrealisedPNL(t *testing.T) {
 	}
 
 	o = nil
-	_, err = o.UpdateOpenPositionUnrealisedPNL("test", asset.Spot, cp, 1, time.Now())
+	_, err = o.UpdateOpenPositionUnrealisedPNL("test", "", asset.Spot, cp, 1, time.Now())
 	assert.ErrorIs(t, err, ErrNilSubsystem)
 }
 
@@ -1434,6 +1512,20 @@ func TestGetOrdersSnapshot(t *testing.T) {
 	}
 }
 
+func TestGetOrderStatusCounts(t *testing.T) {
+	t.Parallel()
+	o := &OrderManager{}
+	assert.Nil(t, o.GetOrderStatusCounts(), "should return nil when not started")
+	o.started.Store(true)
+	o.orderStore.Orders = map[string][]*order.Detail{
+		testExchange: {{Status: order.Open}, {Status: order.Open}, {Status: order.Filled}},
+	}
+	counts := o.GetOrderStatusCounts()
+	require.Contains(t, counts, testExchange)
+	assert.Equal(t, 2, counts[testExchange][order.Open])
+	assert.Equal(t, 1, counts[testExchange][order.Filled])
+}
+
 func TestUpdateExisting(t *testing.T) {
 	t.Parallel()
 	s := &store{}
@@ -1467,7 +1559,7 @@ func TestUpdateExisting(t *testing.T) {
 	err = s.updateExisting(od)
 	assert.NoError(t, err)
 
-	pos, err := s.futuresPositionController.GetPositionsForExchange(testExchange, asset.Futures, od.Pair)
+	pos, err := s.futuresPositionController.GetPositionsForExchange(testExchange, "", asset.Futures, od.Pair)
 	assert.NoError(t, err)
 
 	if len(pos) != 1 {
@@ -1536,11 +1628,11 @@ func TestGetOpenFuturesPosition(t *testing.T) {
 
 	o.started.Store(false)
 	cp := currency.NewPair(currency.BTC, currency.PERP)
-	_, err = o.GetOpenFuturesPosition(testExchange, asset.Spot, cp)
+	_, err = o.GetOpenFuturesPosition(testExchange, "", asset.Spot, cp)
 	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
 
 	o.started.Store(true)
-	_, err = o.GetOpenFuturesPosition(testExchange, asset.Spot, cp)
+	_, err = o.GetOpenFuturesPosition(testExchange, "", asset.Spot, cp)
 	assert.ErrorIs(t, err, futures.ErrNotFuturesAsset)
 
 	em := NewExchangeManager()
@@ -1583,10 +1675,10 @@ func TestGetOpenFuturesPosition(t *testing.T) {
 
 	o.started.Store(true)
 
-	_, err = o.GetOpenFuturesPosition(testExchange, asset.Spot, cp)
+	_, err = o.GetOpenFuturesPosition(testExchange, "", asset.Spot, cp)
 	assert.ErrorIs(t, err, futures.ErrNotFuturesAsset)
 
-	_, err = o.GetOpenFuturesPosition(testExchange, asset.Futures, cp)
+	_, err = o.GetOpenFuturesPosition(testExchange, "", asset.Futures, cp)
 	assert.ErrorIs(t, err, futures.ErrPositionNotFound)
 
 	err = o.orderStore.futuresPositionController.TrackNewOrder(&order.Detail{
@@ -1601,18 +1693,18 @@ func TestGetOpenFuturesPosition(t *testing.T) {
 	})
 	assert.NoError(t, err)
 
-	_, err = o.GetOpenFuturesPosition(testExchange, asset.Futures, cp)
+	_, err = o.GetOpenFuturesPosition(testExchange, "", asset.Futures, cp)
 	assert.NoError(t, err)
 
 	o = nil
-	_, err = o.GetOpenFuturesPosition(testExchange, asset.Spot, cp)
+	_, err = o.GetOpenFuturesPosition(testExchange, "", asset.Spot, cp)
 	assert.ErrorIs(t, err, ErrNilSubsystem)
 }
 
 func TestProcessFuturesPositions(t *testing.T) {
 	t.Parallel()
 	o := &OrderManager{}
-	err := o.processFuturesPositions(t.Context(), nil, nil)
+	err := o.processFuturesPositions(t.Context(), nil, "", nil)
 	assert.ErrorIs(t, err, errFuturesTrackingDisabled)
 
 	em := NewExchangeManager()
@@ -1661,7 +1753,7 @@ func TestProcessFuturesPositions(t *testing.T) {
 
 	o.started.Store(true)
 
-	err = o.processFuturesPositions(t.Context(), fakeExchange, nil)
+	err = o.processFuturesPositions(t.Context(), fakeExchange, "", nil)
 	assert.ErrorIs(t, err, common.ErrNilPointer)
 
 	position := &futures.PositionResponse{
@@ -1669,7 +1761,7 @@ func TestProcessFuturesPositions(t *testing.T) {
 		Pair:   cp,
 		Orders: nil,
 	}
-	err = o.processFuturesPositions(t.Context(), fakeExchange, position)
+	err = o.processFuturesPositions(t.Context(), fakeExchange, "", position)
 	assert.ErrorIs(t, err, errNilOrder)
 
 	od := &order.Detail{
@@ -1685,16 +1777,16 @@ func TestProcessFuturesPositions(t *testing.T) {
 	position.Orders = []order.Detail{
 		*od,
 	}
-	err = o.processFuturesPositions(t.Context(), fakeExchange, position)
+	err = o.processFuturesPositions(t.Context(), fakeExchange, "", position)
 	assert.ErrorIs(t, err, futures.ErrNotFuturesAsset)
 
 	position.Orders[0].AssetType = asset.Futures
 	position.Asset = asset.Futures
-	err = o.processFuturesPositions(t.Context(), fakeExchange, position)
+	err = o.processFuturesPositions(t.Context(), fakeExchange, "", position)
 	assert.NoError(t, err)
 
 	b.Features.Supports.FuturesCapabilities.FundingRates = true
-	err = o.processFuturesPositions(t.Context(), fakeExchange, position)
+	err = o.processFuturesPositions(t.Context(), fakeExchange, "", position)
 	assert.NoError(t, err)
 }
 
@@ -1731,3 +1823,32 @@ func TestGetByDetail(t *testing.T) {
 		assert.Equal(t, od.ClientOrderID, byID.ClientOrderID, "Retrieve by id pointer should contain the correct ClientOrderID")
 	}
 }
+
+func TestLastPoll(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	assert.True(t, m.LastPoll().IsZero(), "nil order manager should return a zero time")
+
+	em := NewExchangeManager()
+	require.NoError(t, em.Add(&sharedtestvalues.CustomEx{}), "Add must not error")
+	m, err := SetupOrderManager(em, &CommunicationManager{}, &sync.WaitGroup{}, &config.OrderManager{})
+	require.NoError(t, err, "SetupOrderManager must not error")
+	assert.True(t, m.LastPoll().IsZero(), "LastPoll should return a zero time before orders are processed")
+
+	m.processOrders(t.Context())
+	assert.WithinDuration(t, time.Now(), m.LastPoll(), time.Minute, "LastPoll should be set after orders are processed")
+}
+
+func TestOrderLogFields(t *testing.T) {
+	t.Parallel()
+	d := &order.Detail{Exchange: testExchange, AssetType: asset.Spot, Pair: currency.NewBTCUSDT(), OrderID: "1337"}
+	assert.Equal(t, log.ExtraFields{
+		"trace_id":       "abc",
+		log.SubsystemKey: OrderManagerName,
+		log.ExchangeKey:  testExchange,
+		log.AssetKey:     "spot",
+		log.PairKey:      "BTCUSDT",
+		log.OrderIDKey:   "1337",
+	}, orderLogFields(log.ExtraFields{"trace_id": "abc"}, d))
+	assert.Equal(t, log.ExtraFields{log.SubsystemKey: OrderManagerName, log.ExchangeKey: testExchange}, orderLogFields(nil, &order.Detail{Exchange: testExchange}), "unset order fields should be omitted")
+}
@@ -45,6 +45,7 @@ type orderManagerConfig struct {
 type OrderManager struct {
 	started                       atomic.Bool
 	processingOrders              atomic.Bool
+	lastPoll                      atomic.Int64
 	shutdown                      chan struct{}
 	orderStore                    store
 	cfg                           orderManagerConfig
@@ -62,6 +63,7 @@ type store struct {
 	exchangeManager           iExchangeManager
 	wg                        *sync.WaitGroup
 	futuresPositionController futures.PositionController
+	feed                      orderFeed
 }
 
 // OrderSubmitResponse contains the order response along with an internal order ID
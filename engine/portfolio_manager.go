@@ -9,6 +9,7 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -140,7 +141,7 @@ func (m *portfolioManager) processPortfolio(ctx context.Context) {
 	m.processing.CompareAndSwap(true, false)
 }
 
-// updateExchangeBalances calls UpdateAccountBalance on each exchange, and transfers the account balances into portfolio
+// updateExchangeBalances calls UpdateAccountBalance for each account of each exchange, and transfers the account balances into portfolio
 func (m *portfolioManager) updateExchangeBalances(ctx context.Context) error {
 	if err := common.NilGuard(m); err != nil {
 		return err
@@ -164,30 +165,41 @@ func (m *portfolioManager) updateExchangeBalances(ctx context.Context) error {
 			assetTypes = e.GetAssetTypes(true)
 		}
 
-		for _, a := range assetTypes {
-			if _, err := e.UpdateAccountBalances(ctx, a); err != nil {
-				errs = common.AppendError(errs, fmt.Errorf("error updating %s %s account balances: %w", e.GetName(), a, err))
+		for _, account := range e.GetAccountNames() {
+			accountCtx := accounts.DeployAccountToContext(ctx, account)
+			for _, a := range assetTypes {
+				if _, err := e.UpdateAccountBalances(accountCtx, a); err != nil {
+					errs = common.AppendError(errs, fmt.Errorf("error updating %s %s %s account balances: %w", e.GetName(), account, a, err))
+				}
+			}
+			if err := m.updateExchangeAddressBalances(accountCtx, e); err != nil {
+				errs = common.AppendError(errs, fmt.Errorf("error updating %s %s account balances: %w", e.GetName(), account, err))
 			}
-		}
-		if err := m.updateExchangeAddressBalances(e); err != nil {
-			errs = common.AppendError(errs, fmt.Errorf("error updating %s account balances: %w", e.GetName(), err))
 		}
 	}
 	return errs
 }
 
-// updateExchangeAddressBalances fetches and collates all account balances with their deposit addresses
-func (m *portfolioManager) updateExchangeAddressBalances(e exchange.IBotExchange) error {
+// updateExchangeAddressBalances fetches and collates the account balances of the account set on the context with their deposit addresses
+func (m *portfolioManager) updateExchangeAddressBalances(ctx context.Context, e exchange.IBotExchange) error {
 	if err := common.NilGuard(m, e); err != nil {
 		return err
 	}
-	currs, err := e.GetBase().Accounts.CurrencyBalances(nil, asset.All)
+	// Balances for all credentials are collated unless named accounts need to be kept apart
+	var creds *accounts.Credentials
+	if len(e.GetAccountNames()) > 1 {
+		var err error
+		if creds, err = e.GetCredentials(ctx); err != nil {
+			return err
+		}
+	}
+	currs, err := e.GetBase().Accounts.CurrencyBalances(creds, asset.All)
 	if err != nil {
 		return err
 	}
-	eName := e.GetName()
+	eName := exchangeAccountAddress(e.GetName(), accounts.AccountFromContext(ctx))
 	for c, b := range currs {
-		if !m.base.ExchangeAddressCoinExists(e.GetName(), c) {
+		if !m.base.ExchangeAddressCoinExists(eName, c) {
 			if b.Total <= 0 {
 				continue
 			}
@@ -217,6 +229,15 @@ func (m *portfolioManager) updateExchangeAddressBalances(e exchange.IBotExchange
 	return nil
 }
 
+// exchangeAccountAddress returns the portfolio address of an exchange account,
+// which is the exchange name for the default account
+func exchangeAccountAddress(exchName, account string) string {
+	if account == accounts.DefaultAccount {
+		return exchName
+	}
+	return exchName + "/" + account
+}
+
 // AddAddress adds a new portfolio address for the portfolio manager to track
 func (m *portfolioManager) AddAddress(address, description string, coinType currency.Code, balance float64) error {
 	if m == nil {
@@ -126,13 +126,13 @@ func TestUpdateExchangeBalances(t *testing.T) {
 func TestUpdateExchangeAddressBalances(t *testing.T) {
 	t.Parallel()
 
-	assert.ErrorContains(t, (*portfolioManager)(nil).updateExchangeAddressBalances(nil), "nil pointer: *engine.portfolioManager")
-	assert.ErrorContains(t, new(portfolioManager).updateExchangeAddressBalances(nil), "nil pointer: <nil>")
+	assert.ErrorContains(t, (*portfolioManager)(nil).updateExchangeAddressBalances(t.Context(), nil), "nil pointer: *engine.portfolioManager")
+	assert.ErrorContains(t, new(portfolioManager).updateExchangeAddressBalances(t.Context(), nil), "nil pointer: <nil>")
 
 	e := &mockExchange{enabled: false, err: errors.New("Mock UpdateBalanceError")}
 	m, err := setupPortfolioManager(NewExchangeManager(), 0, nil)
 	require.NoError(t, err, "setupPortfolioManager must not error")
-	assert.ErrorContains(t, m.updateExchangeAddressBalances(e), "nil pointer: *accounts.Accounts", "updateExchangeAddressBalances should propagate CurrencyBalances errors")
+	assert.ErrorContains(t, m.updateExchangeAddressBalances(t.Context(), e), "nil pointer: *accounts.Accounts", "updateExchangeAddressBalances should propagate CurrencyBalances errors")
 
 	a := accounts.MustNewAccounts(e)
 	e.accounts = a
@@ -140,20 +140,36 @@ func TestUpdateExchangeAddressBalances(t *testing.T) {
 	subAcct.Balances.Set(currency.BTC, accounts.Balance{Total: 1.5})
 	subAcct.Balances.Set(currency.ETH, accounts.Balance{Total: 0})
 	require.NoError(t, a.Save(t.Context(), accounts.SubAccounts{subAcct}, false), "accounts.Save must not error")
-	require.NoError(t, m.updateExchangeAddressBalances(e))
+	require.NoError(t, m.updateExchangeAddressBalances(t.Context(), e))
 	require.Len(t, m.base.Addresses, 1, "must have one address for the positive balance")
 	assert.Equal(t, 1.5, m.base.Addresses[0].Balance, "balance should match on a new address")
 
 	subAcct.Balances.Set(currency.BTC, accounts.Balance{Total: 2})
 	require.NoError(t, a.Save(t.Context(), accounts.SubAccounts{subAcct}, true), "accounts.Save must not error")
-	require.NoError(t, m.updateExchangeAddressBalances(e))
+	require.NoError(t, m.updateExchangeAddressBalances(t.Context(), e))
 	require.Len(t, m.base.Addresses, 1, "must have one address for the positive balance")
 	assert.Equal(t, 2.0, m.base.Addresses[0].Balance, "balance should match after update existing address")
 
 	subAcct.Balances.Set(currency.BTC, accounts.Balance{Total: 0})
 	require.NoError(t, a.Save(t.Context(), accounts.SubAccounts{subAcct}, true), "accounts.Save must not error")
-	require.NoError(t, m.updateExchangeAddressBalances(e))
+	require.NoError(t, m.updateExchangeAddressBalances(t.Context(), e))
 	assert.Empty(t, m.base.Addresses, "should have removed address with no balance")
+
+	e.accountNames = []string{accounts.DefaultAccount, "hedge"}
+	hedgeCtx := accounts.DeployAccountToContext(t.Context(), "hedge")
+	hedgeAcct := accounts.NewSubAccount(asset.Spot, "")
+	hedgeAcct.Balances.Set(currency.BTC, accounts.Balance{Total: 3})
+	require.NoError(t, a.Save(hedgeCtx, accounts.SubAccounts{hedgeAcct}, false), "accounts.Save must not error")
+	subAcct.Balances.Set(currency.BTC, accounts.Balance{Total: 1})
+	require.NoError(t, a.Save(t.Context(), accounts.SubAccounts{subAcct}, true), "accounts.Save must not error")
+	require.NoError(t, m.updateExchangeAddressBalances(t.Context(), e))
+	require.NoError(t, m.updateExchangeAddressBalances(hedgeCtx, e))
+	balance, ok := m.base.GetAddressBalance("mocky", portfolio.ExchangeAddress, currency.BTC)
+	require.True(t, ok, "default account address must exist")
+	assert.Equal(t, 1.0, balance, "default account balance should not include other accounts")
+	balance, ok = m.base.GetAddressBalance("mocky/hedge", portfolio.ExchangeAddress, currency.BTC)
+	require.True(t, ok, "named account address must exist")
+	assert.Equal(t, 3.0, balance, "named account balance should be segregated")
 }
 
 // mockExchange is a minimal mock for testing
@@ -163,6 +179,7 @@ type mockExchange struct {
 	authSupported bool
 	err           error
 	accounts      *accounts.Accounts
+	accountNames  []string
 }
 
 func (m *mockExchange) GetName() string {
@@ -193,6 +210,16 @@ func (m *mockExchange) GetBase() *exchange.Base {
 	return &exchange.Base{Name: "mocky", Accounts: m.accounts}
 }
 
-func (m *mockExchange) GetCredentials(context.Context) (*accounts.Credentials, error) {
+func (m *mockExchange) GetCredentials(ctx context.Context) (*accounts.Credentials, error) {
+	if account := accounts.AccountFromContext(ctx); account != accounts.DefaultAccount {
+		return &accounts.Credentials{Key: account}, nil
+	}
 	return &accounts.Credentials{Key: m.GetName()}, nil
 }
+
+func (m *mockExchange) GetAccountNames() []string {
+	if len(m.accountNames) == 0 {
+		return []string{accounts.DefaultAccount}
+	}
+	return m.accountNames
+}
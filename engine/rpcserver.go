@@ -2,13 +2,13 @@ package engine
 
 import (
 	"context"
-	"encoding/base64"
 	"errors"
 	"fmt"
 	"net"
 	"net/http"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -30,6 +30,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
 	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/changefeed"
 	exchangeDB "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
 	"github.com/thrasher-corp/gocryptotrader/encoding/json"
 	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
@@ -42,20 +43,18 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/gctrpc"
-	"github.com/thrasher-corp/gocryptotrader/gctrpc/auth"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/portfolio"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 	"github.com/thrasher-corp/gocryptotrader/utils"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
-	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -87,45 +86,6 @@ type RPCServer struct {
 	*Engine
 }
 
-func (s *RPCServer) authenticateClient(ctx context.Context) (context.Context, error) {
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return ctx, errors.New("unable to extract metadata")
-	}
-
-	authStr, ok := md["authorization"]
-	if !ok {
-		return ctx, errors.New("authorization header missing")
-	}
-
-	if !strings.Contains(authStr[0], "Basic") {
-		return ctx, errors.New("basic not found in authorization header")
-	}
-
-	decoded, err := base64.StdEncoding.DecodeString(strings.Split(authStr[0], " ")[1])
-	if err != nil {
-		return ctx, errors.New("unable to base64 decode authorization header")
-	}
-
-	cred := strings.Split(string(decoded), ":")
-	username := cred[0]
-	password := cred[1]
-
-	if username != s.Config.RemoteControl.Username ||
-		password != s.Config.RemoteControl.Password {
-		return ctx, errors.New("username/password mismatch")
-	}
-	ctx, err = accounts.ParseCredentialsMetadata(ctx, md)
-	if err != nil {
-		return ctx, err
-	}
-
-	if _, ok := md["verbose"]; ok {
-		ctx = request.WithVerbose(ctx)
-	}
-	return ctx, nil
-}
-
 // StartRPCServer starts a gRPC server with TLS auth
 func StartRPCServer(ctx context.Context, engine *Engine) {
 	targetDir := utils.GetTLSDir(engine.Settings.DataDir)
@@ -145,7 +105,7 @@ func StartRPCServer(ctx context.Context, engine *Engine) {
 		return
 	}
 
-	creds, err := credentials.NewServerTLSFromFile(filepath.Join(targetDir, "cert.pem"), filepath.Join(targetDir, "key.pem"))
+	tlsConfig, err := serverTLSConfig(filepath.Join(targetDir, "cert.pem"), filepath.Join(targetDir, "key.pem"), engine.Config.RemoteControl.GRPC.ClientCAFile)
 	if err != nil {
 		_ = lis.Close()
 		log.Errorf(log.GRPCSys, "gRPC server could not load TLS keys: %s\n", err)
@@ -154,9 +114,10 @@ func StartRPCServer(ctx context.Context, engine *Engine) {
 
 	s := RPCServer{Engine: engine}
 	opts := []grpc.ServerOption{
-		grpc.Creds(creds),
-		grpc.UnaryInterceptor(grpcauth.UnaryServerInterceptor(s.authenticateClient)),
-		grpc.StreamInterceptor(grpcauth.StreamServerInterceptor(s.authenticateClient)),
+		grpc.Creds(credentials.NewTLS(tlsConfig)),
+		grpc.ChainUnaryInterceptor(grpcauth.UnaryServerInterceptor(s.authenticateClient), s.authoriseUnary),
+		grpc.ChainStreamInterceptor(grpcauth.StreamServerInterceptor(s.authenticateClient), s.authoriseStream),
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 	}
 	server := grpc.NewServer(opts...)
 	gctrpc.RegisterGoCryptoTraderServiceServer(server, &s)
@@ -206,13 +167,12 @@ func (s *RPCServer) startRPCRESTProxy(ctx context.Context) {
 		return
 	}
 
+	// The authorization header of each proxied request is forwarded so that
+	// calls are authorised against the requesting user's roles
 	mux := runtime.NewServeMux()
 	opts := []grpc.DialOption{
 		grpc.WithTransportCredentials(creds),
-		grpc.WithPerRPCCredentials(auth.BasicAuth{
-			Username: s.Config.RemoteControl.Username,
-			Password: s.Config.RemoteControl.Password,
-		}),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
 	}
 	err = gctrpc.RegisterGoCryptoTraderServiceHandlerFromEndpoint(context.Background(),
 		mux, s.Config.RemoteControl.GRPC.ListenAddress, opts)
@@ -220,11 +180,32 @@ func (s *RPCServer) startRPCRESTProxy(ctx context.Context) {
 		log.Errorf(log.GRPCSys, "Failed to register gRPC proxy. Err: %s\n", err)
 		return
 	}
+	handler := s.authClient(mux)
+	if s.Config.RemoteControl.GRPC.WebsocketGatewayEnabled {
+		// Streams are relayed as gRPC calls so that they are authorised and
+		// audited as if clients called the gRPC server directly
+		conn, err := grpc.NewClient(s.Config.RemoteControl.GRPC.ListenAddress, opts...)
+		if err != nil {
+			log.Errorf(log.GRPCSys, "Failed to start gRPC websocket gateway. Err: %s\n", err)
+			return
+		}
+		go func() {
+			<-ctx.Done()
+			if err := conn.Close(); err != nil {
+				log.Errorf(log.GRPCSys, "gRPC websocket gateway connection close failed: %s\n", err)
+			}
+		}()
+		root := http.NewServeMux()
+		root.Handle(websocketGatewayPath, s.newWebsocketGateway(conn, s.Config.RemoteControl.GRPC.WebsocketGatewayAllowedOrigins))
+		root.Handle("/", handler)
+		handler = root
+		log.Debugf(log.GRPCSys, "gRPC websocket gateway enabled on wss://%v%v.\n", s.Config.RemoteControl.GRPC.GRPCProxyListenAddress, websocketGatewayPath)
+	}
 	server := &http.Server{
 		Addr:              s.Config.RemoteControl.GRPC.GRPCProxyListenAddress,
 		ReadHeaderTimeout: time.Minute,
 		ReadTimeout:       time.Minute,
-		Handler:           s.authClient(mux),
+		Handler:           handler,
 	}
 
 	go func() {
@@ -246,19 +227,6 @@ func (s *RPCServer) startRPCRESTProxy(ctx context.Context) {
 	log.Debugln(log.GRPCSys, "gRPC proxy server started!")
 }
 
-func (s *RPCServer) authClient(handler http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		username, password, ok := r.BasicAuth()
-		if !ok || username != s.Config.RemoteControl.Username || password != s.Config.RemoteControl.Password {
-			w.Header().Set("WWW-Authenticate", `Basic realm="restricted"`)
-			http.Error(w, "Access denied", http.StatusUnauthorized)
-			log.Warnf(log.GRPCSys, "gRPC proxy server unauthorised access attempt. IP: %s Path: %s\n", r.RemoteAddr, r.URL.Path)
-			return
-		}
-		handler.ServeHTTP(w, r)
-	})
-}
-
 // GetInfo returns info about the current GoCryptoTrader session
 func (s *RPCServer) GetInfo(_ context.Context, _ *gctrpc.GetInfoRequest) (*gctrpc.GetInfoResponse, error) {
 	rpcEndpoints, err := s.getRPCEndpoints()
@@ -958,6 +926,7 @@ func (s *RPCServer) GetOrders(ctx context.Context, r *gctrpc.GetOrdersRequest) (
 		}
 		o := &gctrpc.OrderDetails{
 			Exchange:      r.Exchange,
+			Account:       accounts.AccountFromContext(ctx),
 			Id:            resp[x].OrderID,
 			ClientOrderId: resp[x].ClientOrderID,
 			BaseCurrency:  resp[x].Pair.Base.String(),
@@ -987,7 +956,7 @@ func (s *RPCServer) GetOrders(ctx context.Context, r *gctrpc.GetOrdersRequest) (
 
 // GetManagedOrders returns all orders from the Order Manager for the provided exchange,
 // asset type and currency pair
-func (s *RPCServer) GetManagedOrders(_ context.Context, r *gctrpc.GetOrdersRequest) (*gctrpc.GetOrdersResponse, error) {
+func (s *RPCServer) GetManagedOrders(ctx context.Context, r *gctrpc.GetOrdersRequest) (*gctrpc.GetOrdersResponse, error) {
 	if r == nil {
 		return nil, errInvalidArguments
 	}
@@ -1018,6 +987,7 @@ func (s *RPCServer) GetManagedOrders(_ context.Context, r *gctrpc.GetOrdersReque
 	var resp []order.Detail
 	filter := order.Filter{
 		Exchange:  exch.GetName(),
+		Account:   selectedAccount(ctx),
 		Pair:      cp,
 		AssetType: a,
 	}
@@ -1028,52 +998,58 @@ func (s *RPCServer) GetManagedOrders(_ context.Context, r *gctrpc.GetOrdersReque
 
 	orders := make([]*gctrpc.OrderDetails, len(resp))
 	for x := range resp {
-		trades := make([]*gctrpc.TradeHistory, len(resp[x].Trades))
-		for i := range resp[x].Trades {
-			t := &gctrpc.TradeHistory{
-				Id:        resp[x].Trades[i].TID,
-				Price:     resp[x].Trades[i].Price,
-				Amount:    resp[x].Trades[i].Amount,
-				Exchange:  r.Exchange,
-				AssetType: a.String(),
-				OrderSide: resp[x].Trades[i].Side.String(),
-				Fee:       resp[x].Trades[i].Fee,
-				Total:     resp[x].Trades[i].Total,
-			}
-			if !resp[x].Trades[i].Timestamp.IsZero() {
-				t.CreationTime = s.unixTimestamp(resp[x].Trades[i].Timestamp)
-			}
-			trades[i] = t
-		}
-		o := &gctrpc.OrderDetails{
-			Exchange:      r.Exchange,
-			Id:            resp[x].OrderID,
-			ClientOrderId: resp[x].ClientOrderID,
-			BaseCurrency:  resp[x].Pair.Base.String(),
-			QuoteCurrency: resp[x].Pair.Quote.String(),
-			AssetType:     resp[x].AssetType.String(),
-			OrderSide:     resp[x].Side.String(),
-			OrderType:     resp[x].Type.String(),
-			Status:        resp[x].Status.String(),
-			Price:         resp[x].Price,
-			Amount:        resp[x].Amount,
-			OpenVolume:    resp[x].Amount - resp[x].ExecutedAmount,
-			Fee:           resp[x].Fee,
-			Cost:          resp[x].Cost,
-			Trades:        trades,
-		}
-		if !resp[x].Date.IsZero() {
-			o.CreationTime = resp[x].Date.Format(common.SimpleTimeFormatWithTimezone)
-		}
-		if !resp[x].LastUpdated.IsZero() {
-			o.UpdateTime = resp[x].LastUpdated.Format(common.SimpleTimeFormatWithTimezone)
-		}
-		orders[x] = o
+		orders[x] = s.orderDetailsResponse(r.Exchange, &resp[x])
 	}
 
 	return &gctrpc.GetOrdersResponse{Orders: orders}, nil
 }
 
+// orderDetailsResponse converts an order detail to its RPC representation
+func (s *RPCServer) orderDetailsResponse(exchName string, d *order.Detail) *gctrpc.OrderDetails {
+	trades := make([]*gctrpc.TradeHistory, len(d.Trades))
+	for i := range d.Trades {
+		t := &gctrpc.TradeHistory{
+			Id:        d.Trades[i].TID,
+			Price:     d.Trades[i].Price,
+			Amount:    d.Trades[i].Amount,
+			Exchange:  exchName,
+			AssetType: d.AssetType.String(),
+			OrderSide: d.Trades[i].Side.String(),
+			Fee:       d.Trades[i].Fee,
+			Total:     d.Trades[i].Total,
+		}
+		if !d.Trades[i].Timestamp.IsZero() {
+			t.CreationTime = s.unixTimestamp(d.Trades[i].Timestamp)
+		}
+		trades[i] = t
+	}
+	o := &gctrpc.OrderDetails{
+		Exchange:      exchName,
+		Account:       d.Account,
+		Id:            d.OrderID,
+		ClientOrderId: d.ClientOrderID,
+		BaseCurrency:  d.Pair.Base.String(),
+		QuoteCurrency: d.Pair.Quote.String(),
+		AssetType:     d.AssetType.String(),
+		OrderSide:     d.Side.String(),
+		OrderType:     d.Type.String(),
+		Status:        d.Status.String(),
+		Price:         d.Price,
+		Amount:        d.Amount,
+		OpenVolume:    d.Amount - d.ExecutedAmount,
+		Fee:           d.Fee,
+		Cost:          d.Cost,
+		Trades:        trades,
+	}
+	if !d.Date.IsZero() {
+		o.CreationTime = d.Date.Format(common.SimpleTimeFormatWithTimezone)
+	}
+	if !d.LastUpdated.IsZero() {
+		o.UpdateTime = d.LastUpdated.Format(common.SimpleTimeFormatWithTimezone)
+	}
+	return o
+}
+
 // GetOrder returns order information based on exchange and order ID
 func (s *RPCServer) GetOrder(ctx context.Context, r *gctrpc.GetOrderRequest) (*gctrpc.OrderDetails, error) {
 	if r == nil {
@@ -1134,6 +1110,7 @@ func (s *RPCServer) GetOrder(ctx context.Context, r *gctrpc.GetOrderRequest) (*g
 
 	return &gctrpc.OrderDetails{
 		Exchange:      result.Exchange,
+		Account:       result.Account,
 		Id:            result.OrderID,
 		ClientOrderId: result.ClientOrderID,
 		BaseCurrency:  result.Pair.Base.String(),
@@ -2391,19 +2368,20 @@ func (s *RPCServer) GetHistoricCandles(ctx context.Context, r *gctrpc.GetHistori
 	}
 
 	var klineItem *kline.Item
-	if r.UseDb {
+	switch {
+	case r.UseDb:
 		klineItem, err = kline.LoadFromDatabase(r.Exchange,
 			pair,
 			a,
 			interval,
 			start,
 			end)
-	} else {
-		if r.ExRequest {
-			klineItem, err = exch.GetHistoricCandlesExtended(ctx, pair, a, interval, start, end)
-		} else {
-			klineItem, err = exch.GetHistoricCandles(ctx, pair, a, interval, start, end)
-		}
+	case r.UseCache:
+		klineItem, err = kline.GetHistoricCandlesWithCache(ctx, exch, pair, a, interval, start, end, r.Sync)
+	case r.ExRequest:
+		klineItem, err = exch.GetHistoricCandlesExtended(ctx, pair, a, interval, start, end)
+	default:
+		klineItem, err = exch.GetHistoricCandles(ctx, pair, a, interval, start, end)
 	}
 	if err != nil {
 		return nil, err
@@ -2431,7 +2409,7 @@ func (s *RPCServer) GetHistoricCandles(ctx context.Context, r *gctrpc.GetHistori
 		})
 	}
 
-	if r.Sync && !r.UseDb {
+	if r.Sync && !r.UseDb && !r.UseCache {
 		_, err = kline.StoreInDatabase(klineItem, r.Force)
 		if err != nil {
 			if errors.Is(err, exchangeDB.ErrNoExchangeFound) {
@@ -4135,6 +4113,7 @@ func (s *RPCServer) CurrencyStateTradingPair(_ context.Context, r *gctrpc.Curren
 func (s *RPCServer) buildFuturePosition(position *futures.Position, getFundingPayments, includeFundingRates, includeOrders, includePredictedRate bool) *gctrpc.FuturePosition {
 	response := &gctrpc.FuturePosition{
 		Exchange: position.Exchange,
+		Account:  position.Account,
 		Asset:    position.Asset.String(),
 		Pair: &gctrpc.CurrencyPair{
 			Delimiter: position.Pair.Delimiter,
@@ -4180,6 +4159,7 @@ func (s *RPCServer) buildFuturePosition(position *futures.Position, getFundingPa
 		for i := range position.Orders {
 			od := &gctrpc.OrderDetails{
 				Exchange:      position.Orders[i].Exchange,
+				Account:       position.Account,
 				Id:            position.Orders[i].OrderID,
 				ClientOrderId: position.Orders[i].ClientOrderID,
 				BaseCurrency:  position.Orders[i].Pair.Base.String(),
@@ -4218,7 +4198,7 @@ func (s *RPCServer) buildFuturePosition(position *futures.Position, getFundingPa
 }
 
 // GetManagedPosition returns an open positions from the order manager, no calling any API endpoints to return this information
-func (s *RPCServer) GetManagedPosition(_ context.Context, r *gctrpc.GetManagedPositionRequest) (*gctrpc.GetManagedPositionsResponse, error) {
+func (s *RPCServer) GetManagedPosition(ctx context.Context, r *gctrpc.GetManagedPositionRequest) (*gctrpc.GetManagedPositionsResponse, error) {
 	if r == nil {
 		return nil, fmt.Errorf("%w GetManagedPositionRequest", common.ErrNilPointer)
 	}
@@ -4260,7 +4240,7 @@ func (s *RPCServer) GetManagedPosition(_ context.Context, r *gctrpc.GetManagedPo
 	if err != nil {
 		return nil, err
 	}
-	position, err := s.OrderManager.GetOpenFuturesPosition(r.Exchange, ai, cp)
+	position, err := s.OrderManager.GetOpenFuturesPosition(r.Exchange, accounts.AccountFromContext(ctx), ai, cp)
 	if err != nil {
 		return nil, err
 	}
@@ -4271,7 +4251,7 @@ func (s *RPCServer) GetManagedPosition(_ context.Context, r *gctrpc.GetManagedPo
 }
 
 // GetAllManagedPositions returns all open positions from the order manager, no calling any API endpoints to return this information
-func (s *RPCServer) GetAllManagedPositions(_ context.Context, r *gctrpc.GetAllManagedPositionsRequest) (*gctrpc.GetManagedPositionsResponse, error) {
+func (s *RPCServer) GetAllManagedPositions(ctx context.Context, r *gctrpc.GetAllManagedPositionsRequest) (*gctrpc.GetManagedPositionsResponse, error) {
 	if r == nil {
 		return nil, fmt.Errorf("%w GetAllManagedPositionsRequest", common.ErrNilPointer)
 	}
@@ -4282,6 +4262,11 @@ func (s *RPCServer) GetAllManagedPositions(_ context.Context, r *gctrpc.GetAllMa
 	if err != nil {
 		return nil, err
 	}
+	if account := selectedAccount(ctx); account != "" {
+		positions = slices.DeleteFunc(positions, func(p futures.Position) bool {
+			return p.Account != account
+		})
+	}
 	sort.Slice(positions, func(i, j int) bool {
 		return positions[i].OpeningDate.Before(positions[j].OpeningDate)
 	})
@@ -4502,12 +4487,14 @@ func (s *RPCServer) GetFuturesPositionsOrders(ctx context.Context, r *gctrpc.Get
 	if err != nil {
 		return nil, err
 	}
+	account := accounts.AccountFromContext(ctx)
 	response := &gctrpc.GetFuturesPositionsOrdersResponse{}
 	positions := make([]*gctrpc.FuturePosition, len(positionDetails))
 	var anyOrders bool
 	for i := range positionDetails {
 		details := &gctrpc.FuturePosition{
 			Exchange: exch.GetName(),
+			Account:  account,
 			Asset:    positionDetails[i].Asset.String(),
 			Pair: &gctrpc.CurrencyPair{
 				Delimiter: positionDetails[i].Pair.Delimiter,
@@ -4521,6 +4508,7 @@ func (s *RPCServer) GetFuturesPositionsOrders(ctx context.Context, r *gctrpc.Get
 			anyOrders = true
 			details.Orders[j] = &gctrpc.OrderDetails{
 				Exchange:       exch.GetName(),
+				Account:        account,
 				Id:             positionDetails[i].Orders[j].OrderID,
 				ClientOrderId:  positionDetails[i].Orders[j].ClientOrderID,
 				BaseCurrency:   positionDetails[i].Orders[j].Pair.Base.String(),
@@ -4547,7 +4535,7 @@ func (s *RPCServer) GetFuturesPositionsOrders(ctx context.Context, r *gctrpc.Get
 	response.Positions = positions
 	if r.SyncWithOrderManager {
 		for i := range positionDetails {
-			err = s.OrderManager.processFuturesPositions(ctx, exch, &positionDetails[i])
+			err = s.OrderManager.processFuturesPositions(ctx, exch, account, &positionDetails[i])
 			if err != nil {
 				return nil, err
 			}
@@ -5911,3 +5899,471 @@ func (s *RPCServer) GetCurrencyTradeURL(ctx context.Context, r *gctrpc.GetCurren
 		Url: url,
 	}, nil
 }
+
+// RunDataQualityCheck scans stored candle and trade data for integrity issues
+// and returns the generated report
+func (s *RPCServer) RunDataQualityCheck(_ context.Context, r *gctrpc.DataQualityCheckRequest) (*gctrpc.DataQualityReport, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w DataQualityCheckRequest", common.ErrNilPointer)
+	}
+	if r.Pair == nil {
+		return nil, errCurrencyPairUnset
+	}
+	a, err := asset.New(r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	start, err := time.Parse(common.SimpleTimeFormatWithTimezone, r.Start)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse start time %v", errInvalidTimes, err)
+	}
+	end, err := time.Parse(common.SimpleTimeFormatWithTimezone, r.End)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse end time %v", errInvalidTimes, err)
+	}
+	report, err := s.dataQualityManager.RunCheck(&DataQualityCheck{
+		Exchange:           r.Exchange,
+		Asset:              a,
+		Pair:               currency.NewPairWithDelimiter(r.Pair.Base, r.Pair.Quote, r.Pair.Delimiter),
+		Interval:           kline.Interval(r.Interval),
+		StartDate:          start,
+		EndDate:            end,
+		SecondaryExchanges: r.SecondaryExchanges,
+		IncludeTrades:      r.IncludeTrades,
+		CreateRepairJobs:   r.CreateRepairJobs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dataQualityReportToRPC(report, true), nil
+}
+
+// GetDataQualityReports returns stored data quality reports matching the
+// request filters
+func (s *RPCServer) GetDataQualityReports(_ context.Context, r *gctrpc.GetDataQualityReportsRequest) (*gctrpc.GetDataQualityReportsResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GetDataQualityReportsRequest", common.ErrNilPointer)
+	}
+	if r.Id != "" {
+		id, err := uuid.FromString(r.Id)
+		if err != nil {
+			return nil, err
+		}
+		report, err := s.dataQualityManager.GetReportByID(id)
+		if err != nil {
+			return nil, err
+		}
+		return &gctrpc.GetDataQualityReportsResponse{
+			Reports: []*gctrpc.DataQualityReport{dataQualityReportToRPC(report, r.IncludeIssues)},
+		}, nil
+	}
+	var a asset.Item
+	if r.Asset != "" {
+		var err error
+		a, err = asset.New(r.Asset)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var p currency.Pair
+	if r.Pair != nil {
+		p = currency.NewPairWithDelimiter(r.Pair.Base, r.Pair.Quote, r.Pair.Delimiter)
+	}
+	reports, err := s.dataQualityManager.GetReports(r.Exchange, a, p)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetDataQualityReportsResponse{
+		Reports: make([]*gctrpc.DataQualityReport, len(reports)),
+	}
+	for i := range reports {
+		resp.Reports[i] = dataQualityReportToRPC(&reports[i], r.IncludeIssues)
+	}
+	return resp, nil
+}
+
+func dataQualityReportToRPC(report *DataQualityReport, includeIssues bool) *gctrpc.DataQualityReport {
+	resp := &gctrpc.DataQualityReport{
+		Id:       report.ID.String(),
+		Exchange: report.Exchange,
+		Asset:    report.Asset.String(),
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: report.Pair.Delimiter,
+			Base:      report.Pair.Base.String(),
+			Quote:     report.Pair.Quote.String(),
+		},
+		Interval:       int64(report.Interval.Duration()),
+		Start:          report.StartDate.Format(common.SimpleTimeFormatWithTimezone),
+		End:            report.EndDate.Format(common.SimpleTimeFormatWithTimezone),
+		Created:        report.CreatedDate.Format(common.SimpleTimeFormatWithTimezone),
+		CandlesChecked: report.CandlesChecked,
+		TradesChecked:  report.TradesChecked,
+		IssueSummary:   make(map[string]int64),
+		RepairJobs:     report.RepairJobs,
+	}
+	for i := range report.Issues {
+		resp.IssueSummary[report.Issues[i].Type.String()]++
+		if !includeIssues {
+			continue
+		}
+		resp.Issues = append(resp.Issues, &gctrpc.DataQualityIssue{
+			Type:              report.Issues[i].Type.String(),
+			Start:             report.Issues[i].StartDate.Format(common.SimpleTimeFormatWithTimezone),
+			End:               report.Issues[i].EndDate.Format(common.SimpleTimeFormatWithTimezone),
+			Description:       report.Issues[i].Description,
+			SecondaryExchange: report.Issues[i].Exchange,
+		})
+	}
+	return resp
+}
+
+// GetCompositePrices returns the latest composite reference prices calculated
+// across exchanges
+func (s *RPCServer) GetCompositePrices(_ context.Context, r *gctrpc.GetCompositePricesRequest) (*gctrpc.GetCompositePricesResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GetCompositePricesRequest", common.ErrNilPointer)
+	}
+	var prices []CompositePrice
+	if r.Pair != nil {
+		a, err := asset.New(r.Asset)
+		if err != nil {
+			return nil, err
+		}
+		cp, err := s.compositePriceManager.GetCompositePrice(a, currency.NewPairWithDelimiter(r.Pair.Base, r.Pair.Quote, r.Pair.Delimiter))
+		if err != nil {
+			return nil, err
+		}
+		prices = []CompositePrice{*cp}
+	} else {
+		var err error
+		prices, err = s.compositePriceManager.GetCompositePrices()
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp := &gctrpc.GetCompositePricesResponse{
+		Prices: make([]*gctrpc.CompositePrice, 0, len(prices)),
+	}
+	for i := range prices {
+		if r.Asset != "" && r.Pair == nil && !strings.EqualFold(prices[i].Asset.String(), r.Asset) {
+			continue
+		}
+		cp := &gctrpc.CompositePrice{
+			Exchange: prices[i].Exchange,
+			Asset:    prices[i].Asset.String(),
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: prices[i].Pair.Delimiter,
+				Base:      prices[i].Pair.Base.String(),
+				Quote:     prices[i].Pair.Quote.String(),
+			},
+			VolumeWeightedPrice: prices[i].VolumeWeightedPrice,
+			MedianPrice:         prices[i].MedianPrice,
+			FilteredPrice:       prices[i].FilteredPrice,
+			Bid:                 prices[i].Bid,
+			Ask:                 prices[i].Ask,
+			TotalVolume:         prices[i].TotalVolume,
+			LastUpdated:         prices[i].LastUpdated.Format(common.SimpleTimeFormatWithTimezone),
+		}
+		if r.IncludeConstituents {
+			cp.Constituents = make([]*gctrpc.CompositePriceConstituent, len(prices[i].Constituents))
+			for j := range prices[i].Constituents {
+				c := &prices[i].Constituents[j]
+				cp.Constituents[j] = &gctrpc.CompositePriceConstituent{
+					Exchange: c.Exchange,
+					Pair: &gctrpc.CurrencyPair{
+						Delimiter: c.Pair.Delimiter,
+						Base:      c.Pair.Base.String(),
+						Quote:     c.Pair.Quote.String(),
+					},
+					Price:          c.Price,
+					ConvertedPrice: c.ConvertedPrice,
+					ConversionRate: c.ConversionRate,
+					Volume:         c.Volume,
+					Excluded:       c.Excluded,
+					LastUpdated:    c.LastUpdated.Format(common.SimpleTimeFormatWithTimezone),
+				}
+			}
+		}
+		resp.Prices = append(resp.Prices, cp)
+	}
+	return resp, nil
+}
+
+// GetDatabaseChangeFeedStream streams notifications published when candles,
+// trades, withdrawals, audit events and data history jobs are stored
+func (s *RPCServer) GetDatabaseChangeFeedStream(r *gctrpc.GetDatabaseChangeFeedStreamRequest, stream gctrpc.GoCryptoTraderService_GetDatabaseChangeFeedStreamServer) error {
+	if !s.Config.Database.Enabled {
+		return database.ErrDatabaseSupportDisabled
+	}
+	sub, err := changefeed.Subscribe(r.Channels...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := sub.Close(); err != nil {
+			log.Errorln(log.GRPCSys, err)
+		}
+	}()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case n, ok := <-sub.Channel():
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(&gctrpc.DatabaseChangeFeedResponse{
+				Channel:   n.Channel,
+				Payload:   string(n.Payload),
+				Timestamp: s.unixTimestamp(n.Timestamp),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// GetRateLimitStatus returns the exchange reported rate limit budget and any
+// global rate limit backoff in effect for an exchange
+func (s *RPCServer) GetRateLimitStatus(_ context.Context, r *gctrpc.GenericExchangeNameRequest) (*gctrpc.GetRateLimitStatusResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GenericExchangeNameRequest", common.ErrNilPointer)
+	}
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	status, err := exch.GetBase().GetRateLimitStatus()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetRateLimitStatusResponse{
+		Exchange: exch.GetName(),
+		Budgets:  make([]*gctrpc.RateLimitBudget, len(status.Budgets)),
+	}
+	if !status.BackoffUntil.IsZero() {
+		resp.BackoffUntil = status.BackoffUntil.Format(common.SimpleTimeFormatWithTimezone)
+	}
+	for i := range status.Budgets {
+		resp.Budgets[i] = &gctrpc.RateLimitBudget{
+			Host:      status.Budgets[i].Host,
+			Limit:     int64(status.Budgets[i].Limit),
+			Remaining: int64(status.Budgets[i].Remaining),
+			ResetTime: status.Budgets[i].Reset.Format(common.SimpleTimeFormatWithTimezone),
+			Updated:   status.Budgets[i].Updated.Format(common.SimpleTimeFormatWithTimezone),
+		}
+	}
+	return resp, nil
+}
+
+// ReloadExchangeCredentials resolves an exchange's API credentials from config
+// and its secret providers, or all exchanges when no exchange is specified
+func (s *RPCServer) ReloadExchangeCredentials(ctx context.Context, r *gctrpc.GenericExchangeNameRequest) (*gctrpc.GenericResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GenericExchangeNameRequest", common.ErrNilPointer)
+	}
+	if err := s.Engine.ReloadExchangeCredentials(ctx, r.Exchange); err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess}, nil
+}
+
+// GetPendingWithdrawals returns withdrawals held by the withdrawal policy
+// until they are approved
+func (s *RPCServer) GetPendingWithdrawals(_ context.Context, _ *gctrpc.GetPendingWithdrawalsRequest) (*gctrpc.GetPendingWithdrawalsResponse, error) {
+	pending, err := s.WithdrawManager.GetPendingWithdrawals()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetPendingWithdrawalsResponse{Withdrawals: make([]*gctrpc.PendingWithdrawal, len(pending))}
+	for i := range pending {
+		resp.Withdrawals[i] = &gctrpc.PendingWithdrawal{
+			Id:          pending[i].ID.String(),
+			Exchange:    pending[i].Exchange,
+			Currency:    pending[i].Currency,
+			Chain:       pending[i].Chain,
+			Address:     pending[i].Address,
+			Amount:      pending[i].Amount,
+			AmountUsd:   pending[i].AmountUSD,
+			RequestedBy: pending[i].RequestedBy,
+			CreatedAt:   pending[i].CreatedAt.Format(common.SimpleTimeFormatWithTimezone),
+			ExpiresAt:   pending[i].ExpiresAt.Format(common.SimpleTimeFormatWithTimezone),
+		}
+	}
+	return resp, nil
+}
+
+// ApproveWithdrawal approves a pending withdrawal as the authenticated user
+// and sends it to the exchange
+func (s *RPCServer) ApproveWithdrawal(ctx context.Context, r *gctrpc.ApproveWithdrawalRequest) (*gctrpc.WithdrawResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w ApproveWithdrawalRequest", common.ErrNilPointer)
+	}
+	u, ok := rpcUserFromContext(ctx)
+	if !ok {
+		return nil, errWithdrawalApproverUnknown
+	}
+	resp, err := s.WithdrawManager.ApproveWithdrawal(ctx, r.Id, u.name, r.OtpCode)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.WithdrawResponse{
+		Id:     resp.ID.String(),
+		Status: resp.Exchange.Status,
+	}, nil
+}
+
+// RejectWithdrawal rejects a pending withdrawal as the authenticated user
+func (s *RPCServer) RejectWithdrawal(ctx context.Context, r *gctrpc.RejectWithdrawalRequest) (*gctrpc.GenericResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w RejectWithdrawalRequest", common.ErrNilPointer)
+	}
+	var name string
+	if u, ok := rpcUserFromContext(ctx); ok {
+		name = u.name
+	}
+	if err := s.WithdrawManager.RejectWithdrawal(r.Id, name); err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess}, nil
+}
+
+// ReloadConfig re-reads the engine's config file and applies the changes which
+// can be made without a restart, reporting those which cannot
+func (s *RPCServer) ReloadConfig(ctx context.Context, _ *gctrpc.ReloadConfigRequest) (*gctrpc.ReloadConfigResponse, error) {
+	result, err := s.Engine.ReloadConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.ReloadConfigResponse{
+		Applied:         result.Applied,
+		RestartRequired: result.RestartRequired,
+	}, nil
+}
+
+// orderFeedFilter converts the optional exchange, asset and pair of an order
+// feed stream request to a filter
+func (s *RPCServer) orderFeedFilter(exchName, assetType string, pair *gctrpc.CurrencyPair) (OrderFeedFilter, error) {
+	var filter OrderFeedFilter
+	if exchName != "" {
+		exch, err := s.GetExchangeByName(exchName)
+		if err != nil {
+			return filter, err
+		}
+		filter.Exchange = exch.GetName()
+	}
+	if assetType != "" {
+		a, err := asset.New(assetType)
+		if err != nil {
+			return filter, err
+		}
+		filter.Asset = a
+	}
+	if pair != nil && (pair.Base != "" || pair.Quote != "") {
+		filter.Pair = currency.NewPairWithDelimiter(pair.Base, pair.Quote, pair.Delimiter)
+	}
+	return filter, nil
+}
+
+// selectedAccount returns the exchange account selected by the request's
+// account metadata, or an empty string when requests should span all accounts
+func selectedAccount(ctx context.Context) string {
+	account, _ := ctx.Value(accounts.ContextAccountFlag).(string)
+	return account
+}
+
+// GetOrderUpdatesStream streams orders from the order manager as they are
+// added or change, including new, partially filled, filled, cancelled and
+// rejected orders, optionally filtered by exchange, account, asset and pair
+func (s *RPCServer) GetOrderUpdatesStream(r *gctrpc.GetOrderUpdatesStreamRequest, stream gctrpc.GoCryptoTraderService_GetOrderUpdatesStreamServer) error {
+	if r == nil {
+		return fmt.Errorf("%w GetOrderUpdatesStreamRequest", common.ErrNilPointer)
+	}
+	if !s.OrderManager.IsRunning() {
+		return fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	filter, err := s.orderFeedFilter(r.Exchange, r.AssetType, r.Pair)
+	if err != nil {
+		return err
+	}
+	filter.Account = selectedAccount(stream.Context())
+	sub, err := s.OrderManager.SubscribeOrderUpdates(filter)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := sub.Close(); err != nil {
+			log.Errorln(log.GRPCSys, err)
+		}
+	}()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case d, ok := <-sub.Channel():
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(s.orderDetailsResponse(d.Exchange, &d)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// GetFillsStream streams the fills of orders as they are received from
+// exchange fills feeds or derived from order manager updates, optionally
+// filtered by exchange, account, asset and pair
+func (s *RPCServer) GetFillsStream(r *gctrpc.GetFillsStreamRequest, stream gctrpc.GoCryptoTraderService_GetFillsStreamServer) error {
+	if r == nil {
+		return fmt.Errorf("%w GetFillsStreamRequest", common.ErrNilPointer)
+	}
+	if !s.OrderManager.IsRunning() {
+		return fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	filter, err := s.orderFeedFilter(r.Exchange, r.AssetType, r.Pair)
+	if err != nil {
+		return err
+	}
+	filter.Account = selectedAccount(stream.Context())
+	sub, err := s.OrderManager.SubscribeFills(filter)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := sub.Close(); err != nil {
+			log.Errorln(log.GRPCSys, err)
+		}
+	}()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case f, ok := <-sub.Channel():
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(&gctrpc.FillResponse{
+				Exchange:  f.Exchange,
+				AssetType: f.AssetType.String(),
+				Pair: &gctrpc.CurrencyPair{
+					Delimiter: f.CurrencyPair.Delimiter,
+					Base:      f.CurrencyPair.Base.String(),
+					Quote:     f.CurrencyPair.Quote.String(),
+				},
+				OrderId:       f.OrderID,
+				ClientOrderId: f.ClientOrderID,
+				TradeId:       f.TradeID,
+				Side:          f.Side.String(),
+				Price:         f.Price,
+				Amount:        f.Amount,
+				Timestamp:     s.unixTimestamp(f.Timestamp),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
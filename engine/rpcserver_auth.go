@@ -0,0 +1,238 @@
+package engine
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc/auth"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const rpcAuditType = "grpc"
+
+var (
+	errAuthMetadataMissing     = errors.New("unable to extract metadata")
+	errAuthHeaderMissing       = errors.New("authorization header missing and no verified client certificate")
+	errAuthSchemeUnsupported   = errors.New("authorization scheme must be Basic or Bearer")
+	errAuthHeaderMalformed     = errors.New("malformed authorization header")
+	errAuthInvalidCredentials  = errors.New("invalid credentials")
+	errAuthUnknownCertificate  = errors.New("client certificate does not match a user")
+	errRPCUserNotAuthenticated = errors.New("request is not authenticated")
+	errClientCAInvalid         = errors.New("no client CA certificates found")
+)
+
+// rpcUser is an authenticated gRPC user and the roles granted to them
+type rpcUser struct {
+	name  string
+	roles []auth.Role
+}
+
+type rpcUserContextKey struct{}
+
+// rpcUserFromContext returns the user authenticated for a request
+func rpcUserFromContext(ctx context.Context) (*rpcUser, bool) {
+	u, ok := ctx.Value(rpcUserContextKey{}).(*rpcUser)
+	return u, ok
+}
+
+// authenticateClient authenticates a gRPC request by its authorization header,
+// or by a verified client certificate when no header is sent, and stores the
+// authenticated user in the context for authorisation
+func (s *RPCServer) authenticateClient(ctx context.Context) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx, status.Error(codes.Unauthenticated, errAuthMetadataMissing.Error())
+	}
+
+	var u *rpcUser
+	var err error
+	if authStr := md.Get("authorization"); len(authStr) != 0 {
+		u, err = s.authenticateHeader(authStr[0])
+	} else {
+		u, err = s.authenticateCertificate(ctx)
+	}
+	if err != nil {
+		return ctx, status.Error(codes.Unauthenticated, err.Error())
+	}
+	ctx = context.WithValue(ctx, rpcUserContextKey{}, u)
+
+	ctx, err = accounts.ParseCredentialsMetadata(ctx, md)
+	if err != nil {
+		return ctx, err
+	}
+
+	if _, ok := md["verbose"]; ok {
+		ctx = request.WithVerbose(ctx)
+	}
+	return ctx, nil
+}
+
+// authenticateHeader authenticates an authorization header. Bearer tokens are
+// checked against the hashed tokens of configured users, and basic auth against
+// the remote control username and password, which is granted the admin role
+func (s *RPCServer) authenticateHeader(header string) (*rpcUser, error) {
+	scheme, cred, ok := strings.Cut(header, " ")
+	if !ok || cred == "" {
+		return nil, errAuthHeaderMalformed
+	}
+	switch strings.ToLower(scheme) {
+	case "bearer":
+		hash := []byte(auth.HashToken(cred))
+		for i := range s.Config.RemoteControl.Users {
+			u := &s.Config.RemoteControl.Users[i]
+			if u.TokenHash != "" && subtle.ConstantTimeCompare(hash, []byte(u.TokenHash)) == 1 {
+				return &rpcUser{name: u.Name, roles: u.Roles}, nil
+			}
+		}
+		return nil, errAuthInvalidCredentials
+	case "basic":
+		decoded, err := base64.StdEncoding.DecodeString(cred)
+		if err != nil {
+			return nil, errAuthHeaderMalformed
+		}
+		username, password, ok := strings.Cut(string(decoded), ":")
+		if !ok {
+			return nil, errAuthHeaderMalformed
+		}
+		userMatch := subtle.ConstantTimeCompare([]byte(username), []byte(s.Config.RemoteControl.Username))
+		passMatch := subtle.ConstantTimeCompare([]byte(password), []byte(s.Config.RemoteControl.Password))
+		if userMatch&passMatch != 1 {
+			return nil, errAuthInvalidCredentials
+		}
+		return &rpcUser{name: username, roles: []auth.Role{auth.RoleAdmin}}, nil
+	default:
+		return nil, errAuthSchemeUnsupported
+	}
+}
+
+// authenticateCertificate authenticates a verified client certificate by
+// matching its common name to a configured user
+func (s *RPCServer) authenticateCertificate(ctx context.Context) (*rpcUser, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, errAuthHeaderMissing
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil, errAuthHeaderMissing
+	}
+	cn := tlsInfo.State.VerifiedChains[0][0].Subject.CommonName
+	for i := range s.Config.RemoteControl.Users {
+		u := &s.Config.RemoteControl.Users[i]
+		if u.CertificateCommonName != "" && u.CertificateCommonName == cn {
+			return &rpcUser{name: u.Name, roles: u.Roles}, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %q", errAuthUnknownCertificate, cn)
+}
+
+// authoriseUnary is a unary interceptor which checks the authenticated user
+// holds the role required for the method and audits privileged calls
+func (s *RPCServer) authoriseUnary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	u, err := s.authorise(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := handler(ctx, req)
+	auditRPCCall(u, info.FullMethod, err)
+	return resp, err
+}
+
+// authoriseStream is a stream interceptor which checks the authenticated user
+// holds the role required for the method and audits privileged calls
+func (s *RPCServer) authoriseStream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	u, err := s.authorise(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	err = handler(srv, ss)
+	auditRPCCall(u, info.FullMethod, err)
+	return err
+}
+
+// authorise returns the authenticated user if they hold the role required
+// for a method
+func (s *RPCServer) authorise(ctx context.Context, method string) (*rpcUser, error) {
+	u, ok := rpcUserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, errRPCUserNotAuthenticated.Error())
+	}
+	required := auth.MethodRole(method)
+	if !auth.HasRole(u.roles, required) {
+		log.Warnf(log.GRPCSys, "gRPC user %q denied access to %s, requires role %q", u.name, method, required)
+		audit.Event(u.name, rpcAuditType, method+" denied")
+		return nil, status.Errorf(codes.PermissionDenied, "user %q requires role %q for %s", u.name, required, method)
+	}
+	return u, nil
+}
+
+// auditRPCCall records calls to methods which do not only read market data
+func auditRPCCall(u *rpcUser, method string, err error) {
+	if auth.MethodRole(method) == auth.RoleMarketData {
+		return
+	}
+	if err != nil {
+		audit.Event(u.name, rpcAuditType, method+" failed: "+status.Code(err).String())
+		return
+	}
+	audit.Event(u.name, rpcAuditType, method+" succeeded")
+}
+
+// serverTLSConfig returns the gRPC server TLS config. When a client CA file is
+// configured, client certificates are verified against it so that users can
+// authenticate via mutual TLS
+func serverTLSConfig(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if clientCAFile == "" {
+		return cfg, nil
+	}
+	pem, err := os.ReadFile(clientCAFile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("%w: %s", errClientCAInvalid, clientCAFile)
+	}
+	cfg.ClientCAs = pool
+	cfg.ClientAuth = tls.VerifyClientCertIfGiven
+	return cfg, nil
+}
+
+// authClient authenticates gRPC proxy requests. The authorization header is
+// forwarded to the gRPC server which authorises each call
+func (s *RPCServer) authClient(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := s.authenticateHeader(r.Header.Get("Authorization")); err != nil {
+			w.Header().Set("WWW-Authenticate", `Basic realm="restricted"`)
+			http.Error(w, "Access denied", http.StatusUnauthorized)
+			log.Warnf(log.GRPCSys, "gRPC proxy server unauthorised access attempt. IP: %s Path: %s\n", r.RemoteAddr, r.URL.Path)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,221 @@
+package engine
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testRPCUsername = "bobmarley"
+	testRPCPassword = "Sup3rdup3rS3cr3t"
+	testRPCToken    = "readertoken"
+)
+
+func newAuthTestRPCServer() *RPCServer {
+	return &RPCServer{Engine: &Engine{Config: &config.Config{
+		RemoteControl: config.RemoteControlConfig{
+			Username: testRPCUsername,
+			Password: testRPCPassword,
+			Users: []config.RemoteControlUser{
+				{Name: "reader", TokenHash: auth.HashToken(testRPCToken), Roles: []auth.Role{auth.RoleMarketData}},
+				{Name: "trader", CertificateCommonName: "trader.example", Roles: []auth.Role{auth.RoleMarketData, auth.RoleTrading}},
+			},
+		},
+	}}}
+}
+
+func basicAuthHeader(username, password string) string {
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+}
+
+func TestAuthenticateClient(t *testing.T) {
+	t.Parallel()
+	s := newAuthTestRPCServer()
+
+	_, err := s.authenticateClient(t.Context())
+	assert.Equal(t, codes.Unauthenticated, status.Code(err), "authenticateClient should reject requests without metadata")
+
+	for _, tc := range []struct {
+		name   string
+		header string
+		user   string
+		roles  []auth.Role
+		err    error
+	}{
+		{"basic", basicAuthHeader(testRPCUsername, testRPCPassword), testRPCUsername, []auth.Role{auth.RoleAdmin}, nil},
+		{"basic wrong password", basicAuthHeader(testRPCUsername, "wrong"), "", nil, errAuthInvalidCredentials},
+		{"basic no separator", "Basic " + base64.StdEncoding.EncodeToString([]byte(testRPCUsername)), "", nil, errAuthHeaderMalformed},
+		{"basic bad encoding", "Basic !!!", "", nil, errAuthHeaderMalformed},
+		{"bearer", "Bearer " + testRPCToken, "reader", []auth.Role{auth.RoleMarketData}, nil},
+		{"bearer wrong token", "Bearer wrong", "", nil, errAuthInvalidCredentials},
+		{"bearer hash as token", "Bearer " + auth.HashToken(testRPCToken), "", nil, errAuthInvalidCredentials},
+		{"no credential", "Bearer", "", nil, errAuthHeaderMalformed},
+		{"unsupported scheme", "Digest abc", "", nil, errAuthSchemeUnsupported},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			u, err := s.authenticateHeader(tc.header)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.user, u.name)
+			assert.Equal(t, tc.roles, u.roles)
+
+			ctx := metadata.NewIncomingContext(t.Context(), metadata.Pairs("authorization", tc.header))
+			ctx, err = s.authenticateClient(ctx)
+			require.NoError(t, err)
+			u, ok := rpcUserFromContext(ctx)
+			require.True(t, ok, "authenticateClient must store the user in the context")
+			assert.Equal(t, tc.user, u.name)
+		})
+	}
+
+	ctx := metadata.NewIncomingContext(t.Context(), metadata.Pairs("authorization", "Bearer wrong"))
+	_, err = s.authenticateClient(ctx)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err), "authenticateClient should reject invalid credentials")
+}
+
+func TestAuthenticateCertificate(t *testing.T) {
+	t.Parallel()
+	s := newAuthTestRPCServer()
+
+	_, err := s.authenticateCertificate(t.Context())
+	assert.ErrorIs(t, err, errAuthHeaderMissing)
+
+	certPeer := func(cn string) context.Context {
+		return peer.NewContext(t.Context(), &peer.Peer{AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{
+			VerifiedChains: [][]*x509.Certificate{{{Subject: pkix.Name{CommonName: cn}}}},
+		}}})
+	}
+
+	_, err = s.authenticateCertificate(peer.NewContext(t.Context(), &peer.Peer{AuthInfo: credentials.TLSInfo{}}))
+	assert.ErrorIs(t, err, errAuthHeaderMissing, "unverified certificates should not authenticate")
+
+	_, err = s.authenticateCertificate(certPeer("unknown.example"))
+	assert.ErrorIs(t, err, errAuthUnknownCertificate)
+
+	ctx := metadata.NewIncomingContext(certPeer("trader.example"), metadata.MD{})
+	ctx, err = s.authenticateClient(ctx)
+	require.NoError(t, err)
+	u, ok := rpcUserFromContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, "trader", u.name)
+	assert.Equal(t, []auth.Role{auth.RoleMarketData, auth.RoleTrading}, u.roles)
+}
+
+type authTestServerStream struct {
+	grpc.ServerStream
+	ctx context.Context //nolint:containedctx // Test stream context
+}
+
+func (a *authTestServerStream) Context() context.Context {
+	return a.ctx
+}
+
+func TestAuthorise(t *testing.T) {
+	t.Parallel()
+	s := newAuthTestRPCServer()
+	reader := context.WithValue(t.Context(), rpcUserContextKey{}, &rpcUser{name: "reader", roles: []auth.Role{auth.RoleMarketData}})
+	admin := context.WithValue(t.Context(), rpcUserContextKey{}, &rpcUser{name: "admin", roles: []auth.Role{auth.RoleAdmin}})
+
+	var called int
+	handler := func(context.Context, any) (any, error) {
+		called++
+		return "resp", nil
+	}
+
+	_, err := s.authoriseUnary(t.Context(), nil, &grpc.UnaryServerInfo{FullMethod: gctrpc.GoCryptoTraderService_GetTicker_FullMethodName}, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err), "authoriseUnary should reject unauthenticated requests")
+
+	resp, err := s.authoriseUnary(reader, nil, &grpc.UnaryServerInfo{FullMethod: gctrpc.GoCryptoTraderService_GetTicker_FullMethodName}, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "resp", resp)
+
+	_, err = s.authoriseUnary(reader, nil, &grpc.UnaryServerInfo{FullMethod: gctrpc.GoCryptoTraderService_SubmitOrder_FullMethodName}, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "authoriseUnary should deny methods requiring other roles")
+
+	_, err = s.authoriseUnary(admin, nil, &grpc.UnaryServerInfo{FullMethod: gctrpc.GoCryptoTraderService_DisableSubsystem_FullMethodName}, handler)
+	require.NoError(t, err)
+	assert.Equal(t, 2, called, "handler should only be called for authorised requests")
+
+	streamHandler := func(any, grpc.ServerStream) error {
+		called++
+		return nil
+	}
+	err = s.authoriseStream(nil, &authTestServerStream{ctx: reader}, &grpc.StreamServerInfo{FullMethod: gctrpc.GoCryptoTraderService_GetTickerStream_FullMethodName}, streamHandler)
+	require.NoError(t, err)
+	err = s.authoriseStream(nil, &authTestServerStream{ctx: reader}, &grpc.StreamServerInfo{FullMethod: gctrpc.GoCryptoTraderService_GetAccountBalancesStream_FullMethodName}, streamHandler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "authoriseStream should deny methods requiring other roles")
+	assert.Equal(t, 3, called, "stream handler should only be called for authorised requests")
+}
+
+func TestServerTLSConfig(t *testing.T) {
+	t.Parallel()
+	tlsDir := filepath.Join(t.TempDir(), "tls")
+	require.NoError(t, CheckCerts(tlsDir))
+	certFile, keyFile := filepath.Join(tlsDir, "cert.pem"), filepath.Join(tlsDir, "key.pem")
+
+	cfg, err := serverTLSConfig(certFile, keyFile, "")
+	require.NoError(t, err)
+	assert.Len(t, cfg.Certificates, 1)
+	assert.Equal(t, tls.NoClientCert, cfg.ClientAuth, "client certificates should not be requested without a client CA")
+
+	cfg, err = serverTLSConfig(certFile, keyFile, certFile)
+	require.NoError(t, err)
+	assert.Equal(t, tls.VerifyClientCertIfGiven, cfg.ClientAuth)
+	assert.NotNil(t, cfg.ClientCAs)
+
+	_, err = serverTLSConfig(certFile, keyFile, filepath.Join(tlsDir, "missing.pem"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	_, err = serverTLSConfig(certFile, keyFile, keyFile)
+	assert.ErrorIs(t, err, errClientCAInvalid)
+
+	_, err = serverTLSConfig(keyFile, keyFile, "")
+	assert.Error(t, err, "serverTLSConfig should error on an invalid key pair")
+}
+
+func TestRPCProxyAuthClientToken(t *testing.T) {
+	t.Parallel()
+	s := newAuthTestRPCServer()
+	handler := s.authClient(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	for header, code := range map[string]int{
+		"Bearer " + testRPCToken: http.StatusOK,
+		"Bearer wrong":           http.StatusUnauthorized,
+		"":                       http.StatusUnauthorized,
+	} {
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "/", http.NoBody)
+		require.NoError(t, err, "NewRequestWithContext must not error")
+		if header != "" {
+			req.Header.Set("Authorization", header)
+		}
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		assert.Equalf(t, code, rr.Code, "authClient should return %d for %q", code, header)
+	}
+}
@@ -0,0 +1,409 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// websocketGatewayPath is the gRPC proxy path the websocket gateway is served on
+const websocketGatewayPath = "/v1/websocket"
+
+const (
+	gatewayOutboundBufferSize = 256
+	gatewayMaxSubscriptions   = 64
+	gatewayMaxMessageSize     = 64 * 1024
+	gatewayAuthTimeout        = 10 * time.Second
+	gatewayWriteTimeout       = 10 * time.Second
+	gatewayPongTimeout        = 60 * time.Second
+	gatewayPingInterval       = gatewayPongTimeout * 9 / 10
+)
+
+// Websocket gateway actions sent by clients
+const (
+	gatewayActionAuth        = "auth"
+	gatewayActionSubscribe   = "subscribe"
+	gatewayActionUnsubscribe = "unsubscribe"
+)
+
+// Websocket gateway message types sent to clients
+const (
+	gatewayTypeAuthenticated = "authenticated"
+	gatewayTypeSubscribed    = "subscribed"
+	gatewayTypeUnsubscribed  = "unsubscribed"
+	gatewayTypeData          = "data"
+	gatewayTypeClosed        = "closed"
+	gatewayTypeError         = "error"
+)
+
+var (
+	errGatewayUnknownAction       = errors.New("unknown action")
+	errGatewayUnknownMethod       = errors.New("unknown method")
+	errGatewayIDRequired          = errors.New("subscription id required")
+	errGatewayDuplicateID         = errors.New("subscription id already in use")
+	errGatewaySubscriptionMissing = errors.New("subscription not found")
+	errGatewayTooManySubs         = errors.New("too many subscriptions")
+	errGatewayNotStreaming        = errors.New("method is not a server streaming RPC")
+	errGatewayAuthRequired        = errors.New("first message must authenticate when no authorization header is sent")
+)
+
+var (
+	gatewayMarshaler   = protojson.MarshalOptions{EmitUnpopulated: true}
+	gatewayUnmarshaler = protojson.UnmarshalOptions{DiscardUnknown: true}
+)
+
+// gatewayRequest is a message sent by a websocket gateway client
+type gatewayRequest struct {
+	ID     string          `json:"id,omitempty"`
+	Action string          `json:"action"`
+	Method string          `json:"method,omitempty"`
+	Params json.RawMessage `json:"params,omitempty"`
+	Token  string          `json:"token,omitempty"`
+}
+
+// gatewayResponse is a message sent to a websocket gateway client. Dropped is
+// the number of data messages of the subscription discarded since the last one
+// delivered because the client was not reading fast enough
+type gatewayResponse struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Data    json.RawMessage `json:"data,omitempty"`
+	Error   string          `json:"error,omitempty"`
+	Dropped int64           `json:"dropped,omitempty"`
+}
+
+// websocketGateway bridges the server streaming RPCs of the gRPC server to
+// websocket clients. Each stream is opened as a gRPC call carrying the
+// client's authorization, so it is authorised against the user's roles
+type websocketGateway struct {
+	s        *RPCServer
+	conn     grpc.ClientConnInterface
+	service  protoreflect.ServiceDescriptor
+	upgrader websocket.Upgrader
+}
+
+// gatewayConn is a single websocket client with its multiplexed subscriptions
+type gatewayConn struct {
+	g             *websocketGateway
+	ws            *websocket.Conn
+	ctx           context.Context
+	authorization string
+	out           chan *gatewayResponse
+	m             sync.Mutex
+	subs          map[string]*gatewaySubscription
+}
+
+// gatewaySubscription is a server stream relayed to a websocket client
+type gatewaySubscription struct {
+	cancel  context.CancelFunc
+	dropped atomic.Int64
+}
+
+// newWebsocketGateway returns a gateway relaying streams from the gRPC client
+// connection. Browser origins other than the proxy's own must be listed in
+// allowedOrigins, or "*" to allow any origin
+func (s *RPCServer) newWebsocketGateway(conn grpc.ClientConnInterface, allowedOrigins []string) *websocketGateway {
+	g := &websocketGateway{
+		s:       s,
+		conn:    conn,
+		service: gctrpc.File_rpc_proto.Services().ByName("GoCryptoTraderService"),
+	}
+	g.upgrader.CheckOrigin = func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			return true
+		}
+		if u, err := url.Parse(origin); err == nil && strings.EqualFold(u.Host, r.Host) {
+			return true
+		}
+		return slices.ContainsFunc(allowedOrigins, func(o string) bool { return o == "*" || strings.EqualFold(o, origin) })
+	}
+	return g
+}
+
+// ServeHTTP upgrades a request to a websocket connection and serves it until
+// the client disconnects
+func (g *websocketGateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	authorization := r.Header.Get("Authorization")
+	if authorization != "" {
+		if _, err := g.s.authenticateHeader(authorization); err != nil {
+			http.Error(w, "Access denied", http.StatusUnauthorized)
+			log.Warnf(log.GRPCSys, "gRPC websocket gateway unauthorised access attempt. IP: %s\n", r.RemoteAddr)
+			return
+		}
+	}
+	ws, err := g.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// Upgrade has already replied to the client
+		log.Debugf(log.GRPCSys, "gRPC websocket gateway upgrade failed. IP: %s Err: %s\n", r.RemoteAddr, err)
+		return
+	}
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	c := &gatewayConn{
+		g:             g,
+		ws:            ws,
+		ctx:           ctx,
+		authorization: authorization,
+		out:           make(chan *gatewayResponse, gatewayOutboundBufferSize),
+		subs:          make(map[string]*gatewaySubscription),
+	}
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		c.writeLoop()
+		cancel()
+	})
+	c.readLoop()
+	cancel()
+	wg.Wait()
+	if err := ws.Close(); err != nil {
+		log.Debugf(log.GRPCSys, "gRPC websocket gateway close failed. IP: %s Err: %s\n", r.RemoteAddr, err)
+	}
+}
+
+// readLoop handles client messages until the connection fails or closes
+func (c *gatewayConn) readLoop() {
+	c.ws.SetReadLimit(gatewayMaxMessageSize)
+	if c.authorization == "" {
+		if err := c.ws.SetReadDeadline(time.Now().Add(gatewayAuthTimeout)); err != nil {
+			return
+		}
+	} else if err := c.ws.SetReadDeadline(time.Now().Add(gatewayPongTimeout)); err != nil {
+		return
+	}
+	c.ws.SetPongHandler(func(string) error {
+		return c.ws.SetReadDeadline(time.Now().Add(gatewayPongTimeout))
+	})
+	for {
+		_, msg, err := c.ws.ReadMessage()
+		if err != nil {
+			return
+		}
+		var req gatewayRequest
+		if err := json.Unmarshal(msg, &req); err != nil {
+			if c.authorization == "" {
+				c.sendClose(websocket.ClosePolicyViolation, errGatewayAuthRequired.Error())
+				return
+			}
+			c.send(&gatewayResponse{Type: gatewayTypeError, Error: err.Error()})
+			continue
+		}
+		if c.authorization == "" {
+			if !c.authenticate(&req) {
+				return
+			}
+			continue
+		}
+		switch req.Action {
+		case gatewayActionSubscribe:
+			err = c.subscribe(&req)
+		case gatewayActionUnsubscribe:
+			err = c.unsubscribe(req.ID)
+		default:
+			err = fmt.Errorf("%w %q", errGatewayUnknownAction, req.Action)
+		}
+		if err != nil {
+			c.send(&gatewayResponse{ID: req.ID, Type: gatewayTypeError, Error: err.Error()})
+		}
+	}
+}
+
+// authenticate checks the first message of a client which did not send an
+// authorization header holds a valid token
+func (c *gatewayConn) authenticate(req *gatewayRequest) bool {
+	if req.Action != gatewayActionAuth || req.Token == "" {
+		c.sendClose(websocket.ClosePolicyViolation, errGatewayAuthRequired.Error())
+		return false
+	}
+	authorization := "Bearer " + req.Token
+	if _, err := c.g.s.authenticateHeader(authorization); err != nil {
+		c.sendClose(websocket.ClosePolicyViolation, err.Error())
+		return false
+	}
+	c.authorization = authorization
+	if err := c.ws.SetReadDeadline(time.Now().Add(gatewayPongTimeout)); err != nil {
+		return false
+	}
+	c.send(&gatewayResponse{Type: gatewayTypeAuthenticated})
+	return true
+}
+
+// writeLoop writes queued messages and keepalive pings to the client until
+// the connection fails or the client disconnects
+func (c *gatewayConn) writeLoop() {
+	ping := time.NewTicker(gatewayPingInterval)
+	defer ping.Stop()
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case resp := <-c.out:
+			if err := c.ws.SetWriteDeadline(time.Now().Add(gatewayWriteTimeout)); err != nil {
+				return
+			}
+			if err := c.ws.WriteJSON(resp); err != nil {
+				return
+			}
+		case <-ping.C:
+			if err := c.ws.WriteControl(websocket.PingMessage, nil, time.Now().Add(gatewayWriteTimeout)); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// send queues a control message, waiting for space so that it is not lost
+func (c *gatewayConn) send(resp *gatewayResponse) {
+	select {
+	case c.out <- resp:
+	case <-c.ctx.Done():
+	}
+}
+
+// sendClose writes a close message directly, as the connection is closed
+// straight after
+func (c *gatewayConn) sendClose(code int, reason string) {
+	if err := c.ws.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(gatewayWriteTimeout)); err != nil {
+		log.Debugf(log.GRPCSys, "gRPC websocket gateway close message failed: %s\n", err)
+	}
+}
+
+// subscribe opens the requested server stream and relays its messages
+func (c *gatewayConn) subscribe(req *gatewayRequest) error {
+	if req.ID == "" {
+		return errGatewayIDRequired
+	}
+	method := c.g.service.Methods().ByName(protoreflect.Name(req.Method))
+	if method == nil {
+		return fmt.Errorf("%w %q", errGatewayUnknownMethod, req.Method)
+	}
+	if !method.IsStreamingServer() || method.IsStreamingClient() {
+		return fmt.Errorf("%w: %s", errGatewayNotStreaming, req.Method)
+	}
+	reqType, err := protoregistry.GlobalTypes.FindMessageByName(method.Input().FullName())
+	if err != nil {
+		return err
+	}
+	respType, err := protoregistry.GlobalTypes.FindMessageByName(method.Output().FullName())
+	if err != nil {
+		return err
+	}
+	in := reqType.New().Interface()
+	if len(req.Params) > 0 {
+		if err := gatewayUnmarshaler.Unmarshal(req.Params, in); err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithCancel(metadata.AppendToOutgoingContext(c.ctx, "authorization", c.authorization))
+	sub := &gatewaySubscription{cancel: cancel}
+	c.m.Lock()
+	if _, ok := c.subs[req.ID]; ok {
+		c.m.Unlock()
+		cancel()
+		return fmt.Errorf("%w %q", errGatewayDuplicateID, req.ID)
+	}
+	if len(c.subs) >= gatewayMaxSubscriptions {
+		c.m.Unlock()
+		cancel()
+		return fmt.Errorf("%w, maximum %d", errGatewayTooManySubs, gatewayMaxSubscriptions)
+	}
+	c.subs[req.ID] = sub
+	c.m.Unlock()
+
+	stream, err := c.g.conn.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true}, "/"+string(c.g.service.FullName())+"/"+req.Method)
+	if err == nil {
+		err = stream.SendMsg(in)
+	}
+	if err == nil {
+		err = stream.CloseSend()
+	}
+	if err != nil {
+		c.removeSubscription(req.ID, sub)
+		return err
+	}
+	c.send(&gatewayResponse{ID: req.ID, Type: gatewayTypeSubscribed})
+	go c.relay(req.ID, sub, stream, respType)
+	return nil
+}
+
+// relay forwards stream messages to the client until the stream ends. Data is
+// dropped rather than blocking the stream when the client's queue is full, and
+// the number dropped is reported with the next message delivered
+func (c *gatewayConn) relay(id string, sub *gatewaySubscription, stream grpc.ClientStream, respType protoreflect.MessageType) {
+	for {
+		msg := respType.New().Interface()
+		err := stream.RecvMsg(msg)
+		if err != nil {
+			if !c.removeSubscription(id, sub) || c.ctx.Err() != nil {
+				// Unsubscribed or the client disconnected
+				return
+			}
+			resp := &gatewayResponse{ID: id, Type: gatewayTypeClosed}
+			if !errors.Is(err, io.EOF) {
+				resp.Type, resp.Error = gatewayTypeError, status.Convert(err).Message()
+			}
+			c.send(resp)
+			return
+		}
+		data, err := gatewayMarshaler.Marshal(msg)
+		if err != nil {
+			log.Errorf(log.GRPCSys, "gRPC websocket gateway failed to encode %s message: %s\n", respType.Descriptor().FullName(), err)
+			continue
+		}
+		resp := &gatewayResponse{ID: id, Type: gatewayTypeData, Data: data, Dropped: sub.dropped.Swap(0)}
+		select {
+		case c.out <- resp:
+		default:
+			sub.dropped.Add(resp.Dropped + 1)
+		}
+	}
+}
+
+// unsubscribe cancels a subscription's stream
+func (c *gatewayConn) unsubscribe(id string) error {
+	c.m.Lock()
+	sub, ok := c.subs[id]
+	if ok {
+		delete(c.subs, id)
+	}
+	c.m.Unlock()
+	if !ok {
+		return fmt.Errorf("%w %q", errGatewaySubscriptionMissing, id)
+	}
+	sub.cancel()
+	c.send(&gatewayResponse{ID: id, Type: gatewayTypeUnsubscribed})
+	return nil
+}
+
+// removeSubscription removes a subscription whose stream has ended, returning
+// false if it was already unsubscribed
+func (c *gatewayConn) removeSubscription(id string, sub *gatewaySubscription) bool {
+	c.m.Lock()
+	defer c.m.Unlock()
+	if c.subs[id] != sub {
+		return false
+	}
+	delete(c.subs, id)
+	sub.cancel()
+	return true
+}
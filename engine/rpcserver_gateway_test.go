@@ -0,0 +1,190 @@
+package engine
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	grpcauth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newGatewayTestServer serves the websocket gateway in front of an in-process
+// gRPC server using the same authentication and authorisation interceptors
+func newGatewayTestServer(t *testing.T, allowedOrigins ...string) (*httptest.Server, *OrderManager) {
+	t.Helper()
+	s := newAuthTestRPCServer()
+	s.OrderManager = orderFeedSetup(t)
+
+	lis := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(grpcauth.UnaryServerInterceptor(s.authenticateClient), s.authoriseUnary),
+		grpc.ChainStreamInterceptor(grpcauth.StreamServerInterceptor(s.authenticateClient), s.authoriseStream),
+	)
+	gctrpc.RegisterGoCryptoTraderServiceServer(server, s)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, conn.Close()) })
+
+	ts := httptest.NewServer(s.newWebsocketGateway(conn, allowedOrigins))
+	t.Cleanup(ts.Close)
+	return ts, s.OrderManager
+}
+
+func dialGateway(t *testing.T, ts *httptest.Server, header http.Header) *websocket.Conn {
+	t.Helper()
+	ws, resp, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(ts.URL, "http"), header)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	t.Cleanup(func() { _ = ws.Close() })
+	return ws
+}
+
+func readGateway(t *testing.T, ws *websocket.Conn) *gatewayResponse {
+	t.Helper()
+	require.NoError(t, ws.SetReadDeadline(time.Now().Add(5*time.Second)))
+	_, msg, err := ws.ReadMessage()
+	require.NoError(t, err)
+	var resp gatewayResponse
+	require.NoError(t, json.Unmarshal(msg, &resp))
+	return &resp
+}
+
+func TestWebsocketGateway(t *testing.T) {
+	t.Parallel()
+	ts, m := newGatewayTestServer(t)
+	ws := dialGateway(t, ts, http.Header{"Authorization": {basicAuthHeader(testRPCUsername, testRPCPassword)}})
+
+	for _, tc := range []struct {
+		req gatewayRequest
+		err string
+	}{
+		{gatewayRequest{ID: "1", Action: "bad"}, errGatewayUnknownAction.Error()},
+		{gatewayRequest{Action: gatewayActionSubscribe, Method: "GetOrderUpdatesStream"}, errGatewayIDRequired.Error()},
+		{gatewayRequest{ID: "1", Action: gatewayActionSubscribe, Method: "NotAMethod"}, errGatewayUnknownMethod.Error()},
+		{gatewayRequest{ID: "1", Action: gatewayActionSubscribe, Method: "GetInfo"}, errGatewayNotStreaming.Error()},
+		{gatewayRequest{ID: "1", Action: gatewayActionSubscribe, Method: "GetOrderUpdatesStream", Params: json.RawMessage(`{"exchange":1}`)}, "exchange"},
+		{gatewayRequest{ID: "1", Action: gatewayActionUnsubscribe}, errGatewaySubscriptionMissing.Error()},
+	} {
+		require.NoError(t, ws.WriteJSON(tc.req))
+		resp := readGateway(t, ws)
+		assert.Equalf(t, gatewayTypeError, resp.Type, "%+v should error", tc.req)
+		assert.Containsf(t, resp.Error, tc.err, "%+v should return the correct error", tc.req)
+	}
+
+	require.NoError(t, ws.WriteJSON(gatewayRequest{ID: "orders", Action: gatewayActionSubscribe, Method: "GetOrderUpdatesStream", Params: json.RawMessage(`{"exchange":"","asset_type":""}`)}))
+	resp := readGateway(t, ws)
+	require.Equal(t, gatewayTypeSubscribed, resp.Type, "Subscribe must succeed")
+	assert.Equal(t, "orders", resp.ID)
+
+	require.NoError(t, ws.WriteJSON(gatewayRequest{ID: "orders", Action: gatewayActionSubscribe, Method: "GetFillsStream"}))
+	resp = readGateway(t, ws)
+	assert.Equal(t, gatewayTypeError, resp.Type)
+	assert.Contains(t, resp.Error, errGatewayDuplicateID.Error())
+
+	// The subscription is registered with the order manager asynchronously
+	var data *gatewayResponse
+	for i := 0; data == nil && i < 50; i++ {
+		_, err := m.UpsertOrder(&order.Detail{
+			Exchange:    testExchange,
+			OrderID:     "gateway",
+			AssetType:   asset.Spot,
+			Pair:        currency.NewBTCUSD(),
+			Side:        order.Buy,
+			Type:        order.Limit,
+			Status:      order.New,
+			Price:       100,
+			Amount:      1,
+			LastUpdated: time.Now().Add(time.Duration(i) * time.Millisecond),
+		})
+		require.NoError(t, err)
+		require.NoError(t, ws.SetReadDeadline(time.Now().Add(100*time.Millisecond)))
+		if _, msg, err := ws.ReadMessage(); err == nil {
+			data = new(gatewayResponse)
+			require.NoError(t, json.Unmarshal(msg, data))
+		}
+	}
+	require.NotNil(t, data, "Order updates must be relayed")
+	assert.Equal(t, gatewayTypeData, data.Type)
+	assert.Equal(t, "orders", data.ID)
+	var od gctrpc.OrderDetails
+	require.NoError(t, gatewayUnmarshaler.Unmarshal(data.Data, &od))
+	assert.Equal(t, "gateway", od.Id)
+}
+
+func TestWebsocketGatewayUnsubscribe(t *testing.T) {
+	t.Parallel()
+	ts, _ := newGatewayTestServer(t)
+	ws := dialGateway(t, ts, http.Header{"Authorization": {basicAuthHeader(testRPCUsername, testRPCPassword)}})
+	require.NoError(t, ws.WriteJSON(gatewayRequest{ID: "fills", Action: gatewayActionSubscribe, Method: "GetFillsStream"}))
+	require.Equal(t, gatewayTypeSubscribed, readGateway(t, ws).Type, "Subscribe must succeed")
+	require.NoError(t, ws.WriteJSON(gatewayRequest{ID: "fills", Action: gatewayActionUnsubscribe}))
+	resp := readGateway(t, ws)
+	assert.Equal(t, gatewayTypeUnsubscribed, resp.Type)
+	assert.Equal(t, "fills", resp.ID)
+	require.NoError(t, ws.WriteJSON(gatewayRequest{ID: "fills", Action: gatewayActionSubscribe, Method: "GetFillsStream"}))
+	assert.Equal(t, gatewayTypeSubscribed, readGateway(t, ws).Type, "Unsubscribed ids should be reusable")
+}
+
+func TestWebsocketGatewayAuthentication(t *testing.T) {
+	t.Parallel()
+	ts, _ := newGatewayTestServer(t, "https://allowed.example")
+	url := "ws" + strings.TrimPrefix(ts.URL, "http")
+
+	_, resp, err := websocket.DefaultDialer.Dial(url, http.Header{"Authorization": {basicAuthHeader(testRPCUsername, "wrong")}})
+	require.ErrorIs(t, err, websocket.ErrBadHandshake)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode, "Invalid authorization headers should be rejected")
+	require.NoError(t, resp.Body.Close())
+
+	_, resp, err = websocket.DefaultDialer.Dial(url, http.Header{"Origin": {"https://evil.example"}})
+	require.ErrorIs(t, err, websocket.ErrBadHandshake)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode, "Unlisted origins should be rejected")
+	require.NoError(t, resp.Body.Close())
+
+	ws := dialGateway(t, ts, http.Header{"Origin": {"https://allowed.example"}})
+	require.NoError(t, ws.WriteJSON(gatewayRequest{Action: gatewayActionSubscribe, Method: "GetFillsStream"}))
+	_, _, err = ws.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, websocket.ClosePolicyViolation), "Unauthenticated first messages should close the connection")
+
+	ws = dialGateway(t, ts, nil)
+	require.NoError(t, ws.WriteJSON(gatewayRequest{Action: gatewayActionAuth, Token: "wrong"}))
+	_, _, err = ws.ReadMessage()
+	assert.True(t, websocket.IsCloseError(err, websocket.ClosePolicyViolation), "Invalid tokens should close the connection")
+
+	ws = dialGateway(t, ts, nil)
+	require.NoError(t, ws.WriteJSON(gatewayRequest{Action: gatewayActionAuth, Token: testRPCToken}))
+	require.Equal(t, gatewayTypeAuthenticated, readGateway(t, ws).Type, "Valid tokens must authenticate")
+	require.NoError(t, ws.WriteJSON(gatewayRequest{ID: "fills", Action: gatewayActionSubscribe, Method: "GetFillsStream"}))
+	// The stream is opened before the server authorises it, so the denial
+	// arrives after the subscription is acknowledged
+	for {
+		r := readGateway(t, ws)
+		if r.Type == gatewayTypeSubscribed {
+			continue
+		}
+		assert.Equal(t, gatewayTypeError, r.Type)
+		assert.Equal(t, "fills", r.ID)
+		assert.Contains(t, r.Error, "trading", "Streams should be authorised against the user's roles")
+		break
+	}
+}
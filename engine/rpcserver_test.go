@@ -31,6 +31,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/database/drivers"
 	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/changefeed"
 	dbexchange "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
 	sqltrade "github.com/thrasher-corp/gocryptotrader/database/repository/trade"
 	"github.com/thrasher-corp/gocryptotrader/encoding/json"
@@ -40,12 +41,14 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/binance"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/collateral"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/gctrpc"
@@ -861,6 +864,22 @@ func TestGetHistoricCandles(t *testing.T) {
 	if len(results.Candle) == 0 {
 		t.Error("expected results")
 	}
+
+	// cache run
+	results, err = s.GetHistoricCandles(t.Context(), &gctrpc.GetHistoricCandlesRequest{
+		Exchange: testExchange,
+		Pair: &gctrpc.CurrencyPair{
+			Base:  cp.Base.String(),
+			Quote: cp.Quote.String(),
+		},
+		AssetType:    asset.Spot.String(),
+		Start:        defaultStart.Format(common.SimpleTimeFormatWithTimezone),
+		End:          defaultEnd.Format(common.SimpleTimeFormatWithTimezone),
+		TimeInterval: int64(kline.OneHour.Duration()),
+		UseCache:     true,
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, results.Candle, "cached candles should be returned")
 	err = trade.SaveTradesToDatabase(trade.Data{
 		TID:          "test123",
 		Exchange:     testExchange,
@@ -3068,8 +3087,14 @@ func TestGetAllManagedPositions(t *testing.T) {
 	request.GetFundingPayments = true
 	request.IncludeFullFundingRates = true
 	request.IncludeFullOrderData = true
-	_, err = s.GetAllManagedPositions(t.Context(), request)
-	assert.NoError(t, err)
+	resp, err := s.GetAllManagedPositions(t.Context(), request)
+	require.NoError(t, err)
+	require.Len(t, resp.Positions, 1)
+	assert.Equal(t, accounts.DefaultAccount, resp.Positions[0].Account, "position should be labelled with its account")
+
+	resp, err = s.GetAllManagedPositions(accounts.DeployAccountToContext(t.Context(), "hedge"), request)
+	require.NoError(t, err)
+	assert.Empty(t, resp.Positions, "positions of other accounts should be filtered out")
 }
 
 func TestGetOrderbookMovement(t *testing.T) {
@@ -3938,3 +3963,326 @@ func TestGetCurrencyTradeURL(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotEmpty(t, resp.Url)
 }
+
+func TestRunDataQualityCheck(t *testing.T) {
+	t.Parallel()
+	m := setupTestDataQualityManager(t, dataQualityTestCandles(5))
+	s := RPCServer{Engine: &Engine{dataQualityManager: m}}
+	_, err := s.RunDataQualityCheck(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	req := &gctrpc.DataQualityCheckRequest{Exchange: testExchange, Asset: "spot"}
+	_, err = s.RunDataQualityCheck(t.Context(), req)
+	assert.ErrorIs(t, err, errCurrencyPairUnset)
+
+	req.Pair = &gctrpc.CurrencyPair{Delimiter: "-", Base: "btc", Quote: "usd"}
+	req.Interval = int64(kline.OneHour.Duration())
+	_, err = s.RunDataQualityCheck(t.Context(), req)
+	assert.ErrorIs(t, err, errInvalidTimes)
+
+	req.Start = dataQualityTestStart.Format(common.SimpleTimeFormatWithTimezone)
+	req.End = dataQualityTestStart.Add(time.Hour * 6).Format(common.SimpleTimeFormatWithTimezone)
+	resp, err := s.RunDataQualityCheck(t.Context(), req)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), resp.CandlesChecked)
+	require.Len(t, resp.Issues, 1)
+	assert.Equal(t, int64(1), resp.IssueSummary[dataQualityGap.String()])
+}
+
+func TestGetDataQualityReports(t *testing.T) {
+	t.Parallel()
+	m := setupTestDataQualityManager(t, dataQualityTestCandles(5))
+	s := RPCServer{Engine: &Engine{dataQualityManager: m}}
+	_, err := s.GetDataQualityReports(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	report, err := m.RunCheck(&DataQualityCheck{
+		Exchange:  testExchange,
+		Asset:     asset.Spot,
+		Pair:      currency.NewPair(currency.BTC, currency.USD),
+		Interval:  kline.OneHour,
+		StartDate: dataQualityTestStart,
+		EndDate:   dataQualityTestStart.Add(time.Hour * 6),
+	})
+	require.NoError(t, err)
+
+	resp, err := s.GetDataQualityReports(t.Context(), &gctrpc.GetDataQualityReportsRequest{Id: report.ID.String()})
+	require.NoError(t, err)
+	require.Len(t, resp.Reports, 1)
+	assert.Empty(t, resp.Reports[0].Issues, "issues should be omitted unless requested")
+	assert.Equal(t, int64(1), resp.Reports[0].IssueSummary[dataQualityGap.String()])
+
+	resp, err = s.GetDataQualityReports(t.Context(), &gctrpc.GetDataQualityReportsRequest{Exchange: testExchange, Asset: "spot", IncludeIssues: true})
+	require.NoError(t, err)
+	require.Len(t, resp.Reports, 1)
+	assert.Len(t, resp.Reports[0].Issues, 1)
+
+	_, err = s.GetDataQualityReports(t.Context(), &gctrpc.GetDataQualityReportsRequest{Id: "invalid"})
+	assert.Error(t, err)
+}
+
+func TestGetCompositePricesRPC(t *testing.T) {
+	t.Parallel()
+	m := setupTestCompositePriceManager(t)
+	s := RPCServer{Engine: &Engine{compositePriceManager: m}}
+	_, err := s.GetCompositePrices(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	_, err = s.GetCompositePrices(t.Context(), &gctrpc.GetCompositePricesRequest{})
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	m.started.Store(true)
+	now := time.Now()
+	pair := currency.NewPair(currency.BTC, currency.USD)
+	m.updateFromTicker(&ticker.Price{ExchangeName: "Kraken", Pair: pair, AssetType: asset.Spot, Last: 102, Volume: 30, LastUpdated: now})
+	m.calculateAll(now)
+
+	resp, err := s.GetCompositePrices(t.Context(), &gctrpc.GetCompositePricesRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Prices, 1)
+	assert.Empty(t, resp.Prices[0].Constituents)
+
+	_, err = s.GetCompositePrices(t.Context(), &gctrpc.GetCompositePricesRequest{Pair: &gctrpc.CurrencyPair{Base: "btc", Quote: "usd"}})
+	assert.ErrorIs(t, err, asset.ErrNotSupported)
+
+	resp, err = s.GetCompositePrices(t.Context(), &gctrpc.GetCompositePricesRequest{Asset: "spot", Pair: &gctrpc.CurrencyPair{Base: "btc", Quote: "usd"}, IncludeConstituents: true})
+	require.NoError(t, err)
+	require.Len(t, resp.Prices, 1)
+	require.Len(t, resp.Prices[0].Constituents, 1)
+	assert.Equal(t, "kraken", resp.Prices[0].Constituents[0].Exchange)
+
+	resp, err = s.GetCompositePrices(t.Context(), &gctrpc.GetCompositePricesRequest{Asset: "futures"})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Prices)
+}
+
+// changeFeedTestStream captures change feed responses sent to the client
+type changeFeedTestStream struct {
+	dummyServer
+	ctx      context.Context
+	received chan *gctrpc.DatabaseChangeFeedResponse
+}
+
+func (c *changeFeedTestStream) Send(r *gctrpc.DatabaseChangeFeedResponse) error {
+	c.received <- r
+	return nil
+}
+
+func (c *changeFeedTestStream) Context() context.Context { return c.ctx }
+
+func TestGetDatabaseChangeFeedStream(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{Config: &config.Config{}}}
+	err := s.GetDatabaseChangeFeedStream(&gctrpc.GetDatabaseChangeFeedStreamRequest{}, nil)
+	assert.ErrorIs(t, err, database.ErrDatabaseSupportDisabled)
+
+	s.Config.Database.Enabled = true
+	err = s.GetDatabaseChangeFeedStream(&gctrpc.GetDatabaseChangeFeedStreamRequest{Channels: []string{"bad"}}, nil)
+	assert.Error(t, err, "GetDatabaseChangeFeedStream should error on an unknown channel")
+
+	ctx, cancel := context.WithCancel(t.Context())
+	stream := &changeFeedTestStream{ctx: ctx, received: make(chan *gctrpc.DatabaseChangeFeedResponse, 100)}
+	errs := make(chan error, 1)
+	go func() {
+		errs <- s.GetDatabaseChangeFeedStream(&gctrpc.GetDatabaseChangeFeedStreamRequest{Channels: []string{changefeed.DataHistoryJobChannel}}, stream)
+	}()
+
+	var resp *gctrpc.DatabaseChangeFeedResponse
+	require.Eventually(t, func() bool {
+		changefeed.Publish(changefeed.DataHistoryJobChannel, &changefeed.DataHistoryJobPayload{Nickname: "test", Status: 1})
+		select {
+		case resp = <-stream.received:
+			return true
+		default:
+			return false
+		}
+	}, time.Second*5, time.Millisecond*10, "stream must receive published notifications")
+	assert.Equal(t, changefeed.DataHistoryJobChannel, resp.Channel)
+	assert.Contains(t, resp.Payload, `"nickname":"test"`)
+	assert.NotZero(t, resp.Timestamp)
+
+	cancel()
+	assert.ErrorIs(t, <-errs, context.Canceled)
+}
+
+func TestGetRateLimitStatus(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err)
+	exch.GetBase().Name = fakeExchangeName
+	require.NoError(t, em.Add(exch))
+	s := RPCServer{Engine: &Engine{ExchangeManager: em}}
+
+	_, err = s.GetRateLimitStatus(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	_, err = s.GetRateLimitStatus(t.Context(), &gctrpc.GenericExchangeNameRequest{Exchange: "meow"})
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+
+	_, err = s.GetRateLimitStatus(t.Context(), &gctrpc.GenericExchangeNameRequest{Exchange: fakeExchangeName})
+	assert.ErrorIs(t, err, request.ErrRequestSystemIsNil)
+
+	exch.GetBase().Requester, err = request.New(fakeExchangeName, new(http.Client))
+	require.NoError(t, err)
+	resp, err := s.GetRateLimitStatus(t.Context(), &gctrpc.GenericExchangeNameRequest{Exchange: fakeExchangeName})
+	require.NoError(t, err)
+	assert.Equal(t, exch.GetName(), resp.Exchange)
+	assert.Empty(t, resp.BackoffUntil, "backoff should be empty when not in effect")
+	assert.Empty(t, resp.Budgets)
+}
+
+func TestRPCReloadExchangeCredentials(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	exch.GetBase().Config = &config.Exchange{API: config.APIConfig{Credentials: config.APICredentialsConfig{Key: "key", Secret: "secret"}}}
+	require.NoError(t, em.Add(exch))
+	s := RPCServer{Engine: &Engine{ExchangeManager: em}}
+
+	_, err = s.ReloadExchangeCredentials(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	_, err = s.ReloadExchangeCredentials(t.Context(), &gctrpc.GenericExchangeNameRequest{Exchange: "meow"})
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+
+	resp, err := s.ReloadExchangeCredentials(t.Context(), &gctrpc.GenericExchangeNameRequest{Exchange: testExchange})
+	require.NoError(t, err)
+	assert.Equal(t, MsgStatusSuccess, resp.Status)
+	assert.Equal(t, "key", exch.GetBase().GetDefaultCredentials().Key)
+}
+
+func TestRPCReloadConfig(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{}}
+	_, err := s.ReloadConfig(t.Context(), &gctrpc.ReloadConfigRequest{})
+	assert.ErrorIs(t, err, errNilConfig)
+
+	cfgFile := filepath.Join(t.TempDir(), "config.json")
+	data, err := os.ReadFile(config.TestFile)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(cfgFile, data, 0o600))
+	s.Config = &config.Config{}
+	require.NoError(t, s.Config.LoadConfig(cfgFile, true))
+	s.Settings.ConfigFile = cfgFile
+	s.ExchangeManager = NewExchangeManager()
+
+	resp, err := s.ReloadConfig(t.Context(), &gctrpc.ReloadConfigRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Applied, "Reloading an unchanged config should apply nothing")
+	assert.Empty(t, resp.RestartRequired, "Reloading an unchanged config should require no restart")
+}
+
+// orderUpdatesTestStream captures order updates sent to the client
+type orderUpdatesTestStream struct {
+	dummyServer
+	ctx      context.Context
+	received chan *gctrpc.OrderDetails
+}
+
+func (o *orderUpdatesTestStream) Send(r *gctrpc.OrderDetails) error {
+	o.received <- r
+	return nil
+}
+
+func (o *orderUpdatesTestStream) Context() context.Context { return o.ctx }
+
+// fillsTestStream captures fills sent to the client
+type fillsTestStream struct {
+	dummyServer
+	ctx      context.Context
+	received chan *gctrpc.FillResponse
+}
+
+func (f *fillsTestStream) Send(r *gctrpc.FillResponse) error {
+	f.received <- r
+	return nil
+}
+
+func (f *fillsTestStream) Context() context.Context { return f.ctx }
+
+func TestGetOrderUpdatesStream(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{Config: &config.Config{}}}
+	err := s.GetOrderUpdatesStream(nil, nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	err = s.GetOrderUpdatesStream(&gctrpc.GetOrderUpdatesStreamRequest{}, nil)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	m := orderFeedSetup(t)
+	em, ok := m.orderStore.exchangeManager.(*ExchangeManager)
+	require.True(t, ok)
+	s.OrderManager, s.ExchangeManager = m, em
+	err = s.GetOrderUpdatesStream(&gctrpc.GetOrderUpdatesStreamRequest{Exchange: "fake"}, nil)
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+	err = s.GetOrderUpdatesStream(&gctrpc.GetOrderUpdatesStreamRequest{AssetType: "fake"}, nil)
+	assert.ErrorIs(t, err, asset.ErrNotSupported)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	stream := &orderUpdatesTestStream{ctx: ctx, received: make(chan *gctrpc.OrderDetails, 100)}
+	errs := make(chan error, 1)
+	go func() {
+		errs <- s.GetOrderUpdatesStream(&gctrpc.GetOrderUpdatesStreamRequest{
+			Exchange:  testExchange,
+			AssetType: "spot",
+			Pair:      &gctrpc.CurrencyPair{Base: "BTC", Quote: "USD"},
+		}, stream)
+	}()
+
+	var resp *gctrpc.OrderDetails
+	var id int
+	require.Eventually(t, func() bool {
+		id++
+		_, err := m.UpsertOrder(&order.Detail{Exchange: testExchange, OrderID: strconv.Itoa(id), AssetType: asset.Spot, Pair: currency.NewBTCUSD(), Status: order.Filled, Amount: 1})
+		require.NoError(t, err)
+		select {
+		case resp = <-stream.received:
+			return true
+		default:
+			return false
+		}
+	}, time.Second*5, time.Millisecond*10, "stream must receive order updates")
+	assert.Equal(t, testExchange, resp.Exchange)
+	assert.Equal(t, order.Filled.String(), resp.Status)
+
+	cancel()
+	assert.ErrorIs(t, <-errs, context.Canceled)
+}
+
+func TestGetFillsStream(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{Config: &config.Config{}}}
+	err := s.GetFillsStream(nil, nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	err = s.GetFillsStream(&gctrpc.GetFillsStreamRequest{}, nil)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	m := orderFeedSetup(t)
+	s.OrderManager = m
+	ctx, cancel := context.WithCancel(t.Context())
+	stream := &fillsTestStream{ctx: ctx, received: make(chan *gctrpc.FillResponse, 100)}
+	errs := make(chan error, 1)
+	go func() {
+		errs <- s.GetFillsStream(&gctrpc.GetFillsStreamRequest{AssetType: "spot"}, stream)
+	}()
+
+	var resp *gctrpc.FillResponse
+	require.Eventually(t, func() bool {
+		require.NoError(t, m.PublishFills(fill.Data{Exchange: testExchange, AssetType: asset.Spot, CurrencyPair: currency.NewBTCUSD(), TradeID: "1", Price: 100, Amount: 1, Timestamp: time.Now()}))
+		select {
+		case resp = <-stream.received:
+			return true
+		default:
+			return false
+		}
+	}, time.Second*5, time.Millisecond*10, "stream must receive fills")
+	assert.Equal(t, "1", resp.TradeId)
+	assert.Equal(t, "BTC", resp.Pair.Base)
+	assert.Equal(t, 100.0, resp.Price)
+	assert.NotZero(t, resp.Timestamp)
+
+	cancel()
+	assert.ErrorIs(t, <-errs, context.Canceled)
+}
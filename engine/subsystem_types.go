@@ -7,8 +7,10 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/exchange/websocket"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -59,6 +61,7 @@ type iOrderManager interface {
 	Cancel(context.Context, *order.Cancel) error
 	GetByExchangeAndID(string, string) (*order.Detail, error)
 	UpdateExistingOrder(*order.Detail) error
+	PublishFills(...fill.Data) error
 }
 
 // iPortfolioManager limits exposure of accessible functions to portfolio manager
@@ -74,6 +77,7 @@ type iCurrencyPairSyncer interface {
 	PrintTickerSummary(*ticker.Price, string, error)
 	PrintOrderbookSummary(*orderbook.Book, string, error)
 	WebsocketUpdate(string, currency.Pair, asset.Item, syncItemType, error) error
+	SubscriptionHealthUpdate(*websocket.SubscriptionHealthEvent) error
 }
 
 // iDatabaseConnectionManager defines a limited scoped databaseConnectionManager
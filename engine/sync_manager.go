@@ -14,10 +14,12 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/websocket"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stats"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/subscription"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
@@ -170,7 +172,8 @@ func (m *SyncManager) Start(ctx context.Context) error {
 			}
 			enabledPairs, err := exchanges[x].GetEnabledPairs(assetTypes[y])
 			if err != nil {
-				log.Errorf(log.SyncMgr,
+				log.ErrorWithFieldsf(log.SyncMgr,
+					log.ExtraFields{}.WithSubsystem(SyncManagerName).WithExchange(exchangeName, assetTypes[y], nil).WithError(err),
 					"%s failed to get enabled pairs. Err: %s",
 					exchangeName,
 					err)
@@ -253,6 +256,42 @@ func (m *SyncManager) get(k key.ExchangeAssetPair) *currencyPairSyncAgent {
 	return m.currencyPairs[k]
 }
 
+// removePairs removes the sync agents of the supplied exchange asset pairs,
+// releasing any initial sync items still outstanding so waiters are not
+// blocked on pairs which will never be synced. It returns the number of agents
+// removed
+func (m *SyncManager) removePairs(exchangeName string, a asset.Item, pairs currency.Pairs) int {
+	if m == nil {
+		return 0
+	}
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	var removed int
+	for _, p := range pairs {
+		k := key.NewExchangeAssetPair(exchangeName, a, p)
+		c, ok := m.currencyPairs[k]
+		if !ok {
+			continue
+		}
+		for i := range c.trackers {
+			c.locks[i].Lock()
+			if c.trackers[i] != nil && !c.trackers[i].HaveData && !m.initSyncCompleted.Load() {
+				c.trackers[i].HaveData = true
+				removedCounter.Add(1)
+				m.initSyncWG.Done()
+			}
+			c.locks[i].Unlock()
+		}
+		delete(m.currencyPairs, k)
+		removed++
+		if m.config.Verbose {
+			log.Debugf(log.SyncMgr, "%s: Removed sync agent %v %s", exchangeName, m.FormatCurrency(p), a)
+		}
+	}
+	return removed
+}
+
 func newCurrencyPairSyncAgent(k key.ExchangeAssetPair) *currencyPairSyncAgent {
 	return &currencyPairSyncAgent{
 		Key:      k,
@@ -369,8 +408,8 @@ func (m *SyncManager) WebsocketUpdate(exchangeName string, p currency.Pair, a as
 	}
 
 	k := key.NewExchangeAssetPair(exchangeName, a, p)
-	c, exists := m.currencyPairs[k]
-	if !exists {
+	c := m.get(k)
+	if c == nil {
 		return fmt.Errorf("%w for %q %q %q %q %q",
 			errCouldNotSyncNewData,
 			k.Exchange,
@@ -392,7 +431,8 @@ func (m *SyncManager) WebsocketUpdate(exchangeName string, p currency.Pair, a as
 		s.IsUsingWebsocket = true
 		s.IsUsingREST = false
 		if m.config.LogSwitchProtocolEvents {
-			log.Warnf(log.SyncMgr,
+			log.WarnWithFieldsf(log.SyncMgr,
+				c.logFields(),
 				"%s %s %s: %s Websocket re-enabled, switching from rest to websocket",
 				k.Exchange,
 				m.FormatCurrency(c.Pair),
@@ -405,6 +445,83 @@ func (m *SyncManager) WebsocketUpdate(exchangeName string, p currency.Pair, a as
 	return m.update(c, syncType, err)
 }
 
+// logFields returns structured logging fields for the sync agent's exchange,
+// asset and pair
+func (c *currencyPairSyncAgent) logFields() log.ExtraFields {
+	return log.ExtraFields{}.WithSubsystem(SyncManagerName).WithExchange(c.Key.Exchange, c.Key.Asset, c.Pair)
+}
+
+// SubscriptionHealthUpdate switches the sync items of the pairs of a websocket
+// subscription to REST polling when the subscription falls back and back to
+// websocket when it recovers
+func (m *SyncManager) SubscriptionHealthUpdate(e *websocket.SubscriptionHealthEvent) error {
+	if m == nil {
+		return fmt.Errorf("exchange CurrencyPairSyncer %w", ErrNilSubsystem)
+	}
+	if !m.started.Load() {
+		return fmt.Errorf("exchange CurrencyPairSyncer %w", ErrSubSystemNotStarted)
+	}
+	if e == nil {
+		return fmt.Errorf("subscription health event %w", common.ErrNilPointer)
+	}
+	if e.State != websocket.SubscriptionFallback && e.State != websocket.SubscriptionRecovered {
+		return nil
+	}
+	var syncType syncItemType
+	switch e.Channel {
+	case subscription.TickerChannel:
+		syncType = SyncItemTicker
+	case subscription.OrderbookChannel:
+		syncType = SyncItemOrderbook
+	case subscription.AllTradesChannel:
+		syncType = SyncItemTrade
+	default:
+		return nil
+	}
+	useWebsocket := e.State == websocket.SubscriptionRecovered
+
+	m.mux.Lock()
+	agents := make([]*currencyPairSyncAgent, 0, len(e.Pairs))
+	for k, c := range m.currencyPairs {
+		if k.Exchange != e.Exchange || (e.Asset.IsValid() && k.Asset != e.Asset) {
+			continue
+		}
+		if len(e.Pairs) != 0 && !e.Pairs.Contains(c.Pair, true) {
+			continue
+		}
+		agents = append(agents, c)
+	}
+	m.mux.Unlock()
+
+	for _, c := range agents {
+		c.locks[syncType].Lock()
+		s := c.trackers[syncType]
+		if s != nil && s.IsUsingWebsocket != useWebsocket {
+			s.IsUsingWebsocket = useWebsocket
+			s.IsUsingREST = !useWebsocket
+			if m.config.LogSwitchProtocolEvents {
+				from, to := "websocket", "rest"
+				if useWebsocket {
+					from, to = to, from
+				}
+				log.WarnWithFieldsf(log.SyncMgr,
+					c.logFields(),
+					"%s %s %s: %s subscription %s, switching from %s to %s",
+					c.Key.Exchange,
+					m.FormatCurrency(c.Pair),
+					strings.ToUpper(c.Key.Asset.String()),
+					syncType,
+					e.State,
+					from,
+					to,
+				)
+			}
+		}
+		c.locks[syncType].Unlock()
+	}
+	return nil
+}
+
 // update notifies the SyncManager to change the last updated time for a exchange asset pair
 func (m *SyncManager) update(c *currencyPairSyncAgent, syncType syncItemType, err error) error {
 	if syncType < SyncItemTicker || syncType > SyncItemTrade {
@@ -539,7 +656,8 @@ func (m *SyncManager) syncTicker(ctx context.Context, c *currencyPairSyncAgent,
 		s.IsUsingWebsocket = false
 		s.IsUsingREST = true
 		if m.config.LogSwitchProtocolEvents {
-			log.Warnf(log.SyncMgr,
+			log.WarnWithFieldsf(log.SyncMgr,
+				c.logFields(),
 				"%s %s %s: No ticker update after %s, switching from websocket to rest",
 				c.Key.Exchange,
 				m.FormatCurrency(c.Pair),
@@ -595,7 +713,7 @@ func (m *SyncManager) syncTicker(ctx context.Context, c *currencyPairSyncAgent,
 		m.PrintTickerSummary(result, "REST", err)
 		updateErr := m.update(c, SyncItemTicker, err)
 		if updateErr != nil {
-			log.Errorln(log.SyncMgr, updateErr)
+			log.ErrorlnWithFields(log.SyncMgr, c.logFields().WithError(updateErr), updateErr)
 		}
 	}
 }
@@ -622,7 +740,8 @@ func (m *SyncManager) syncOrderbook(ctx context.Context, c *currencyPairSyncAgen
 		s.IsUsingWebsocket = false
 		s.IsUsingREST = true
 		if m.config.LogSwitchProtocolEvents {
-			log.Warnf(log.SyncMgr,
+			log.WarnWithFieldsf(log.SyncMgr,
+				c.logFields(),
 				"%s %s %s: No orderbook update after %s, switching from websocket to rest",
 				c.Key.Exchange,
 				m.FormatCurrency(c.Pair),
@@ -639,7 +758,7 @@ func (m *SyncManager) syncOrderbook(ctx context.Context, c *currencyPairSyncAgen
 		m.PrintOrderbookSummary(result, "REST", err)
 		updateErr := m.update(c, SyncItemOrderbook, err)
 		if updateErr != nil {
-			log.Errorln(log.SyncMgr, updateErr)
+			log.ErrorlnWithFields(log.SyncMgr, c.logFields().WithError(updateErr), updateErr)
 		}
 	}
 }
@@ -653,7 +772,7 @@ func (m *SyncManager) syncTrades(c *currencyPairSyncAgent) {
 	if time.Since(c.trackers[SyncItemTrade].LastUpdated) > m.config.TimeoutREST {
 		err := m.update(c, SyncItemTrade, nil)
 		if err != nil {
-			log.Errorln(log.SyncMgr, err)
+			log.ErrorlnWithFields(log.SyncMgr, c.logFields().WithError(err), err)
 		}
 	}
 }
@@ -716,7 +835,7 @@ func (m *SyncManager) PrintTickerSummary(result *ticker.Price, protocol string,
 				err)
 			return
 		}
-		log.Errorf(log.SyncMgr, "Failed to get %s ticker. Error: %s",
+		log.ErrorWithFieldsf(log.SyncMgr, log.ExtraFields{}.WithSubsystem(SyncManagerName).WithError(err), "Failed to get %s ticker. Error: %s",
 			protocol,
 			err)
 		return
@@ -812,7 +931,9 @@ func (m *SyncManager) PrintOrderbookSummary(result *orderbook.Book, protocol str
 				err)
 			return
 		}
-		log.Errorf(log.OrderBook, "Failed to get %s orderbook for %s %s %s. Error: %s",
+		log.ErrorWithFieldsf(log.OrderBook,
+			log.ExtraFields{}.WithSubsystem(SyncManagerName).WithExchange(result.Exchange, result.Asset, result.Pair).WithError(err),
+			"Failed to get %s orderbook for %s %s %s. Error: %s",
 			protocol,
 			result.Exchange,
 			result.Pair,
@@ -877,6 +998,41 @@ func (m *SyncManager) WaitForInitialSync() error {
 	return nil
 }
 
+// SyncStatus returns a snapshot of all synchronised items
+func (m *SyncManager) SyncStatus() []SyncItemStatus {
+	if m == nil {
+		return nil
+	}
+	m.mux.Lock()
+	agents := make([]*currencyPairSyncAgent, 0, len(m.currencyPairs))
+	for _, c := range m.currencyPairs {
+		agents = append(agents, c)
+	}
+	m.mux.Unlock()
+
+	var status []SyncItemStatus
+	for _, c := range agents {
+		for i := range c.trackers {
+			c.locks[i].Lock()
+			if c.trackers[i] != nil {
+				status = append(status, SyncItemStatus{
+					Exchange:         c.Key.Exchange,
+					Asset:            c.Key.Asset,
+					Pair:             c.Pair,
+					Item:             syncItemType(i).String(),
+					IsUsingWebsocket: c.trackers[i].IsUsingWebsocket,
+					IsUsingREST:      c.trackers[i].IsUsingREST,
+					HaveData:         c.trackers[i].HaveData,
+					LastUpdated:      c.trackers[i].LastUpdated,
+					NumErrors:        c.trackers[i].NumErrors,
+				})
+			}
+			c.locks[i].Unlock()
+		}
+	}
+	return status
+}
+
 func greatestCommonDivisor(a, b time.Duration) time.Duration {
 	for b != 0 {
 		t := b
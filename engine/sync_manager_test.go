@@ -13,9 +13,11 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/websocket"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/subscription"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 )
 
@@ -295,6 +297,56 @@ func TestWaitForInitialSync(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSyncStatus(t *testing.T) {
+	t.Parallel()
+	var m *SyncManager
+	assert.Nil(t, m.SyncStatus())
+
+	m = &SyncManager{currencyPairs: make(map[key.ExchangeAssetPair]*currencyPairSyncAgent)}
+	m.config.SynchronizeTicker = true
+	m.initSyncCompleted.Store(true)
+	updated := time.Now()
+	k := key.NewExchangeAssetPair(testExchange, asset.Spot, currency.NewBTCUSDT())
+	m.add(k, syncBase{IsUsingWebsocket: true, HaveData: true, LastUpdated: updated})
+
+	status := m.SyncStatus()
+	require.Len(t, status, 1, "SyncStatus must only return enabled sync items")
+	assert.Equal(t, testExchange, status[0].Exchange)
+	assert.Equal(t, asset.Spot, status[0].Asset)
+	assert.True(t, currency.NewBTCUSDT().Equal(status[0].Pair))
+	assert.Equal(t, "Ticker", status[0].Item)
+	assert.True(t, status[0].IsUsingWebsocket)
+	assert.True(t, status[0].HaveData)
+	assert.Equal(t, updated, status[0].LastUpdated)
+}
+
+func TestSyncManagerRemovePairs(t *testing.T) {
+	t.Parallel()
+	assert.Zero(t, (*SyncManager)(nil).removePairs(testExchange, asset.Spot, currency.Pairs{currency.NewBTCUSDT()}))
+
+	m := &SyncManager{currencyPairs: make(map[key.ExchangeAssetPair]*currencyPairSyncAgent)}
+	m.config.SynchronizeTicker = true
+	m.config.SynchronizeOrderbook = true
+	m.add(key.NewExchangeAssetPair(testExchange, asset.Spot, currency.NewBTCUSDT()), syncBase{})
+	m.add(key.NewExchangeAssetPair(testExchange, asset.Spot, currency.NewBTCUSD()), syncBase{})
+
+	assert.Equal(t, 1, m.removePairs(testExchange, asset.Spot, currency.Pairs{currency.NewBTCUSDT(), currency.NewPair(currency.ETH, currency.USD)}))
+	assert.Nil(t, m.get(key.NewExchangeAssetPair(testExchange, asset.Spot, currency.NewBTCUSDT())), "Removed pair agent should be deleted")
+	require.NotNil(t, m.get(key.NewExchangeAssetPair(testExchange, asset.Spot, currency.NewBTCUSD())), "Other pair agents must be kept")
+
+	assert.Equal(t, 1, m.removePairs(testExchange, asset.Spot, currency.Pairs{currency.NewBTCUSD()}))
+	done := make(chan struct{})
+	go func() {
+		m.initSyncWG.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		assert.Fail(t, "Removing pairs should release their outstanding initial sync items")
+	}
+}
+
 func TestSyncManagerWebsocketUpdate(t *testing.T) {
 	t.Parallel()
 	var m *SyncManager
@@ -346,3 +398,46 @@ func TestSyncManagerWebsocketUpdate(t *testing.T) {
 	err = m.WebsocketUpdate("", currency.EMPTYPAIR, asset.Spot, SyncItemTrade, errors.New("test"))
 	require.NoError(t, err)
 }
+
+func TestSyncManagerSubscriptionHealthUpdate(t *testing.T) {
+	t.Parallel()
+	var m *SyncManager
+	require.ErrorIs(t, m.SubscriptionHealthUpdate(nil), ErrNilSubsystem)
+
+	m = &SyncManager{}
+	require.ErrorIs(t, m.SubscriptionHealthUpdate(nil), ErrSubSystemNotStarted)
+
+	m.started.Store(true)
+	require.ErrorIs(t, m.SubscriptionHealthUpdate(nil), common.ErrNilPointer)
+
+	m.initSyncCompleted.Store(true)
+	m.config.SynchronizeTicker = true
+	m.config.SynchronizeOrderbook = true
+	m.config.LogSwitchProtocolEvents = true
+	btc := m.add(key.NewExchangeAssetPair("test", asset.Spot, currency.NewBTCUSDT()), syncBase{IsUsingWebsocket: true})
+	eth := m.add(key.NewExchangeAssetPair("test", asset.Spot, currency.NewPair(currency.ETH, currency.USDT)), syncBase{IsUsingWebsocket: true})
+
+	e := &websocket.SubscriptionHealthEvent{Exchange: "test", Channel: subscription.TickerChannel, Asset: asset.Spot, Pairs: currency.Pairs{currency.NewBTCUSDT()}, State: websocket.SubscriptionStale}
+	require.NoError(t, m.SubscriptionHealthUpdate(e))
+	assert.True(t, btc.trackers[SyncItemTicker].IsUsingWebsocket, "stale subscriptions should not switch protocol")
+
+	e.State = websocket.SubscriptionFallback
+	require.NoError(t, m.SubscriptionHealthUpdate(e))
+	assert.True(t, btc.trackers[SyncItemTicker].IsUsingREST, "fallback should switch the pair to REST")
+	assert.False(t, btc.trackers[SyncItemTicker].IsUsingWebsocket, "fallback should switch the pair from websocket")
+	assert.True(t, btc.trackers[SyncItemOrderbook].IsUsingWebsocket, "other sync items should not be switched")
+	assert.True(t, eth.trackers[SyncItemTicker].IsUsingWebsocket, "other pairs should not be switched")
+
+	e.State = websocket.SubscriptionRecovered
+	require.NoError(t, m.SubscriptionHealthUpdate(e))
+	assert.True(t, btc.trackers[SyncItemTicker].IsUsingWebsocket, "recovery should switch the pair back to websocket")
+	assert.False(t, btc.trackers[SyncItemTicker].IsUsingREST, "recovery should switch the pair from REST")
+
+	e = &websocket.SubscriptionHealthEvent{Exchange: "test", Channel: subscription.OrderbookChannel, Asset: asset.Spot, State: websocket.SubscriptionFallback}
+	require.NoError(t, m.SubscriptionHealthUpdate(e))
+	assert.True(t, btc.trackers[SyncItemOrderbook].IsUsingREST, "subscriptions without pairs should switch every pair of the asset")
+	assert.True(t, eth.trackers[SyncItemOrderbook].IsUsingREST, "subscriptions without pairs should switch every pair of the asset")
+
+	e.Channel = subscription.MyOrdersChannel
+	require.NoError(t, m.SubscriptionHealthUpdate(e), "untracked channels should be ignored")
+}
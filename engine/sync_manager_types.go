@@ -8,6 +8,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 )
 
 // syncBase stores information
@@ -19,6 +20,19 @@ type syncBase struct {
 	NumErrors        int
 }
 
+// SyncItemStatus is a point in time snapshot of a synchronised item
+type SyncItemStatus struct {
+	Exchange         string
+	Asset            asset.Item
+	Pair             currency.Pair
+	Item             string
+	IsUsingWebsocket bool
+	IsUsingREST      bool
+	HaveData         bool
+	LastUpdated      time.Time
+	NumErrors        int
+}
+
 // currencyPairSyncAgent stores the sync agent info
 type currencyPairSyncAgent struct {
 	Key      key.ExchangeAssetPair
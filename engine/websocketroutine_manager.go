@@ -200,10 +200,11 @@ func (m *WebsocketRoutineManager) websocketDataReceiver(ws *websocket.Manager) e
 				if payload.Data == nil {
 					log.Errorf(log.WebsocketMgr, "exchange %s nil data sent to websocket", ws.GetName())
 				}
+				ws.RecordDataActivity(payload.Data)
 				m.mu.RLock()
 				for x := range m.dataHandlers {
 					if err := m.dataHandlers[x](ws.GetName(), payload.Data); err != nil {
-						log.Errorln(log.WebsocketMgr, err)
+						log.ErrorlnWithFields(log.WebsocketMgr, log.ExtraFields{}.WithSubsystem(websocketRoutineManagerName).WithExchange(ws.GetName(), nil, nil).WithError(err), err)
 					}
 				}
 				m.mu.RUnlock()
@@ -357,6 +358,16 @@ func (m *WebsocketRoutineManager) websocketDataHandler(exchName string, data any
 		return fmt.Errorf("%w %s", d.Err, d.Error())
 	case websocket.UnhandledMessageWarning:
 		log.Warnf(log.WebsocketMgr, "%s unhandled message - %s", exchName, d.Message)
+	case websocket.SubscriptionHealthEvent:
+		fields := log.ExtraFields{}.WithSubsystem(websocketRoutineManagerName).WithExchange(exchName, d.Asset, nil).With(log.PairKey, d.Pairs.Join())
+		if d.Err != nil {
+			log.WarnWithFieldsf(log.WebsocketMgr, fields.WithError(d.Err), "%s websocket %s %s %s subscription %s: %v", exchName, d.Asset, d.Channel, d.Pairs, d.State, d.Err)
+		} else if m.verbose || d.State == websocket.SubscriptionFallback || d.State == websocket.SubscriptionRecovered {
+			log.InfoWithFieldsf(log.WebsocketMgr, fields, "%s websocket %s %s %s subscription %s", exchName, d.Asset, d.Channel, d.Pairs, d.State)
+		}
+		if m.syncer.IsRunning() {
+			return m.syncer.SubscriptionHealthUpdate(&d)
+		}
 	case []accounts.Change, accounts.Change:
 		if m.verbose {
 			log.Debugf(log.WebsocketMgr, "%s %+v", exchName, d)
@@ -369,6 +380,9 @@ func (m *WebsocketRoutineManager) websocketDataHandler(exchName string, data any
 		if m.verbose {
 			log.Infof(log.Fill, "%+v", d)
 		}
+		if m.orderManager.IsRunning() {
+			return m.orderManager.PublishFills(d...)
+		}
 	default:
 		if m.verbose {
 			log.Warnf(log.WebsocketMgr, "%s websocket Unknown type: %+v", exchName, d)
@@ -19,6 +19,9 @@ var (
 	errUseAPointer                     = errors.New("could not process, pass to websocket routine manager as a pointer")
 )
 
+// websocketRoutineManagerName is the subsystem name attached to structured logs
+const websocketRoutineManagerName = "websocket_routine_manager"
+
 const (
 	stoppedState int32 = iota
 	startingState
@@ -6,28 +6,45 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
 	dbwithdraw "github.com/thrasher-corp/gocryptotrader/database/repository/withdraw"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/withdrawapproval"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
-// SetupWithdrawManager creates a new withdraw manager
-func SetupWithdrawManager(em iExchangeManager, pm iPortfolioManager, isDryRun bool) (*WithdrawManager, error) {
+// SetupWithdrawManager creates a new withdraw manager. A nil or disabled
+// withdrawal policy leaves withdrawals governed by the portfolio address
+// whitelist only. The database connection manager is used to store
+// withdrawals pending approval and may be nil
+func SetupWithdrawManager(em iExchangeManager, pm iPortfolioManager, dcm iDatabaseConnectionManager, isDryRun bool, policy *config.WithdrawalPolicyConfig) (*WithdrawManager, error) {
 	if em == nil {
 		return nil, errors.New("nil manager")
 	}
-	return &WithdrawManager{
+	m := &WithdrawManager{
 		exchangeManager:  em,
 		portfolioManager: pm,
+		database:         dcm,
 		isDryRun:         isDryRun,
-	}, nil
+		policy:           policy,
+		approvals:        make(map[uuid.UUID]*withdrawalApproval),
+	}
+	if m.policyEnabled() {
+		if err := m.loadWithdrawalApprovals(); err != nil {
+			log.Errorf(log.Global, "Unable to load withdrawals pending approval: %v", err)
+		}
+	}
+	return m, nil
 }
 
 // SubmitWithdrawal performs validation and submits a new withdraw request to
-// exchange
+// exchange. When the withdrawal policy requires approval, the withdrawal is
+// stored and returned with a pending approval status instead of being sent
 func (m *WithdrawManager) SubmitWithdrawal(ctx context.Context, req *withdraw.Request) (*withdraw.Response, error) {
 	if m == nil {
 		return nil, ErrNilSubsystem
@@ -41,6 +58,43 @@ func (m *WithdrawManager) SubmitWithdrawal(ctx context.Context, req *withdraw.Re
 		return nil, err
 	}
 
+	// Determines if the currency can be withdrawn from the exchange
+	errF := exch.CanWithdraw(req.Currency, asset.Spot)
+	if errF != nil && !errors.Is(errF, currencystate.ErrCurrencyStateNotFound) { // Suppress not found error
+		return nil, errF
+	}
+
+	var approved *withdrawalApproval
+	if m.policyEnabled() {
+		a, err := m.applyWithdrawalPolicy(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		approved = a
+		if a.Status == withdrawapproval.StatusPending {
+			log.Infof(log.Global, "Withdrawal %s of %v %s on %s is pending approval until %s", a.ID, req.Amount, req.Currency, req.Exchange, a.ExpiresAt.Format(common.SimpleTimeFormatWithTimezone))
+			return &withdraw.Response{
+				ID: a.ID,
+				Exchange: withdraw.ExchangeResponse{
+					Name:   req.Exchange,
+					Status: WithdrawalPendingApprovalStatus,
+				},
+				RequestDetails: *req,
+				CreatedAt:      a.CreatedAt,
+				UpdatedAt:      a.UpdatedAt,
+			}, nil
+		}
+	}
+	resp, err := m.sendWithdrawal(ctx, exch, req)
+	if err != nil && approved != nil {
+		m.failWithdrawalApproval(approved, err)
+	}
+	return resp, err
+}
+
+// sendWithdrawal submits a withdrawal request to an exchange and records the
+// withdrawal event
+func (m *WithdrawManager) sendWithdrawal(ctx context.Context, exch exchange.IBotExchange, req *withdraw.Request) (*withdraw.Response, error) {
 	resp := &withdraw.Response{
 		Exchange: withdraw.ExchangeResponse{
 			Name: req.Exchange,
@@ -48,12 +102,7 @@ func (m *WithdrawManager) SubmitWithdrawal(ctx context.Context, req *withdraw.Re
 		RequestDetails: *req,
 	}
 
-	// Determines if the currency can be withdrawn from the exchange
-	errF := exch.CanWithdraw(req.Currency, asset.Spot)
-	if errF != nil && !errors.Is(errF, currencystate.ErrCurrencyStateNotFound) { // Suppress not found error
-		return nil, errF
-	}
-
+	var err error
 	if m.isDryRun {
 		log.Warnln(log.Global, "Dry run enabled, no withdrawal request will be submitted or have an event created")
 		resp.ID = withdraw.DryRunID
@@ -61,7 +110,8 @@ func (m *WithdrawManager) SubmitWithdrawal(ctx context.Context, req *withdraw.Re
 		resp.Exchange.ID = withdraw.DryRunID.String()
 	} else {
 		var ret *withdraw.ExchangeResponse
-		if req.Type == withdraw.Crypto {
+		// The withdrawal policy address book supersedes the portfolio whitelist
+		if req.Type == withdraw.Crypto && !m.policyEnabled() {
 			if !m.portfolioManager.IsWhiteListed(req.Crypto.Address) {
 				return nil, withdraw.ErrStrAddressNotWhiteListed
 			}
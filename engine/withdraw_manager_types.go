@@ -2,15 +2,52 @@ package engine
 
 import (
 	"errors"
+	"sync"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/withdrawapproval"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
 // ErrWithdrawRequestNotFound message to display when no record is found
 var ErrWithdrawRequestNotFound = errors.New("request not found")
 
+// WithdrawalPendingApprovalStatus is the status returned for withdrawals held
+// by the withdrawal policy until approved by a second operator
+const WithdrawalPendingApprovalStatus = "pending approval"
+
+var (
+	errWithdrawalPolicyDisabled      = errors.New("withdrawal policy is not enabled")
+	errWithdrawalAddressNotInBook    = errors.New("withdrawal address is not in the address book")
+	errWithdrawalAddressTimeLocked   = errors.New("withdrawal address is time locked")
+	errWithdrawalPerTransactionLimit = errors.New("withdrawal exceeds per transaction limit")
+	errWithdrawalDailyLimit          = errors.New("withdrawal exceeds daily limit")
+	errWithdrawalUSDValueUnavailable = errors.New("unable to determine withdrawal USD value")
+	errWithdrawalApprovalNotPending  = errors.New("withdrawal is not pending approval")
+	errWithdrawalApprovalExpired     = errors.New("withdrawal approval has expired")
+	errWithdrawalApproverUnknown     = errors.New("user is not a withdrawal approver")
+	errWithdrawalApproverIsRequester = errors.New("withdrawal cannot be approved by the operator who requested it")
+	errWithdrawalInvalidOTP          = errors.New("invalid withdrawal approval OTP code")
+)
+
 // WithdrawManager is responsible for performing withdrawal requests and
 // saving them to the database
 type WithdrawManager struct {
 	exchangeManager  iExchangeManager
 	portfolioManager iPortfolioManager
+	database         iDatabaseConnectionManager
 	isDryRun         bool
+	policy           *config.WithdrawalPolicyConfig
+
+	approvalsMtx sync.Mutex
+	approvals    map[uuid.UUID]*withdrawalApproval
+}
+
+// withdrawalApproval is a withdrawal governed by the withdrawal policy. The
+// full request is kept in memory, whereas the stored request has passwords and
+// one time codes removed
+type withdrawalApproval struct {
+	withdrawapproval.Details
+	request withdraw.Request
 }
@@ -0,0 +1,442 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pquerna/otp/totp"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/config/secrets"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/withdrawapproval"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+// withdrawalLimitPeriod is the rolling period the daily withdrawal limit
+// applies to
+const withdrawalLimitPeriod = time.Hour * 24
+
+// withdrawalUSDQuotes are the quote currencies used to price crypto
+// withdrawals from exchange tickers, in order of preference
+var withdrawalUSDQuotes = []currency.Code{currency.USD, currency.USDT, currency.USDC, currency.EUR}
+
+// policyEnabled returns whether withdrawals are governed by the withdrawal
+// policy
+func (m *WithdrawManager) policyEnabled() bool {
+	return m.policy != nil && m.policy.Enabled
+}
+
+// databaseConnected returns whether withdrawals can be stored in the database
+func (m *WithdrawManager) databaseConnected() bool {
+	if m.database == nil {
+		return false
+	}
+	db := m.database.GetInstance()
+	return db != nil && db.IsConnected()
+}
+
+// loadWithdrawalApprovals loads pending withdrawals and those counting towards
+// the daily limit from the database, so they survive restarts
+func (m *WithdrawManager) loadWithdrawalApprovals() error {
+	if !m.databaseConnected() {
+		return nil
+	}
+	pending, err := withdrawapproval.GetByStatus(withdrawapproval.StatusPending)
+	if err != nil {
+		return err
+	}
+	recent, err := withdrawapproval.GetCreatedSince(time.Now().Add(-withdrawalLimitPeriod))
+	if err != nil {
+		return err
+	}
+	m.approvalsMtx.Lock()
+	defer m.approvalsMtx.Unlock()
+	for _, d := range slices.Concat(pending, recent) {
+		a := &withdrawalApproval{Details: d}
+		if err := json.Unmarshal(d.Request, &a.request); err != nil {
+			return fmt.Errorf("withdrawal approval %s: %w", d.ID, err)
+		}
+		m.approvals[d.ID] = a
+	}
+	return nil
+}
+
+// applyWithdrawalPolicy checks a withdrawal against the address book and USD
+// limits and records it. The returned approval is pending when a second
+// operator must approve the withdrawal before it is sent
+func (m *WithdrawManager) applyWithdrawalPolicy(ctx context.Context, req *withdraw.Request) (*withdrawalApproval, error) {
+	now := time.Now().UTC()
+	if req.Type == withdraw.Crypto {
+		if err := checkWithdrawalAddressBook(m.policy, req, now); err != nil {
+			return nil, err
+		}
+	}
+	usd, err := withdrawalValueUSD(req.Exchange, req.Currency, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+	if m.policy.PerTransactionLimitUSD > 0 && usd > m.policy.PerTransactionLimitUSD {
+		return nil, fmt.Errorf("%w: %.2f USD exceeds %.2f USD", errWithdrawalPerTransactionLimit, usd, m.policy.PerTransactionLimitUSD)
+	}
+
+	m.approvalsMtx.Lock()
+	defer m.approvalsMtx.Unlock()
+	m.expireWithdrawalApprovals(now)
+	if m.policy.DailyLimitUSD > 0 {
+		if spent := m.withdrawnUSD(now); spent+usd > m.policy.DailyLimitUSD {
+			return nil, fmt.Errorf("%w: %.2f USD withdrawn in the last 24 hours, %.2f USD requested, limit %.2f USD", errWithdrawalDailyLimit, spent, usd, m.policy.DailyLimitUSD)
+		}
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	a := &withdrawalApproval{
+		Details: withdrawapproval.Details{
+			ID:        id,
+			Exchange:  req.Exchange,
+			Currency:  req.Currency.String(),
+			Chain:     req.Crypto.Chain,
+			Address:   req.Crypto.Address,
+			Amount:    req.Amount,
+			AmountUSD: usd,
+			Account:   accounts.AccountFromContext(ctx),
+			Status:    withdrawapproval.StatusApproved,
+			ExpiresAt: now,
+			CreatedAt: now,
+			UpdatedAt: now,
+		},
+		request: *req,
+	}
+	if u, ok := rpcUserFromContext(ctx); ok {
+		a.RequestedBy = u.name
+	}
+	if m.policy.RequireApproval {
+		a.Status = withdrawapproval.StatusPending
+		a.ExpiresAt = now.Add(m.policy.ApprovalExpiry)
+	}
+	// Passwords and one time codes are not stored, they are refreshed from
+	// the exchange config when the withdrawal is sent
+	stored := *req
+	stored.TradePassword, stored.PIN, stored.OneTimePassword = "", 0, 0
+	if a.Request, err = json.Marshal(&stored); err != nil {
+		return nil, err
+	}
+	if m.databaseConnected() {
+		if err := withdrawapproval.Insert(&a.Details); err != nil {
+			return nil, fmt.Errorf("unable to store withdrawal for approval: %w", err)
+		}
+	} else if m.policy.RequireApproval {
+		log.Warnf(log.Global, "Database is not connected, pending withdrawal %s will be lost on restart", a.ID)
+	}
+	m.approvals[a.ID] = a
+	return a, nil
+}
+
+// checkWithdrawalAddressBook ensures a crypto withdrawal address is in the
+// address book and its new address time lock has passed
+func checkWithdrawalAddressBook(p *config.WithdrawalPolicyConfig, req *withdraw.Request, now time.Time) error {
+	var unlocksAt time.Time
+	for i := range p.AddressBook {
+		a := &p.AddressBook[i]
+		if !a.Currency.Equal(req.Currency) ||
+			a.Address != req.Crypto.Address ||
+			a.AddressTag != req.Crypto.AddressTag ||
+			(a.Exchange != "" && !strings.EqualFold(a.Exchange, req.Exchange)) ||
+			(a.Chain != "" && !strings.EqualFold(a.Chain, req.Crypto.Chain)) {
+			continue
+		}
+		unlocked := a.AddedAt.Add(p.NewAddressTimeLock)
+		if !now.Before(unlocked) {
+			return nil
+		}
+		if unlocksAt.IsZero() || unlocked.Before(unlocksAt) {
+			unlocksAt = unlocked
+		}
+	}
+	if !unlocksAt.IsZero() {
+		return fmt.Errorf("%w until %s", errWithdrawalAddressTimeLocked, unlocksAt.Format(common.SimpleTimeFormatWithTimezone))
+	}
+	return fmt.Errorf("%w: %s %s chain %q", errWithdrawalAddressNotInBook, req.Currency, req.Crypto.Address, req.Crypto.Chain)
+}
+
+// withdrawalValueUSD returns the USD value of a withdrawal amount. Fiat is
+// converted with the currency forex rates and stablecoins are valued at par.
+// Other currencies are priced by the withdrawing exchange's spot tickers
+func withdrawalValueUSD(exchName string, code currency.Code, amount float64) (float64, error) {
+	switch {
+	case code.Equal(currency.USD):
+		return amount, nil
+	case code.IsFiatCurrency():
+		v, err := currency.ConvertFiat(amount, code, currency.USD)
+		if err != nil {
+			return 0, fmt.Errorf("%w for %s: %w", errWithdrawalUSDValueUnavailable, code, err)
+		}
+		return v, nil
+	case code.IsStableCurrency():
+		return amount, nil
+	}
+	for _, quote := range withdrawalUSDQuotes {
+		t, err := ticker.GetTicker(exchName, currency.NewPair(code, quote), asset.Spot)
+		if err != nil || t.Last <= 0 {
+			continue
+		}
+		if v, err := withdrawalValueUSD(exchName, quote, amount*t.Last); err == nil {
+			return v, nil
+		}
+	}
+	return 0, fmt.Errorf("%w for %s: no %s spot ticker quoted in %v", errWithdrawalUSDValueUnavailable, code, exchName, withdrawalUSDQuotes)
+}
+
+// withdrawnUSD returns the USD value of withdrawals approved or pending
+// approval within the daily limit period, excluding those which failed to
+// send. approvalsMtx must be held
+func (m *WithdrawManager) withdrawnUSD(now time.Time) float64 {
+	var total float64
+	since := now.Add(-withdrawalLimitPeriod)
+	for _, a := range m.approvals {
+		if a.CreatedAt.After(since) && (a.Status == withdrawapproval.StatusPending || a.Status == withdrawapproval.StatusApproved) {
+			total += a.AmountUSD
+		}
+	}
+	return total
+}
+
+// expireWithdrawalApprovals expires pending withdrawals which were not
+// approved in time and drops settled withdrawals outside the daily limit
+// period. approvalsMtx must be held
+func (m *WithdrawManager) expireWithdrawalApprovals(now time.Time) {
+	since := now.Add(-withdrawalLimitPeriod)
+	for id, a := range m.approvals {
+		if a.Status == withdrawapproval.StatusPending && now.After(a.ExpiresAt) {
+			if err := m.setWithdrawalApprovalStatus(a, withdrawapproval.StatusPending, withdrawapproval.StatusExpired, "", now); err != nil {
+				log.Errorf(log.Global, "Unable to expire withdrawal %s: %v", id, err)
+				continue
+			}
+			log.Warnf(log.Global, "Withdrawal %s of %v %s on %s expired without approval", id, a.Amount, a.Currency, a.Exchange)
+		}
+		if a.Status != withdrawapproval.StatusPending && a.CreatedAt.Before(since) {
+			delete(m.approvals, id)
+		}
+	}
+}
+
+// setWithdrawalApprovalStatus moves a withdrawal from one status to another.
+// approvalsMtx must be held
+func (m *WithdrawManager) setWithdrawalApprovalStatus(a *withdrawalApproval, from, status, approvedBy string, now time.Time) error {
+	if a.Status != from {
+		return fmt.Errorf("%w: %s", errWithdrawalApprovalNotPending, a.Status)
+	}
+	if m.databaseConnected() {
+		err := withdrawapproval.UpdateStatus(a.ID.String(), from, status, approvedBy, now)
+		if errors.Is(err, withdrawapproval.ErrStatusChanged) {
+			// Another instance sharing the database has acted on it
+			if d, errGet := withdrawapproval.GetByID(a.ID.String()); errGet == nil {
+				a.Status, a.ApprovedBy, a.UpdatedAt = d.Status, d.ApprovedBy, d.UpdatedAt
+			}
+			return fmt.Errorf("%w: %s", errWithdrawalApprovalNotPending, a.Status)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	a.Status, a.ApprovedBy, a.UpdatedAt = status, approvedBy, now
+	return nil
+}
+
+// pendingWithdrawalApproval returns a withdrawal which is pending approval.
+// approvalsMtx must be held
+func (m *WithdrawManager) pendingWithdrawalApproval(id string, now time.Time) (*withdrawalApproval, error) {
+	u, err := uuid.FromString(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w %s", ErrWithdrawRequestNotFound, id)
+	}
+	m.expireWithdrawalApprovals(now)
+	a, ok := m.approvals[u]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", ErrWithdrawRequestNotFound, id)
+	}
+	switch a.Status {
+	case withdrawapproval.StatusPending:
+		return a, nil
+	case withdrawapproval.StatusExpired:
+		return nil, fmt.Errorf("%w at %s", errWithdrawalApprovalExpired, a.ExpiresAt.Format(common.SimpleTimeFormatWithTimezone))
+	default:
+		return nil, fmt.Errorf("%w: %s", errWithdrawalApprovalNotPending, a.Status)
+	}
+}
+
+// GetPendingWithdrawals returns withdrawals waiting for approval, oldest first
+func (m *WithdrawManager) GetPendingWithdrawals() ([]withdrawapproval.Details, error) {
+	if m == nil {
+		return nil, fmt.Errorf("withdraw manager %w", ErrNilSubsystem)
+	}
+	if !m.policyEnabled() {
+		return nil, errWithdrawalPolicyDisabled
+	}
+	m.approvalsMtx.Lock()
+	defer m.approvalsMtx.Unlock()
+	m.expireWithdrawalApprovals(time.Now().UTC())
+	var resp []withdrawapproval.Details
+	for _, a := range m.approvals {
+		if a.Status == withdrawapproval.StatusPending {
+			resp = append(resp, a.Details)
+		}
+	}
+	slices.SortFunc(resp, func(a, b withdrawapproval.Details) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+	return resp, nil
+}
+
+// ApproveWithdrawal approves a pending withdrawal and sends it to the
+// exchange. The approver must be configured as a withdrawal approver, confirm
+// the approval with a TOTP code and must not be the operator who requested the
+// withdrawal
+func (m *WithdrawManager) ApproveWithdrawal(ctx context.Context, id, approver, otpCode string) (*withdraw.Response, error) {
+	if m == nil {
+		return nil, fmt.Errorf("withdraw manager %w", ErrNilSubsystem)
+	}
+	if !m.policyEnabled() {
+		return nil, errWithdrawalPolicyDisabled
+	}
+	idx := slices.IndexFunc(m.policy.Approvers, func(a config.WithdrawalApprover) bool { return a.Name == approver })
+	if approver == "" || idx == -1 {
+		return nil, fmt.Errorf("%w %q", errWithdrawalApproverUnknown, approver)
+	}
+	secret, err := secrets.Resolve(ctx, m.policy.Approvers[idx].OTPSecret)
+	if err != nil {
+		return nil, err
+	}
+	if !totp.Validate(otpCode, secret) {
+		return nil, errWithdrawalInvalidOTP
+	}
+
+	now := time.Now().UTC()
+	m.approvalsMtx.Lock()
+	a, err := m.pendingWithdrawalApproval(id, now)
+	if err == nil && a.RequestedBy == approver {
+		err = errWithdrawalApproverIsRequester
+	}
+	if err == nil && a.request.Type == withdraw.Crypto {
+		// The address may have been removed since the withdrawal was requested
+		err = checkWithdrawalAddressBook(m.policy, &a.request, now)
+	}
+	if err == nil {
+		err = m.setWithdrawalApprovalStatus(a, withdrawapproval.StatusPending, withdrawapproval.StatusApproved, approver, now)
+	}
+	m.approvalsMtx.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	log.Infof(log.Global, "Withdrawal %s of %v %s on %s approved by %s", a.ID, a.Amount, a.Currency, a.Exchange, approver)
+
+	exch, err := m.exchangeManager.GetExchangeByName(a.request.Exchange)
+	if err != nil {
+		m.failWithdrawalApproval(a, err)
+		return nil, err
+	}
+	// The withdrawal is sent from the account it was requested on rather than
+	// the approver's account
+	accountCtx := accounts.DeployAccountToContext(ctx, a.Account)
+	req := a.request
+	if err := refreshWithdrawalCredentials(accountCtx, exch, &req); err != nil {
+		m.failWithdrawalApproval(a, err)
+		return nil, err
+	}
+	resp, err := m.sendWithdrawal(accountCtx, exch, &req)
+	if err != nil {
+		m.failWithdrawalApproval(a, err)
+	}
+	return resp, err
+}
+
+// failWithdrawalApproval marks an approved withdrawal which could not be sent
+// as failed, so that it no longer counts towards the daily limit
+func (m *WithdrawManager) failWithdrawalApproval(a *withdrawalApproval, sendErr error) {
+	m.approvalsMtx.Lock()
+	defer m.approvalsMtx.Unlock()
+	if err := m.setWithdrawalApprovalStatus(a, withdrawapproval.StatusApproved, withdrawapproval.StatusFailed, a.ApprovedBy, time.Now().UTC()); err != nil {
+		log.Errorf(log.Global, "Unable to mark withdrawal %s as failed: %v", a.ID, err)
+		return
+	}
+	log.Errorf(log.Global, "Withdrawal %s of %v %s on %s failed: %v", a.ID, a.Amount, a.Currency, a.Exchange, sendErr)
+}
+
+// RejectWithdrawal rejects a pending withdrawal so it is never sent
+func (m *WithdrawManager) RejectWithdrawal(id, rejectedBy string) error {
+	if m == nil {
+		return fmt.Errorf("withdraw manager %w", ErrNilSubsystem)
+	}
+	if !m.policyEnabled() {
+		return errWithdrawalPolicyDisabled
+	}
+	now := time.Now().UTC()
+	m.approvalsMtx.Lock()
+	defer m.approvalsMtx.Unlock()
+	a, err := m.pendingWithdrawalApproval(id, now)
+	if err != nil {
+		return err
+	}
+	if err := m.setWithdrawalApprovalStatus(a, withdrawapproval.StatusPending, withdrawapproval.StatusRejected, rejectedBy, now); err != nil {
+		return err
+	}
+	log.Infof(log.Global, "Withdrawal %s of %v %s on %s rejected by %s", a.ID, a.Amount, a.Currency, a.Exchange, rejectedBy)
+	return nil
+}
+
+// refreshWithdrawalCredentials sets the trade password, PIN and a current one
+// time password of the account set on the context on an approved withdrawal,
+// as codes generated when the withdrawal was requested will have expired
+func refreshWithdrawalCredentials(ctx context.Context, exch exchange.IBotExchange, req *withdraw.Request) error {
+	b := exch.GetBase()
+	if b.Config == nil {
+		return nil
+	}
+	cfgCreds := &b.Config.API.Credentials
+	if account := accounts.AccountFromContext(ctx); account != accounts.DefaultAccount {
+		idx := slices.IndexFunc(b.Config.API.Accounts, func(a config.APIAccountConfig) bool { return a.Name == account })
+		if idx == -1 {
+			return fmt.Errorf("%s %w: %q", b.Name, exchange.ErrAccountNotFound, account)
+		}
+		cfgCreds = &b.Config.API.Accounts[idx].Credentials
+	}
+	creds, err := cfgCreds.ResolveSecrets(ctx)
+	if err != nil {
+		return err
+	}
+	if creds.OTPSecret != "" {
+		code, err := totp.GenerateCode(creds.OTPSecret, time.Now())
+		if err != nil {
+			return err
+		}
+		if req.OneTimePassword, err = strconv.ParseInt(code, 10, 64); err != nil {
+			return err
+		}
+	}
+	if req.PIN == 0 && creds.PIN != "" {
+		pin, err := strconv.ParseInt(creds.PIN, 10, 64)
+		if err != nil {
+			return err
+		}
+		req.PIN = pin
+	}
+	if req.TradePassword == "" {
+		req.TradePassword = creds.TradePassword
+	}
+	return nil
+}
@@ -0,0 +1,306 @@
+package engine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pquerna/otp/totp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/withdrawapproval"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc/auth"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+const testWithdrawalOTPSecret = "JBSWY3DPEHPK3PXP"
+
+func TestCheckWithdrawalAddressBook(t *testing.T) {
+	t.Parallel()
+	now := time.Now().UTC()
+	p := &config.WithdrawalPolicyConfig{
+		NewAddressTimeLock: time.Hour * 24,
+		AddressBook: []config.WithdrawalAddress{
+			{Currency: currency.BTC, Address: "bc1qold", AddedAt: now.Add(-time.Hour * 48)},
+			{Currency: currency.BTC, Address: "bc1qnew", AddedAt: now.Add(-time.Hour)},
+			{Exchange: "okx", Currency: currency.USDT, Chain: "TRC20", Address: "Tusdt", AddedAt: now.Add(-time.Hour * 48)},
+		},
+	}
+	for _, tc := range []struct {
+		name string
+		req  withdraw.Request
+		err  error
+	}{
+		{"allowed", withdraw.Request{Exchange: "binance", Currency: currency.BTC, Crypto: withdraw.CryptoRequest{Address: "bc1qold"}}, nil},
+		{"time locked", withdraw.Request{Exchange: "binance", Currency: currency.BTC, Crypto: withdraw.CryptoRequest{Address: "bc1qnew"}}, errWithdrawalAddressTimeLocked},
+		{"unknown address", withdraw.Request{Exchange: "binance", Currency: currency.BTC, Crypto: withdraw.CryptoRequest{Address: "bc1qunknown"}}, errWithdrawalAddressNotInBook},
+		{"wrong currency", withdraw.Request{Exchange: "binance", Currency: currency.LTC, Crypto: withdraw.CryptoRequest{Address: "bc1qold"}}, errWithdrawalAddressNotInBook},
+		{"chain and exchange", withdraw.Request{Exchange: "OKX", Currency: currency.USDT, Crypto: withdraw.CryptoRequest{Address: "Tusdt", Chain: "trc20"}}, nil},
+		{"wrong chain", withdraw.Request{Exchange: "okx", Currency: currency.USDT, Crypto: withdraw.CryptoRequest{Address: "Tusdt", Chain: "erc20"}}, errWithdrawalAddressNotInBook},
+		{"wrong exchange", withdraw.Request{Exchange: "binance", Currency: currency.USDT, Crypto: withdraw.CryptoRequest{Address: "Tusdt", Chain: "trc20"}}, errWithdrawalAddressNotInBook},
+		{"wrong tag", withdraw.Request{Exchange: "binance", Currency: currency.BTC, Crypto: withdraw.CryptoRequest{Address: "bc1qold", AddressTag: "1"}}, errWithdrawalAddressNotInBook},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.ErrorIs(t, checkWithdrawalAddressBook(p, &tc.req, now), tc.err)
+		})
+	}
+}
+
+func TestWithdrawalValueUSD(t *testing.T) {
+	t.Parallel()
+	v, err := withdrawalValueUSD("withdrawalvaluetest", currency.USD, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 10.0, v)
+
+	v, err = withdrawalValueUSD("withdrawalvaluetest", currency.USDT, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 10.0, v, "Stablecoins should be valued at par")
+
+	_, err = withdrawalValueUSD("withdrawalvaluetest", currency.BTC, 1)
+	assert.ErrorIs(t, err, errWithdrawalUSDValueUnavailable)
+
+	require.NoError(t, ticker.ProcessTicker(&ticker.Price{
+		ExchangeName: "withdrawalvaluetest",
+		Pair:         currency.NewPair(currency.BTC, currency.USDT),
+		AssetType:    asset.Spot,
+		Last:         50000,
+	}))
+	v, err = withdrawalValueUSD("withdrawalvaluetest", currency.BTC, 0.5)
+	require.NoError(t, err)
+	assert.Equal(t, 25000.0, v, "Crypto should be priced by the exchange ticker")
+}
+
+func withdrawPolicyTestManager(t *testing.T) *WithdrawManager {
+	t.Helper()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	exch.GetBase().States = currencystate.NewCurrencyStates()
+	require.NoError(t, em.Add(exch), "Add must not error")
+	m, err := SetupWithdrawManager(em, nil, nil, true, &config.WithdrawalPolicyConfig{
+		Enabled:                true,
+		PerTransactionLimitUSD: 1000,
+		DailyLimitUSD:          1500,
+		RequireApproval:        true,
+		ApprovalExpiry:         time.Hour,
+		Approvers: []config.WithdrawalApprover{
+			{Name: "alice", OTPSecret: testWithdrawalOTPSecret},
+			{Name: "bob", OTPSecret: testWithdrawalOTPSecret},
+		},
+		AddressBook: []config.WithdrawalAddress{
+			{Currency: currency.USDT, Address: "treasury", AddedAt: time.Now().Add(-time.Hour)},
+		},
+	})
+	require.NoError(t, err, "SetupWithdrawManager must not error")
+	return m
+}
+
+func withdrawPolicyTestRequest(amount float64) *withdraw.Request {
+	return &withdraw.Request{
+		Exchange: testExchange,
+		Currency: currency.USDT,
+		Amount:   amount,
+		Type:     withdraw.Crypto,
+		Crypto:   withdraw.CryptoRequest{Address: "treasury"},
+	}
+}
+
+func TestWithdrawalPolicyApproval(t *testing.T) {
+	t.Parallel()
+	m := withdrawPolicyTestManager(t)
+	alice := context.WithValue(t.Context(), rpcUserContextKey{}, &rpcUser{name: "alice", roles: []auth.Role{auth.RoleWithdrawals}})
+
+	_, err := m.SubmitWithdrawal(alice, withdrawPolicyTestRequest(2000))
+	assert.ErrorIs(t, err, errWithdrawalPerTransactionLimit)
+
+	req := withdrawPolicyTestRequest(100)
+	req.Crypto.Address = "unknown"
+	_, err = m.SubmitWithdrawal(alice, req)
+	assert.ErrorIs(t, err, errWithdrawalAddressNotInBook)
+
+	req = withdrawPolicyTestRequest(800)
+	req.TradePassword = "hunter2"
+	resp, err := m.SubmitWithdrawal(alice, req)
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	assert.Equal(t, WithdrawalPendingApprovalStatus, resp.Exchange.Status)
+	id := resp.ID.String()
+
+	_, err = m.SubmitWithdrawal(alice, withdrawPolicyTestRequest(800))
+	assert.ErrorIs(t, err, errWithdrawalDailyLimit, "Pending withdrawals should count towards the daily limit")
+
+	pending, err := m.GetPendingWithdrawals()
+	require.NoError(t, err, "GetPendingWithdrawals must not error")
+	require.Len(t, pending, 1)
+	assert.Equal(t, "alice", pending[0].RequestedBy)
+	assert.Equal(t, 800.0, pending[0].AmountUSD)
+	assert.NotContains(t, string(pending[0].Request), "hunter2", "Stored requests should not contain passwords")
+
+	code, err := totp.GenerateCode(testWithdrawalOTPSecret, time.Now())
+	require.NoError(t, err, "GenerateCode must not error")
+
+	_, err = m.ApproveWithdrawal(t.Context(), id, "carol", code)
+	assert.ErrorIs(t, err, errWithdrawalApproverUnknown)
+	_, err = m.ApproveWithdrawal(t.Context(), id, "bob", "000000")
+	assert.ErrorIs(t, err, errWithdrawalInvalidOTP)
+	_, err = m.ApproveWithdrawal(t.Context(), id, "alice", code)
+	assert.ErrorIs(t, err, errWithdrawalApproverIsRequester)
+	_, err = m.ApproveWithdrawal(t.Context(), "notanid", "bob", code)
+	assert.ErrorIs(t, err, ErrWithdrawRequestNotFound)
+
+	resp, err = m.ApproveWithdrawal(t.Context(), id, "bob", code)
+	require.NoError(t, err, "ApproveWithdrawal must not error")
+	assert.Equal(t, "dryrun", resp.Exchange.Status, "Approved withdrawals should be sent")
+
+	_, err = m.ApproveWithdrawal(t.Context(), id, "bob", code)
+	assert.ErrorIs(t, err, errWithdrawalApprovalNotPending)
+
+	pending, err = m.GetPendingWithdrawals()
+	require.NoError(t, err, "GetPendingWithdrawals must not error")
+	assert.Empty(t, pending)
+}
+
+func TestWithdrawalPolicyApprovalSendFailure(t *testing.T) {
+	t.Parallel()
+	m := withdrawPolicyTestManager(t)
+
+	resp, err := m.SubmitWithdrawal(t.Context(), withdrawPolicyTestRequest(800))
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	em, ok := m.exchangeManager.(*ExchangeManager)
+	require.True(t, ok, "exchangeManager must be an ExchangeManager")
+	require.NoError(t, em.RemoveExchange(testExchange), "RemoveExchange must not error")
+
+	code, err := totp.GenerateCode(testWithdrawalOTPSecret, time.Now())
+	require.NoError(t, err, "GenerateCode must not error")
+	_, err = m.ApproveWithdrawal(t.Context(), resp.ID.String(), "bob", code)
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+
+	m.approvalsMtx.Lock()
+	defer m.approvalsMtx.Unlock()
+	assert.Equal(t, withdrawapproval.StatusFailed, m.approvals[resp.ID].Status, "Withdrawals which fail to send should be marked as failed")
+	assert.Zero(t, m.withdrawnUSD(time.Now()), "Failed withdrawals should not count towards the daily limit")
+}
+
+func TestWithdrawalPolicyExpiryAndReject(t *testing.T) {
+	t.Parallel()
+	m := withdrawPolicyTestManager(t)
+
+	resp, err := m.SubmitWithdrawal(t.Context(), withdrawPolicyTestRequest(100))
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	m.approvalsMtx.Lock()
+	m.approvals[resp.ID].ExpiresAt = time.Now().Add(-time.Minute)
+	m.approvalsMtx.Unlock()
+
+	pending, err := m.GetPendingWithdrawals()
+	require.NoError(t, err, "GetPendingWithdrawals must not error")
+	assert.Empty(t, pending, "Expired withdrawals should not be pending")
+	code, err := totp.GenerateCode(testWithdrawalOTPSecret, time.Now())
+	require.NoError(t, err, "GenerateCode must not error")
+	_, err = m.ApproveWithdrawal(t.Context(), resp.ID.String(), "bob", code)
+	assert.ErrorIs(t, err, errWithdrawalApprovalExpired)
+	assert.Equal(t, withdrawapproval.StatusExpired, m.approvals[resp.ID].Status)
+
+	resp, err = m.SubmitWithdrawal(t.Context(), withdrawPolicyTestRequest(100))
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	require.NoError(t, m.RejectWithdrawal(resp.ID.String(), "bob"), "RejectWithdrawal must not error")
+	assert.ErrorIs(t, m.RejectWithdrawal(resp.ID.String(), "bob"), errWithdrawalApprovalNotPending)
+	assert.Equal(t, withdrawapproval.StatusRejected, m.approvals[resp.ID].Status)
+
+	assert.ErrorIs(t, (*WithdrawManager)(nil).RejectWithdrawal("", ""), ErrNilSubsystem)
+	_, err = (*WithdrawManager)(nil).ApproveWithdrawal(t.Context(), "", "", "")
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+	_, err = (*WithdrawManager)(nil).GetPendingWithdrawals()
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	m.policy.Enabled = false
+	_, err = m.GetPendingWithdrawals()
+	assert.ErrorIs(t, err, errWithdrawalPolicyDisabled)
+}
+
+func TestWithdrawalPolicyApprovalAccount(t *testing.T) {
+	t.Parallel()
+	m := withdrawPolicyTestManager(t)
+	exch, err := m.exchangeManager.GetExchangeByName(testExchange)
+	require.NoError(t, err, "GetExchangeByName must not error")
+	exch.GetBase().Config = &config.Exchange{}
+
+	resp, err := m.SubmitWithdrawal(accounts.DeployAccountToContext(t.Context(), "hedge"), withdrawPolicyTestRequest(100))
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	m.approvalsMtx.Lock()
+	assert.Equal(t, "hedge", m.approvals[resp.ID].Account, "The requesting account should be stored with the withdrawal")
+	m.approvalsMtx.Unlock()
+
+	code, err := totp.GenerateCode(testWithdrawalOTPSecret, time.Now())
+	require.NoError(t, err, "GenerateCode must not error")
+	_, err = m.ApproveWithdrawal(t.Context(), resp.ID.String(), "bob", code)
+	assert.ErrorIs(t, err, exchange.ErrAccountNotFound, "Withdrawals should be sent from the requesting account rather than the approver's")
+}
+
+func TestRefreshWithdrawalCredentials(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.GetBase().Config = &config.Exchange{API: config.APIConfig{
+		Credentials: config.APICredentialsConfig{PIN: "1234", TradePassword: "default"},
+		Accounts: []config.APIAccountConfig{
+			{Name: "hedge", Credentials: config.APICredentialsConfig{OTPSecret: testWithdrawalOTPSecret, PIN: "5678", TradePassword: "hedge"}},
+		},
+	}}
+
+	req := withdrawPolicyTestRequest(100)
+	require.NoError(t, refreshWithdrawalCredentials(t.Context(), exch, req), "refreshWithdrawalCredentials must not error")
+	assert.Equal(t, int64(1234), req.PIN)
+	assert.Equal(t, "default", req.TradePassword)
+	assert.Zero(t, req.OneTimePassword, "No one time password should be set without an OTP secret")
+
+	req = withdrawPolicyTestRequest(100)
+	require.NoError(t, refreshWithdrawalCredentials(accounts.DeployAccountToContext(t.Context(), "hedge"), exch, req), "refreshWithdrawalCredentials must not error")
+	assert.Equal(t, int64(5678), req.PIN)
+	assert.Equal(t, "hedge", req.TradePassword)
+	assert.NotZero(t, req.OneTimePassword, "A one time password should be generated from the account's OTP secret")
+
+	err = refreshWithdrawalCredentials(accounts.DeployAccountToContext(t.Context(), "unknown"), exch, withdrawPolicyTestRequest(100))
+	assert.ErrorIs(t, err, exchange.ErrAccountNotFound)
+}
+
+func TestRPCApproveWithdrawal(t *testing.T) {
+	t.Parallel()
+	m := withdrawPolicyTestManager(t)
+	s := &RPCServer{Engine: &Engine{WithdrawManager: m}}
+
+	resp, err := m.SubmitWithdrawal(t.Context(), withdrawPolicyTestRequest(100))
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+
+	pending, err := s.GetPendingWithdrawals(t.Context(), &gctrpc.GetPendingWithdrawalsRequest{})
+	require.NoError(t, err, "GetPendingWithdrawals must not error")
+	require.Len(t, pending.Withdrawals, 1)
+	assert.Equal(t, resp.ID.String(), pending.Withdrawals[0].Id)
+
+	_, err = s.ApproveWithdrawal(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = s.ApproveWithdrawal(t.Context(), &gctrpc.ApproveWithdrawalRequest{Id: resp.ID.String()})
+	assert.ErrorIs(t, err, errWithdrawalApproverUnknown, "Approvals must be made by an authenticated user")
+
+	bob := context.WithValue(t.Context(), rpcUserContextKey{}, &rpcUser{name: "bob", roles: []auth.Role{auth.RoleWithdrawals}})
+	code, err := totp.GenerateCode(testWithdrawalOTPSecret, time.Now())
+	require.NoError(t, err, "GenerateCode must not error")
+	approved, err := s.ApproveWithdrawal(bob, &gctrpc.ApproveWithdrawalRequest{Id: resp.ID.String(), OtpCode: code})
+	require.NoError(t, err, "ApproveWithdrawal must not error")
+	assert.Equal(t, "dryrun", approved.Status)
+
+	_, err = s.RejectWithdrawal(bob, nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = s.RejectWithdrawal(bob, &gctrpc.RejectWithdrawalRequest{Id: resp.ID.String()})
+	assert.ErrorIs(t, err, errWithdrawalApprovalNotPending)
+}
@@ -21,10 +21,17 @@ const (
 	// context, when the default config credentials sub account needs to be
 	// changed while the same keys can be used.
 	ContextSubAccountFlag contextCredential = "subaccountoverride"
+	// ContextAccountFlag used for retrieving the name of a configured exchange
+	// account from context, selecting its credentials over the defaults.
+	ContextAccountFlag contextCredential = "account"
 
 	apiKeyDisplaySize = 16
 )
 
+// DefaultAccount is the name of the account holding the default config
+// credentials of an exchange
+const DefaultAccount = "default"
+
 // Default credential values
 const (
 	Key             = "key"
@@ -39,6 +46,7 @@ var (
 	errMetaDataIsNil                   = errors.New("meta data is nil")
 	errInvalidCredentialMetaDataLength = errors.New("invalid meta data to process credentials")
 	errMissingInfo                     = errors.New("cannot parse meta data missing information in key value pair")
+	errInvalidAccountMetaDataLength    = errors.New("invalid meta data to process account")
 )
 
 // Credentials define parameters that allow for an authenticated request.
@@ -152,6 +160,13 @@ func ParseCredentialsMetadata(ctx context.Context, md metadata.MD) (context.Cont
 		return ctx, errMetaDataIsNil
 	}
 
+	if accountMD, ok := md[string(ContextAccountFlag)]; ok && len(accountMD) != 0 {
+		if len(accountMD) != 1 {
+			return ctx, errInvalidAccountMetaDataLength
+		}
+		ctx = DeployAccountToContext(ctx, accountMD[0])
+	}
+
 	credMD, ok := md[string(ContextCredentialsFlag)]
 	if !ok || len(credMD) == 0 {
 		return ctx, nil
@@ -210,3 +225,27 @@ func DeployCredentialsToContext(ctx context.Context, creds *Credentials) context
 func DeploySubAccountOverrideToContext(ctx context.Context, subAccount string) context.Context {
 	return context.WithValue(ctx, ContextSubAccountFlag, subAccount)
 }
+
+// DeployAccountToContext sets the name of the configured exchange account whose
+// credentials are to be used. An empty name or DefaultAccount selects the
+// default credentials. Account names are case-insensitive.
+func DeployAccountToContext(ctx context.Context, account string) context.Context {
+	return context.WithValue(ctx, ContextAccountFlag, account)
+}
+
+// AccountFromContext returns the normalised exchange account name set on the
+// context, or DefaultAccount when none has been set
+func AccountFromContext(ctx context.Context) string {
+	if account, ok := ctx.Value(ContextAccountFlag).(string); ok {
+		if account = NormaliseAccountName(account); account != "" {
+			return account
+		}
+	}
+	return DefaultAccount
+}
+
+// NormaliseAccountName returns an exchange account name trimmed and lower
+// cased, as account names are case-insensitive
+func NormaliseAccountName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
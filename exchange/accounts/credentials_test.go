@@ -3,6 +3,7 @@ package accounts
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/metadata"
 )
@@ -98,6 +99,28 @@ func TestParseCredentialsMetadata(t *testing.T) {
 	if sa != "supersub" {
 		t.Fatal("unexpected value")
 	}
+
+	ctx = metadata.AppendToOutgoingContext(t.Context(), string(ContextAccountFlag), "a", string(ContextAccountFlag), "b")
+	nortyMD, _ = metadata.FromOutgoingContext(ctx)
+	_, err = ParseCredentialsMetadata(t.Context(), nortyMD)
+	require.ErrorIs(t, err, errInvalidAccountMetaDataLength)
+
+	ctx = metadata.AppendToOutgoingContext(t.Context(), string(ContextAccountFlag), "trading", flag, outGoing)
+	lovelyMD, _ = metadata.FromOutgoingContext(ctx)
+	ctx, err = ParseCredentialsMetadata(t.Context(), lovelyMD)
+	require.NoError(t, err)
+	assert.Equal(t, "trading", AccountFromContext(ctx), "AccountFromContext should return the account from metadata")
+	assert.Equal(t, "supersub", ctx.Value(ContextSubAccountFlag), "sub account override should be parsed alongside the account")
+}
+
+func TestAccountFromContext(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, DefaultAccount, AccountFromContext(t.Context()), "AccountFromContext should return the default account when unset")
+	assert.Equal(t, DefaultAccount, AccountFromContext(DeployAccountToContext(t.Context(), "")), "AccountFromContext should return the default account when empty")
+	assert.Equal(t, "hedge", AccountFromContext(DeployAccountToContext(t.Context(), "hedge")), "AccountFromContext should return the deployed account")
+	assert.Equal(t, "hedge", AccountFromContext(DeployAccountToContext(t.Context(), " HeDge ")), "AccountFromContext should normalise the deployed account")
+	assert.Equal(t, DefaultAccount, AccountFromContext(DeployAccountToContext(t.Context(), "DEFAULT")), "AccountFromContext should normalise the default account")
+	assert.Equal(t, DefaultAccount, AccountFromContext(DeployAccountToContext(t.Context(), " ")), "AccountFromContext should return the default account when blank")
 }
 
 func TestGetInternal(t *testing.T) {
@@ -38,6 +38,7 @@ func (o *Orderbook) Setup(exchangeConfig *config.Exchange, c *Config, dataHandle
 
 	o.sortBuffer = c.SortBuffer
 	o.sortBufferByUpdateIDs = c.SortBufferByUpdateIDs
+	o.generateChecksum = c.GenerateChecksum
 	o.exchangeName = exchangeConfig.Name
 	o.dataHandler = dataHandler
 	o.ob = make(map[key.PairAsset]*orderbookHolder)
@@ -88,12 +89,18 @@ func (o *Orderbook) Update(u *orderbook.Update) error {
 		return fmt.Errorf("%w for Exchange %s CurrencyPair: %s AssetType: %s", orderbook.ErrDepthNotFound, o.exchangeName, u.Pair, u.Asset)
 	}
 
+	if u.ExpectedChecksum != 0 && u.GenerateChecksum == nil {
+		u.GenerateChecksum = o.generateChecksum
+	}
+
 	if o.bufferEnabled {
 		if processed, err := o.processBufferUpdate(holder, u); err != nil || !processed {
+			o.checksumMismatch(err, u.Pair, u.Asset)
 			return err
 		}
 	} else {
 		if err := holder.ob.ProcessUpdate(u); err != nil {
+			o.checksumMismatch(err, u.Pair, u.Asset)
 			return err
 		}
 	}
@@ -103,6 +110,20 @@ func (o *Orderbook) Update(u *orderbook.Update) error {
 	return o.dataHandler.Send(context.TODO(), holder.ob)
 }
 
+// checksumMismatch flushes the buffer of an orderbook which failed checksum
+// verification. ProcessUpdate has already invalidated the orderbook so no
+// trading can occur on it until the exchange resyncs it
+func (o *Orderbook) checksumMismatch(err error, p currency.Pair, a asset.Item) {
+	if !errors.Is(err, orderbook.ErrChecksumMismatch) {
+		return
+	}
+	o.m.Lock()
+	defer o.m.Unlock()
+	if holder, ok := o.ob[key.PairAsset{Base: p.Base.Item, Quote: p.Quote.Item, Asset: a}]; ok {
+		holder.buffer = holder.buffer[:0]
+	}
+}
+
 // processBufferUpdate stores update into buffer, when buffer at capacity as
 // defined by o.obBufferLimit it well then sort and apply updates.
 func (o *Orderbook) processBufferUpdate(holder *orderbookHolder, u *orderbook.Update) (bool, error) {
@@ -720,6 +720,7 @@ func TestSetup(t *testing.T) {
 	exchangeConfig.Name = "test"
 	bufferConf.SortBuffer = true
 	bufferConf.SortBufferByUpdateIDs = true
+	bufferConf.GenerateChecksum = func(*orderbook.Book) uint32 { return 1337 }
 	err = w.Setup(exchangeConfig, bufferConf, stream.NewRelay(1))
 	require.NoError(t, err)
 
@@ -728,6 +729,7 @@ func TestSetup(t *testing.T) {
 	require.True(t, w.sortBuffer)
 	require.True(t, w.sortBufferByUpdateIDs)
 	require.Equal(t, "test", w.exchangeName)
+	require.NotNil(t, w.generateChecksum)
 }
 
 func TestInvalidateOrderbook(t *testing.T) {
@@ -763,3 +765,23 @@ func TestInvalidateOrderbook(t *testing.T) {
 	_, err = w.GetOrderbook(cp, asset.Spot)
 	require.ErrorIs(t, err, orderbook.ErrOrderbookInvalid)
 }
+
+func TestChecksumVerification(t *testing.T) {
+	t.Parallel()
+	cp, err := getExclusivePair()
+	require.NoError(t, err)
+
+	holder, _, _, err := createSnapshot(cp)
+	require.NoError(t, err)
+	checksum := &orderbook.Checksum{Delimiter: ":"}
+	holder.generateChecksum = checksum.Generate
+
+	update := &orderbook.Update{Asks: orderbook.Levels{{Price: 4001, Amount: 2}}, Pair: cp, UpdateTime: time.Now(), Asset: asset.Spot}
+	update.ExpectedChecksum = checksum.Generate(&orderbook.Book{Bids: orderbook.Levels{{Price: 4000, Amount: 1}}, Asks: orderbook.Levels{{Price: 4000, Amount: 1}, {Price: 4001, Amount: 2}}})
+	require.NoError(t, holder.Update(update), "Update must not error with a matching checksum")
+
+	update = &orderbook.Update{Asks: orderbook.Levels{{Price: 4002, Amount: 2}}, Pair: cp, UpdateTime: time.Now(), Asset: asset.Spot, ExpectedChecksum: 1337}
+	require.ErrorIs(t, holder.Update(update), orderbook.ErrChecksumMismatch)
+	_, err = holder.GetOrderbook(cp, asset.Spot)
+	assert.ErrorIs(t, err, orderbook.ErrOrderbookInvalid, "checksum mismatch should invalidate the orderbook")
+}
@@ -16,6 +16,11 @@ type Config struct {
 	// SortBufferByUpdateIDs allows the sorting of the buffered updates by their
 	// corresponding update IDs.
 	SortBufferByUpdateIDs bool
+	// GenerateChecksum generates the exchange's checksum from the stored
+	// orderbook. When set, every update with an ExpectedChecksum and no
+	// GenerateChecksum of its own is verified against it after being applied.
+	// orderbook.Checksum covers the common CRC32 variants.
+	GenerateChecksum func(*orderbook.Book) uint32
 }
 
 // Orderbook defines a local cache of orderbooks for amending, appending
@@ -29,6 +34,7 @@ type Orderbook struct {
 	exchangeName          string
 	dataHandler           *stream.Relay
 	verbose               bool
+	generateChecksum      func(*orderbook.Book) uint32
 
 	m sync.RWMutex
 }
@@ -0,0 +1,116 @@
+package websocket
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	gws "github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var errCapturePathEmpty = errors.New("capture path is empty")
+
+// Capture records raw inbound and outbound websocket frames for all
+// connections of a websocket manager to a file so they can be replayed with
+// mock.NewWebsocketReplayServer
+type Capture struct {
+	m           sync.Mutex
+	f           *os.File
+	connections atomic.Uint64
+}
+
+// NewCapture creates a capture file at path, truncating any existing file
+func NewCapture(path string) (*Capture, error) {
+	if path == "" {
+		return nil, errCapturePathEmpty
+	}
+	f, err := file.Writer(path)
+	if err != nil {
+		return nil, err
+	}
+	return &Capture{f: f}, nil
+}
+
+// connect records a new connection and returns its identifier
+func (c *Capture) connect(url string) uint64 {
+	id := c.connections.Add(1)
+	c.record(&mock.WebsocketFrame{Connection: id, URL: url, Direction: mock.WebsocketConnect})
+	return id
+}
+
+// record writes a frame to the capture file
+func (c *Capture) record(frame *mock.WebsocketFrame) {
+	frame.Time = time.Now()
+	b, err := json.Marshal(frame)
+	if err != nil {
+		log.Errorf(log.WebsocketMgr, "Websocket capture unable to marshal frame: %v", err)
+		return
+	}
+	c.m.Lock()
+	defer c.m.Unlock()
+	if c.f == nil {
+		return
+	}
+	if _, err := c.f.Write(append(b, '\n')); err != nil {
+		log.Errorf(log.WebsocketMgr, "Websocket capture unable to record frame: %v", err)
+	}
+}
+
+// recordMessage records a message sent or received on a connection
+func (c *Capture) recordMessage(id uint64, direction string, messageType int, message []byte) {
+	frame := &mock.WebsocketFrame{Connection: id, Direction: direction, Type: messageType}
+	if messageType == gws.BinaryMessage {
+		frame.Binary = message
+	} else {
+		frame.Message = string(message)
+	}
+	c.record(frame)
+}
+
+// Close stops recording and closes the capture file
+func (c *Capture) Close() error {
+	c.m.Lock()
+	defer c.m.Unlock()
+	if c.f == nil {
+		return nil
+	}
+	err := c.f.Close()
+	c.f = nil
+	return err
+}
+
+// StartCapture records all websocket frames sent and received by the manager's
+// connections to a file at path, replacing any capture already in progress
+func (m *Manager) StartCapture(path string) error {
+	c, err := NewCapture(path)
+	if err != nil {
+		return fmt.Errorf("%s websocket unable to start capture: %w", m.exchangeName, err)
+	}
+	if old := m.capture.Swap(c); old != nil {
+		if err := old.Close(); err != nil {
+			log.Errorf(log.WebsocketMgr, "%s websocket unable to close previous capture: %v", m.exchangeName, err)
+		}
+	}
+	log.Infof(log.WebsocketMgr, "%s websocket capturing frames to %s", m.exchangeName, path)
+	return nil
+}
+
+// StopCapture stops any capture in progress
+func (m *Manager) StopCapture() error {
+	if c := m.capture.Swap(nil); c != nil {
+		return c.Close()
+	}
+	return nil
+}
+
+// IsCapturing returns whether a capture is in progress
+func (m *Manager) IsCapturing() bool {
+	return m.capture.Load() != nil
+}
@@ -0,0 +1,96 @@
+package websocket
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	gws "github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	mockws "github.com/thrasher-corp/gocryptotrader/internal/testing/websocket"
+)
+
+func readCapture(t *testing.T, path string) []mock.WebsocketFrame {
+	t.Helper()
+	f, err := os.Open(path)
+	require.NoError(t, err, "Open must not error")
+	defer func() { assert.NoError(t, f.Close(), "Close should not error") }()
+	var frames []mock.WebsocketFrame
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		var frame mock.WebsocketFrame
+		require.NoError(t, json.Unmarshal(s.Bytes(), &frame), "Unmarshal must not error")
+		frames = append(frames, frame)
+	}
+	require.NoError(t, s.Err(), "Scanner must not error")
+	return frames
+}
+
+func TestNewCapture(t *testing.T) {
+	t.Parallel()
+	_, err := NewCapture("")
+	require.ErrorIs(t, err, errCapturePathEmpty)
+
+	c, err := NewCapture(filepath.Join(t.TempDir(), "capture", "ws.jsonl"))
+	require.NoError(t, err, "NewCapture must create missing directories")
+	require.NoError(t, c.Close(), "Close must not error")
+	require.NoError(t, c.Close(), "Close must not error when already closed")
+}
+
+func TestCapture(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { mockws.WsMockUpgrader(t, w, r, mockws.EchoHandler) }))
+	t.Cleanup(server.Close)
+
+	path := filepath.Join(t.TempDir(), "ws.jsonl")
+	m := NewManager()
+	m.exchangeName = "test"
+	require.NoError(t, m.StartCapture(path), "StartCapture must not error")
+	assert.True(t, m.IsCapturing(), "IsCapturing should return true once a capture is started")
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/ws"
+	conn := m.createConnectionFromSetup(&ConnectionSetup{URL: wsURL + "?listenKey=secret"})
+	require.NoError(t, conn.Dial(t.Context(), &gws.Dialer{}, http.Header{}, nil), "Dial must not error")
+	t.Cleanup(func() { assert.NoError(t, conn.Shutdown(), "Shutdown should not error") })
+
+	require.NoError(t, conn.SendRawMessage(t.Context(), request.Unset, gws.TextMessage, []byte("hello")), "SendRawMessage must not error")
+	assert.Equal(t, "hello", string(conn.ReadMessage().Raw))
+	require.NoError(t, conn.SendJSONMessage(t.Context(), request.Unset, map[string]int{"id": 1}), "SendJSONMessage must not error")
+	assert.Equal(t, `{"id":1}`, string(conn.ReadMessage().Raw))
+	require.NoError(t, m.StopCapture(), "StopCapture must not error")
+	assert.False(t, m.IsCapturing(), "IsCapturing should return false once a capture is stopped")
+	require.NoError(t, conn.SendRawMessage(t.Context(), request.Unset, gws.TextMessage, []byte("ignored")), "SendRawMessage must not error")
+	conn.ReadMessage()
+
+	frames := readCapture(t, path)
+	require.Len(t, frames, 5, "frames after the capture is stopped must not be recorded")
+	assert.Equal(t, mock.WebsocketConnect, frames[0].Direction)
+	assert.Equal(t, wsURL, frames[0].URL, "query string should not be recorded")
+	for i, f := range frames {
+		assert.Equal(t, uint64(1), f.Connection, "all frames should belong to the same connection")
+		assert.False(t, f.Time.IsZero(), "frame %d should be timestamped", i)
+	}
+	assert.Equal(t, mock.WebsocketOutbound, frames[1].Direction)
+	assert.Equal(t, "hello", frames[1].Message)
+	assert.Equal(t, gws.TextMessage, frames[1].Type)
+	assert.Equal(t, mock.WebsocketInbound, frames[2].Direction)
+	assert.Equal(t, "hello", frames[2].Message)
+	assert.Equal(t, mock.WebsocketOutbound, frames[3].Direction)
+	assert.Equal(t, `{"id":1}`, frames[3].Message)
+	assert.Equal(t, mock.WebsocketInbound, frames[4].Direction)
+
+	replayURL, err := mock.NewWebsocketReplayServer(path, "id")
+	require.NoError(t, err, "NewWebsocketReplayServer must not error")
+	replayConn := m.createConnectionFromSetup(&ConnectionSetup{URL: replayURL + "/ws"})
+	require.NoError(t, replayConn.Dial(t.Context(), &gws.Dialer{}, http.Header{}, nil), "Dial must not error")
+	t.Cleanup(func() { assert.NoError(t, replayConn.Shutdown(), "Shutdown should not error") })
+	require.NoError(t, replayConn.SendJSONMessage(t.Context(), request.Unset, map[string]int{"id": 2}), "SendJSONMessage must not error")
+	assert.Equal(t, `{"id":2}`, string(replayConn.ReadMessage().Raw), "replayed response should carry the new request id")
+}
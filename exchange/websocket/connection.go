@@ -18,10 +18,13 @@ import (
 
 	gws "github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/tracing"
 	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/subscription"
 	"github.com/thrasher-corp/gocryptotrader/log"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 var (
@@ -134,6 +137,8 @@ type connection struct {
 	ResponseMaxLimit     time.Duration
 	Traffic              chan struct{}
 	readMessageErrors    chan error
+	capture              *atomic.Pointer[Capture] // capture is shared with the manager so captures may be started at any time
+	captureID            atomic.Uint64
 }
 
 // Dial sets proxy urls and then connects to the websocket
@@ -157,6 +162,9 @@ func (c *connection) Dial(ctx context.Context, dialer *gws.Dialer, headers http.
 	}
 	_ = resp.Body.Close()
 	c.Connection = conn
+	if capture := c.getCapture(); capture != nil {
+		c.captureID.Store(capture.connect(removeURLQueryString(c.URL)))
+	}
 
 	if c.Verbose {
 		log.Infof(log.WebsocketMgr, "%v Websocket connected to %s\n", c.ExchangeName, path)
@@ -177,6 +185,14 @@ func (c *connection) SendJSONMessage(ctx context.Context, epl request.EndpointLi
 				log.Debugf(log.WebsocketMgr, "%v %v: Sending message: %v", c.ExchangeName, removeURLQueryString(c.URL), string(msg))
 			}
 		}
+		if capture := c.getCapture(); capture != nil {
+			msg, err := json.Marshal(data)
+			if err != nil {
+				return err
+			}
+			capture.recordMessage(c.captureID.Load(), mock.WebsocketOutbound, gws.TextMessage, msg)
+			return c.Connection.WriteMessage(gws.TextMessage, msg)
+		}
 		return c.Connection.WriteJSON(data)
 	})
 }
@@ -187,6 +203,9 @@ func (c *connection) SendRawMessage(ctx context.Context, epl request.EndpointLim
 		if request.IsVerbose(ctx, c.Verbose) {
 			log.Debugf(log.WebsocketMgr, "%v %v: Sending message: %v", c.ExchangeName, removeURLQueryString(c.URL), string(message))
 		}
+		if capture := c.getCapture(); capture != nil {
+			capture.recordMessage(c.captureID.Load(), mock.WebsocketOutbound, messageType, message)
+		}
 		return c.Connection.WriteMessage(messageType, message)
 	})
 }
@@ -213,9 +232,16 @@ func (c *connection) writeToConn(ctx context.Context, epl request.EndpointLimit,
 	}
 
 	if rl != nil {
-		if err := rl.RateLimit(ctx); err != nil {
+		start := time.Now()
+		rlCtx, rlSpan := tracing.Start(ctx, "websocket.RateLimit", attribute.String("exchange", c.ExchangeName))
+		err := rl.RateLimit(rlCtx)
+		tracing.End(rlSpan, err)
+		if err != nil {
 			return fmt.Errorf("%s websocket connection: rate limit error: %w", c.ExchangeName, err)
 		}
+		if rep, ok := c.Reporter.(request.RateLimitReporter); ok {
+			rep.RateLimitWait(c.ExchangeName, time.Since(start))
+		}
 	}
 	// This lock acts as a rolling gate to prevent WriteMessage panics. Acquire after rate limit check.
 	c.writeControl.Lock()
@@ -294,11 +320,19 @@ func (c *connection) ReadMessage() Response {
 		return Response{}
 	}
 
+	if capture := c.getCapture(); capture != nil {
+		capture.recordMessage(c.captureID.Load(), mock.WebsocketInbound, mType, resp)
+	}
+
 	select {
 	case c.Traffic <- struct{}{}:
 	default: // Non-Blocking write ensures 1 buffered signal per trafficCheckInterval to avoid flooding
 	}
 
+	if rep, ok := c.Reporter.(MessageReporter); ok {
+		rep.MessageReceived(c.ExchangeName)
+	}
+
 	var standardMessage []byte
 	switch mType {
 	case gws.TextMessage:
@@ -316,6 +350,14 @@ func (c *connection) ReadMessage() Response {
 	return Response{Raw: standardMessage, Type: mType}
 }
 
+// getCapture returns the capture in progress, if any
+func (c *connection) getCapture() *Capture {
+	if c.capture == nil {
+		return nil
+	}
+	return c.capture.Load()
+}
+
 // parseBinaryResponse parses a websocket binary response into a usable byte array
 func (c *connection) parseBinaryResponse(resp []byte) ([]byte, error) {
 	var reader io.ReadCloser
@@ -379,6 +421,17 @@ func (c *connection) SendMessageReturnResponses(ctx context.Context, epl request
 // SendMessageReturnResponsesWithInspector will send a WS message to the connection and wait for N responses
 // An error of ErrSignatureTimeout can be ignored if individual responses are being otherwise tracked
 func (c *connection) SendMessageReturnResponsesWithInspector(ctx context.Context, epl request.EndpointLimit, signature, payload any, expected int, messageInspector Inspector) ([][]byte, error) {
+	ctx, span := tracing.Start(ctx, "websocket.SendMessageReturnResponse",
+		attribute.String("exchange", c.ExchangeName),
+		attribute.String("url", removeURLQueryString(c.URL)),
+		attribute.String("signature", fmt.Sprint(signature)),
+		attribute.Int("expected_responses", expected))
+	resps, err := c.sendMessageReturnResponses(ctx, epl, signature, payload, expected, messageInspector)
+	tracing.End(span, err)
+	return resps, err
+}
+
+func (c *connection) sendMessageReturnResponses(ctx context.Context, epl request.EndpointLimit, signature, payload any, expected int, messageInspector Inspector) ([][]byte, error) {
 	outbound, err := json.Marshal(payload)
 	if err != nil {
 		return nil, fmt.Errorf("error marshaling json for %s: %w", signature, err)
@@ -395,7 +448,9 @@ func (c *connection) SendMessageReturnResponsesWithInspector(ctx context.Context
 		return nil, err
 	}
 
-	resps, err := c.waitForResponses(ctx, signature, ch, expected, messageInspector)
+	waitCtx, waitSpan := tracing.Start(ctx, "websocket.WaitForResponses")
+	resps, err := c.waitForResponses(waitCtx, signature, ch, expected, messageInspector)
+	tracing.End(waitSpan, err)
 	if err != nil {
 		return nil, err
 	}
@@ -435,7 +490,7 @@ inspection:
 	// Only check context verbosity. If the exchange is verbose, it will log the responses in the ReadMessage() call.
 	if request.IsVerbose(ctx, false) {
 		for i := range resps {
-			log.Debugf(log.WebsocketMgr, "%v %v: Received response [%d/%d]: %v", c.ExchangeName, removeURLQueryString(c.URL), i+1, len(resps), string(resps[i]))
+			log.DebugWithFieldsf(log.WebsocketMgr, tracing.LogFields(ctx), "%v %v: Received response [%d/%d]: %v", c.ExchangeName, removeURLQueryString(c.URL), i+1, len(resps), string(resps[i]))
 		}
 	}
 
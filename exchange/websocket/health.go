@@ -0,0 +1,353 @@
+package websocket
+
+import (
+	"context"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/subscription"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SubscriptionFallbackAttempts is the number of resubscribe attempts which
+// fail to restore a stale subscription before its pairs fall back to REST
+var SubscriptionFallbackAttempts = 3
+
+// SubscriptionHealthState describes a subscription health transition
+type SubscriptionHealthState uint8
+
+// SubscriptionHealthState values
+const (
+	// SubscriptionStale is sent when no data has been received within the
+	// subscription timeout
+	SubscriptionStale SubscriptionHealthState = iota + 1
+	// SubscriptionResubscribed is sent when a stale subscription has been
+	// resubscribed
+	SubscriptionResubscribed
+	// SubscriptionResubscribeFailed is sent when resubscribing a stale
+	// subscription errors
+	SubscriptionResubscribeFailed
+	// SubscriptionFallback is sent when resubscribing has repeatedly failed
+	// to restore a subscription and its pairs should be polled via REST
+	SubscriptionFallback
+	// SubscriptionRecovered is sent when data is received again for a stale
+	// or fallen back subscription
+	SubscriptionRecovered
+)
+
+// String implements fmt.Stringer
+func (s SubscriptionHealthState) String() string {
+	switch s {
+	case SubscriptionStale:
+		return "stale"
+	case SubscriptionResubscribed:
+		return "resubscribed"
+	case SubscriptionResubscribeFailed:
+		return "resubscribe failed"
+	case SubscriptionFallback:
+		return "fallback"
+	case SubscriptionRecovered:
+		return "recovered"
+	default:
+		return "unknown"
+	}
+}
+
+// SubscriptionHealthEvent is sent to the DataHandler on each subscription
+// health transition
+type SubscriptionHealthEvent struct {
+	Exchange    string
+	Channel     string
+	Asset       asset.Item
+	Pairs       currency.Pairs
+	State       SubscriptionHealthState
+	LastMessage time.Time
+	Attempts    int
+	Err         error
+}
+
+// activityKey identifies data received for a channel, asset and pair
+type activityKey struct {
+	Channel string
+	Asset   asset.Item
+	Base    *currency.Item
+	Quote   *currency.Item
+}
+
+// healthKey identifies a subscription across reconnections, which create new
+// subscription instances
+type healthKey struct {
+	Channel string
+	Asset   asset.Item
+	Pairs   string
+}
+
+// subscriptionHealth tracks the health of a single subscription
+type subscriptionHealth struct {
+	lastMessage time.Time // last data received
+	since       time.Time // subscribed or last resubscribed
+	staleAt     time.Time
+	attempts    int
+	stale       bool
+	fallback    bool
+}
+
+// trackedSubscription is a subscription and the connection it is subscribed on
+type trackedSubscription struct {
+	conn Connection
+	sub  *subscription.Subscription
+}
+
+// RecordActivity records that data has been received for a channel, asset and
+// pair, and is used to detect stale subscriptions
+func (m *Manager) RecordActivity(channel string, a asset.Item, p currency.Pair) {
+	if m.subscriptionTimeout <= 0 {
+		return
+	}
+	now := time.Now()
+	m.healthMu.Lock()
+	if m.activity == nil {
+		m.activity = make(map[activityKey]time.Time)
+	}
+	m.activity[activityKey{Channel: channel, Asset: a, Base: p.Base.Item, Quote: p.Quote.Item}] = now
+	m.healthMu.Unlock()
+}
+
+// RecordDataActivity records activity for processed websocket data sent to the
+// DataHandler, mapping tickers, orderbooks, trades and candles to their
+// standard subscription channels
+func (m *Manager) RecordDataActivity(data any) {
+	if m.subscriptionTimeout <= 0 {
+		return
+	}
+	switch d := data.(type) {
+	case *ticker.Price:
+		m.RecordActivity(subscription.TickerChannel, d.AssetType, d.Pair)
+	case []ticker.Price:
+		for i := range d {
+			m.RecordActivity(subscription.TickerChannel, d[i].AssetType, d[i].Pair)
+		}
+	case *orderbook.Depth:
+		m.RecordActivity(subscription.OrderbookChannel, d.Asset(), d.Pair())
+	case trade.Data:
+		m.RecordActivity(subscription.AllTradesChannel, d.AssetType, d.CurrencyPair)
+	case []trade.Data:
+		for i := range d {
+			m.RecordActivity(subscription.AllTradesChannel, d[i].AssetType, d[i].CurrencyPair)
+		}
+	case kline.Item:
+		m.RecordActivity(subscription.CandlesChannel, d.Asset, d.Pair)
+	case []kline.Item:
+		for i := range d {
+			m.RecordActivity(subscription.CandlesChannel, d[i].Asset, d[i].Pair)
+		}
+	}
+}
+
+// monitorSubscriptions monitors subscription health until the websocket is shut down
+func (m *Manager) monitorSubscriptions(ctx context.Context) func() bool {
+	t := time.NewTicker(m.subscriptionTimeout)
+	return func() bool {
+		select {
+		case <-m.ShutdownC:
+			t.Stop()
+			return true
+		case <-ctx.Done():
+			t.Stop()
+			return true
+		case <-t.C:
+			if !m.IsConnected() && !m.IsConnecting() {
+				// Connection failed before shutdown could be signalled
+				t.Stop()
+				return true
+			}
+			m.checkSubscriptionHealth(ctx)
+			return false
+		}
+	}
+}
+
+// checkSubscriptionHealth resubscribes subscriptions which have not received
+// data within the subscription timeout and emits health transitions
+func (m *Manager) checkSubscriptionHealth(ctx context.Context) {
+	now := time.Now()
+	seen := make(map[healthKey]struct{})
+	for _, ts := range m.trackedSubscriptions() {
+		s := ts.sub
+		if !isTrackedChannel(s.Channel) {
+			continue
+		}
+		// Subscriptions left resubscribing by a failed resubscribe are retried
+		if state := s.State(); state != subscription.SubscribedState && state != subscription.ResubscribingState {
+			continue
+		}
+		k := healthKey{Channel: s.Channel, Asset: s.Asset, Pairs: s.Pairs.Join()}
+		seen[k] = struct{}{}
+
+		m.healthMu.Lock()
+		if m.health == nil {
+			m.health = make(map[healthKey]*subscriptionHealth)
+		}
+		h, ok := m.health[k]
+		if !ok {
+			// Allow a full timeout for the first data from new subscriptions
+			h = &subscriptionHealth{since: now}
+			m.health[k] = h
+		}
+		if last := m.lastActivity(s); last.After(h.lastMessage) {
+			h.lastMessage = last
+		}
+		ref := h.lastMessage
+		if h.since.After(ref) {
+			ref = h.since
+		}
+		expired := now.Sub(ref) > m.subscriptionTimeout
+		var state SubscriptionHealthState
+		switch {
+		case h.stale && h.lastMessage.After(h.staleAt):
+			state = SubscriptionRecovered
+			*h = subscriptionHealth{lastMessage: h.lastMessage, since: now}
+		case !h.stale && expired:
+			state = SubscriptionStale
+			h.stale = true
+			h.staleAt = now
+		}
+		resubscribe := h.stale && expired
+		lastMessage, attempts := h.lastMessage, h.attempts
+		m.healthMu.Unlock()
+
+		if state != 0 {
+			m.emitHealthEvent(ctx, s, state, lastMessage, attempts, nil)
+		}
+		if resubscribe {
+			m.resubscribeStale(ctx, ts, h, k)
+		}
+	}
+
+	m.healthMu.Lock()
+	for k := range m.health {
+		if _, ok := seen[k]; !ok {
+			delete(m.health, k)
+		}
+	}
+	m.healthMu.Unlock()
+}
+
+// resubscribeStale resubscribes a stale subscription and falls back to REST
+// once SubscriptionFallbackAttempts resubscriptions have not restored it
+func (m *Manager) resubscribeStale(ctx context.Context, ts trackedSubscription, h *subscriptionHealth, k healthKey) {
+	err := m.ResubscribeToChannel(ctx, ts.conn, ts.sub)
+
+	m.healthMu.Lock()
+	if m.health[k] != h {
+		m.healthMu.Unlock()
+		return
+	}
+	h.attempts++
+	if err == nil {
+		// Allow a full timeout for data to arrive after resubscribing
+		h.since = time.Now()
+	}
+	lastMessage, attempts := h.lastMessage, h.attempts
+	fallback := !h.fallback && attempts >= SubscriptionFallbackAttempts
+	if fallback {
+		h.fallback = true
+	}
+	m.healthMu.Unlock()
+
+	if err != nil {
+		log.WarnWithFieldsf(log.WebsocketMgr,
+			m.logFields().With(log.AssetKey, ts.sub.Asset.String()).With(log.PairKey, ts.sub.Pairs.Join()).WithError(err),
+			"%v websocket: failed to resubscribe stale subscription %s: %v", m.exchangeName, ts.sub, err)
+		m.emitHealthEvent(ctx, ts.sub, SubscriptionResubscribeFailed, lastMessage, attempts, err)
+	} else {
+		m.emitHealthEvent(ctx, ts.sub, SubscriptionResubscribed, lastMessage, attempts, nil)
+	}
+	if fallback {
+		m.emitHealthEvent(ctx, ts.sub, SubscriptionFallback, lastMessage, attempts, err)
+	}
+}
+
+// lastActivity returns the most recent activity recorded for a subscription
+// NOTE: This requires locking
+func (m *Manager) lastActivity(s *subscription.Subscription) time.Time {
+	var last time.Time
+	if len(s.Pairs) != 0 && s.Asset.IsValid() {
+		for _, p := range s.Pairs {
+			if t := m.activity[activityKey{Channel: s.Channel, Asset: s.Asset, Base: p.Base.Item, Quote: p.Quote.Item}]; t.After(last) {
+				last = t
+			}
+		}
+		return last
+	}
+	for k, t := range m.activity {
+		if k.Channel != s.Channel || (s.Asset.IsValid() && k.Asset != s.Asset) {
+			continue
+		}
+		if len(s.Pairs) != 0 && !s.Pairs.Contains(currency.Pair{Base: currency.Code{Item: k.Base}, Quote: currency.Code{Item: k.Quote}}, true) {
+			continue
+		}
+		if t.After(last) {
+			last = t
+		}
+	}
+	return last
+}
+
+// trackedSubscriptions returns all subscriptions with the connection they are
+// subscribed on
+func (m *Manager) trackedSubscriptions() []trackedSubscription {
+	var tracked []trackedSubscription
+	if m.useMultiConnectionManagement {
+		for _, ws := range m.snapshotConnectionManager() {
+			for _, conn := range m.snapshotManagedConnections(ws) {
+				if store := conn.Subscriptions(); store != nil {
+					for _, s := range store.List() {
+						tracked = append(tracked, trackedSubscription{conn: conn, sub: s})
+					}
+				}
+			}
+		}
+		return tracked
+	}
+	if store := m.subscriptionStore(nil); store != nil {
+		for _, s := range store.List() {
+			tracked = append(tracked, trackedSubscription{sub: s})
+		}
+	}
+	return tracked
+}
+
+// emitHealthEvent sends a subscription health transition to the DataHandler
+func (m *Manager) emitHealthEvent(ctx context.Context, s *subscription.Subscription, state SubscriptionHealthState, lastMessage time.Time, attempts int, err error) {
+	if m.verbose {
+		log.Debugf(log.WebsocketMgr, "%v websocket: subscription %s %s", m.exchangeName, s, state)
+	}
+	if sendErr := m.DataHandler.Send(ctx, SubscriptionHealthEvent{
+		Exchange:    m.exchangeName,
+		Channel:     s.Channel,
+		Asset:       s.Asset,
+		Pairs:       s.Pairs,
+		State:       state,
+		LastMessage: lastMessage,
+		Attempts:    attempts,
+		Err:         err,
+	}); sendErr != nil {
+		log.Errorf(log.WebsocketMgr, "%v websocket: subscription health data handler err: %s", m.exchangeName, sendErr)
+	}
+}
+
+// isTrackedChannel returns whether subscription health can be tracked for a
+// channel from the data sent to the DataHandler
+func isTrackedChannel(channel string) bool {
+	switch channel {
+	case subscription.TickerChannel, subscription.OrderbookChannel, subscription.AllTradesChannel, subscription.CandlesChannel:
+		return true
+	}
+	return false
+}
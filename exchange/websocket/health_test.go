@@ -0,0 +1,151 @@
+package websocket
+
+import (
+	"errors"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/stream"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/subscription"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+func TestSubscriptionHealthStateString(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "stale", SubscriptionStale.String())
+	assert.Equal(t, "resubscribed", SubscriptionResubscribed.String())
+	assert.Equal(t, "resubscribe failed", SubscriptionResubscribeFailed.String())
+	assert.Equal(t, "fallback", SubscriptionFallback.String())
+	assert.Equal(t, "recovered", SubscriptionRecovered.String())
+	assert.Equal(t, "unknown", SubscriptionHealthState(0).String())
+}
+
+func TestRecordDataActivity(t *testing.T) {
+	t.Parallel()
+	m := NewManager()
+	p := currency.NewBTCUSDT()
+	m.RecordDataActivity(&ticker.Price{Pair: p, AssetType: asset.Spot})
+	assert.Empty(t, m.activity, "activity should not be recorded without a subscription timeout")
+
+	m.subscriptionTimeout = time.Minute
+	m.RecordDataActivity(&ticker.Price{Pair: p, AssetType: asset.Spot})
+	m.RecordDataActivity([]trade.Data{{CurrencyPair: p, AssetType: asset.Futures}})
+	m.RecordDataActivity(kline.Item{Pair: p, Asset: asset.Margin})
+	m.RecordDataActivity("unhandled")
+	require.Len(t, m.activity, 3, "activity must be recorded for each data type")
+
+	for _, s := range []*subscription.Subscription{
+		{Channel: subscription.TickerChannel, Asset: asset.Spot, Pairs: currency.Pairs{p}},
+		{Channel: subscription.AllTradesChannel, Asset: asset.Futures},
+		{Channel: subscription.CandlesChannel},
+	} {
+		assert.NotZerof(t, m.lastActivity(s), "lastActivity should find activity for %s", s)
+	}
+	assert.Zero(t, m.lastActivity(&subscription.Subscription{Channel: subscription.TickerChannel, Asset: asset.Futures}), "lastActivity should not match other assets")
+	assert.Zero(t, m.lastActivity(&subscription.Subscription{Channel: subscription.OrderbookChannel}), "lastActivity should not match other channels")
+}
+
+func TestCheckSubscriptionHealth(t *testing.T) {
+	t.Parallel()
+	synctest.Test(t, func(t *testing.T) { //nolint:thelper,nolintlint // false positive
+		m := NewManager()
+		require.NoError(t, m.Setup(newDefaultSetup()), "Setup must not error")
+		m.subscriptionTimeout = time.Minute
+		m.DataHandler = stream.NewRelay(10)
+		m.Subscriber = currySimpleSub(m)
+		m.Unsubscriber = currySimpleUnsub(m)
+
+		p := currency.NewBTCUSDT()
+		s := &subscription.Subscription{Channel: subscription.TickerChannel, Asset: asset.Spot, Pairs: currency.Pairs{p}}
+		require.NoError(t, m.AddSuccessfulSubscriptions(nil, s, &subscription.Subscription{Channel: "untracked"}), "AddSuccessfulSubscriptions must not error")
+
+		expectEvent := func(state SubscriptionHealthState, attempts int) {
+			t.Helper()
+			select {
+			case payload := <-m.DataHandler.C:
+				e, ok := payload.Data.(SubscriptionHealthEvent)
+				require.Truef(t, ok, "payload must be a SubscriptionHealthEvent not %T", payload.Data)
+				assert.Equal(t, state, e.State, "event should have the correct state")
+				assert.Equal(t, attempts, e.Attempts, "event should have the correct attempts")
+				assert.Equal(t, subscription.TickerChannel, e.Channel, "event should have the correct channel")
+				assert.Equal(t, currency.Pairs{p}, e.Pairs, "event should have the correct pairs")
+			default:
+				require.Failf(t, "missing event", "expected %s event", state)
+			}
+		}
+		expectNoEvent := func() {
+			t.Helper()
+			select {
+			case payload := <-m.DataHandler.C:
+				require.Failf(t, "unexpected event", "received %v", payload.Data)
+			default:
+			}
+		}
+
+		m.checkSubscriptionHealth(t.Context())
+		expectNoEvent()
+
+		time.Sleep(time.Second * 30)
+		m.RecordDataActivity(&ticker.Price{Pair: p, AssetType: asset.Spot})
+		time.Sleep(time.Second * 45)
+		m.checkSubscriptionHealth(t.Context())
+		expectNoEvent()
+
+		time.Sleep(time.Minute)
+		m.checkSubscriptionHealth(t.Context())
+		expectEvent(SubscriptionStale, 0)
+		expectEvent(SubscriptionResubscribed, 1)
+		assert.Equal(t, subscription.SubscribedState, s.State(), "subscription should be subscribed again")
+
+		m.checkSubscriptionHealth(t.Context())
+		expectNoEvent()
+
+		errUnsub := errors.New("unsubscribe failed")
+		m.Unsubscriber = func(subscription.List) error { return errUnsub }
+		time.Sleep(time.Minute + time.Second)
+		m.checkSubscriptionHealth(t.Context())
+		expectEvent(SubscriptionResubscribeFailed, 2)
+		assert.Equal(t, subscription.ResubscribingState, s.State(), "subscription should be left resubscribing")
+
+		m.checkSubscriptionHealth(t.Context())
+		expectEvent(SubscriptionResubscribeFailed, 3)
+		expectEvent(SubscriptionFallback, 3)
+
+		m.checkSubscriptionHealth(t.Context())
+		expectEvent(SubscriptionResubscribeFailed, 4)
+		expectNoEvent()
+
+		time.Sleep(time.Second)
+		m.RecordActivity(subscription.TickerChannel, asset.Spot, p)
+		m.checkSubscriptionHealth(t.Context())
+		expectEvent(SubscriptionRecovered, 0)
+		m.checkSubscriptionHealth(t.Context())
+		expectNoEvent()
+
+		require.NoError(t, m.RemoveSubscriptions(nil, s), "RemoveSubscriptions must not error")
+		m.checkSubscriptionHealth(t.Context())
+		assert.Empty(t, m.health, "health should be pruned for removed subscriptions")
+	})
+}
+
+func TestMonitorSubscriptions(t *testing.T) {
+	t.Parallel()
+	synctest.Test(t, func(t *testing.T) { //nolint:thelper,nolintlint // false positive
+		m := NewManager()
+		m.subscriptionTimeout = time.Minute
+		assert.True(t, m.monitorSubscriptions(t.Context())(), "monitor should exit when not connected")
+
+		m.setState(connectedState)
+		fn := m.monitorSubscriptions(t.Context())
+		assert.False(t, fn(), "monitor should continue while connected")
+		close(m.ShutdownC)
+		assert.True(t, fn(), "monitor should exit on shutdown")
+	})
+}
@@ -117,6 +117,11 @@ type Manager struct {
 	AuthConn                      Connection // Authenticated Private connection
 	ExchangeLevelReporter         Reporter   // Latency reporter
 	MaxSubscriptionsPerConnection int
+	capture                       atomic.Pointer[Capture]
+	subscriptionTimeout           time.Duration
+	healthMu                      sync.Mutex
+	activity                      map[activityKey]time.Time
+	health                        map[healthKey]*subscriptionHealth
 
 	// connectionManager stores all *potential* connections for the exchange, organised within websocket structs.
 	// For example, separate connections can be used for Spot, Margin, and Futures trading. This structure is especially useful
@@ -279,6 +284,7 @@ func (m *Manager) Setup(s *ManagerSetup) error {
 			time.Second)
 	}
 	m.trafficTimeout = s.ExchangeConfig.WebsocketTrafficTimeout
+	m.subscriptionTimeout = s.ExchangeConfig.WebsocketSubscriptionTimeout
 
 	m.SetCanUseAuthenticatedEndpoints(s.ExchangeConfig.API.AuthenticatedWebsocketSupport)
 
@@ -296,6 +302,12 @@ func (m *Manager) Setup(s *ManagerSetup) error {
 	m.setState(disconnectedState)
 
 	m.rateLimitDefinitions = s.RateLimitDefinitions
+
+	if s.ExchangeConfig.WebsocketCaptureFile != "" {
+		if err := m.StartCapture(s.ExchangeConfig.WebsocketCaptureFile); err != nil {
+			log.Errorln(log.WebsocketMgr, err)
+		}
+	}
 	return nil
 }
 
@@ -409,6 +421,7 @@ func (m *Manager) createConnectionFromSetup(c *ConnectionSetup) *connection {
 		Reporter:             c.ConnectionLevelReporter,
 		RateLimitDefinitions: m.rateLimitDefinitions,
 		subscriptions:        subscription.NewStore(),
+		capture:              &m.capture,
 	}
 }
 
@@ -474,6 +487,11 @@ func (m *Manager) connect(ctx context.Context) error {
 	m.Wg.Add(1)
 	go m.monitorFrame(ctx, &m.Wg, m.monitorTraffic)
 
+	if m.subscriptionTimeout > 0 {
+		m.Wg.Add(1)
+		go m.monitorFrame(ctx, &m.Wg, m.monitorSubscriptions)
+	}
+
 	if !m.useMultiConnectionManagement {
 		if m.connector == nil {
 			return fmt.Errorf("%v %w", m.exchangeName, errNoConnectFunc)
@@ -1077,7 +1095,7 @@ func (m *Manager) observeConnection(ctx context.Context, t *time.Timer) (exit bo
 	select {
 	case err := <-m.ReadMessageErrors:
 		if errors.Is(err, errConnectionFault) {
-			log.Warnf(log.WebsocketMgr, "%v websocket has been disconnected. Reason: %v", m.exchangeName, err)
+			log.WarnWithFieldsf(log.WebsocketMgr, m.logFields().WithError(err), "%v websocket has been disconnected. Reason: %v", m.exchangeName, err)
 			if m.IsConnected() {
 				if shutdownErr := m.Shutdown(); shutdownErr != nil {
 					log.Errorf(log.WebsocketMgr, "%v websocket: connectionMonitor shutdown err: %s", m.exchangeName, shutdownErr)
@@ -1089,7 +1107,9 @@ func (m *Manager) observeConnection(ctx context.Context, t *time.Timer) (exit bo
 		// Speedier reconnection, instead of waiting for the next cycle.
 		if m.IsEnabled() && (!m.IsConnected() && !m.IsConnecting()) {
 			if connectErr := m.Connect(ctx); connectErr != nil {
-				log.Errorln(log.WebsocketMgr, connectErr)
+				log.ErrorlnWithFields(log.WebsocketMgr, m.logFields().WithError(connectErr), connectErr)
+			} else {
+				m.reportReconnect()
 			}
 		}
 		if err := m.DataHandler.Send(ctx, err); err != nil {
@@ -1118,7 +1138,9 @@ func (m *Manager) observeConnection(ctx context.Context, t *time.Timer) (exit bo
 		if !m.IsConnecting() && !m.IsConnected() {
 			err := m.Connect(ctx)
 			if err != nil {
-				log.Errorln(log.WebsocketMgr, err)
+				log.ErrorlnWithFields(log.WebsocketMgr, m.logFields().WithError(err), err)
+			} else {
+				m.reportReconnect()
 			}
 		}
 		t.Reset(m.connectionMonitorDelay)
@@ -1126,6 +1148,18 @@ func (m *Manager) observeConnection(ctx context.Context, t *time.Timer) (exit bo
 	return false
 }
 
+// reportReconnect notifies the exchange or global reporter of a successful
+// reconnection if it supports it
+func (m *Manager) reportReconnect() {
+	r := m.ExchangeLevelReporter
+	if r == nil {
+		r = globalReporter
+	}
+	if rep, ok := r.(ReconnectReporter); ok {
+		rep.Reconnected(m.exchangeName)
+	}
+}
+
 // monitorTraffic monitors to see if there has been traffic within the trafficTimeout time window. If there is no traffic
 // the connection is shutdown and will be reconnected by the connectionMonitor routine.
 func (m *Manager) monitorTraffic(context.Context) func() bool {
@@ -1151,7 +1185,7 @@ func (m *Manager) observeTraffic(timeout <-chan time.Time, onTimeout func()) boo
 			return false
 		}
 		if m.verbose {
-			log.Warnf(log.WebsocketMgr, "%v websocket: has not received a traffic alert in %v. Reconnecting", m.exchangeName, m.trafficTimeout)
+			log.WarnWithFieldsf(log.WebsocketMgr, m.logFields(), "%v websocket: has not received a traffic alert in %v. Reconnecting", m.exchangeName, m.trafficTimeout)
 		}
 		if m.IsConnected() && onTimeout != nil {
 			onTimeout()
@@ -1205,3 +1239,8 @@ func (m *Manager) GetConnection(messageFilter any) (Connection, error) {
 
 	return nil, fmt.Errorf("%s: %w associated with message filter: '%v'", m.exchangeName, ErrRequestRouteNotFound, messageFilter)
 }
+
+// logFields returns structured logging fields for the websocket's exchange
+func (m *Manager) logFields() log.ExtraFields {
+	return log.ExtraFields{}.WithExchange(m.exchangeName, nil, nil)
+}
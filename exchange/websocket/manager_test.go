@@ -12,6 +12,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -27,6 +28,10 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/subscription"
 	mockws "github.com/thrasher-corp/gocryptotrader/internal/testing/websocket"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 const (
@@ -1056,6 +1061,44 @@ func TestSendMessage(t *testing.T) {
 	}
 }
 
+func TestSendMessageReturnResponseTracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	mock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { mockws.WsMockUpgrader(t, w, r, mockws.EchoHandler) }))
+	defer mock.Close()
+
+	wc := &connection{
+		ExchangeName:     "test",
+		URL:              "ws" + mock.URL[len("http"):] + "/ws?token=secret",
+		ResponseMaxLimit: time.Second * 5,
+		Match:            NewMatch(),
+		RateLimit:        request.NewRateLimitWithWeight(time.Second, 100, 1),
+	}
+	require.NoError(t, wc.Dial(t.Context(), &gws.Dialer{}, http.Header{}, nil), "Dial must not error")
+	go readMessages(t, wc)
+
+	req := testRequest{Event: "subscribe", RequestID: 12345}
+	_, err := wc.SendMessageReturnResponse(t.Context(), request.Unset, req.RequestID, req)
+	require.NoError(t, err, "SendMessageReturnResponse must not error")
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 3)
+	assert.Equal(t, "websocket.RateLimit", spans[0].Name())
+	assert.Equal(t, "websocket.WaitForResponses", spans[1].Name())
+	assert.Equal(t, "websocket.SendMessageReturnResponse", spans[2].Name())
+	assert.Contains(t, spans[2].Attributes(), attribute.String("exchange", "test"))
+	assert.Contains(t, spans[2].Attributes(), attribute.String("signature", "12345"))
+	for _, a := range spans[2].Attributes() {
+		assert.NotContains(t, a.Value.Emit(), "secret", "span attributes should not contain the URL query string")
+	}
+	for _, s := range spans[:2] {
+		assert.Equal(t, spans[2].SpanContext().SpanID(), s.Parent().SpanID(), "%s should be a child of websocket.SendMessageReturnResponse", s.Name())
+	}
+}
+
 func TestSendMessageReturnResponse(t *testing.T) {
 	t.Parallel()
 
@@ -1140,9 +1183,12 @@ type inspection struct {
 func (i inspection) IsFinal([]byte) bool { return i.breakEarly }
 
 type reporter struct {
-	name string
-	msg  []byte
-	t    time.Duration
+	name          string
+	msg           []byte
+	t             time.Duration
+	received      atomic.Int64
+	reconnects    atomic.Int64
+	rateLimitWait atomic.Int64
 }
 
 func (r *reporter) Latency(name string, payload []byte, t time.Duration) {
@@ -1151,6 +1197,12 @@ func (r *reporter) Latency(name string, payload []byte, t time.Duration) {
 	r.t = t
 }
 
+func (r *reporter) MessageReceived(string) { r.received.Add(1) }
+
+func (r *reporter) Reconnected(string) { r.reconnects.Add(1) }
+
+func (r *reporter) RateLimitWait(string, time.Duration) { r.rateLimitWait.Add(1) }
+
 // readMessages helper func
 func readMessages(t *testing.T, wc *connection) {
 	t.Helper()
@@ -1549,6 +1601,7 @@ func TestLatency(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEmpty(t, r.t, "Latency must have a duration")
 	require.Equal(t, exch, r.name, "Latency must have the correct exchange name")
+	assert.Positive(t, r.received.Load(), "MessageReceived should be reported for each message read")
 }
 
 func TestRemoveURLQueryString(t *testing.T) {
@@ -1568,7 +1621,10 @@ func TestWriteToConn(t *testing.T) {
 	// connection rate limit set
 	// Use a longer interval so the second call always requires delay and hits ctx deadline checks deterministically.
 	wc.RateLimit = request.NewWeightedRateLimitByDuration(time.Second)
+	r := &reporter{}
+	wc.Reporter = r
 	require.NoError(t, wc.writeToConn(t.Context(), request.Unset, func() error { return nil }))
+	assert.Equal(t, int64(1), r.rateLimitWait.Load(), "RateLimitWait should be reported when a rate limiter is used")
 	ctx, cancel := context.WithTimeout(t.Context(), 0) // deadline exceeded
 	cancel()
 	require.ErrorIs(t, wc.writeToConn(ctx, request.Unset, func() error { return nil }), context.DeadlineExceeded)
@@ -1653,6 +1709,16 @@ func TestMonitorConnection(t *testing.T) {
 	require.False(t, innerShell())
 }
 
+func TestReportReconnect(t *testing.T) {
+	t.Parallel()
+	ws := Manager{exchangeName: "test"}
+	ws.reportReconnect() // No reporter set
+	r := &reporter{}
+	ws.ExchangeLevelReporter = r
+	ws.reportReconnect()
+	assert.Equal(t, int64(1), r.reconnects.Load(), "Reconnected should be reported to the exchange level reporter")
+}
+
 func TestMonitorTraffic(t *testing.T) { //nolint:tparallel // top-level parallel is safe; serial subtests limit websocket CI contention
 	t.Parallel()
 
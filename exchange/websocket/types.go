@@ -37,3 +37,15 @@ type UnhandledMessageWarning struct {
 type Reporter interface {
 	Latency(name string, message []byte, t time.Duration)
 }
+
+// MessageReporter is an optional Reporter extension which is notified of each
+// message read from a connection
+type MessageReporter interface {
+	MessageReceived(name string)
+}
+
+// ReconnectReporter is an optional Reporter extension which is notified when
+// the connection monitor re-establishes a lost connection
+type ReconnectReporter interface {
+	Reconnected(name string)
+}
@@ -1,11 +1,19 @@
 package binance
 
 import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 )
 
+// usedWeightHeader reports the request weight used by the IP address in the
+// current minute window
+const usedWeightHeader = "X-Mbx-Used-Weight-1m"
+
 const (
 	// Binance limit rates
 	// Global dictates the max rate limit for general request items which is
@@ -193,3 +201,30 @@ func orderbookLimit(depth uint64) request.EndpointLimit {
 
 	return spotOrderbookDepth5000Rate
 }
+
+// rateLimitParser reports the remaining request weight of the current minute
+// window. Spot, USDT margined and coin margined futures are weighted separately
+func rateLimitParser(resp *http.Response, now time.Time) (*request.RateLimitState, error) {
+	used := resp.Header.Get(usedWeightHeader)
+	if used == "" {
+		return nil, nil
+	}
+	weight, err := strconv.Atoi(used)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s header %q: %w", usedWeightHeader, used, err)
+	}
+	limit := spotRequestRate
+	if resp.Request != nil && resp.Request.URL != nil {
+		switch {
+		case strings.HasPrefix(resp.Request.URL.Path, "/fapi/"):
+			limit = uFuturesRequestRate
+		case strings.HasPrefix(resp.Request.URL.Path, "/dapi/"):
+			limit = cFuturesRequestRate
+		}
+	}
+	return &request.RateLimitState{
+		Limit:     limit,
+		Remaining: limit - weight,
+		Reset:     now.Truncate(time.Minute).Add(time.Minute),
+	}, nil
+}
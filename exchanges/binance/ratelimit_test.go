@@ -4,9 +4,12 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"net/url"
+	"strconv"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 )
@@ -79,3 +82,36 @@ func TestRateLimit_LimitStatic(t *testing.T) {
 		})
 	}
 }
+
+func TestRateLimitParser(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2024, 1, 1, 0, 0, 15, 0, time.UTC)
+	newResponse := func(path, used string) *http.Response {
+		resp := &http.Response{Header: http.Header{}, Request: &http.Request{URL: &url.URL{Path: path}}}
+		if used != "" {
+			resp.Header.Set(usedWeightHeader, used)
+		}
+		return resp
+	}
+
+	state, err := rateLimitParser(newResponse("/api/v3/time", ""), now)
+	require.NoError(t, err)
+	assert.Nil(t, state, "response without a used weight header should not return a state")
+
+	_, err = rateLimitParser(newResponse("/api/v3/time", "meow"), now)
+	assert.ErrorIs(t, err, strconv.ErrSyntax)
+
+	for path, limit := range map[string]int{
+		"/api/v3/time":   spotRequestRate,
+		"/fapi/v1/time":  uFuturesRequestRate,
+		"/dapi/v1/time":  cFuturesRequestRate,
+		"/sapi/v1/asset": spotRequestRate,
+	} {
+		state, err = rateLimitParser(newResponse(path, "100"), now)
+		require.NoError(t, err, "rateLimitParser must not error")
+		require.NotNil(t, state, "rateLimitParser must return a state")
+		assert.Equal(t, limit, state.Limit, path)
+		assert.Equal(t, limit-100, state.Remaining, path)
+		assert.Equal(t, time.Date(2024, 1, 1, 0, 1, 0, 0, time.UTC), state.Reset, "reset should be the end of the minute window")
+	}
+}
@@ -773,34 +773,7 @@ func TestOrderbookChecksum(t *testing.T) {
 			{Price: 0.3958, Amount: 18570.0},
 		},
 	}
-	require.Equal(t, uint32(3802968298), orderbookChecksum(b))
-}
-
-func TestTrim(t *testing.T) {
-	testCases := []struct {
-		Value    float64
-		Expected string
-	}{
-		{Value: 0.1234, Expected: "1234"},
-		{Value: 0.00001234, Expected: "1234"},
-		{Value: 32.00001234, Expected: "3200001234"},
-		{Value: 0, Expected: ""},
-		{Value: 0.0, Expected: ""},
-		{Value: 1.0, Expected: "1"},
-		{Value: 0.3965, Expected: "3965"},
-		{Value: 16000.0, Expected: "16000"},
-		{Value: 0.0019, Expected: "19"},
-		{Value: 1.01, Expected: "101"},
-	}
-
-	for _, tt := range testCases {
-		t.Run("", func(t *testing.T) {
-			received := trim(tt.Value)
-			if received != tt.Expected {
-				t.Fatalf("received: %v but expected: %v", received, tt.Expected)
-			}
-		})
-	}
+	require.Equal(t, uint32(3802968298), orderbookChecksum.Generate(b))
 }
 
 func TestFormatOrderType(t *testing.T) {
@@ -5,10 +5,8 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
-	"hash/crc32"
 	"net/http"
 	"strconv"
-	"strings"
 	"text/template"
 	"time"
 
@@ -140,7 +138,6 @@ func (e *Exchange) wsHandleData(ctx context.Context, respRaw []byte) error {
 				Asks:                       orderbook.Levels(ob.Asks),
 				Pair:                       ob.Currency,
 				ExpectedChecksum:           ob.Checksum,
-				GenerateChecksum:           orderbookChecksum,
 				SkipOutOfOrderLastUpdateID: true,
 			})
 		}
@@ -404,27 +401,11 @@ func (e *Exchange) ReSubscribeSpecificOrderbook(pair currency.Pair) error {
 	return e.Subscribe(sub)
 }
 
-// orderbookChecksum calculates a checksum for the orderbook liquidity
-func orderbookChecksum(ob *orderbook.Book) uint32 {
-	return crc32.ChecksumIEEE([]byte(concatOrderbookLiquidity(ob.Bids) + concatOrderbookLiquidity(ob.Asks)))
-}
-
-// concatOrderbookLiquidity concatenates price and amounts together for checksum processing
-func concatOrderbookLiquidity(liquidity orderbook.Levels) string {
-	var c strings.Builder
-	for x := range min(10, len(liquidity)) {
-		c.WriteString(trim(liquidity[x].Price))
-		c.WriteString(trim(liquidity[x].Amount))
-	}
-	return c.String()
-}
-
-// trim turns value into string, removes the decimal point and all the leading zeros
-func trim(value float64) string {
-	valstr := strconv.FormatFloat(value, 'f', -1, 64)
-	valstr = strings.ReplaceAll(valstr, ".", "")
-	valstr = strings.TrimLeft(valstr, "0")
-	return valstr
+// orderbookChecksum calculates a checksum from the top 10 bids and asks with
+// the decimal point and leading zeros removed from each price and amount
+var orderbookChecksum = &orderbook.Checksum{
+	Depth:  10,
+	Format: orderbook.ChecksumTrimmed,
 }
 
 func channelName(s *subscription.Subscription) string {
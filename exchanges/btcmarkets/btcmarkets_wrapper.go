@@ -163,7 +163,8 @@ func (e *Exchange) Setup(exch *config.Exchange) error {
 		GenerateSubscriptions: e.generateSubscriptions,
 		Features:              &e.Features.Supports.WebsocketCapabilities,
 		OrderbookBufferConfig: buffer.Config{
-			SortBuffer: true,
+			SortBuffer:       true,
+			GenerateChecksum: orderbookChecksum.Generate,
 		},
 	})
 	if err != nil {
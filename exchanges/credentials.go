@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
@@ -28,6 +29,11 @@ var (
 	errRequiresAPIPEMKey   = errors.New("requires API PEM key but default/empty one set")
 	errRequiresAPIClientID = errors.New("requires API Client ID but default/empty one set")
 	errBase64DecodeFailure = errors.New("base64 decode has failed")
+	// ErrAccountNotFound defines an error when credentials are requested for an
+	// account which has not been loaded
+	ErrAccountNotFound     = errors.New("exchange account not found")
+	errAccountNameEmpty    = errors.New("exchange account name is empty")
+	errAccountNameReserved = errors.New("exchange account name is reserved for the default credentials")
 )
 
 // CheckCredentials checks to see if the required fields have been set before
@@ -73,7 +79,22 @@ func (b *Base) GetDefaultCredentials() *accounts.Credentials {
 	return &creds
 }
 
+// GetAccountNames returns the default account followed by the sorted names of
+// the accounts loaded with SetAccountCredentials
+func (b *Base) GetAccountNames() []string {
+	b.API.credMu.RLock()
+	defer b.API.credMu.RUnlock()
+	names := make([]string, 0, len(b.API.accounts)+1)
+	names = append(names, accounts.DefaultAccount)
+	for name := range b.API.accounts {
+		names = append(names, name)
+	}
+	slices.Sort(names[1:])
+	return names
+}
+
 // GetCredentials checks and validates current credentials, context credentials
+// override the credentials of the account set on the context, which otherwise
 // override default credentials, if no credentials found, will return an error.
 func (b *Base) GetCredentials(ctx context.Context) (*accounts.Credentials, error) {
 	value := ctx.Value(accounts.ContextCredentialsFlag)
@@ -91,8 +112,16 @@ func (b *Base) GetCredentials(ctx context.Context) (*accounts.Credentials, error
 	}
 
 	// Fallback to exchange loaded credentials
+	account := accounts.AccountFromContext(ctx)
 	b.API.credMu.RLock()
 	creds := b.API.credentials
+	if account != accounts.DefaultAccount {
+		var ok bool
+		if creds, ok = b.API.accounts[account]; !ok {
+			b.API.credMu.RUnlock()
+			return nil, fmt.Errorf("%s %w: %q", b.Name, ErrAccountNotFound, account)
+		}
+	}
 	b.API.credMu.RUnlock()
 	if err := b.CheckCredentials(&creds, false); err != nil {
 		return nil, fmt.Errorf("error checking credentials: %w", err)
@@ -172,6 +201,106 @@ func (b *Base) SetCredentials(creds *accounts.Credentials) {
 	}
 }
 
+// SetAccountCredentials sets the API credentials of a named account, which are
+// used when the account is set on the context with
+// accounts.DeployAccountToContext. Names are case-insensitive. Nil credentials
+// remove the account
+func (b *Base) SetAccountCredentials(name string, creds *accounts.Credentials) error {
+	name = accounts.NormaliseAccountName(name)
+	if name == accounts.DefaultAccount {
+		b.SetCredentials(creds)
+		return nil
+	}
+	if creds == nil {
+		b.API.credMu.Lock()
+		delete(b.API.accounts, name)
+		b.API.credMu.Unlock()
+		return nil
+	}
+	c, err := b.accountCredentials(name, creds)
+	if err != nil {
+		return err
+	}
+	b.API.credMu.Lock()
+	defer b.API.credMu.Unlock()
+	if b.API.accounts == nil {
+		b.API.accounts = make(map[string]accounts.Credentials)
+	}
+	b.API.accounts[name] = c
+	return nil
+}
+
+// accountCredentials returns a copy of a named account's credentials with the
+// secret decoded when required by the exchange
+func (b *Base) accountCredentials(name string, creds *accounts.Credentials) (accounts.Credentials, error) {
+	if name == "" {
+		return accounts.Credentials{}, fmt.Errorf("%s %w", b.Name, errAccountNameEmpty)
+	}
+	c := *creds
+	if b.API.CredentialsValidator.RequiresBase64DecodeSecret && !c.SecretBase64Decoded {
+		result, err := base64.StdEncoding.DecodeString(c.Secret)
+		if err != nil {
+			return accounts.Credentials{}, fmt.Errorf("%s account %q API secret %w: %s", b.Name, name, errBase64DecodeFailure, err)
+		}
+		c.Secret = string(result)
+		c.SecretBase64Decoded = true
+	}
+	return c, nil
+}
+
+// LoadCredentialsFromConfig resolves the exchange config API credentials,
+// including any secret references, and sets them as the default credentials
+// along with the credentials of each named account. It can be called again to
+// rotate credentials which have changed at their secret provider, replacing
+// all named accounts
+func (b *Base) LoadCredentialsFromConfig(ctx context.Context) error {
+	if b.Config == nil {
+		return fmt.Errorf("%s %w", b.Name, errSetDefaultsNotCalled)
+	}
+	creds, err := resolveConfigCredentials(ctx, &b.Config.API.Credentials)
+	if err != nil {
+		return fmt.Errorf("%s %w", b.Name, err)
+	}
+	named := make(map[string]accounts.Credentials, len(b.Config.API.Accounts))
+	for i := range b.Config.API.Accounts {
+		acc := &b.Config.API.Accounts[i]
+		name := accounts.NormaliseAccountName(acc.Name)
+		if name == accounts.DefaultAccount {
+			return fmt.Errorf("%s account %q %w", b.Name, acc.Name, errAccountNameReserved)
+		}
+		resolved, err := resolveConfigCredentials(ctx, &acc.Credentials)
+		if err != nil {
+			return fmt.Errorf("%s account %q %w", b.Name, acc.Name, err)
+		}
+		if named[name], err = b.accountCredentials(name, resolved); err != nil {
+			return err
+		}
+	}
+
+	b.SetCredentials(creds)
+	b.API.credMu.Lock()
+	b.API.accounts = named
+	b.API.credMu.Unlock()
+	return nil
+}
+
+// resolveConfigCredentials resolves the secret references of config
+// credentials
+func resolveConfigCredentials(ctx context.Context, c *config.APICredentialsConfig) (*accounts.Credentials, error) {
+	creds, err := c.ResolveSecrets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &accounts.Credentials{
+		Key:             creds.Key,
+		Secret:          creds.Secret,
+		ClientID:        creds.ClientID,
+		SubAccount:      creds.Subaccount,
+		PEMKey:          creds.PEMKey,
+		OneTimePassword: creds.OTPSecret,
+	}, nil
+}
+
 // SetAPICredentialDefaults sets the API Credential validator defaults
 func (b *Base) SetAPICredentialDefaults() {
 	b.API.credMu.Lock()
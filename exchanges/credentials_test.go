@@ -2,6 +2,8 @@ package exchange
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -107,6 +109,87 @@ func TestGetCredentials(t *testing.T) {
 	assert.Empty(t, notOverridden.SubAccount, "SubAccount should be empty")
 }
 
+func TestGetCredentialsAccount(t *testing.T) {
+	t.Parallel()
+	var b Base
+	b.SetCredentials(&accounts.Credentials{Key: "defaultkey", Secret: "defaultsecret"})
+	require.NoError(t, b.SetAccountCredentials("hedge", &accounts.Credentials{Key: "hedgekey", Secret: "hedgesecret", SubAccount: "hedgesub"}))
+
+	ctx := accounts.DeployAccountToContext(t.Context(), "hedge")
+	creds, err := b.GetCredentials(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "hedgekey", creds.Key, "Key should be the named account's")
+	assert.Equal(t, "hedgesub", creds.SubAccount, "SubAccount should be the named account's")
+
+	creds, err = b.GetCredentials(accounts.DeploySubAccountOverrideToContext(ctx, "override"))
+	require.NoError(t, err)
+	assert.Equal(t, "hedgekey", creds.Key, "Key should be the named account's")
+	assert.Equal(t, "override", creds.SubAccount, "SubAccount should be overridden")
+
+	creds, err = b.GetCredentials(accounts.DeployCredentialsToContext(ctx, &accounts.Credentials{Key: "ctxkey", Secret: "ctxsecret"}))
+	require.NoError(t, err)
+	assert.Equal(t, "ctxkey", creds.Key, "Context credentials should override the named account")
+
+	creds, err = b.GetCredentials(accounts.DeployAccountToContext(t.Context(), accounts.DefaultAccount))
+	require.NoError(t, err)
+	assert.Equal(t, "defaultkey", creds.Key, "Default account should use the default credentials")
+
+	_, err = b.GetCredentials(accounts.DeployAccountToContext(t.Context(), "missing"))
+	require.ErrorIs(t, err, ErrAccountNotFound)
+
+	require.NoError(t, b.SetAccountCredentials("hedge", nil))
+	_, err = b.GetCredentials(ctx)
+	require.ErrorIs(t, err, ErrAccountNotFound, "Removed account should not be found")
+}
+
+func TestGetCredentialsAccountMixedCase(t *testing.T) {
+	t.Parallel()
+	var b Base
+	b.SetCredentials(&accounts.Credentials{Key: "defaultkey"})
+	require.NoError(t, b.SetAccountCredentials("Hedge", &accounts.Credentials{Key: "hedgekey"}), "SetAccountCredentials must not error")
+	assert.Equal(t, []string{accounts.DefaultAccount, "hedge"}, b.GetAccountNames(), "Account names should be normalised")
+
+	for _, account := range []string{"hedge", "HEDGE", "Hedge", " hEdGe "} {
+		creds, err := b.GetCredentials(accounts.DeployAccountToContext(t.Context(), account))
+		require.NoErrorf(t, err, "GetCredentials must not error for account %q", account)
+		assert.Equalf(t, "hedgekey", creds.Key, "Key should be the named account's for account %q", account)
+	}
+
+	creds, err := b.GetCredentials(accounts.DeployAccountToContext(t.Context(), "Default"))
+	require.NoError(t, err, "GetCredentials must not error for the default account")
+	assert.Equal(t, "defaultkey", creds.Key, "Default account should be selected regardless of case")
+
+	require.NoError(t, b.SetAccountCredentials("DEFAULT", &accounts.Credentials{Key: "newdefault"}), "SetAccountCredentials must not error")
+	assert.Equal(t, "newdefault", b.GetDefaultCredentials().Key, "Default account should set the default credentials regardless of case")
+
+	require.NoError(t, b.SetAccountCredentials("HEDGE", nil), "SetAccountCredentials must not error")
+	assert.Equal(t, []string{accounts.DefaultAccount}, b.GetAccountNames(), "Account should be removed regardless of case")
+}
+
+func TestSetAccountCredentials(t *testing.T) {
+	t.Parallel()
+	var b Base
+	require.ErrorIs(t, b.SetAccountCredentials("", &accounts.Credentials{Key: "k"}), errAccountNameEmpty)
+
+	require.NoError(t, b.SetAccountCredentials(accounts.DefaultAccount, &accounts.Credentials{Key: "defaultkey"}))
+	assert.Equal(t, "defaultkey", b.GetDefaultCredentials().Key, "Default account should set the default credentials")
+
+	b.API.CredentialsValidator.RequiresBase64DecodeSecret = true
+	require.ErrorIs(t, b.SetAccountCredentials("bad", &accounts.Credentials{Key: "k", Secret: "%%"}), errBase64DecodeFailure)
+	require.NoError(t, b.SetAccountCredentials("good", &accounts.Credentials{Key: "k", Secret: "aGVsbG8gd29ybGQ="}))
+	assert.Equal(t, []string{accounts.DefaultAccount, "good"}, b.GetAccountNames(), "Only valid accounts should be set")
+	assert.Equal(t, "hello world", b.API.accounts["good"].Secret, "Secret should be decoded")
+}
+
+func TestGetAccountNames(t *testing.T) {
+	t.Parallel()
+	var b Base
+	assert.Equal(t, []string{accounts.DefaultAccount}, b.GetAccountNames(), "Default account should always be returned")
+	require.NoError(t, b.SetAccountCredentials("zulu", &accounts.Credentials{Key: "z"}))
+	require.NoError(t, b.SetAccountCredentials("alpha", &accounts.Credentials{Key: "a"}))
+	assert.Equal(t, []string{accounts.DefaultAccount, "alpha", "zulu"}, b.GetAccountNames(), "Named accounts should be sorted after the default")
+}
+
 func TestAreCredentialsValid(t *testing.T) {
 	t.Parallel()
 	var b Base
@@ -349,6 +432,57 @@ func TestSetCredentials(t *testing.T) {
 	require.ErrorIs(t, b.VerifyAPICredentials(b.GetDefaultCredentials()), errBase64DecodeFailure, "invalid secret must fail verification")
 }
 
+func TestLoadCredentialsFromConfig(t *testing.T) {
+	t.Parallel()
+	var b Base
+	require.ErrorIs(t, b.LoadCredentialsFromConfig(t.Context()), errSetDefaultsNotCalled)
+
+	keyFile := filepath.Join(t.TempDir(), "key")
+	require.NoError(t, os.WriteFile(keyFile, []byte("filekey\n"), 0o600))
+	b.Config = &config.Exchange{API: config.APIConfig{Credentials: config.APICredentialsConfig{
+		Key:      "file://" + filepath.ToSlash(keyFile),
+		Secret:   "plainsecret",
+		ClientID: "clientid",
+	}}}
+	require.NoError(t, b.LoadCredentialsFromConfig(t.Context()))
+	creds := b.GetDefaultCredentials()
+	require.NotNil(t, creds)
+	assert.Equal(t, "filekey", creds.Key, "Key should be resolved from the secret reference")
+	assert.Equal(t, "plainsecret", creds.Secret, "Secret should be set from the plain config value")
+	assert.Equal(t, "clientid", creds.ClientID)
+	assert.Equal(t, "file://"+filepath.ToSlash(keyFile), b.Config.API.Credentials.Key, "Config should retain the secret reference")
+
+	require.NoError(t, os.WriteFile(keyFile, []byte("rotatedkey"), 0o600))
+	require.NoError(t, b.LoadCredentialsFromConfig(t.Context()))
+	assert.Equal(t, "rotatedkey", b.GetDefaultCredentials().Key, "Reloading should rotate the key")
+
+	b.Config.API.Accounts = []config.APIAccountConfig{
+		{Name: "Hedge", Credentials: config.APICredentialsConfig{Key: "file://" + filepath.ToSlash(keyFile), Secret: "hedgesecret", Subaccount: "hedgesub"}},
+	}
+	require.NoError(t, b.LoadCredentialsFromConfig(t.Context()))
+	assert.Equal(t, []string{accounts.DefaultAccount, "hedge"}, b.GetAccountNames(), "Config account names should be normalised")
+	creds, err := b.GetCredentials(accounts.DeployAccountToContext(t.Context(), "HEDGE"))
+	require.NoError(t, err)
+	assert.Equal(t, "rotatedkey", creds.Key, "Account key should be resolved from the secret reference")
+	assert.Equal(t, "hedgesub", creds.SubAccount)
+
+	b.Config.API.Accounts = []config.APIAccountConfig{{Name: "Default"}}
+	require.ErrorIs(t, b.LoadCredentialsFromConfig(t.Context()), errAccountNameReserved)
+	assert.Equal(t, []string{accounts.DefaultAccount, "hedge"}, b.GetAccountNames(), "Accounts should be unchanged when loading fails")
+
+	b.Config.API.Accounts = nil
+	require.NoError(t, b.LoadCredentialsFromConfig(t.Context()))
+	assert.Equal(t, []string{accounts.DefaultAccount}, b.GetAccountNames(), "Accounts removed from config should be removed")
+
+	b.Config.API.Accounts = []config.APIAccountConfig{
+		{Name: "hedge", Credentials: config.APICredentialsConfig{Key: "file://" + filepath.ToSlash(keyFile)}},
+	}
+	require.NoError(t, os.Remove(keyFile))
+	require.ErrorIs(t, b.LoadCredentialsFromConfig(t.Context()), os.ErrNotExist)
+	assert.Equal(t, "rotatedkey", b.GetDefaultCredentials().Key, "Credentials should be unchanged when resolving fails")
+	assert.Equal(t, []string{accounts.DefaultAccount}, b.GetAccountNames(), "Accounts should be unchanged when resolving fails")
+}
+
 func TestGetDefaultCredentials(t *testing.T) {
 	var b Base
 	if b.GetDefaultCredentials() != nil {
@@ -18,6 +18,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/config/secrets"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/dispatch"
 	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
@@ -534,16 +535,15 @@ func (b *Base) SetupDefaults(exch *config.Exchange) error {
 
 	b.API.AuthenticatedSupport = exch.API.AuthenticatedSupport
 	b.API.AuthenticatedWebsocketSupport = exch.API.AuthenticatedWebsocketSupport
-	b.API.credentials.SubAccount = exch.API.Credentials.Subaccount
+	if !secrets.IsReference(exch.API.Credentials.Subaccount) {
+		b.API.credentials.SubAccount = exch.API.Credentials.Subaccount
+	}
 	if b.API.AuthenticatedSupport || b.API.AuthenticatedWebsocketSupport {
-		b.SetCredentials(&accounts.Credentials{
-			Key:             exch.API.Credentials.Key,
-			Secret:          exch.API.Credentials.Secret,
-			ClientID:        exch.API.Credentials.ClientID,
-			SubAccount:      exch.API.Credentials.Subaccount,
-			PEMKey:          exch.API.Credentials.PEMKey,
-			OneTimePassword: exch.API.Credentials.OTPSecret,
-		})
+		if err := b.LoadCredentialsFromConfig(context.TODO()); err != nil {
+			b.API.AuthenticatedSupport = false
+			b.API.AuthenticatedWebsocketSupport = false
+			log.Warnf(log.ExchangeSys, "%s unable to load API credentials, authenticated support disabled: %s", b.Name, err)
+		}
 	}
 
 	if exch.HTTPTimeout <= time.Duration(0) {
@@ -1603,7 +1603,12 @@ func (b *Base) GetKlineExtendedRequest(pair currency.Pair, a asset.Item, interva
 func (b *Base) Shutdown() error {
 	if b.Websocket != nil {
 		err := b.Websocket.Shutdown()
-		if err != nil && !errors.Is(err, websocket.ErrNotConnected) {
+		if errors.Is(err, websocket.ErrNotConnected) {
+			err = nil
+		}
+		// Captures span reconnections, so are only stopped when the exchange
+		// is shut down
+		if err := common.AppendError(err, b.Websocket.StopCapture()); err != nil {
 			return err
 		}
 	}
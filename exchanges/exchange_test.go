@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"net"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -2946,3 +2947,14 @@ func TestMessageID(t *testing.T) {
 	require.NoError(t, err, "MessageID must return a valid UUID")
 	assert.Equal(t, uuid.V7, u.Version(), "MessageID should return a V7 uuid")
 }
+
+func TestShutdownStopsWebsocketCapture(t *testing.T) {
+	t.Parallel()
+	requester, err := request.New("testShutdown", common.NewHTTPClientWithTimeout(0))
+	require.NoError(t, err, "request.New must not error")
+	b := Base{Name: "testShutdown", Requester: requester, Websocket: websocket.NewManager()}
+	require.NoError(t, b.Websocket.StartCapture(filepath.Join(t.TempDir(), "ws.jsonl")), "StartCapture must not error")
+	require.True(t, b.Websocket.IsCapturing(), "IsCapturing must return true once a capture is started")
+	require.NoError(t, b.Shutdown(), "Shutdown must not error")
+	assert.False(t, b.Websocket.IsCapturing(), "Shutdown should stop and close the websocket capture")
+}
@@ -223,7 +223,9 @@ type API struct {
 	Endpoints *Endpoints
 
 	credentials accounts.Credentials
-	credMu      sync.RWMutex
+	// accounts holds the credentials of named accounts keyed by name
+	accounts map[string]accounts.Credentials
+	credMu   sync.RWMutex
 
 	CredentialsValidator config.APICredentialsValidatorConfig
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
@@ -21,13 +22,23 @@ import (
 // to track futures orders
 func SetupPositionController() PositionController {
 	return PositionController{
-		multiPositionTrackers: make(map[key.ExchangeAssetPair]*MultiPositionTracker),
+		multiPositionTrackers: make(map[positionKey]*MultiPositionTracker),
 	}
 }
 
+// newPositionKey returns the key of an account's positions, where an empty
+// account is the default account
+func newPositionKey(exch, account string, item asset.Item, pair currency.Pair) positionKey {
+	if account == "" {
+		account = accounts.DefaultAccount
+	}
+	return positionKey{ExchangeAssetPair: key.NewExchangeAssetPair(exch, item, pair), account: account}
+}
+
 // TrackNewOrder sets up the maps to then create a
 // multi position tracker which funnels down into the
-// position tracker, to then track an order's pnl
+// position tracker, to then track an order's pnl. Positions are tracked
+// separately for the order's account
 func (c *PositionController) TrackNewOrder(d *order.Detail) error {
 	if c == nil {
 		return fmt.Errorf("position controller %w", common.ErrNilPointer)
@@ -42,7 +53,8 @@ func (c *PositionController) TrackNewOrder(d *order.Detail) error {
 	}
 	c.m.Lock()
 	defer c.m.Unlock()
-	exchMap, ok := c.multiPositionTrackers[key.NewExchangeAssetPair(d.Exchange, d.AssetType, d.Pair)]
+	k := newPositionKey(d.Exchange, d.Account, d.AssetType, d.Pair)
+	exchMap, ok := c.multiPositionTrackers[k]
 	if !ok {
 		exchMap, err = SetupMultiPositionTracker(&MultiPositionTrackerSetup{
 			Exchange:   d.Exchange,
@@ -53,7 +65,7 @@ func (c *PositionController) TrackNewOrder(d *order.Detail) error {
 		if err != nil {
 			return err
 		}
-		c.multiPositionTrackers[key.NewExchangeAssetPair(d.Exchange, d.AssetType, d.Pair)] = exchMap
+		c.multiPositionTrackers[k] = exchMap
 	}
 	err = exchMap.TrackNewOrder(d)
 	if err != nil {
@@ -64,8 +76,8 @@ func (c *PositionController) TrackNewOrder(d *order.Detail) error {
 }
 
 // SetCollateralCurrency allows the setting of a collateral currency to all child trackers
-// when using position controller for futures orders tracking
-func (c *PositionController) SetCollateralCurrency(exch string, item asset.Item, pair currency.Pair, collateralCurrency currency.Code) error {
+// of an account when using position controller for futures orders tracking
+func (c *PositionController) SetCollateralCurrency(exch, account string, item asset.Item, pair currency.Pair, collateralCurrency currency.Code) error {
 	if c == nil {
 		return fmt.Errorf("position controller %w", common.ErrNilPointer)
 	}
@@ -77,7 +89,7 @@ func (c *PositionController) SetCollateralCurrency(exch string, item asset.Item,
 	c.m.Lock()
 	defer c.m.Unlock()
 
-	tracker := c.multiPositionTrackers[key.NewExchangeAssetPair(exch, item, pair)]
+	tracker := c.multiPositionTrackers[newPositionKey(exch, account, item, pair)]
 	if tracker == nil {
 		return fmt.Errorf("%w no open position for %v %v %v", ErrPositionNotFound, exch, item, pair)
 	}
@@ -93,8 +105,8 @@ func (c *PositionController) SetCollateralCurrency(exch string, item asset.Item,
 }
 
 // GetPositionsForExchange returns all positions for an
-// exchange, asset pair that is stored in the position controller
-func (c *PositionController) GetPositionsForExchange(exch string, item asset.Item, pair currency.Pair) ([]Position, error) {
+// exchange account, asset pair that is stored in the position controller
+func (c *PositionController) GetPositionsForExchange(exch, account string, item asset.Item, pair currency.Pair) ([]Position, error) {
 	if c == nil {
 		return nil, fmt.Errorf("position controller %w", common.ErrNilPointer)
 	}
@@ -105,16 +117,17 @@ func (c *PositionController) GetPositionsForExchange(exch string, item asset.Ite
 	}
 	c.m.Lock()
 	defer c.m.Unlock()
-	tracker := c.multiPositionTrackers[key.NewExchangeAssetPair(exch, item, pair)]
+	tracker := c.multiPositionTrackers[newPositionKey(exch, account, item, pair)]
 	if tracker == nil {
 		return nil, fmt.Errorf("%w no open position for %v %v %v", ErrPositionNotFound, exch, item, pair)
 	}
 
-	return tracker.GetPositions(), nil
+	return tracker.getAccountPositions(account), nil
 }
 
-// TrackFundingDetails applies funding rate details to a tracked position
-func (c *PositionController) TrackFundingDetails(d *fundingrate.HistoricalRates) error {
+// TrackFundingDetails applies funding rate details to an account's tracked
+// position
+func (c *PositionController) TrackFundingDetails(account string, d *fundingrate.HistoricalRates) error {
 	if c == nil {
 		return fmt.Errorf("position controller %w", common.ErrNilPointer)
 	}
@@ -128,7 +141,7 @@ func (c *PositionController) TrackFundingDetails(d *fundingrate.HistoricalRates)
 	}
 	c.m.Lock()
 	defer c.m.Unlock()
-	tracker := c.multiPositionTrackers[key.NewExchangeAssetPair(d.Exchange, d.Asset, d.Pair)]
+	tracker := c.multiPositionTrackers[newPositionKey(d.Exchange, account, d.Asset, d.Pair)]
 	if tracker == nil {
 		return fmt.Errorf("%w no open position for %v %v %v", ErrPositionNotFound, d.Exchange, d.Asset, d.Pair)
 	}
@@ -151,8 +164,9 @@ func (c *PositionController) LastUpdated() (time.Time, error) {
 	return c.updated, nil
 }
 
-// GetOpenPosition returns an open positions that matches the exchange, asset, pair
-func (c *PositionController) GetOpenPosition(exch string, item asset.Item, pair currency.Pair) (*Position, error) {
+// GetOpenPosition returns an open positions that matches the exchange account,
+// asset, pair
+func (c *PositionController) GetOpenPosition(exch, account string, item asset.Item, pair currency.Pair) (*Position, error) {
 	if c == nil {
 		return nil, fmt.Errorf("position controller %w", common.ErrNilPointer)
 	}
@@ -163,11 +177,11 @@ func (c *PositionController) GetOpenPosition(exch string, item asset.Item, pair
 	}
 	c.m.Lock()
 	defer c.m.Unlock()
-	tracker := c.multiPositionTrackers[key.NewExchangeAssetPair(exch, item, pair)]
+	tracker := c.multiPositionTrackers[newPositionKey(exch, account, item, pair)]
 	if tracker == nil {
 		return nil, fmt.Errorf("%w no open position for %v %v %v", ErrPositionNotFound, exch, item, pair)
 	}
-	positions := tracker.GetPositions()
+	positions := tracker.getAccountPositions(account)
 	for i := range positions {
 		if positions[i].Status.IsInactive() {
 			continue
@@ -186,8 +200,8 @@ func (c *PositionController) GetAllOpenPositions() ([]Position, error) {
 	c.m.Lock()
 	defer c.m.Unlock()
 	var openPositions []Position
-	for _, multiPositionTracker := range c.multiPositionTrackers {
-		positions := multiPositionTracker.GetPositions()
+	for k, multiPositionTracker := range c.multiPositionTrackers {
+		positions := multiPositionTracker.getAccountPositions(k.account)
 		for i := range positions {
 			if positions[i].Status.IsInactive() {
 				continue
@@ -202,9 +216,9 @@ func (c *PositionController) GetAllOpenPositions() ([]Position, error) {
 }
 
 // UpdateOpenPositionUnrealisedPNL finds an open position from
-// an exchange asset pair, then calculates the unrealisedPNL
+// an exchange account asset pair, then calculates the unrealisedPNL
 // using the latest ticker data
-func (c *PositionController) UpdateOpenPositionUnrealisedPNL(exch string, item asset.Item, pair currency.Pair, last float64, updated time.Time) (decimal.Decimal, error) {
+func (c *PositionController) UpdateOpenPositionUnrealisedPNL(exch, account string, item asset.Item, pair currency.Pair, last float64, updated time.Time) (decimal.Decimal, error) {
 	if c == nil {
 		return decimal.Zero, fmt.Errorf("position controller %w", common.ErrNilPointer)
 	}
@@ -215,7 +229,7 @@ func (c *PositionController) UpdateOpenPositionUnrealisedPNL(exch string, item a
 	}
 	c.m.Lock()
 	defer c.m.Unlock()
-	tracker := c.multiPositionTrackers[key.NewExchangeAssetPair(exch, item, pair)]
+	tracker := c.multiPositionTrackers[newPositionKey(exch, account, item, pair)]
 	if tracker == nil {
 		return decimal.Zero, fmt.Errorf("%v %v %v %w", exch, item, pair, ErrPositionNotFound)
 	}
@@ -294,8 +308,8 @@ func (m *MultiPositionTracker) UpdateOpenPositionUnrealisedPNL(last float64, upd
 }
 
 // ClearPositionsForExchange resets positions for an
-// exchange, asset, pair that has been stored
-func (c *PositionController) ClearPositionsForExchange(exch string, item asset.Item, pair currency.Pair) error {
+// exchange account, asset, pair that has been stored
+func (c *PositionController) ClearPositionsForExchange(exch, account string, item asset.Item, pair currency.Pair) error {
 	if c == nil {
 		return fmt.Errorf("position controller %w", common.ErrNilPointer)
 	}
@@ -307,7 +321,7 @@ func (c *PositionController) ClearPositionsForExchange(exch string, item asset.I
 	c.m.Lock()
 	defer c.m.Unlock()
 
-	tracker := c.multiPositionTrackers[key.NewExchangeAssetPair(exch, item, pair)]
+	tracker := c.multiPositionTrackers[newPositionKey(exch, account, item, pair)]
 	if tracker == nil {
 		return fmt.Errorf("%v %v %v %w", exch, item, pair, ErrPositionNotFound)
 	}
@@ -325,7 +339,7 @@ func (c *PositionController) ClearPositionsForExchange(exch string, item asset.I
 	if err != nil {
 		return err
 	}
-	c.multiPositionTrackers[key.NewExchangeAssetPair(exch, item, pair)] = newMPT
+	c.multiPositionTrackers[newPositionKey(exch, account, item, pair)] = newMPT
 	return nil
 }
 
@@ -346,6 +360,19 @@ func (m *MultiPositionTracker) GetPositions() []Position {
 	return resp
 }
 
+// getAccountPositions returns all positions labelled with the account they
+// are tracked for
+func (m *MultiPositionTracker) getAccountPositions(account string) []Position {
+	if account == "" {
+		account = accounts.DefaultAccount
+	}
+	positions := m.GetPositions()
+	for i := range positions {
+		positions[i].Account = account
+	}
+	return positions
+}
+
 // TrackNewOrder upserts an order to the tracker and updates position
 // status and exposure. PNL is calculated separately as it requires mark prices
 func (m *MultiPositionTracker) TrackNewOrder(d *order.Detail) error {
@@ -9,8 +9,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/common"
-	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/accounts"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
@@ -434,6 +434,34 @@ func TestPositionControllerTestTrackNewOrder(t *testing.T) {
 	assert.ErrorIs(t, err, common.ErrNilPointer)
 }
 
+func TestPositionControllerAccounts(t *testing.T) {
+	t.Parallel()
+	pc := SetupPositionController()
+	for _, o := range []order.Detail{
+		{Exchange: testExchange, Date: time.Now(), Pair: currency.NewBTCUSDT(), AssetType: asset.Futures, Side: order.Long, OrderID: "1", Amount: 1, Price: 1337},
+		{Exchange: testExchange, Account: "hedge", Date: time.Now(), Pair: currency.NewBTCUSDT(), AssetType: asset.Futures, Side: order.Short, OrderID: "2", Amount: 2, Price: 1337},
+	} {
+		require.NoError(t, pc.TrackNewOrder(&o))
+	}
+
+	pos, err := pc.GetOpenPosition(testExchange, accounts.DefaultAccount, asset.Futures, currency.NewBTCUSDT())
+	require.NoError(t, err, "GetOpenPosition must not error for the default account")
+	assert.Equal(t, order.Long, pos.OpeningDirection, "Default account position should only hold its own orders")
+	assert.Equal(t, accounts.DefaultAccount, pos.Account, "Position should be labelled with the default account")
+
+	pos, err = pc.GetOpenPosition(testExchange, "hedge", asset.Futures, currency.NewBTCUSDT())
+	require.NoError(t, err, "GetOpenPosition must not error for a named account")
+	assert.Equal(t, order.Short, pos.OpeningDirection, "Named account position should only hold its own orders")
+	assert.Equal(t, "hedge", pos.Account, "Position should be labelled with its account")
+
+	_, err = pc.GetOpenPosition(testExchange, "missing", asset.Futures, currency.NewBTCUSDT())
+	assert.ErrorIs(t, err, ErrPositionNotFound)
+
+	positions, err := pc.GetAllOpenPositions()
+	require.NoError(t, err)
+	assert.Len(t, positions, 2, "GetAllOpenPositions should return the positions of every account")
+}
+
 func TestGetLatestPNLSnapshot(t *testing.T) {
 	t.Parallel()
 	pt := PositionTracker{}
@@ -523,38 +551,38 @@ func TestGetPositionsForExchange(t *testing.T) {
 	c := &PositionController{}
 	p := currency.NewBTCUSDT()
 
-	_, err := c.GetPositionsForExchange("", asset.Futures, p)
+	_, err := c.GetPositionsForExchange("", "", asset.Futures, p)
 	assert.ErrorIs(t, err, common.ErrExchangeNameNotSet)
 
-	pos, err := c.GetPositionsForExchange(testExchange, asset.Futures, p)
+	pos, err := c.GetPositionsForExchange(testExchange, "", asset.Futures, p)
 	assert.ErrorIs(t, err, ErrPositionNotFound)
 
 	if len(pos) != 0 {
 		t.Error("expected zero")
 	}
-	c.multiPositionTrackers = make(map[key.ExchangeAssetPair]*MultiPositionTracker)
-	c.multiPositionTrackers[key.NewExchangeAssetPair(testExchange, asset.Futures, p)] = nil
-	_, err = c.GetPositionsForExchange(testExchange, asset.Futures, p)
+	c.multiPositionTrackers = make(map[positionKey]*MultiPositionTracker)
+	c.multiPositionTrackers[newPositionKey(testExchange, "", asset.Futures, p)] = nil
+	_, err = c.GetPositionsForExchange(testExchange, "", asset.Futures, p)
 	require.ErrorIs(t, err, ErrPositionNotFound, "GetPositionsForExchange must return ErrPositionNotFound")
 
-	c.multiPositionTrackers[key.NewExchangeAssetPair(testExchange, asset.Futures, p)] = nil
-	_, err = c.GetPositionsForExchange(testExchange, asset.Futures, p)
+	c.multiPositionTrackers[newPositionKey(testExchange, "", asset.Futures, p)] = nil
+	_, err = c.GetPositionsForExchange(testExchange, "", asset.Futures, p)
 	assert.ErrorIs(t, err, ErrPositionNotFound)
 
-	_, err = c.GetPositionsForExchange(testExchange, asset.Spot, p)
+	_, err = c.GetPositionsForExchange(testExchange, "", asset.Spot, p)
 	assert.ErrorIs(t, err, ErrNotFuturesAsset)
 
-	c.multiPositionTrackers[key.NewExchangeAssetPair(testExchange, asset.Futures, p)] = &MultiPositionTracker{
+	c.multiPositionTrackers[newPositionKey(testExchange, "", asset.Futures, p)] = &MultiPositionTracker{
 		exchange: testExchange,
 	}
 
-	pos, err = c.GetPositionsForExchange(testExchange, asset.Futures, p)
+	pos, err = c.GetPositionsForExchange(testExchange, "", asset.Futures, p)
 	assert.NoError(t, err)
 
 	if len(pos) != 0 {
 		t.Fatal("expected zero")
 	}
-	c.multiPositionTrackers[key.NewExchangeAssetPair(testExchange, asset.Futures, p)] = &MultiPositionTracker{
+	c.multiPositionTrackers[newPositionKey(testExchange, "", asset.Futures, p)] = &MultiPositionTracker{
 		exchange: testExchange,
 		positions: []*PositionTracker{
 			{
@@ -562,7 +590,7 @@ func TestGetPositionsForExchange(t *testing.T) {
 			},
 		},
 	}
-	pos, err = c.GetPositionsForExchange(testExchange, asset.Futures, p)
+	pos, err = c.GetPositionsForExchange(testExchange, "", asset.Futures, p)
 	assert.NoError(t, err)
 
 	if len(pos) != 1 {
@@ -572,7 +600,7 @@ func TestGetPositionsForExchange(t *testing.T) {
 		t.Error("expected test")
 	}
 	c = nil
-	_, err = c.GetPositionsForExchange(testExchange, asset.Futures, p)
+	_, err = c.GetPositionsForExchange(testExchange, "", asset.Futures, p)
 	assert.ErrorIs(t, err, common.ErrNilPointer)
 }
 
@@ -580,20 +608,20 @@ func TestClearPositionsForExchange(t *testing.T) {
 	t.Parallel()
 	c := &PositionController{}
 	p := currency.NewBTCUSDT()
-	err := c.ClearPositionsForExchange("", asset.Futures, p)
+	err := c.ClearPositionsForExchange("", "", asset.Futures, p)
 	assert.ErrorIs(t, err, common.ErrExchangeNameNotSet)
 
-	err = c.ClearPositionsForExchange(testExchange, asset.Futures, p)
+	err = c.ClearPositionsForExchange(testExchange, "", asset.Futures, p)
 	assert.ErrorIs(t, err, ErrPositionNotFound)
 
-	c.multiPositionTrackers = make(map[key.ExchangeAssetPair]*MultiPositionTracker)
-	err = c.ClearPositionsForExchange(testExchange, asset.Futures, p)
+	c.multiPositionTrackers = make(map[positionKey]*MultiPositionTracker)
+	err = c.ClearPositionsForExchange(testExchange, "", asset.Futures, p)
 	assert.ErrorIs(t, err, ErrPositionNotFound)
 
-	err = c.ClearPositionsForExchange(testExchange, asset.Spot, p)
+	err = c.ClearPositionsForExchange(testExchange, "", asset.Spot, p)
 	assert.ErrorIs(t, err, ErrNotFuturesAsset)
 
-	c.multiPositionTrackers[key.NewExchangeAssetPair(testExchange, asset.Futures, p)] = &MultiPositionTracker{
+	c.multiPositionTrackers[newPositionKey(testExchange, "", asset.Futures, p)] = &MultiPositionTracker{
 		exchange:   testExchange,
 		underlying: currency.DOGE,
 		positions: []*PositionTracker{
@@ -602,13 +630,13 @@ func TestClearPositionsForExchange(t *testing.T) {
 			},
 		},
 	}
-	err = c.ClearPositionsForExchange(testExchange, asset.Futures, p)
+	err = c.ClearPositionsForExchange(testExchange, "", asset.Futures, p)
 	require.NoError(t, err, "ClearPositionsForExchange must not error")
-	if len(c.multiPositionTrackers[key.NewExchangeAssetPair(testExchange, asset.Futures, p)].positions) != 0 {
+	if len(c.multiPositionTrackers[newPositionKey(testExchange, "", asset.Futures, p)].positions) != 0 {
 		t.Fatal("expected 0")
 	}
 	c = nil
-	_, err = c.GetPositionsForExchange(testExchange, asset.Futures, p)
+	_, err = c.GetPositionsForExchange(testExchange, "", asset.Futures, p)
 	assert.ErrorIs(t, err, common.ErrNilPointer)
 }
 
@@ -757,13 +785,13 @@ func TestUpdateOpenPositionUnrealisedPNL(t *testing.T) {
 	t.Parallel()
 	pc := SetupPositionController()
 
-	_, err := pc.UpdateOpenPositionUnrealisedPNL("", asset.Futures, currency.NewBTCUSDT(), 2, time.Now())
+	_, err := pc.UpdateOpenPositionUnrealisedPNL("", "", asset.Futures, currency.NewBTCUSDT(), 2, time.Now())
 	assert.ErrorIs(t, err, common.ErrExchangeNameNotSet)
 
-	_, err = pc.UpdateOpenPositionUnrealisedPNL("hi", asset.Futures, currency.NewBTCUSDT(), 2, time.Now())
+	_, err = pc.UpdateOpenPositionUnrealisedPNL("hi", "", asset.Futures, currency.NewBTCUSDT(), 2, time.Now())
 	assert.ErrorIs(t, err, ErrPositionNotFound)
 
-	_, err = pc.UpdateOpenPositionUnrealisedPNL("hi", asset.Spot, currency.NewBTCUSDT(), 2, time.Now())
+	_, err = pc.UpdateOpenPositionUnrealisedPNL("hi", "", asset.Spot, currency.NewBTCUSDT(), 2, time.Now())
 	assert.ErrorIs(t, err, ErrNotFuturesAsset)
 
 	err = pc.TrackNewOrder(&order.Detail{
@@ -778,16 +806,16 @@ func TestUpdateOpenPositionUnrealisedPNL(t *testing.T) {
 	})
 	assert.NoError(t, err)
 
-	_, err = pc.UpdateOpenPositionUnrealisedPNL("hi2", asset.Futures, currency.NewBTCUSDT(), 2, time.Now())
+	_, err = pc.UpdateOpenPositionUnrealisedPNL("hi2", "", asset.Futures, currency.NewBTCUSDT(), 2, time.Now())
 	assert.ErrorIs(t, err, ErrPositionNotFound)
 
-	_, err = pc.UpdateOpenPositionUnrealisedPNL("hi", asset.PerpetualSwap, currency.NewBTCUSDT(), 2, time.Now())
+	_, err = pc.UpdateOpenPositionUnrealisedPNL("hi", "", asset.PerpetualSwap, currency.NewBTCUSDT(), 2, time.Now())
 	assert.ErrorIs(t, err, ErrPositionNotFound)
 
-	_, err = pc.UpdateOpenPositionUnrealisedPNL("hi", asset.Futures, currency.NewPair(currency.BTC, currency.DOGE), 2, time.Now())
+	_, err = pc.UpdateOpenPositionUnrealisedPNL("hi", "", asset.Futures, currency.NewPair(currency.BTC, currency.DOGE), 2, time.Now())
 	assert.ErrorIs(t, err, ErrPositionNotFound)
 
-	pnl, err := pc.UpdateOpenPositionUnrealisedPNL("hi", asset.Futures, currency.NewBTCUSDT(), 2, time.Now())
+	pnl, err := pc.UpdateOpenPositionUnrealisedPNL("hi", "", asset.Futures, currency.NewBTCUSDT(), 2, time.Now())
 	assert.NoError(t, err)
 
 	if !pnl.Equal(decimal.NewFromInt(1)) {
@@ -795,28 +823,28 @@ func TestUpdateOpenPositionUnrealisedPNL(t *testing.T) {
 	}
 
 	var nilPC *PositionController
-	_, err = nilPC.UpdateOpenPositionUnrealisedPNL("hi", asset.Futures, currency.NewBTCUSDT(), 2, time.Now())
+	_, err = nilPC.UpdateOpenPositionUnrealisedPNL("hi", "", asset.Futures, currency.NewBTCUSDT(), 2, time.Now())
 	assert.ErrorIs(t, err, common.ErrNilPointer)
 }
 
 func TestSetCollateralCurrency(t *testing.T) {
 	t.Parallel()
 	pc := SetupPositionController()
-	err := pc.SetCollateralCurrency("", asset.Spot, currency.EMPTYPAIR, currency.Code{})
+	err := pc.SetCollateralCurrency("", "", asset.Spot, currency.EMPTYPAIR, currency.Code{})
 	assert.ErrorIs(t, err, common.ErrExchangeNameNotSet)
 
-	err = pc.SetCollateralCurrency("hi", asset.Spot, currency.EMPTYPAIR, currency.Code{})
+	err = pc.SetCollateralCurrency("hi", "", asset.Spot, currency.EMPTYPAIR, currency.Code{})
 	assert.ErrorIs(t, err, ErrNotFuturesAsset)
 
 	p := currency.NewBTCUSDT()
-	pc.multiPositionTrackers = make(map[key.ExchangeAssetPair]*MultiPositionTracker)
-	err = pc.SetCollateralCurrency("hi", asset.Futures, p, currency.DOGE)
+	pc.multiPositionTrackers = make(map[positionKey]*MultiPositionTracker)
+	err = pc.SetCollateralCurrency("hi", "", asset.Futures, p, currency.DOGE)
 	require.ErrorIs(t, err, ErrPositionNotFound)
 
-	err = pc.SetCollateralCurrency("hi", asset.Futures, p, currency.DOGE)
+	err = pc.SetCollateralCurrency("hi", "", asset.Futures, p, currency.DOGE)
 	require.ErrorIs(t, err, ErrPositionNotFound)
 
-	mapKey := key.NewExchangeAssetPair("hi", asset.Futures, p)
+	mapKey := newPositionKey("hi", "", asset.Futures, p)
 	pc.multiPositionTrackers[mapKey] = &MultiPositionTracker{
 		exchange:       "hi",
 		asset:          asset.Futures,
@@ -835,7 +863,7 @@ func TestSetCollateralCurrency(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	err = pc.SetCollateralCurrency("hi", asset.Futures, p, currency.DOGE)
+	err = pc.SetCollateralCurrency("hi", "", asset.Futures, p, currency.DOGE)
 	require.NoError(t, err)
 
 	if !pc.multiPositionTrackers[mapKey].collateralCurrency.Equal(currency.DOGE) {
@@ -847,7 +875,7 @@ func TestSetCollateralCurrency(t *testing.T) {
 	}
 
 	var nilPC *PositionController
-	err = nilPC.SetCollateralCurrency("hi", asset.Spot, currency.EMPTYPAIR, currency.Code{})
+	err = nilPC.SetCollateralCurrency("hi", "", asset.Spot, currency.EMPTYPAIR, currency.Code{})
 	assert.ErrorIs(t, err, common.ErrNilPointer)
 }
 
@@ -867,7 +895,7 @@ func TestMPTUpdateOpenPositionUnrealisedPNL(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	mapKey := key.NewExchangeAssetPair("hi", asset.Futures, p)
+	mapKey := newPositionKey("hi", "", asset.Futures, p)
 	result, err := pc.multiPositionTrackers[mapKey].UpdateOpenPositionUnrealisedPNL(1337, time.Now())
 	require.NoError(t, err)
 
@@ -994,10 +1022,10 @@ func TestGetOpenPosition(t *testing.T) {
 	cp := currency.NewPair(currency.BTC, currency.PERP)
 	tn := time.Now()
 
-	_, err := pc.GetOpenPosition("", asset.Futures, cp)
+	_, err := pc.GetOpenPosition("", "", asset.Futures, cp)
 	assert.ErrorIs(t, err, common.ErrExchangeNameNotSet)
 
-	_, err = pc.GetOpenPosition(testExchange, asset.Futures, cp)
+	_, err = pc.GetOpenPosition(testExchange, "", asset.Futures, cp)
 	assert.ErrorIs(t, err, ErrPositionNotFound)
 
 	err = pc.TrackNewOrder(&order.Detail{
@@ -1012,7 +1040,7 @@ func TestGetOpenPosition(t *testing.T) {
 	})
 	assert.NoError(t, err)
 
-	_, err = pc.GetOpenPosition(testExchange, asset.Futures, cp)
+	_, err = pc.GetOpenPosition(testExchange, "", asset.Futures, cp)
 	assert.NoError(t, err)
 }
 
@@ -1044,7 +1072,7 @@ func TestGetAllOpenPositions(t *testing.T) {
 func TestPCTrackFundingDetails(t *testing.T) {
 	t.Parallel()
 	pc := SetupPositionController()
-	err := pc.TrackFundingDetails(nil)
+	err := pc.TrackFundingDetails("", nil)
 	assert.ErrorIs(t, err, common.ErrNilPointer)
 
 	p := currency.NewPair(currency.BTC, currency.PERP)
@@ -1052,11 +1080,11 @@ func TestPCTrackFundingDetails(t *testing.T) {
 		Asset: asset.Futures,
 		Pair:  p,
 	}
-	err = pc.TrackFundingDetails(rates)
+	err = pc.TrackFundingDetails("", rates)
 	assert.ErrorIs(t, err, common.ErrExchangeNameNotSet)
 
 	rates.Exchange = testExchange
-	err = pc.TrackFundingDetails(rates)
+	err = pc.TrackFundingDetails("", rates)
 	assert.ErrorIs(t, err, ErrPositionNotFound)
 
 	tn := time.Now()
@@ -1082,10 +1110,10 @@ func TestPCTrackFundingDetails(t *testing.T) {
 		},
 	}
 
-	mapKey := key.NewExchangeAssetPair(testExchange, asset.Futures, p)
+	mapKey := newPositionKey(testExchange, "", asset.Futures, p)
 	pc.multiPositionTrackers[mapKey].orderPositions["lol"].openingDate = tn.Add(-time.Hour)
 	pc.multiPositionTrackers[mapKey].orderPositions["lol"].lastUpdated = tn
-	err = pc.TrackFundingDetails(rates)
+	err = pc.TrackFundingDetails("", rates)
 	assert.NoError(t, err)
 }
 
@@ -85,10 +85,16 @@ type TotalCollateralResponse struct {
 // the position controller and its all tracked happily
 type PositionController struct {
 	m                     sync.Mutex
-	multiPositionTrackers map[key.ExchangeAssetPair]*MultiPositionTracker
+	multiPositionTrackers map[positionKey]*MultiPositionTracker
 	updated               time.Time
 }
 
+// positionKey segregates the positions of each exchange account
+type positionKey struct {
+	key.ExchangeAssetPair
+	account string
+}
+
 // MultiPositionTracker will track the performance of
 // futures positions over time. If an old position tracker
 // is closed, then the position controller will create a new one
@@ -251,6 +257,7 @@ type PNLResult struct {
 // Position is a basic holder for position information
 type Position struct {
 	Exchange           string
+	Account            string
 	Asset              asset.Item
 	Pair               currency.Pair
 	Underlying         currency.Code
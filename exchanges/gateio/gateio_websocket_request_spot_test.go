@@ -4,6 +4,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -57,6 +58,34 @@ func TestWebsocketSpotSubmitOrder(t *testing.T) {
 	require.NotEmpty(t, got)
 }
 
+// TestWebsocketSubmitOrderMock exercises an order submit round trip against a websocket fixture
+func TestWebsocketSubmitOrderMock(t *testing.T) {
+	t.Parallel()
+	e, m := testexch.MockWsFixtureInstance[Exchange](t, "testdata/wsSpotOrderPlace.json")
+	s := &order.Submit{
+		Exchange:      e.Name,
+		Pair:          BTCUSDT,
+		AssetType:     asset.Spot,
+		Side:          order.Buy,
+		Type:          order.Limit,
+		Amount:        0.001,
+		Price:         20000,
+		ClientOrderID: "mock",
+	}
+	got, err := e.WebsocketSubmitOrder(t.Context(), s)
+	require.NoError(t, err, "WebsocketSubmitOrder must not error")
+	assert.Equal(t, "1337", got.OrderID, "OrderID should be correct")
+	assert.Equal(t, "t-mock", got.ClientOrderID, "ClientOrderID should be echoed from the request")
+	assert.Equal(t, BTCUSDT, got.Pair, "Pair should be correct")
+	assert.Equal(t, asset.Spot, got.AssetType, "AssetType should be correct")
+	assert.Equal(t, order.Buy, got.Side, "Side should be correct")
+	assert.Equal(t, order.Limit, got.Type, "Type should be correct")
+	assert.Equal(t, order.Open, got.Status, "Status should be correct")
+	assert.Equal(t, 0.001, got.Amount, "Amount should be correct")
+	assert.Equal(t, 20000.0, got.Price, "Price should be correct")
+	assert.Len(t, m.Received(), 1, "Websocket mock should receive only the order request")
+}
+
 func TestWebsocketSpotSubmitOrders(t *testing.T) {
 	t.Parallel()
 	_, err := e.WebsocketSpotSubmitOrders(t.Context())
@@ -161,6 +161,10 @@ type CredentialsManagement interface {
 	// GetDefaultCredentials returns the exchange.Base API credentials loaded by
 	// config.json. See exchanges/credentials.go Base method for implementation.
 	GetDefaultCredentials() *accounts.Credentials
+	// GetAccountNames returns the default account followed by the named
+	// accounts loaded by config.json. See exchanges/credentials.go Base method
+	// for implementation.
+	GetAccountNames() []string
 	// ValidateAPICredentials validates the API keys by sending an authenticated
 	// REST request. See exchange specific wrapper implementation.
 	ValidateAPICredentials(ctx context.Context, a asset.Item) error
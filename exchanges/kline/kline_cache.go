@@ -0,0 +1,153 @@
+package kline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// maxCacheFetchRanges limits the amount of separate exchange requests made to
+// fill gaps in stored data. Once exceeded, a single request spanning all
+// missing ranges is made instead
+const maxCacheFetchRanges = 10
+
+var errNilCandleFetcher = errors.New("nil candle fetcher")
+
+// ExtendedCandleFetcher defines the exchange functionality required to fetch
+// candles which are not stored in the database
+type ExtendedCandleFetcher interface {
+	GetName() string
+	GetHistoricCandlesExtended(ctx context.Context, pair currency.Pair, a asset.Item, interval Interval, start, end time.Time) (*Item, error)
+}
+
+// cacheFetchRange is a contiguous range of intervals missing from the
+// database
+type cacheFetchRange struct {
+	start time.Time
+	end   time.Time
+}
+
+// GetHistoricCandlesWithCache is a read-through cache around
+// GetHistoricCandlesExtended. Candles stored in the candle repository are
+// served from the database and only the missing ranges are requested from the
+// exchange. When persist is set, completed candles fetched from the exchange
+// are stored for subsequent requests. If the database is not connected, all
+// candles are requested from the exchange
+func GetHistoricCandlesWithCache(ctx context.Context, f ExtendedCandleFetcher, pair currency.Pair, a asset.Item, interval Interval, start, end time.Time, persist bool) (*Item, error) {
+	if f == nil {
+		return nil, errNilCandleFetcher
+	}
+	if !database.DB.IsConnected() {
+		return f.GetHistoricCandlesExtended(ctx, pair, a, interval, start, end)
+	}
+
+	holder, err := CalculateCandleDateRanges(start, end, interval, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	exchName := f.GetName()
+	ret := &Item{
+		Exchange: exchName,
+		Pair:     pair,
+		Asset:    a,
+		Interval: interval,
+	}
+	// Range queries are inclusive so the end is excluded as no candle opens
+	// at that time within the requested range
+	stored, err := LoadFromDatabase(exchName, pair, a, interval, holder.Start.Time, holder.End.Time.Add(-time.Nanosecond))
+	switch {
+	case err == nil:
+		ret.Candles = stored.Candles
+	case errors.Is(err, candle.ErrNoCandleDataFound), errors.Is(err, exchange.ErrNoExchangeFound):
+	default:
+		log.Warnf(log.DatabaseMgr, "%s %s %s unable to load cached candles, fetching all candles from the exchange: %v", exchName, a, pair, err)
+	}
+
+	have := make(map[int64]struct{}, len(ret.Candles))
+	for i := range ret.Candles {
+		have[ret.Candles[i].Time.Unix()] = struct{}{}
+	}
+
+	missing := missingCacheRanges(holder, have)
+	if len(missing) > maxCacheFetchRanges {
+		missing = []cacheFetchRange{{start: missing[0].start, end: missing[len(missing)-1].end}}
+	}
+
+	var fetched []Candle
+	for i := range missing {
+		resp, err := f.GetHistoricCandlesExtended(ctx, pair, a, interval, missing[i].start, missing[i].end)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s %s unable to fetch candles between %s and %s: %w",
+				exchName,
+				a,
+				pair,
+				missing[i].start.Format(time.DateTime),
+				missing[i].end.Format(time.DateTime),
+				err)
+		}
+		for j := range resp.Candles {
+			if resp.Candles[j].Time.Before(holder.Start.Time) || !resp.Candles[j].Time.Before(holder.End.Time) {
+				continue
+			}
+			if _, ok := have[resp.Candles[j].Time.Unix()]; ok {
+				continue
+			}
+			have[resp.Candles[j].Time.Unix()] = struct{}{}
+			ret.Candles = append(ret.Candles, resp.Candles[j])
+			if resp.Candles[j].ValidationIssues != PartialCandle && !isEmptyCandle(&resp.Candles[j]) {
+				fetched = append(fetched, resp.Candles[j])
+			}
+		}
+	}
+	ret.SortCandlesByTimestamp(false)
+
+	if persist && len(fetched) > 0 {
+		if _, err := StoreInDatabase(&Item{
+			Exchange: exchName,
+			Pair:     pair,
+			Asset:    a,
+			Interval: interval,
+			Candles:  fetched,
+		}, false); err != nil {
+			log.Errorf(log.DatabaseMgr, "%s %s %s unable to store fetched candles: %v", exchName, a, pair, err)
+		}
+	}
+	return ret, nil
+}
+
+// missingCacheRanges returns the contiguous ranges in the holder which have no
+// stored candle
+func missingCacheRanges(h *IntervalRangeHolder, have map[int64]struct{}) []cacheFetchRange {
+	var missing []cacheFetchRange
+	var current *cacheFetchRange
+	for x := range h.Ranges {
+		for y := range h.Ranges[x].Intervals {
+			interval := &h.Ranges[x].Intervals[y]
+			_, interval.HasData = have[interval.Start.Ticks]
+			if interval.HasData {
+				current = nil
+				continue
+			}
+			if current == nil {
+				missing = append(missing, cacheFetchRange{start: interval.Start.Time})
+				current = &missing[len(missing)-1]
+			}
+			current.end = interval.End.Time
+		}
+	}
+	return missing
+}
+
+// isEmptyCandle determines whether a candle is padding with no data
+func isEmptyCandle(c *Candle) bool {
+	return c.Open == 0 && c.High == 0 && c.Low == 0 && c.Close == 0 && c.Volume == 0
+}
@@ -0,0 +1,105 @@
+package kline
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+type fakeCandleFetcher struct {
+	calls []cacheFetchRange
+}
+
+func (f *fakeCandleFetcher) GetName() string {
+	return testExchanges[0].Name
+}
+
+func (f *fakeCandleFetcher) GetHistoricCandlesExtended(_ context.Context, pair currency.Pair, a asset.Item, interval Interval, start, end time.Time) (*Item, error) {
+	f.calls = append(f.calls, cacheFetchRange{start: start, end: end})
+	item := &Item{Exchange: f.GetName(), Pair: pair, Asset: a, Interval: interval}
+	for t := start; t.Before(end); t = t.Add(interval.Duration()) {
+		item.Candles = append(item.Candles, Candle{Time: t, Open: 2000, High: 2000, Low: 2000, Close: 2000, Volume: 2000})
+	}
+	return item, nil
+}
+
+func TestGetHistoricCandlesWithCache(t *testing.T) {
+	setupTest(t)
+
+	_, err := GetHistoricCandlesWithCache(t.Context(), nil, currency.NewBTCUSDT(), asset.Spot, OneDay, time.Time{}, time.Time{}, false)
+	require.ErrorIs(t, err, errNilCandleFetcher)
+
+	start := time.Date(2018, 12, 30, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC)
+
+	f := &fakeCandleFetcher{}
+	ret, err := GetHistoricCandlesWithCache(t.Context(), f, currency.NewBTCUSDT(), asset.Spot, OneDay, start, end, true)
+	require.NoError(t, err, "GetHistoricCandlesWithCache must not error without a database connection")
+	require.Len(t, f.calls, 1, "all candles must be fetched from the exchange without a database connection")
+	assert.Len(t, ret.Candles, 369)
+
+	dbConn, err := testhelpers.ConnectToDatabase(&database.Config{
+		Driver:            database.DBSQLite3,
+		ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+	})
+	require.NoError(t, err, "ConnectToDatabase must not error for SQLite")
+	t.Cleanup(func() {
+		assert.NoError(t, testhelpers.CloseDatabase(dbConn), "CloseDatabase should not error for SQLite")
+		assert.NoError(t, os.RemoveAll(testhelpers.TempDir), "Removing the temporary database should not error")
+	})
+	require.NoError(t, seedDB(true), "seedDB must not error for SQLite")
+
+	f = &fakeCandleFetcher{}
+	ret, err = GetHistoricCandlesWithCache(t.Context(), f, currency.NewBTCUSDT(), asset.Spot, OneDay, start, end, true)
+	require.NoError(t, err, "GetHistoricCandlesWithCache must not error")
+	assert.Equal(t, []cacheFetchRange{
+		{start: start, end: time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{start: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), end: end},
+	}, f.calls, "only ranges missing from the database should be fetched")
+	require.Len(t, ret.Candles, 369, "stored and fetched candles must be combined")
+	assert.Equal(t, testExchanges[0].Name, ret.Exchange)
+	assert.Equal(t, start, ret.Candles[0].Time, "candles should be sorted by time")
+	assert.Equal(t, 2000.0, ret.Candles[0].Close, "first candle should be fetched from the exchange")
+	assert.Equal(t, 1000.0, ret.Candles[2].Close, "stored candles should be served from the database")
+
+	f = &fakeCandleFetcher{}
+	ret, err = GetHistoricCandlesWithCache(t.Context(), f, currency.NewBTCUSDT(), asset.Spot, OneDay, start, end, false)
+	require.NoError(t, err, "GetHistoricCandlesWithCache must not error")
+	assert.Empty(t, f.calls, "persisted candles should be served from the database")
+	assert.Len(t, ret.Candles, 369)
+}
+
+func TestMissingCacheRanges(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	h, err := CalculateCandleDateRanges(start, start.Add(time.Hour*5), OneHour, 2)
+	require.NoError(t, err)
+
+	have := map[int64]struct{}{
+		start.Add(time.Hour).Unix():     {},
+		start.Add(time.Hour * 2).Unix(): {},
+	}
+	assert.Equal(t, []cacheFetchRange{
+		{start: start, end: start.Add(time.Hour)},
+		{start: start.Add(time.Hour * 3), end: start.Add(time.Hour * 5)},
+	}, missingCacheRanges(h, have), "missing ranges should span request limit batches")
+	assert.True(t, h.HasDataAtDate(start.Add(time.Hour)), "range holder should be updated with stored data")
+	assert.False(t, h.HasDataAtDate(start))
+
+	assert.Empty(t, missingCacheRanges(h, map[int64]struct{}{
+		start.Unix():                    {},
+		start.Add(time.Hour).Unix():     {},
+		start.Add(time.Hour * 2).Unix(): {},
+		start.Add(time.Hour * 3).Unix(): {},
+		start.Add(time.Hour * 4).Unix(): {},
+	}))
+}
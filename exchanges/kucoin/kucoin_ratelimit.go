@@ -1,11 +1,21 @@
 package kucoin
 
 import (
+	"fmt"
+	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 )
 
+// Rate limit response headers of the resource pool used by a request
+const (
+	rateLimitLimitHeader     = "Gw-Ratelimit-Limit"
+	rateLimitRemainingHeader = "Gw-Ratelimit-Remaining"
+	rateLimitResetHeader     = "Gw-Ratelimit-Reset"
+)
+
 const (
 	thirtySecondsInterval = time.Second * 30
 )
@@ -425,3 +435,31 @@ func GetRateLimit() request.RateLimitDefinitions {
 		spotFuturesOrderbookV1EPL: request.GetRateLimiterWithWeight(managementRate, 6),
 	}
 }
+
+// rateLimitParser reports the remaining weight of the resource pool used by a
+// request. The reset header holds the milliseconds until the pool resets
+func rateLimitParser(resp *http.Response, now time.Time) (*request.RateLimitState, error) {
+	remaining := resp.Header.Get(rateLimitRemainingHeader)
+	if remaining == "" {
+		return nil, nil
+	}
+	var (
+		state request.RateLimitState
+		reset int64
+		err   error
+	)
+	if state.Remaining, err = strconv.Atoi(remaining); err != nil {
+		return nil, fmt.Errorf("invalid %s header %q: %w", rateLimitRemainingHeader, remaining, err)
+	}
+	if v := resp.Header.Get(rateLimitLimitHeader); v != "" {
+		if state.Limit, err = strconv.Atoi(v); err != nil {
+			return nil, fmt.Errorf("invalid %s header %q: %w", rateLimitLimitHeader, v, err)
+		}
+	}
+	v := resp.Header.Get(rateLimitResetHeader)
+	if reset, err = strconv.ParseInt(v, 10, 64); err != nil {
+		return nil, fmt.Errorf("invalid %s header %q: %w", rateLimitResetHeader, v, err)
+	}
+	state.Reset = now.Add(time.Duration(reset) * time.Millisecond)
+	return &state, nil
+}
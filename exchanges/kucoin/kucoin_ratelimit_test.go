@@ -2,8 +2,11 @@ package kucoin
 
 import (
 	"net/http"
+	"strconv"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 )
@@ -198,3 +201,33 @@ func TestRateLimit_LimitStatic(t *testing.T) {
 		})
 	}
 }
+
+func TestRateLimitParser(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	resp := &http.Response{Header: http.Header{}}
+	state, err := rateLimitParser(resp, now)
+	require.NoError(t, err)
+	assert.Nil(t, state, "response without rate limit headers should not return a state")
+
+	resp.Header.Set(rateLimitRemainingHeader, "meow")
+	_, err = rateLimitParser(resp, now)
+	assert.ErrorIs(t, err, strconv.ErrSyntax)
+
+	resp.Header.Set(rateLimitRemainingHeader, "1500")
+	resp.Header.Set(rateLimitLimitHeader, "meow")
+	_, err = rateLimitParser(resp, now)
+	assert.ErrorIs(t, err, strconv.ErrSyntax)
+
+	resp.Header.Set(rateLimitLimitHeader, "2000")
+	_, err = rateLimitParser(resp, now)
+	assert.ErrorIs(t, err, strconv.ErrSyntax, "missing reset header should error")
+
+	resp.Header.Set(rateLimitResetHeader, "12500")
+	state, err = rateLimitParser(resp, now)
+	require.NoError(t, err, "rateLimitParser must not error")
+	require.NotNil(t, state, "rateLimitParser must return a state")
+	assert.Equal(t, 2000, state.Limit)
+	assert.Equal(t, 1500, state.Remaining)
+	assert.Equal(t, now.Add(12500*time.Millisecond), state.Reset)
+}
@@ -151,7 +151,8 @@ func (e *Exchange) SetDefaults() {
 	var err error
 	e.Requester, err = request.New(e.Name,
 		common.NewHTTPClientWithTimeout(exchange.DefaultHTTPTimeout),
-		request.WithLimiter(GetRateLimit()))
+		request.WithLimiter(GetRateLimit()),
+		request.WithRateLimitParser(rateLimitParser))
 	if err != nil {
 		log.Errorln(log.ExchangeSys, err)
 	}
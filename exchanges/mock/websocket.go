@@ -0,0 +1,312 @@
+package mock
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+// Websocket frame directions
+const (
+	WebsocketConnect  = "connect"
+	WebsocketInbound  = "inbound"
+	WebsocketOutbound = "outbound"
+)
+
+// maxWebsocketFrameSize limits the size of a single recorded frame line
+const maxWebsocketFrameSize = 64 * 1024 * 1024
+
+var (
+	errWebsocketRecordingEmpty      = errors.New("websocket recording holds no frames")
+	errWebsocketConnectionsConsumed = errors.New("all recorded websocket connections have been replayed")
+)
+
+// WebsocketFrame is a single recorded websocket event. A recording holds one
+// JSON encoded frame per line. Text frames are stored in Message and binary
+// frames, before decompression, in Binary
+type WebsocketFrame struct {
+	Time       time.Time `json:"time"`
+	Connection uint64    `json:"connection"`
+	URL        string    `json:"url,omitempty"`
+	Direction  string    `json:"direction"`
+	Type       int       `json:"type,omitempty"`
+	Message    string    `json:"message,omitempty"`
+	Binary     []byte    `json:"binary,omitempty"`
+}
+
+// payload returns the frame's message contents
+func (f *WebsocketFrame) payload() []byte {
+	if f.Type == websocket.BinaryMessage {
+		return f.Binary
+	}
+	return []byte(f.Message)
+}
+
+// messageType returns the websocket message type, defaulting to text
+func (f *WebsocketFrame) messageType() int {
+	if f.Type == 0 {
+		return websocket.TextMessage
+	}
+	return f.Type
+}
+
+// WebsocketReplay serves a websocket recording. Each client connection is
+// served the next unplayed recorded connection, preferring one with the same
+// URL path. Inbound frames recorded before the first outbound frame are sent
+// on connect. Each message sent by the client is matched to an unplayed
+// recorded outbound frame and the inbound frames which followed it are then
+// sent, so subscription responses are replayed regardless of the order the
+// client subscribes in. Replay is immediate and does not reproduce the
+// recorded timing
+type WebsocketReplay struct {
+	m            sync.Mutex
+	connections  []*replayConnection
+	ignoreFields []string
+}
+
+// replayConnection is a single recorded connection
+type replayConnection struct {
+	path      string
+	greeting  []*WebsocketFrame
+	exchanges []*replayExchange
+	played    bool
+}
+
+// replayExchange is a recorded outbound frame and the inbound frames which
+// followed it
+type replayExchange struct {
+	request   *WebsocketFrame
+	responses []*WebsocketFrame
+	played    bool
+}
+
+// NewWebsocketReplay loads a websocket recording from path. JSON object fields
+// named in ignoreFields, such as request IDs or timestamps, are ignored when
+// matching client messages to recorded outbound frames. When the client's value
+// differs, the recorded value is replaced with the client's value in the same
+// field of the responses
+func NewWebsocketReplay(path string, ignoreFields ...string) (*WebsocketReplay, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := &WebsocketReplay{ignoreFields: ignoreFields}
+	connections := make(map[uint64]*replayConnection)
+	s := bufio.NewScanner(f)
+	s.Buffer(nil, maxWebsocketFrameSize)
+	for line := 1; s.Scan(); line++ {
+		if len(bytes.TrimSpace(s.Bytes())) == 0 {
+			continue
+		}
+		frame := new(WebsocketFrame)
+		if err := json.Unmarshal(s.Bytes(), frame); err != nil {
+			return nil, fmt.Errorf("%s line %d: %w", path, line, err)
+		}
+		c, ok := connections[frame.Connection]
+		if !ok {
+			c = &replayConnection{}
+			connections[frame.Connection] = c
+			r.connections = append(r.connections, c)
+		}
+		switch frame.Direction {
+		case WebsocketConnect:
+			if u, err := url.Parse(frame.URL); err == nil {
+				c.path = u.Path
+			}
+		case WebsocketOutbound:
+			c.exchanges = append(c.exchanges, &replayExchange{request: frame})
+		case WebsocketInbound:
+			if len(c.exchanges) == 0 {
+				c.greeting = append(c.greeting, frame)
+			} else {
+				last := c.exchanges[len(c.exchanges)-1]
+				last.responses = append(last.responses, frame)
+			}
+		default:
+			return nil, fmt.Errorf("%s line %d: unknown frame direction %q", path, line, frame.Direction)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if len(r.connections) == 0 {
+		return nil, fmt.Errorf("%s: %w", path, errWebsocketRecordingEmpty)
+	}
+	return r, nil
+}
+
+// NewWebsocketReplayServer starts a new server replaying a websocket recording
+// for testing purposes and returns its websocket URL
+func NewWebsocketReplayServer(path string, ignoreFields ...string) (string, error) {
+	r, err := NewWebsocketReplay(path, ignoreFields...)
+	if err != nil {
+		return "", err
+	}
+	s := httptest.NewServer(r)
+	return "ws" + strings.TrimPrefix(s.URL, "http"), nil
+}
+
+// ServeHTTP upgrades the request and replays the next recorded connection
+func (r *WebsocketReplay) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	c := r.nextConnection(req.URL.Path)
+	if c == nil {
+		http.Error(w, errWebsocketConnectionsConsumed.Error(), http.StatusNotFound)
+		return
+	}
+	upgrader := websocket.Upgrader{CheckOrigin: func(*http.Request) bool { return true }}
+	conn, err := upgrader.Upgrade(w, req, nil)
+	if err != nil {
+		log.Printf("Websocket replay upgrade error: %v", err)
+		return
+	}
+	defer conn.Close()
+
+	if err := writeFrames(conn, c.greeting, nil); err != nil {
+		return
+	}
+	for {
+		mType, msg, err := conn.ReadMessage()
+		if err != nil {
+			// Any error here is likely due to the connection closing
+			return
+		}
+		e, subs := r.match(c, mType, msg)
+		if e == nil {
+			log.Printf("Websocket replay: no recorded outbound frame matches %s", msg)
+			continue
+		}
+		if err := writeFrames(conn, e.responses, subs); err != nil {
+			return
+		}
+	}
+}
+
+// nextConnection returns the next unplayed connection, preferring one with a
+// matching URL path
+func (r *WebsocketReplay) nextConnection(path string) *replayConnection {
+	r.m.Lock()
+	defer r.m.Unlock()
+	var next *replayConnection
+	for _, c := range r.connections {
+		if c.played {
+			continue
+		}
+		if c.path == path {
+			next = c
+			break
+		}
+		if next == nil {
+			next = c
+		}
+	}
+	if next != nil {
+		next.played = true
+	}
+	return next
+}
+
+// match returns the first unplayed recorded exchange with an outbound frame
+// matching the client's message and the field substitutions to apply to its
+// responses
+func (r *WebsocketReplay) match(c *replayConnection, mType int, msg []byte) (*replayExchange, map[string][2]json.RawMessage) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	for _, e := range c.exchanges {
+		if e.played || e.request.messageType() != mType {
+			continue
+		}
+		if subs, ok := r.matchMessage(e.request.payload(), msg); ok {
+			e.played = true
+			return e, subs
+		}
+	}
+	return nil, nil
+}
+
+// matchMessage compares a recorded message to a client message ignoring the
+// configured fields. Ignored fields with differing values are returned as
+// pairs of recorded and client values
+func (r *WebsocketReplay) matchMessage(recorded, msg []byte) (map[string][2]json.RawMessage, bool) {
+	if bytes.Equal(recorded, msg) {
+		return nil, true
+	}
+	if len(r.ignoreFields) == 0 {
+		return nil, false
+	}
+	var want, got map[string]json.RawMessage
+	if json.Unmarshal(recorded, &want) != nil || json.Unmarshal(msg, &got) != nil {
+		return nil, false
+	}
+	subs := make(map[string][2]json.RawMessage)
+	for _, field := range r.ignoreFields {
+		w, wOK := want[field]
+		g, gOK := got[field]
+		if wOK && gOK && !bytes.Equal(w, g) {
+			subs[field] = [2]json.RawMessage{w, g}
+		}
+		delete(want, field)
+		delete(got, field)
+	}
+	if len(want) != len(got) {
+		return nil, false
+	}
+	for k, w := range want {
+		if g, ok := got[k]; !ok || !bytes.Equal(w, g) {
+			return nil, false
+		}
+	}
+	return subs, true
+}
+
+// writeFrames writes recorded inbound frames to the client, replacing the
+// recorded values of substituted fields
+func writeFrames(conn *websocket.Conn, frames []*WebsocketFrame, subs map[string][2]json.RawMessage) error {
+	for _, f := range frames {
+		if err := conn.WriteMessage(f.messageType(), substitute(f.payload(), subs)); err != nil {
+			log.Printf("Websocket replay write error: %v", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// substitute replaces the recorded value of each substituted field in a JSON
+// object message with the client's value
+func substitute(msg []byte, subs map[string][2]json.RawMessage) []byte {
+	if len(subs) == 0 {
+		return msg
+	}
+	var fields map[string]json.RawMessage
+	if json.Unmarshal(msg, &fields) != nil {
+		return msg
+	}
+	var replaced bool
+	for field, s := range subs {
+		if v, ok := fields[field]; ok && bytes.Equal(v, s[0]) {
+			fields[field] = s[1]
+			replaced = true
+		}
+	}
+	if !replaced {
+		return msg
+	}
+	b, err := json.Marshal(fields)
+	if err != nil {
+		return msg
+	}
+	return b
+}
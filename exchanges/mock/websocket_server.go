@@ -0,0 +1,317 @@
+package mock
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"reflect"
+	"sync"
+	"text/template"
+
+	"github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+// WebsocketMatchAny matches any value of a field in a fixture rule
+const WebsocketMatchAny = "*"
+
+var (
+	errWebsocketFixtureEmpty   = errors.New("websocket fixture has no connect messages or rules")
+	errWebsocketMessageEmpty   = errors.New("websocket fixture message must set one of json, text or binary")
+	errWebsocketMessageInvalid = errors.New("websocket fixture message must set only one of json, text or binary")
+)
+
+// WebsocketFixture declares the behaviour of a mock websocket server. Connect
+// messages are pushed to each client on connection. Each client message is
+// matched against the rules in order and the first matching rule's responses
+// are sent
+type WebsocketFixture struct {
+	Connect []WebsocketMessage `json:"connect"`
+	Rules   []WebsocketRule    `json:"rules"`
+}
+
+// WebsocketRule maps an expected client message to server responses. JSON
+// object messages match when every field in Match is present with an equal
+// value, nested objects are matched the same way and WebsocketMatchAny matches
+// any value. Other messages match Text exactly. A rule setting neither
+// matches every message
+type WebsocketRule struct {
+	Name      string             `json:"name"`
+	Match     json.RawMessage    `json:"match,omitempty"`
+	Text      string             `json:"text,omitempty"`
+	Responses []WebsocketMessage `json:"responses"`
+
+	match any
+}
+
+// WebsocketMessage is a message sent by the mock websocket server. JSON and
+// Text are text/template templates executed with the top level fields of the
+// client's JSON message, e.g. {{.id}} echoes the request ID
+type WebsocketMessage struct {
+	JSON   json.RawMessage `json:"json,omitempty"`
+	Text   string          `json:"text,omitempty"`
+	Binary []byte          `json:"binary,omitempty"`
+
+	tmpl *template.Template
+}
+
+// WebsocketMock is a scriptable websocket server for exchange tests which
+// responds to client messages as declared by a WebsocketFixture
+type WebsocketMock struct {
+	fixture   *WebsocketFixture
+	m         sync.Mutex
+	conns     map[*websocket.Conn]*sync.Mutex
+	received  [][]byte
+	unmatched [][]byte
+}
+
+// NewWebsocketMock loads a WebsocketFixture from a JSON file at path
+func NewWebsocketMock(path string) (*WebsocketMock, error) {
+	if path == "" {
+		return nil, errJSONMockFilePathRequired
+	}
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var f WebsocketFixture
+	if err := json.Unmarshal(contents, &f); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	m, err := NewWebsocketMockFromFixture(&f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return m, nil
+}
+
+// NewWebsocketMockFromFixture creates a WebsocketMock from a fixture
+func NewWebsocketMockFromFixture(f *WebsocketFixture) (*WebsocketMock, error) {
+	if f == nil || (len(f.Connect) == 0 && len(f.Rules) == 0) {
+		return nil, errWebsocketFixtureEmpty
+	}
+	for i := range f.Connect {
+		if err := f.Connect[i].parse(); err != nil {
+			return nil, fmt.Errorf("connect message %d: %w", i, err)
+		}
+	}
+	for i := range f.Rules {
+		r := &f.Rules[i]
+		if len(r.Match) != 0 {
+			if err := decodeJSON(r.Match, &r.match); err != nil {
+				return nil, fmt.Errorf("rule %d %q match: %w", i, r.Name, err)
+			}
+		}
+		for j := range r.Responses {
+			if err := r.Responses[j].parse(); err != nil {
+				return nil, fmt.Errorf("rule %d %q response %d: %w", i, r.Name, j, err)
+			}
+		}
+	}
+	return &WebsocketMock{fixture: f, conns: make(map[*websocket.Conn]*sync.Mutex)}, nil
+}
+
+// ServeHTTP upgrades the request and responds to client messages until the
+// connection closes
+func (m *WebsocketMock) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	upgrader := websocket.Upgrader{CheckOrigin: func(*http.Request) bool { return true }}
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Printf("Websocket mock upgrade error: %v", err)
+		return
+	}
+	writeMu := new(sync.Mutex)
+	m.m.Lock()
+	m.conns[conn] = writeMu
+	m.m.Unlock()
+	defer func() {
+		m.m.Lock()
+		delete(m.conns, conn)
+		m.m.Unlock()
+		conn.Close()
+	}()
+
+	if err := m.send(conn, writeMu, m.fixture.Connect, nil); err != nil {
+		return
+	}
+	for {
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			// Any error here is likely due to the connection closing
+			return
+		}
+		var data any
+		if decodeJSON(msg, &data) != nil {
+			data = nil
+		}
+		rule := m.matchRule(msg, data)
+		m.m.Lock()
+		m.received = append(m.received, msg)
+		if rule == nil {
+			m.unmatched = append(m.unmatched, msg)
+		}
+		m.m.Unlock()
+		if rule == nil {
+			log.Printf("Websocket mock: no rule matches %s", msg)
+			continue
+		}
+		if err := m.send(conn, writeMu, rule.Responses, data); err != nil {
+			return
+		}
+	}
+}
+
+// Push sends a message to every connected client, e.g. to emit order events
+// after an order is submitted
+func (m *WebsocketMock) Push(msg *WebsocketMessage) error {
+	if err := msg.parse(); err != nil {
+		return err
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	var errs error
+	for conn, writeMu := range m.conns {
+		if err := m.send(conn, writeMu, []WebsocketMessage{*msg}, nil); err != nil {
+			errs = errors.Join(errs, err)
+		}
+	}
+	return errs
+}
+
+// Received returns all messages received from clients
+func (m *WebsocketMock) Received() [][]byte {
+	m.m.Lock()
+	defer m.m.Unlock()
+	return append([][]byte(nil), m.received...)
+}
+
+// Unmatched returns messages received from clients which matched no rule
+func (m *WebsocketMock) Unmatched() [][]byte {
+	m.m.Lock()
+	defer m.m.Unlock()
+	return append([][]byte(nil), m.unmatched...)
+}
+
+// matchRule returns the first rule matching a client message
+func (m *WebsocketMock) matchRule(msg []byte, data any) *WebsocketRule {
+	for i := range m.fixture.Rules {
+		r := &m.fixture.Rules[i]
+		switch {
+		case r.match != nil:
+			if data != nil && matchJSON(r.match, data) {
+				return r
+			}
+		case r.Text != "":
+			if r.Text == string(msg) {
+				return r
+			}
+		default:
+			return r
+		}
+	}
+	return nil
+}
+
+// send renders and writes messages to a client
+func (m *WebsocketMock) send(conn *websocket.Conn, writeMu *sync.Mutex, msgs []WebsocketMessage, data any) error {
+	writeMu.Lock()
+	defer writeMu.Unlock()
+	for i := range msgs {
+		mType, payload, err := msgs[i].render(data)
+		if err != nil {
+			log.Printf("Websocket mock unable to render message: %v", err)
+			return err
+		}
+		if err := conn.WriteMessage(mType, payload); err != nil {
+			log.Printf("Websocket mock write error: %v", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// parse validates a message and parses its template
+func (w *WebsocketMessage) parse() error {
+	var set int
+	var text string
+	if len(w.JSON) != 0 {
+		set++
+		text = string(w.JSON)
+	}
+	if w.Text != "" {
+		set++
+		text = w.Text
+	}
+	if len(w.Binary) != 0 {
+		set++
+	}
+	switch {
+	case set == 0:
+		return errWebsocketMessageEmpty
+	case set > 1:
+		return errWebsocketMessageInvalid
+	case len(w.Binary) != 0:
+		return nil
+	}
+	t, err := template.New("").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return err
+	}
+	w.tmpl = t
+	return nil
+}
+
+// render returns the message type and contents of a message
+func (w *WebsocketMessage) render(data any) (int, []byte, error) {
+	if w.tmpl == nil {
+		return websocket.BinaryMessage, w.Binary, nil
+	}
+	var b bytes.Buffer
+	if err := w.tmpl.Execute(&b, data); err != nil {
+		return 0, nil, err
+	}
+	return websocket.TextMessage, b.Bytes(), nil
+}
+
+// decodeJSON decodes JSON preserving numbers as json.Number
+func decodeJSON(data []byte, v any) error {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	return d.Decode(v)
+}
+
+// matchJSON reports whether got holds every field of want with equal values
+func matchJSON(want, got any) bool {
+	switch w := want.(type) {
+	case string:
+		if w == WebsocketMatchAny {
+			return got != nil
+		}
+	case map[string]any:
+		g, ok := got.(map[string]any)
+		if !ok {
+			return false
+		}
+		for k, v := range w {
+			if !matchJSON(v, g[k]) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		g, ok := got.([]any)
+		if !ok || len(g) != len(w) {
+			return false
+		}
+		for i := range w {
+			if !matchJSON(w[i], g[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(want, got)
+}
@@ -0,0 +1,137 @@
+package mock
+
+import (
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+const testWebsocketFixture = `{
+	"connect": [{"json": {"event": "welcome"}}],
+	"rules": [
+		{
+			"name": "auth",
+			"match": {"op": "auth", "args": {"key": "*"}},
+			"responses": [{"text": "{\"id\":{{.id}},\"event\":\"authenticated\"}"}]
+		},
+		{
+			"name": "subscribe",
+			"match": {"op": "subscribe", "channels": ["ticker", "*"]},
+			"responses": [
+				{"json": {"id": "{{.id}}", "event": "subscribed"}},
+				{"binary": "AQI="}
+			]
+		},
+		{
+			"name": "ping",
+			"text": "ping",
+			"responses": [{"text": "pong"}]
+		}
+	]
+}`
+
+func newTestWebsocketMock(t *testing.T) (*WebsocketMock, *websocket.Conn) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "ws.json")
+	require.NoError(t, os.WriteFile(path, []byte(testWebsocketFixture), 0o600), "WriteFile must not error")
+	m, err := NewWebsocketMock(path)
+	require.NoError(t, err, "NewWebsocketMock must not error")
+	server := httptest.NewServer(m)
+	t.Cleanup(server.Close)
+	conn := dialReplay(t, server.URL, "")
+	_, msg := readReplay(t, conn)
+	assert.JSONEq(t, `{"event":"welcome"}`, msg, "connect messages should be sent on connection")
+	return m, conn
+}
+
+func TestNewWebsocketMock(t *testing.T) {
+	t.Parallel()
+	_, err := NewWebsocketMock("")
+	require.ErrorIs(t, err, errJSONMockFilePathRequired)
+
+	_, err = NewWebsocketMock(filepath.Join(t.TempDir(), "missing.json"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	_, err = NewWebsocketMockFromFixture(nil)
+	require.ErrorIs(t, err, errWebsocketFixtureEmpty)
+	_, err = NewWebsocketMockFromFixture(&WebsocketFixture{})
+	require.ErrorIs(t, err, errWebsocketFixtureEmpty)
+
+	_, err = NewWebsocketMockFromFixture(&WebsocketFixture{Connect: []WebsocketMessage{{}}})
+	require.ErrorIs(t, err, errWebsocketMessageEmpty)
+
+	_, err = NewWebsocketMockFromFixture(&WebsocketFixture{Rules: []WebsocketRule{{Responses: []WebsocketMessage{{Text: "a", Binary: []byte("b")}}}}})
+	require.ErrorIs(t, err, errWebsocketMessageInvalid)
+
+	_, err = NewWebsocketMockFromFixture(&WebsocketFixture{Rules: []WebsocketRule{{Responses: []WebsocketMessage{{Text: "{{.id"}}}}})
+	require.ErrorContains(t, err, "response 0", "invalid templates must error")
+
+	_, err = NewWebsocketMockFromFixture(&WebsocketFixture{Rules: []WebsocketRule{{Match: json.RawMessage("{")}}})
+	require.ErrorContains(t, err, "match", "invalid match must error")
+}
+
+func TestWebsocketMock(t *testing.T) {
+	t.Parallel()
+	m, conn := newTestWebsocketMock(t)
+
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"id":12345678901,"op":"auth","args":{"key":"abc","sign":"def"}}`)), "WriteMessage must not error")
+	_, msg := readReplay(t, conn)
+	assert.Equal(t, `{"id":12345678901,"event":"authenticated"}`, msg, "numeric fields should be rendered without loss")
+
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"id":"a1","op":"subscribe","channels":["ticker","trades"]}`)), "WriteMessage must not error")
+	_, msg = readReplay(t, conn)
+	assert.JSONEq(t, `{"id":"a1","event":"subscribed"}`, msg)
+	mType, msg := readReplay(t, conn)
+	assert.Equal(t, websocket.BinaryMessage, mType)
+	assert.Equal(t, []byte{1, 2}, []byte(msg))
+
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"id":"a2","op":"subscribe","channels":["ticker"]}`)), "WriteMessage must not error")
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte("ping")), "WriteMessage must not error")
+	_, msg = readReplay(t, conn)
+	assert.Equal(t, "pong", msg, "message not matching a rule should not be answered")
+
+	require.NoError(t, m.Push(&WebsocketMessage{JSON: json.RawMessage(`{"event":"order","status":"filled"}`)}), "Push must not error")
+	_, msg = readReplay(t, conn)
+	assert.JSONEq(t, `{"event":"order","status":"filled"}`, msg)
+	assert.ErrorIs(t, m.Push(&WebsocketMessage{}), errWebsocketMessageEmpty)
+
+	assert.Len(t, m.Received(), 4)
+	require.Len(t, m.Unmatched(), 1)
+	assert.Equal(t, `{"id":"a2","op":"subscribe","channels":["ticker"]}`, string(m.Unmatched()[0]))
+}
+
+func TestWebsocketMockRenderError(t *testing.T) {
+	t.Parallel()
+	m, err := NewWebsocketMockFromFixture(&WebsocketFixture{Rules: []WebsocketRule{{Responses: []WebsocketMessage{{Text: "{{.id}}"}}}}})
+	require.NoError(t, err, "NewWebsocketMockFromFixture must not error")
+	server := httptest.NewServer(m)
+	t.Cleanup(server.Close)
+	conn := dialReplay(t, server.URL, "")
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"op":"missing id"}`)), "WriteMessage must not error")
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)), "SetReadDeadline must not error")
+	_, _, err = conn.ReadMessage()
+	assert.Error(t, err, "connection should be closed when a response cannot be rendered")
+}
+
+func TestMatchJSON(t *testing.T) {
+	t.Parallel()
+	var want, got any
+	require.NoError(t, decodeJSON([]byte(`{"a":1,"b":{"c":"*"},"d":[1,2]}`), &want), "decodeJSON must not error")
+	require.NoError(t, decodeJSON([]byte(`{"a":1,"b":{"c":"x","e":2},"d":[1,2],"f":3}`), &got), "decodeJSON must not error")
+	assert.True(t, matchJSON(want, got), "superset should match")
+	var partial, shortArray any
+	require.NoError(t, decodeJSON([]byte(`{"a":1}`), &partial), "decodeJSON must not error")
+	require.NoError(t, decodeJSON([]byte(`{"d":[1]}`), &shortArray), "decodeJSON must not error")
+	assert.False(t, matchJSON(want, partial), "missing fields should not match")
+	assert.False(t, matchJSON(shortArray, got), "arrays of differing length should not match")
+	assert.False(t, matchJSON(map[string]any{"a": "*"}, map[string]any{}), "wildcard should require the field")
+	assert.False(t, matchJSON([]any{}, "a"))
+	assert.False(t, matchJSON(map[string]any{}, "a"))
+}
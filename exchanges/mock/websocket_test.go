@@ -0,0 +1,132 @@
+package mock
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testWebsocketRecording = `{"connection":1,"direction":"connect","url":"wss://test.com/spot"}
+{"connection":1,"direction":"inbound","message":"welcome"}
+{"connection":1,"direction":"outbound","message":"{\"id\":1,\"op\":\"subscribe\",\"channel\":\"ticker\"}"}
+{"connection":1,"direction":"inbound","message":"{\"id\":1,\"result\":\"ok\"}"}
+{"connection":1,"direction":"inbound","message":"{\"channel\":\"ticker\",\"price\":1}"}
+{"connection":1,"direction":"outbound","message":"{\"id\":2,\"op\":\"subscribe\",\"channel\":\"trades\"}"}
+{"connection":1,"direction":"inbound","message":"{\"id\":2,\"result\":\"ok\"}"}
+{"connection":1,"direction":"inbound","type":2,"binary":"AQI="}
+
+{"connection":2,"direction":"connect","url":"wss://test.com/futures"}
+{"connection":2,"direction":"inbound","message":"futures"}
+`
+
+func writeWebsocketRecording(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "ws.jsonl")
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0o600), "WriteFile must not error")
+	return path
+}
+
+func dialReplay(t *testing.T, serverURL, path string) *websocket.Conn {
+	t.Helper()
+	conn, resp, err := websocket.DefaultDialer.DialContext(t.Context(), "ws"+strings.TrimPrefix(serverURL, "http")+path, nil)
+	require.NoError(t, err, "Dial must not error")
+	require.NoError(t, resp.Body.Close(), "Body Close must not error")
+	t.Cleanup(func() { assert.NoError(t, conn.Close(), "Close should not error") })
+	return conn
+}
+
+func readReplay(t *testing.T, conn *websocket.Conn) (int, string) {
+	t.Helper()
+	mType, msg, err := conn.ReadMessage()
+	require.NoError(t, err, "ReadMessage must not error")
+	return mType, string(msg)
+}
+
+func TestNewWebsocketReplay(t *testing.T) {
+	t.Parallel()
+	_, err := NewWebsocketReplay(filepath.Join(t.TempDir(), "missing.jsonl"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	_, err = NewWebsocketReplay(writeWebsocketRecording(t, "\n"))
+	require.ErrorIs(t, err, errWebsocketRecordingEmpty)
+
+	_, err = NewWebsocketReplay(writeWebsocketRecording(t, "{"))
+	require.ErrorContains(t, err, "line 1")
+
+	_, err = NewWebsocketReplay(writeWebsocketRecording(t, `{"connection":1,"direction":"sideways"}`))
+	require.ErrorContains(t, err, "unknown frame direction")
+
+	r, err := NewWebsocketReplay(writeWebsocketRecording(t, testWebsocketRecording))
+	require.NoError(t, err, "NewWebsocketReplay must not error")
+	require.Len(t, r.connections, 2)
+	assert.Equal(t, "/spot", r.connections[0].path)
+	assert.Len(t, r.connections[0].greeting, 1)
+	require.Len(t, r.connections[0].exchanges, 2)
+	assert.Len(t, r.connections[0].exchanges[0].responses, 2)
+	assert.Len(t, r.connections[0].exchanges[1].responses, 2)
+}
+
+func TestWebsocketReplay(t *testing.T) {
+	t.Parallel()
+	r, err := NewWebsocketReplay(writeWebsocketRecording(t, testWebsocketRecording), "id")
+	require.NoError(t, err, "NewWebsocketReplay must not error")
+	server := httptest.NewServer(r)
+	t.Cleanup(server.Close)
+
+	futures := dialReplay(t, server.URL, "/futures")
+	_, msg := readReplay(t, futures)
+	assert.Equal(t, "futures", msg, "connection with a matching path should be replayed")
+
+	spot := dialReplay(t, server.URL, "")
+	_, msg = readReplay(t, spot)
+	assert.Equal(t, "welcome", msg, "greeting should be sent on connect")
+
+	require.NoError(t, spot.WriteMessage(websocket.TextMessage, []byte(`{"op":"unknown"}`)), "WriteMessage must not error")
+	require.NoError(t, spot.WriteMessage(websocket.TextMessage, []byte(`{"channel":"trades","op":"subscribe","id":20}`)), "WriteMessage must not error")
+	_, msg = readReplay(t, spot)
+	assert.JSONEq(t, `{"id":20,"result":"ok"}`, msg, "response should be matched regardless of order and carry the new id")
+	mType, msg := readReplay(t, spot)
+	assert.Equal(t, websocket.BinaryMessage, mType)
+	assert.Equal(t, []byte{1, 2}, []byte(msg))
+
+	require.NoError(t, spot.WriteMessage(websocket.TextMessage, []byte(`{"id":10,"op":"subscribe","channel":"ticker"}`)), "WriteMessage must not error")
+	_, msg = readReplay(t, spot)
+	assert.JSONEq(t, `{"id":10,"result":"ok"}`, msg)
+	_, msg = readReplay(t, spot)
+	assert.Equal(t, `{"channel":"ticker","price":1}`, msg, "messages without the ignored field should be unchanged")
+
+	_, resp, err := websocket.DefaultDialer.DialContext(t.Context(), "ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.Error(t, err, "Dial must error once all connections have been replayed")
+	require.NotNil(t, resp)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	require.NoError(t, resp.Body.Close(), "Body Close must not error")
+}
+
+func TestWebsocketReplayMatchMessage(t *testing.T) {
+	t.Parallel()
+	r := &WebsocketReplay{}
+	_, ok := r.matchMessage([]byte("ping"), []byte("ping"))
+	assert.True(t, ok, "identical messages should match")
+	_, ok = r.matchMessage([]byte(`{"id":1}`), []byte(`{"id":2}`))
+	assert.False(t, ok, "differing messages should not match without ignored fields")
+
+	r.ignoreFields = []string{"id", "ts"}
+	subs, ok := r.matchMessage([]byte(`{"id":1,"ts":5,"op":"sub"}`), []byte(`{"id":2,"ts":5,"op":"sub"}`))
+	require.True(t, ok, "messages differing by ignored fields must match")
+	assert.Len(t, subs, 1, "only differing fields should be substituted")
+	_, ok = r.matchMessage([]byte(`{"id":1,"op":"sub"}`), []byte(`{"id":1,"op":"unsub"}`))
+	assert.False(t, ok)
+	_, ok = r.matchMessage([]byte(`{"id":1,"op":"sub"}`), []byte(`{"id":1}`))
+	assert.False(t, ok)
+	_, ok = r.matchMessage([]byte("ping"), []byte("pong"))
+	assert.False(t, ok, "non JSON messages must match exactly")
+
+	assert.Equal(t, "ping", string(substitute([]byte("ping"), subs)), "non JSON messages should not be changed")
+}
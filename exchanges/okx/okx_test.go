@@ -4017,7 +4017,7 @@ func TestGenerateOrderbookChecksum(t *testing.T) {
 	var orderbookBase orderbook.Book
 	err := json.Unmarshal([]byte(calculateOrderbookChecksumUpdateOrderbookJSON), &orderbookBase)
 	require.NoError(t, err)
-	require.Equal(t, uint32(2832680552), generateOrderbookChecksum(&orderbookBase))
+	require.Equal(t, uint32(2832680552), orderbookChecksum.Generate(&orderbookBase))
 }
 
 func TestOrderPushData(t *testing.T) {
@@ -4652,6 +4652,36 @@ func TestWsProcessOrderbook5(t *testing.T) {
 	assert.Len(t, got.Bids, 5)
 }
 
+func TestWsProcessOrderBooksChecksumMismatch(t *testing.T) {
+	t.Parallel()
+	ex := new(Exchange)
+	require.NoError(t, testexch.Setup(ex), "Setup must not error")
+	pair := currency.NewPairWithDelimiter("ETH", "USDT", "-")
+	require.NoError(t, ex.Websocket.Orderbook.LoadSnapshot(&orderbook.Book{
+		Exchange:          ex.Name,
+		Pair:              pair,
+		Asset:             asset.Spot,
+		Bids:              orderbook.Levels{{Price: 99, Amount: 1}},
+		Asks:              orderbook.Levels{{Price: 100, Amount: 1}},
+		LastUpdated:       time.Now(),
+		ValidateOrderbook: true,
+	}), "LoadSnapshot must not error")
+
+	conn := &subscriptionRecorderConnection{}
+	err := ex.wsProcessOrderBooks(t.Context(), conn, []byte(`{"arg":{"channel":"books","instId":"ETH-USDT","instType":"SPOT"},"action":"update","data":[{"asks":[["100","2","0","1"]],"bids":[],"ts":"1695864901807","checksum":1337,"seqId":2}]}`))
+	require.NoError(t, err, "wsProcessOrderBooks must not error on a checksum mismatch")
+	_, err = ex.Websocket.Orderbook.GetOrderbook(pair, asset.Spot)
+	assert.ErrorIs(t, err, orderbook.ErrOrderbookInvalid, "A checksum mismatch should invalidate the orderbook")
+
+	require.Len(t, conn.requests, 2, "A checksum mismatch must resubscribe to the orderbook")
+	for i, op := range []string{operationUnsubscribe, operationSubscribe} {
+		assert.Equal(t, op, conn.requests[i].Operation)
+		require.Len(t, conn.requests[i].Arguments, 1)
+		assert.Equal(t, channelOrderBooks, conn.requests[i].Arguments[0].Channel)
+		assert.Equal(t, pair, conn.requests[i].Arguments[0].InstrumentID)
+	}
+}
+
 func TestGetLeverateEstimatedInfo(t *testing.T) {
 	t.Parallel()
 	_, err := e.GetLeverageEstimatedInfo(contextGenerate(), "", "cross", "1", "", mainPair.String(), currency.BTC)
@@ -935,20 +935,12 @@ func (e *Exchange) wsProcessOrderBooks(ctx context.Context, conn websocket.Conne
 			err = e.WsProcessUpdateOrderbook(&response.Data[i], response.Argument.InstrumentID, assets)
 		}
 		if err != nil {
-			if errors.Is(err, errInvalidChecksum) {
-				err = e.Subscribe(ctx, conn, subscription.List{
-					{
-						Channel: response.Argument.Channel,
-						Asset:   assets[0],
-						Pairs:   currency.Pairs{response.Argument.InstrumentID},
-					},
-				})
-				if err != nil {
-					return err
-				}
-			} else {
+			if !errors.Is(err, errInvalidChecksum) && !errors.Is(err, orderbook.ErrChecksumMismatch) {
 				return err
 			}
+			// The orderbook has been invalidated, so resubscribe for a new
+			// snapshot and ignore the remaining updates
+			return e.resubscribeOrderbook(ctx, conn, &response.Argument)
 		}
 	}
 	if e.Verbose {
@@ -957,6 +949,22 @@ func (e *Exchange) wsProcessOrderBooks(ctx context.Context, conn websocket.Conne
 	return nil
 }
 
+// resubscribeOrderbook unsubscribes and subscribes to an orderbook channel so
+// that OKX sends a new snapshot of an orderbook which failed checksum
+// verification. The subscription store is unchanged as the channel remains
+// subscribed
+func (e *Exchange) resubscribeOrderbook(ctx context.Context, conn websocket.Connection, arg *SubscriptionInfo) error {
+	if e.Verbose {
+		log.Debugf(log.ExchangeSys, "%s resubscribing to %s %s after checksum mismatch", e.Name, arg.Channel, arg.InstrumentID)
+	}
+	for _, op := range []string{operationUnsubscribe, operationSubscribe} {
+		if err := conn.SendJSONMessage(ctx, websocketRequestEPL, WSSubscriptionInformationList{Operation: op, Arguments: []SubscriptionInfo{*arg}}); err != nil {
+			return fmt.Errorf("%s unable to %s orderbook %s %s: %w", e.Name, op, arg.Channel, arg.InstrumentID, err)
+		}
+	}
+	return nil
+}
+
 // WsProcessSnapshotOrderBook processes snapshot order books
 func (e *Exchange) WsProcessSnapshotOrderBook(data *WsOrderBookData, pair currency.Pair, assets []asset.Item) error {
 	signedChecksum, err := e.CalculateOrderbookChecksum(data)
@@ -1011,7 +1019,6 @@ func (e *Exchange) WsProcessUpdateOrderbook(data *WsOrderBookData, pair currency
 			Asset:            assets[i],
 			UpdateTime:       data.Timestamp.Time(),
 			LastPushed:       data.Timestamp.Time(),
-			GenerateChecksum: generateOrderbookChecksum,
 			ExpectedChecksum: uint32(data.Checksum), //nolint:gosec // Requires type casting
 			Asks:             asks,
 			Bids:             bids,
@@ -1032,27 +1039,15 @@ func (e *Exchange) AppendWsOrderbookItems(entries [][4]types.Number) (orderbook.
 	return items, nil
 }
 
-// generateOrderbookChecksum alternates over the first 25 bid and ask
-// entries of a merged orderbook. The checksum is made up of the price and the
-// quantity with a semicolon (:) deliminating them. This will also work when
-// there are less than 25 entries (for whatever reason)
+// orderbookChecksum alternates over the first 25 bid and ask entries of a
+// merged orderbook. The checksum is made up of the price and the quantity with
+// a semicolon (:) deliminating them. This will also work when there are less
+// than 25 entries (for whatever reason)
 // eg Bid:Ask:Bid:Ask:Ask:Ask
-func generateOrderbookChecksum(orderbookData *orderbook.Book) uint32 {
-	var checksum strings.Builder
-	for i := range allowableIterations {
-		if len(orderbookData.Bids)-1 >= i {
-			price := strconv.FormatFloat(orderbookData.Bids[i].Price, 'f', -1, 64)
-			amount := strconv.FormatFloat(orderbookData.Bids[i].Amount, 'f', -1, 64)
-			checksum.WriteString(price + wsOrderbookChecksumDelimiter + amount + wsOrderbookChecksumDelimiter)
-		}
-		if len(orderbookData.Asks)-1 >= i {
-			price := strconv.FormatFloat(orderbookData.Asks[i].Price, 'f', -1, 64)
-			amount := strconv.FormatFloat(orderbookData.Asks[i].Amount, 'f', -1, 64)
-			checksum.WriteString(price + wsOrderbookChecksumDelimiter + amount + wsOrderbookChecksumDelimiter)
-		}
-	}
-	checksumStr := strings.TrimSuffix(checksum.String(), wsOrderbookChecksumDelimiter)
-	return crc32.ChecksumIEEE([]byte(checksumStr))
+var orderbookChecksum = &orderbook.Checksum{
+	Depth:      allowableIterations,
+	Interleave: true,
+	Delimiter:  wsOrderbookChecksumDelimiter,
 }
 
 // CalculateOrderbookChecksum alternates over the first 25 bid and ask entries from websocket data.
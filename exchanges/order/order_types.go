@@ -248,6 +248,7 @@ type Detail struct {
 	OrderID              string
 	ClientOrderID        string
 	AccountID            string
+	Account              string // Name of the configured exchange account, as opposed to the exchange's AccountID
 	ClientID             string
 	Type                 Type
 	Side                 Side
@@ -270,6 +271,7 @@ type Filter struct {
 	OrderID         string
 	ClientOrderID   string
 	AccountID       string
+	Account         string
 	ClientID        string
 	Type            Type
 	Side            Side
@@ -1018,6 +1018,7 @@ func TestUpdateOrderFromDetail(t *testing.T) {
 		InternalOrderID: id,
 		OrderID:         "1",
 		AccountID:       "1",
+		Account:         "hedge",
 		ClientID:        "1",
 		ClientOrderID:   "DukeOfWombleton",
 		Type:            1,
@@ -1053,6 +1054,7 @@ func TestUpdateOrderFromDetail(t *testing.T) {
 	assert.Equal(t, 1.0, od.Fee)
 	assert.Equal(t, "test", od.Exchange, "Should not be able to update exchange via modify")
 	assert.Equal(t, "1", od.OrderID)
+	assert.Equal(t, "hedge", od.Account)
 	assert.Equal(t, "1", od.ClientID)
 	assert.Equal(t, "DukeOfWombleton", od.ClientOrderID)
 	assert.Equal(t, Type(1), od.Type)
@@ -1263,6 +1265,9 @@ func TestMatchFilter(t *testing.T) {
 		{"AccountID ✓", Filter{AccountID: "A"}, Detail{AccountID: "A"}, true},
 		{"AccountID 𐄂", Filter{AccountID: "A"}, Detail{AccountID: "B"}, false},
 		{"AccountID Empty", Filter{AccountID: "A"}, Detail{}, false},
+		{"Account ✓", Filter{Account: "hedge"}, Detail{Account: "hedge"}, true},
+		{"Account 𐄂", Filter{Account: "hedge"}, Detail{Account: "default"}, false},
+		{"Account Empty", Filter{Account: "hedge"}, Detail{}, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.description, func(t *testing.T) {
@@ -0,0 +1,91 @@
+package orderbook
+
+import (
+	"hash/crc32"
+	"strconv"
+	"strings"
+)
+
+// ChecksumFormatter formats a level price or amount for checksum generation.
+// str holds the exchange's original string value when it has been populated
+type ChecksumFormatter func(value float64, str string) string
+
+// Checksum defines how an exchange generates a CRC32 checksum from the top
+// levels of an orderbook so that incremental updates can be verified against
+// the checksum sent by the exchange
+type Checksum struct {
+	// Depth is the number of levels used from each side, zero uses all levels
+	Depth int
+	// Interleave alternates levels between sides e.g. bid, ask, bid, ask.
+	// Otherwise all levels of the first side are written before the second
+	Interleave bool
+	// AsksFirst writes asks before bids
+	AsksFirst bool
+	// Delimiter separates each price and amount and each level
+	Delimiter string
+	// Format formats prices and amounts, defaults to ChecksumDecimal
+	Format ChecksumFormatter
+}
+
+// ChecksumDecimal formats a value as its original string or as the shortest
+// decimal representation e.g. 0.0001
+func ChecksumDecimal(value float64, str string) string {
+	if str != "" {
+		return str
+	}
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// ChecksumTrimmed formats a value as ChecksumDecimal with the decimal point
+// and leading zeros removed e.g. 0.0001 becomes 1
+func ChecksumTrimmed(value float64, str string) string {
+	return strings.TrimLeft(strings.Replace(ChecksumDecimal(value, str), ".", "", 1), "0")
+}
+
+// Generate returns the CRC32 checksum of an orderbook
+func (c *Checksum) Generate(b *Book) uint32 {
+	first, second := b.Bids, b.Asks
+	if c.AsksFirst {
+		first, second = second, first
+	}
+	format := c.Format
+	if format == nil {
+		format = ChecksumDecimal
+	}
+	var s strings.Builder
+	write := func(l *Level) {
+		if s.Len() != 0 {
+			s.WriteString(c.Delimiter)
+		}
+		s.WriteString(format(l.Price, l.StrPrice))
+		s.WriteString(c.Delimiter)
+		s.WriteString(format(l.Amount, l.StrAmount))
+	}
+	firstDepth, secondDepth := c.depth(first), c.depth(second)
+	if c.Interleave {
+		for i := range max(firstDepth, secondDepth) {
+			if i < firstDepth {
+				write(&first[i])
+			}
+			if i < secondDepth {
+				write(&second[i])
+			}
+		}
+	} else {
+		for i := range firstDepth {
+			write(&first[i])
+		}
+		for i := range secondDepth {
+			write(&second[i])
+		}
+	}
+	return crc32.ChecksumIEEE([]byte(s.String()))
+}
+
+// depth returns the number of levels of a side used in the checksum
+func (c *Checksum) depth(l Levels) int {
+	if c.Depth > 0 {
+		return min(c.Depth, len(l))
+	}
+	return len(l)
+}
@@ -0,0 +1,47 @@
+package orderbook
+
+import (
+	"hash/crc32"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChecksumFormatters(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "0.0001", ChecksumDecimal(0.0001, ""))
+	assert.Equal(t, "0.00010", ChecksumDecimal(0.0001, "0.00010"), "original string should be preferred")
+	for value, want := range map[float64]string{
+		0.1234:      "1234",
+		0.00001234:  "1234",
+		32.00001234: "3200001234",
+		0:           "",
+		1.0:         "1",
+		16000.0:     "16000",
+		1.01:        "101",
+	} {
+		assert.Equalf(t, want, ChecksumTrimmed(value, ""), "ChecksumTrimmed should format %v correctly", value)
+	}
+	assert.Equal(t, "5000", ChecksumTrimmed(0, "0.5000"), "original string should be trimmed")
+}
+
+func TestChecksumGenerate(t *testing.T) {
+	t.Parallel()
+	b := &Book{
+		Bids: Levels{{Price: 2, Amount: 0.5}, {Price: 1, Amount: 1}},
+		Asks: Levels{{Price: 3, Amount: 1.5}, {Price: 4, Amount: 2}, {Price: 5, Amount: 3, StrPrice: "5.0", StrAmount: "3.00"}},
+	}
+	for _, tc := range []struct {
+		c    Checksum
+		want string
+	}{
+		{Checksum{Delimiter: ":"}, "2:0.5:1:1:3:1.5:4:2:5.0:3.00"},
+		{Checksum{Delimiter: ":", Depth: 1}, "2:0.5:3:1.5"},
+		{Checksum{Delimiter: ":", Interleave: true}, "2:0.5:3:1.5:1:1:4:2:5.0:3.00"},
+		{Checksum{Delimiter: ":", Interleave: true, AsksFirst: true, Depth: 2}, "3:1.5:2:0.5:4:2:1:1"},
+		{Checksum{Format: ChecksumTrimmed, AsksFirst: true}, "31542503002511"},
+	} {
+		assert.Equalf(t, crc32.ChecksumIEEE([]byte(tc.want)), tc.c.Generate(b), "Generate should checksum %q", tc.want)
+	}
+	assert.Equal(t, crc32.ChecksumIEEE(nil), (&Checksum{}).Generate(&Book{}), "empty book should checksum an empty string")
+}
@@ -123,6 +123,9 @@ func (d *Depth) invalidate(withReason error) error {
 	d.bidLevels.load(nil)
 	d.askLevels.load(nil)
 	d.validationError = fmt.Errorf("%s %s %s Reason: [%w]", d.exchange, d.pair, d.asset, common.AppendError(ErrOrderbookInvalid, withReason))
+	if r := globalReporter.Load(); r != nil {
+		(*r).Invalidated(d.exchange, d.pair, d.asset)
+	}
 	d.Alert()
 	return d.validationError
 }
@@ -5,6 +5,7 @@ import (
 	"math"
 	"reflect"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -767,3 +768,45 @@ func TestKey(t *testing.T) {
 		key.NewExchangeAssetPair(depth.exchange, depth.asset, depth.pair),
 		depth.Key())
 }
+
+type invalidationReporter struct {
+	m          sync.Mutex
+	exchange   []string
+	mismatches int
+}
+
+func (r *invalidationReporter) Invalidated(exchange string, _ currency.Pair, _ asset.Item) {
+	r.m.Lock()
+	r.exchange = append(r.exchange, exchange)
+	r.m.Unlock()
+}
+
+func (r *invalidationReporter) ChecksumMismatch(string, currency.Pair, asset.Item) {
+	r.m.Lock()
+	r.mismatches++
+	r.m.Unlock()
+}
+
+func TestSetupGlobalReporter(t *testing.T) {
+	r := &invalidationReporter{}
+	SetupGlobalReporter(r)
+	t.Cleanup(func() { SetupGlobalReporter(nil) })
+
+	d := NewDepth(id)
+	d.exchange = "reporterexchange"
+	require.ErrorIs(t, d.Invalidate(nil), ErrOrderbookInvalid)
+	r.m.Lock()
+	assert.Contains(t, r.exchange, "reporterexchange", "Invalidated should be reported")
+	r.m.Unlock()
+
+	require.NoError(t, d.LoadSnapshot(newSnapshot(20)))
+	d.validateOrderbook = true
+	err := d.ProcessUpdate(&Update{UpdateTime: time.Now(), Asks: Levels{{Price: 1337.5, Amount: 1}}, ExpectedChecksum: 1337, GenerateChecksum: func(*Book) uint32 { return 1336 }})
+	require.ErrorIs(t, err, ErrChecksumMismatch)
+	r.m.Lock()
+	assert.Equal(t, 1, r.mismatches, "ChecksumMismatch should be reported")
+	r.m.Unlock()
+
+	SetupGlobalReporter(nil)
+	assert.Nil(t, globalReporter.Load(), "reporter should be removed")
+}
@@ -23,8 +23,9 @@ const (
 
 // Public error vars
 var (
-	ErrDepthNotFound = errors.New("orderbook depth not found")
-	ErrEmptyUpdate   = errors.New("update contains no bids or asks")
+	ErrDepthNotFound    = errors.New("orderbook depth not found")
+	ErrEmptyUpdate      = errors.New("update contains no bids or asks")
+	ErrChecksumMismatch = errors.New("checksum mismatch")
 )
 
 var (
@@ -32,7 +33,6 @@ var (
 	errUpdateFailed           = errors.New("orderbook update failed")
 	errDeleteFailed           = errors.New("orderbook update delete failed")
 	errRESTSnapshot           = errors.New("cannot update REST protocol loaded snapshot")
-	errChecksumMismatch       = errors.New("checksum mismatch")
 	errChecksumGeneratorUnset = errors.New("checksum generator unset")
 )
 
@@ -106,7 +106,12 @@ func (d *Depth) ProcessUpdate(u *Update) error {
 			return d.invalidate(errChecksumGeneratorUnset)
 		}
 		if checksum := u.GenerateChecksum(d.snapshot()); checksum != u.ExpectedChecksum {
-			return d.invalidate(fmt.Errorf("%s %s %s %w: expected '%d', got '%d'", d.exchange, d.pair, d.asset, errChecksumMismatch, u.ExpectedChecksum, checksum))
+			if r := globalReporter.Load(); r != nil {
+				if cr, ok := (*r).(ChecksumReporter); ok {
+					cr.ChecksumMismatch(d.exchange, d.pair, d.asset)
+				}
+			}
+			return d.invalidate(fmt.Errorf("%s %s %s %w: expected '%d', got '%d'", d.exchange, d.pair, d.asset, ErrChecksumMismatch, u.ExpectedChecksum, checksum))
 		}
 	}
 
@@ -73,7 +73,7 @@ func TestProcessUpdate(t *testing.T) {
 
 	require.NoError(t, d.LoadSnapshot(newSnapshot(20)))
 	err = d.ProcessUpdate(&Update{UpdateTime: time.Now(), Asks: Levels{{Price: 1337.5, Amount: 69420, ID: 69420}}, ExpectedChecksum: 1337, GenerateChecksum: func(*Book) uint32 { return 1336 }})
-	require.ErrorIs(t, err, errChecksumMismatch)
+	require.ErrorIs(t, err, ErrChecksumMismatch)
 
 	require.NoError(t, d.LoadSnapshot(newSnapshot(20)))
 	err = d.ProcessUpdate(&Update{UpdateTime: time.Now(), Asks: Levels{{Price: 1337.5, Amount: 69420, ID: 69420}}, ExpectedChecksum: 1337, GenerateChecksum: func(*Book) uint32 { return 1337 }})
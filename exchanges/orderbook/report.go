@@ -0,0 +1,32 @@
+package orderbook
+
+import (
+	"sync/atomic"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// Reporter interface groups observability functionality over orderbook
+// depth integrity
+type Reporter interface {
+	Invalidated(exchange string, p currency.Pair, a asset.Item)
+}
+
+// ChecksumReporter is an optional Reporter extension which is notified when an
+// incremental update fails checksum verification
+type ChecksumReporter interface {
+	ChecksumMismatch(exchange string, p currency.Pair, a asset.Item)
+}
+
+var globalReporter atomic.Pointer[Reporter]
+
+// SetupGlobalReporter sets a reporter interface to be used for all orderbook
+// depths. A nil reporter removes the current reporter
+func SetupGlobalReporter(r Reporter) {
+	if r == nil {
+		globalReporter.Store(nil)
+		return
+	}
+	globalReporter.Store(&r)
+}
@@ -0,0 +1,213 @@
+package request
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"golang.org/x/time/rate"
+)
+
+// Default global rate limit backoff durations
+const (
+	DefaultTooManyRequestsBackoff = 5 * time.Second
+	DefaultBannedBackoff          = 2 * time.Minute
+)
+
+// Global backoff durations applied when an exchange rejects a request for
+// exceeding its rate limits without a Retry-After header
+var (
+	TooManyRequestsBackoff = DefaultTooManyRequestsBackoff
+	BannedBackoff          = DefaultBannedBackoff
+)
+
+// ErrRateLimitBackoff is returned when requests are held back after an
+// exchange rejected a request for exceeding its rate limits
+var ErrRateLimitBackoff = errors.New("rate limit backoff in effect")
+
+// RateLimitState is the rate limit state reported by an exchange in its
+// response headers. Weight should be in the same units as the weights of the
+// endpoint rate limiters
+type RateLimitState struct {
+	Limit     int
+	Remaining int
+	Reset     time.Time
+}
+
+// RateLimitParser parses exchange specific rate limit response headers. A nil
+// state is returned when the response does not hold rate limit headers
+type RateLimitParser func(resp *http.Response, now time.Time) (*RateLimitState, error)
+
+// RateLimitBudget is the last rate limit state reported by an exchange host
+type RateLimitBudget struct {
+	Host string
+	RateLimitState
+	Updated time.Time
+}
+
+// RateLimitStatus is the server reported rate limit budget of a requester
+type RateLimitStatus struct {
+	BackoffUntil time.Time
+	Budgets      []RateLimitBudget
+}
+
+// adaptiveLimiter adjusts endpoint rate limiters from the server reported rate
+// limit state and holds back all requests after a 418 or 429 response
+type adaptiveLimiter struct {
+	m            sync.Mutex
+	parser       RateLimitParser
+	backoffUntil time.Time
+	budgets      map[string]*RateLimitBudget
+	throttled    map[*rate.Limiter]*throttledLimiter
+}
+
+// throttledLimiter holds the configured limit of a throttled limiter which is
+// restored once the server rate limit window resets
+type throttledLimiter struct {
+	limit rate.Limit
+	until time.Time
+}
+
+// WithRateLimitParser configures a parser for exchange rate limit response
+// headers which adjusts the endpoint rate limiters from the server's state
+func WithRateLimitParser(p RateLimitParser) RequesterOption {
+	return func(r *Requester) {
+		r.adaptive.parser = p
+	}
+}
+
+// GetRateLimitStatus returns the server reported rate limit budget
+func (r *Requester) GetRateLimitStatus() (*RateLimitStatus, error) {
+	if r == nil {
+		return nil, ErrRequestSystemIsNil
+	}
+	r.adaptive.m.Lock()
+	defer r.adaptive.m.Unlock()
+	s := &RateLimitStatus{
+		BackoffUntil: r.adaptive.backoffUntil,
+		Budgets:      make([]RateLimitBudget, 0, len(r.adaptive.budgets)),
+	}
+	for _, b := range r.adaptive.budgets {
+		s.Budgets = append(s.Budgets, *b)
+	}
+	slices.SortFunc(s.Budgets, func(a, b RateLimitBudget) int {
+		return strings.Compare(a.Host, b.Host)
+	})
+	return s, nil
+}
+
+// waitForBackoff delays until any global rate limit backoff has ended and
+// restores limiters throttled for a window which has since reset
+func (r *Requester) waitForBackoff(ctx context.Context, l *RateLimiterWithWeight) error {
+	now := time.Now()
+	r.adaptive.m.Lock()
+	if l != nil {
+		if t, ok := r.adaptive.throttled[l.limiter]; ok && !now.Before(t.until) {
+			l.limiter.SetLimitAt(now, t.limit)
+			delete(r.adaptive.throttled, l.limiter)
+		}
+	}
+	delay := r.adaptive.backoffUntil.Sub(now)
+	r.adaptive.m.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+	if hasDelayNotAllowed(ctx) {
+		return fmt.Errorf("%w for %s: %w", ErrRateLimitBackoff, delay, ErrDelayNotAllowed)
+	}
+	if dl, ok := ctx.Deadline(); ok && dl.Before(now.Add(delay)) {
+		return fmt.Errorf("%w for %s will exceed deadline: %w", ErrRateLimitBackoff, delay, context.DeadlineExceeded)
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(delay):
+		return nil
+	}
+}
+
+// adaptRateLimits applies a global backoff when a request is rejected for
+// exceeding rate limits and throttles the endpoint rate limiter so the
+// server's remaining weight is spread over the rest of its window
+func (r *Requester) adaptRateLimits(resp *http.Response, endpoint EndpointLimit, now time.Time) {
+	if resp == nil {
+		return
+	}
+	r.adaptive.m.Lock()
+	defer r.adaptive.m.Unlock()
+
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusTeapot {
+		backoff := RetryAfter(resp, now)
+		if backoff <= 0 {
+			backoff = TooManyRequestsBackoff
+			if resp.StatusCode == http.StatusTeapot {
+				backoff = BannedBackoff
+			}
+		}
+		if until := now.Add(backoff); until.After(r.adaptive.backoffUntil) {
+			r.adaptive.backoffUntil = until
+			log.Warnf(log.RequestSys, "%s rate limit exceeded, status %q, holding back requests until %s", r.name, resp.Status, until)
+		}
+	}
+
+	if r.adaptive.parser == nil {
+		return
+	}
+	state, err := r.adaptive.parser(resp, now)
+	if err != nil {
+		log.Errorf(log.RequestSys, "%s unable to parse rate limit headers: %s", r.name, err)
+		return
+	}
+	if state == nil {
+		return
+	}
+	var host string
+	if resp.Request != nil && resp.Request.URL != nil {
+		host = resp.Request.URL.Host
+	}
+	if r.adaptive.budgets == nil {
+		r.adaptive.budgets = make(map[string]*RateLimitBudget)
+	}
+	r.adaptive.budgets[host] = &RateLimitBudget{Host: host, RateLimitState: *state, Updated: now}
+
+	if l := r.limiter[endpoint]; l != nil {
+		r.throttle(l.limiter, state, now)
+	}
+}
+
+// throttle lowers the limit of a limiter to spread the remaining weight over
+// the time until the window resets. The configured limit is never exceeded and
+// is restored when the window resets. Does not provide locking protection
+func (r *Requester) throttle(l *rate.Limiter, state *RateLimitState, now time.Time) {
+	window := state.Reset.Sub(now)
+	if window <= 0 {
+		return
+	}
+	t, throttled := r.adaptive.throttled[l]
+	configured := l.Limit()
+	if throttled {
+		configured = t.limit
+	}
+	// A single request is allowed per window once exhausted, a rejection will
+	// then trigger the global backoff
+	limit := rate.Limit(float64(max(state.Remaining, 1)) / window.Seconds())
+	if limit >= configured {
+		if throttled {
+			l.SetLimitAt(now, configured)
+			delete(r.adaptive.throttled, l)
+		}
+		return
+	}
+	l.SetLimitAt(now, limit)
+	if r.adaptive.throttled == nil {
+		r.adaptive.throttled = make(map[*rate.Limiter]*throttledLimiter)
+	}
+	r.adaptive.throttled[l] = &throttledLimiter{limit: configured, until: state.Reset}
+}
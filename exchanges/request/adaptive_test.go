@@ -0,0 +1,169 @@
+package request
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+var errParseRateLimit = errors.New("parse rate limit error")
+
+// testRateLimitParser reports the remaining weight from the X-Remaining header
+// with the window resetting in 10 seconds
+func testRateLimitParser(resp *http.Response, now time.Time) (*RateLimitState, error) {
+	v := resp.Header.Get("X-Remaining")
+	if v == "" {
+		return nil, nil
+	}
+	if v == "error" {
+		return nil, errParseRateLimit
+	}
+	remaining, err := strconv.Atoi(v)
+	if err != nil {
+		return nil, err
+	}
+	return &RateLimitState{Limit: 100, Remaining: remaining, Reset: now.Add(10 * time.Second)}, nil
+}
+
+func newRateLimitResponse(status int, headers map[string]string) *http.Response {
+	resp := &http.Response{
+		StatusCode: status,
+		Status:     http.StatusText(status),
+		Header:     http.Header{},
+		Request:    &http.Request{URL: &url.URL{Host: "api.test.com"}},
+	}
+	for k, v := range headers {
+		resp.Header.Set(k, v)
+	}
+	return resp
+}
+
+func TestAdaptRateLimits(t *testing.T) {
+	t.Parallel()
+	r, err := New("test", new(http.Client), WithLimiter(NewBasicRateLimit(time.Second, 100, 1)), WithRateLimitParser(testRateLimitParser))
+	require.NoError(t, err, "New must not error")
+	l := r.limiter[Auth].limiter
+	now := time.Now()
+
+	r.adaptRateLimits(nil, Auth, now)
+	r.adaptRateLimits(newRateLimitResponse(http.StatusOK, nil), Auth, now)
+	s, err := r.GetRateLimitStatus()
+	require.NoError(t, err, "GetRateLimitStatus must not error")
+	assert.Empty(t, s.Budgets, "response without rate limit headers should not record a budget")
+	assert.True(t, s.BackoffUntil.IsZero(), "successful response should not back off")
+
+	r.adaptRateLimits(newRateLimitResponse(http.StatusOK, map[string]string{"X-Remaining": "error"}), Auth, now)
+	s, err = r.GetRateLimitStatus()
+	require.NoError(t, err, "GetRateLimitStatus must not error")
+	assert.Empty(t, s.Budgets, "parser errors should not record a budget")
+
+	r.adaptRateLimits(newRateLimitResponse(http.StatusOK, map[string]string{"X-Remaining": "50"}), Auth, now)
+	assert.Equal(t, rate.Limit(5), l.Limit(), "limiter should spread remaining weight over the window")
+	s, err = r.GetRateLimitStatus()
+	require.NoError(t, err, "GetRateLimitStatus must not error")
+	require.Len(t, s.Budgets, 1)
+	assert.Equal(t, "api.test.com", s.Budgets[0].Host)
+	assert.Equal(t, 50, s.Budgets[0].Remaining)
+	assert.Equal(t, 100, s.Budgets[0].Limit)
+	assert.Equal(t, now, s.Budgets[0].Updated)
+
+	r.adaptRateLimits(newRateLimitResponse(http.StatusOK, map[string]string{"X-Remaining": "0"}), Auth, now)
+	assert.Equal(t, rate.Limit(0.1), l.Limit(), "exhausted budget should allow a single request per window")
+
+	r.adaptRateLimits(newRateLimitResponse(http.StatusOK, map[string]string{"X-Remaining": "5000"}), Auth, now)
+	assert.Equal(t, rate.Limit(100), l.Limit(), "configured limit should be restored and never exceeded")
+	assert.Empty(t, r.adaptive.throttled)
+
+	r.adaptRateLimits(newRateLimitResponse(http.StatusOK, map[string]string{"X-Remaining": "10"}), Auth, now)
+	require.Equal(t, rate.Limit(1), l.Limit())
+	require.NoError(t, r.waitForBackoff(t.Context(), r.limiter[Auth]), "waitForBackoff must not error")
+	assert.Equal(t, rate.Limit(1), l.Limit(), "limiter should remain throttled until the window resets")
+	r.adaptive.throttled[l].until = time.Now()
+	require.NoError(t, r.waitForBackoff(t.Context(), r.limiter[Auth]), "waitForBackoff must not error")
+	assert.Equal(t, rate.Limit(100), l.Limit(), "configured limit should be restored once the window resets")
+	assert.Empty(t, r.adaptive.throttled)
+}
+
+func TestAdaptRateLimitsBackoff(t *testing.T) {
+	t.Parallel()
+	r, err := New("test", new(http.Client))
+	require.NoError(t, err, "New must not error")
+	now := time.Now()
+
+	r.adaptRateLimits(newRateLimitResponse(http.StatusTooManyRequests, nil), Auth, now)
+	assert.Equal(t, now.Add(TooManyRequestsBackoff), r.adaptive.backoffUntil)
+
+	r.adaptRateLimits(newRateLimitResponse(http.StatusTeapot, nil), Auth, now)
+	assert.Equal(t, now.Add(BannedBackoff), r.adaptive.backoffUntil, "ban should extend the backoff")
+
+	r.adaptRateLimits(newRateLimitResponse(http.StatusTooManyRequests, nil), Auth, now)
+	assert.Equal(t, now.Add(BannedBackoff), r.adaptive.backoffUntil, "backoff should not be shortened")
+
+	r.adaptRateLimits(newRateLimitResponse(http.StatusTeapot, map[string]string{headerRetryAfter: "3600"}), Auth, now)
+	assert.Equal(t, now.Add(time.Hour), r.adaptive.backoffUntil, "Retry-After should set the ban until time")
+
+	s, err := r.GetRateLimitStatus()
+	require.NoError(t, err, "GetRateLimitStatus must not error")
+	assert.Equal(t, now.Add(time.Hour), s.BackoffUntil)
+
+	_, err = (*Requester)(nil).GetRateLimitStatus()
+	assert.ErrorIs(t, err, ErrRequestSystemIsNil)
+}
+
+func TestWaitForBackoff(t *testing.T) {
+	t.Parallel()
+	synctest.Test(t, func(t *testing.T) { //nolint:thelper,nolintlint // false positive
+		r := &Requester{}
+		require.NoError(t, r.waitForBackoff(t.Context(), nil), "waitForBackoff must not error without a backoff")
+
+		r.adaptive.backoffUntil = time.Now().Add(time.Minute)
+		err := r.waitForBackoff(WithDelayNotAllowed(t.Context()), nil)
+		assert.ErrorIs(t, err, ErrRateLimitBackoff)
+		assert.ErrorIs(t, err, ErrDelayNotAllowed)
+
+		ctx, cancel := context.WithTimeout(t.Context(), time.Second)
+		defer cancel()
+		err = r.waitForBackoff(ctx, nil)
+		assert.ErrorIs(t, err, ErrRateLimitBackoff)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+		ctx, cancel = context.WithCancel(t.Context())
+		cancel()
+		assert.ErrorIs(t, r.waitForBackoff(ctx, nil), context.Canceled)
+
+		start := time.Now()
+		require.NoError(t, r.waitForBackoff(t.Context(), nil), "waitForBackoff must not error")
+		assert.Equal(t, time.Minute, time.Since(start), "should wait until the backoff ends")
+	})
+}
+
+func TestSendPayloadRateLimitBackoff(t *testing.T) {
+	t.Parallel()
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		calls++
+		w.Header().Set(headerRetryAfter, "3600")
+		w.WriteHeader(http.StatusTeapot)
+	}))
+	t.Cleanup(server.Close)
+
+	r, err := New("test", new(http.Client), WithLimiter(NewBasicRateLimit(time.Second, 100, 1)), WithRetryPolicy(func(*http.Response, error) (bool, error) { return false, nil }))
+	require.NoError(t, err, "New must not error")
+	itemFn := func() (*Item, error) { return &Item{Method: http.MethodGet, Path: server.URL}, nil }
+	err = r.SendPayload(t.Context(), Auth, itemFn, UnauthenticatedRequest)
+	require.ErrorIs(t, err, ErrBadStatus)
+
+	err = r.SendPayload(WithDelayNotAllowed(t.Context()), UnAuth, itemFn, UnauthenticatedRequest)
+	assert.ErrorIs(t, err, ErrRateLimitBackoff, "backoff should apply to all endpoints")
+	assert.Equal(t, 1, calls, "requests should be held back during a ban")
+}
@@ -99,9 +99,22 @@ func (r *Requester) InitiateRateLimit(ctx context.Context, e EndpointLimit) erro
 	if err := common.NilGuard(r.limiter); err != nil {
 		return err
 	}
-	if err := r.limiter[e].RateLimit(ctx); err != nil {
+	start := time.Now()
+	if err := r.waitForBackoff(ctx, r.limiter[e]); err != nil {
 		return fmt.Errorf("cannot rate limit request %w for endpoint %d", err, e)
 	}
+	var err error
+	if r.shared != nil {
+		err = r.sharedRateLimit(ctx, e)
+	} else {
+		err = r.limiter[e].RateLimit(ctx)
+	}
+	if err != nil {
+		return fmt.Errorf("cannot rate limit request %w for endpoint %d", err, e)
+	}
+	if rep, ok := r.reporter.(RateLimitReporter); ok {
+		rep.RateLimitWait(r.name, time.Since(start))
+	}
 	return nil
 }
 
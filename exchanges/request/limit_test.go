@@ -241,4 +241,24 @@ func TestInitiateRateLimit(t *testing.T) {
 	r.limiter = NewBasicRateLimit(time.Second, 10, 1)
 	err = r.InitiateRateLimit(t.Context(), Unset)
 	assert.NoError(t, err, "should not error on valid rate limit initiation")
+
+	rep := &rateLimitReporter{}
+	r.name = "test"
+	r.reporter = rep
+	err = r.InitiateRateLimit(t.Context(), Unset)
+	require.NoError(t, err, "InitiateRateLimit must not error")
+	assert.Equal(t, "test", rep.name, "RateLimitWait should be reported with the requester name")
+	assert.Equal(t, 1, rep.calls, "RateLimitWait should be reported once")
+}
+
+type rateLimitReporter struct {
+	name  string
+	calls int
+}
+
+func (r *rateLimitReporter) Latency(string, string, string, time.Duration) {}
+
+func (r *rateLimitReporter) RateLimitWait(name string, _ time.Duration) {
+	r.name = name
+	r.calls++
 }
@@ -10,6 +10,12 @@ type Reporter interface {
 	Latency(name, method, path string, t time.Duration)
 }
 
+// RateLimitReporter is an optional Reporter extension which receives the
+// time spent waiting on a rate limiter before a request is sent
+type RateLimitReporter interface {
+	RateLimitWait(name string, t time.Duration)
+}
+
 // SetupGlobalReporter sets a reporter interface to be used
 // for all exchange requests
 func SetupGlobalReporter(r Reporter) {
@@ -9,14 +9,17 @@ import (
 	"net/http"
 	"net/http/httputil"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/timedmutex"
+	"github.com/thrasher-corp/gocryptotrader/common/tracing"
 	"github.com/thrasher-corp/gocryptotrader/encoding/json"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/nonce"
 	"github.com/thrasher-corp/gocryptotrader/log"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 const (
@@ -64,12 +67,17 @@ func New(name string, httpRequester *http.Client, opts ...RequesterOption) (*Req
 		maxRetries:  MaxRetryAttempts,
 		timedLock:   timedmutex.NewTimedMutex(DefaultMutexLockTimeout),
 		reporter:    globalReporter,
+		shared:      globalSharedLimiter,
 	}
 
 	for _, o := range opts {
 		o(r)
 	}
 
+	if r.shared != nil {
+		r.sharedKeys = sharedBucketKeys(name, r.limiter)
+	}
+
 	return r, nil
 }
 
@@ -92,10 +100,15 @@ func (r *Requester) SendPayload(ctx context.Context, ep EndpointLimit, newReques
 		return errRequestFunctionIsNil
 	}
 
+	ctx, span := tracing.Start(ctx, "request.SendPayload",
+		attribute.String("exchange", r.name),
+		attribute.Int("endpoint_limit", int(ep)),
+		attribute.Bool("authenticated", requestType == AuthenticatedRequest))
 	err := r.doRequest(ctx, ep, newRequest)
 	if err != nil && requestType == AuthenticatedRequest {
 		err = common.AppendError(err, ErrAuthRequestFailed)
 	}
+	tracing.End(span, err)
 	return err
 }
 
@@ -150,13 +163,18 @@ func (r *Requester) doRequest(ctx context.Context, endpoint EndpointLimit, newRe
 
 		if r.limiter != nil {
 			// Initiate a rate limit reservation and sleep on requested endpoint
-			err := r.InitiateRateLimit(ctx, endpoint)
+			rlCtx, rlSpan := tracing.Start(ctx, "request.RateLimit", attribute.Int("attempt", attempt))
+			err := r.InitiateRateLimit(rlCtx, endpoint)
+			tracing.End(rlSpan, err)
 			if err != nil {
 				return fmt.Errorf("failed to rate limit HTTP request: %w", err)
 			}
 		}
 
+		// Request generation includes nonce retrieval and payload signing
+		_, genSpan := tracing.Start(ctx, "request.Generate", attribute.Int("attempt", attempt))
 		p, err := newRequest()
+		tracing.End(genSpan, err)
 		if err != nil {
 			return err
 		}
@@ -169,7 +187,7 @@ func (r *Requester) doRequest(ctx context.Context, endpoint EndpointLimit, newRe
 		verbose := IsVerbose(ctx, p.Verbose)
 
 		if verbose {
-			log.Debugf(log.RequestSys, "%s attempt %d request path: %s", r.name, attempt, p.Path)
+			log.DebugWithFieldsf(log.RequestSys, tracing.LogFields(ctx), "%s attempt %d request path: %s", r.name, attempt, p.Path)
 			for k, d := range req.Header {
 				log.Debugf(log.RequestSys, "%s request header [%s]: %s", r.name, k, d)
 			}
@@ -191,9 +209,20 @@ func (r *Requester) doRequest(ctx context.Context, endpoint EndpointLimit, newRe
 			}
 		}
 
+		// The query string is omitted as it may contain signatures
+		endpointPath, _, _ := strings.Cut(p.Path, "?")
+		_, attemptSpan := tracing.Start(ctx, "request.Attempt",
+			attribute.Int("attempt", attempt),
+			attribute.String("http.request.method", p.Method),
+			attribute.String("url.path", endpointPath))
 		start := time.Now()
 
 		resp, err := r._HTTPClient.do(req)
+		if resp != nil {
+			attemptSpan.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+		}
+		tracing.End(attemptSpan, err)
+		r.adaptRateLimits(resp, endpoint, time.Now())
 
 		if r.reporter != nil && err == nil {
 			r.reporter.Latency(r.name, p.Method, p.Path, time.Since(start))
@@ -300,24 +329,35 @@ func (r *Requester) evaluateRetry(ctx context.Context, resp *http.Response, inco
 
 	if verbose {
 		if incomingErr != nil {
-			log.Errorf(log.RequestSys, "%s request has failed. Retrying request in %s, attempt %d, cause: %s", r.name, delay, attempt, incomingErr)
+			log.ErrorWithFieldsf(log.RequestSys, retryLogFields(ctx, r.name, attempt).WithError(incomingErr), "%s request has failed. Retrying request in %s, attempt %d, cause: %s", r.name, delay, attempt, incomingErr)
 		} else {
-			log.Errorf(log.RequestSys, "%s request has failed. Retrying request in %s, attempt %d, status: %q", r.name, delay, attempt, resp.Status)
+			log.ErrorWithFieldsf(log.RequestSys, retryLogFields(ctx, r.name, attempt).With(statusCodeKey, resp.StatusCode), "%s request has failed. Retrying request in %s, attempt %d, status: %q", r.name, delay, attempt, resp.Status)
 		}
 	}
 
 	if delay > 0 {
+		_, span := tracing.Start(ctx, "request.RetryBackoff",
+			attribute.Int("attempt", attempt),
+			attribute.Int64("delay_ms", delay.Milliseconds()))
 		// Allow for context cancellation while delaying the retry.
 		select {
 		case <-time.After(delay):
 		case <-ctx.Done():
-			return false, fmt.Errorf("%w %w", errFailedToRetryRequest, ctx.Err())
+			err := fmt.Errorf("%w %w", errFailedToRetryRequest, ctx.Err())
+			tracing.End(span, err)
+			return false, err
 		}
+		span.End()
 	}
 
 	return true, nil
 }
 
+// retryLogFields returns structured logging fields for a request retry
+func retryLogFields(ctx context.Context, exch string, attempt int) log.ExtraFields {
+	return tracing.LogFields(ctx).WithExchange(exch, nil, nil).With(attemptKey, attempt)
+}
+
 func (r *Requester) drainBody(body io.ReadCloser) {
 	if _, err := io.Copy(io.Discard, io.LimitReader(body, drainBodyLimit)); err != nil {
 		log.Errorf(log.RequestSys, "%s failed to drain request body %s", r.name, err)
@@ -21,6 +21,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/nonce"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 const unexpected = "unexpected values"
@@ -450,6 +453,51 @@ func TestDoRequest_Retries(t *testing.T) {
 	require.NoError(t, ec.Collect(), "Collect must return no errors")
 }
 
+func TestSendPayloadTracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+	TooManyRequestsBackoff = time.Millisecond
+	t.Cleanup(func() { TooManyRequestsBackoff = DefaultTooManyRequestsBackoff })
+
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if calls++; calls == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, err := io.WriteString(w, `{"response":true}`)
+		assert.NoError(t, err, "WriteString should not error")
+	}))
+	t.Cleanup(server.Close)
+
+	r, err := New("test", new(http.Client), WithLimiter(globalshell), WithBackoff(func(int) time.Duration { return time.Millisecond }))
+	require.NoError(t, err, "New requester must not error")
+	err = r.SendPayload(t.Context(), UnAuth, func() (*Item, error) {
+		return &Item{Method: http.MethodGet, Path: server.URL + "/test?signature=secret"}, nil
+	}, UnauthenticatedRequest)
+	require.NoError(t, err, "SendPayload must not error")
+
+	names := make([]string, 0, len(recorder.Ended()))
+	for _, s := range recorder.Ended() {
+		names = append(names, s.Name())
+		if s.Name() == "request.Attempt" {
+			for _, a := range s.Attributes() {
+				assert.NotContains(t, a.Value.Emit(), "secret", "span attributes should not contain the query string")
+			}
+		}
+	}
+	assert.Equal(t, []string{
+		"request.RateLimit", "request.Generate", "request.Attempt", "request.RetryBackoff",
+		"request.RateLimit", "request.Generate", "request.Attempt", "request.SendPayload",
+	}, names)
+	root := recorder.Ended()[len(names)-1]
+	for _, s := range recorder.Ended()[:len(names)-1] {
+		assert.Equal(t, root.SpanContext().SpanID(), s.Parent().SpanID(), "%s should be a child of request.SendPayload", s.Name())
+	}
+}
+
 func TestDoRequest_RetryNonRecoverable(t *testing.T) {
 	t.Parallel()
 
@@ -7,6 +7,8 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/common/timedmutex"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/nonce"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"golang.org/x/time/rate"
 )
 
 // Const vars for rate limiter
@@ -16,6 +18,10 @@ const (
 	drainBodyLimit          = 100000
 	proxyTLSTimeout         = 15 * time.Second
 	userAgent               = "User-Agent"
+
+	// Structured logging fields attached to request retries
+	attemptKey    log.Key = "attempt"
+	statusCodeKey log.Key = "status_code"
 )
 
 // Vars for rate limiter
@@ -37,6 +43,9 @@ type Requester struct {
 	backoff            Backoff
 	retryPolicy        RetryPolicy
 	timedLock          *timedmutex.TimedMutex
+	adaptive           adaptiveLimiter
+	shared             SharedLimiter
+	sharedKeys         map[*rate.Limiter]string
 }
 
 // Item is a temp item for requests
@@ -0,0 +1,98 @@
+package request
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"golang.org/x/time/rate"
+)
+
+var globalSharedLimiter SharedLimiter
+
+// SharedLimiter reserves rate limit tokens from a budget shared between
+// processes so that together they do not exceed exchange rate limits
+type SharedLimiter interface {
+	// Reserve reserves weight tokens from the bucket identified by key which
+	// allows a single token every interval, returning the delay before the
+	// reservation may be used. The reservation is not made and false is
+	// returned when the delay would exceed maxDelay
+	Reserve(ctx context.Context, key string, interval time.Duration, weight Weight, maxDelay time.Duration) (time.Duration, bool, error)
+}
+
+// SetupGlobalSharedLimiter sets a shared limiter to be used by all requesters
+// created afterwards
+func SetupGlobalSharedLimiter(s SharedLimiter) {
+	globalSharedLimiter = s
+}
+
+// WithSharedLimiter configures a shared limiter for a Requester
+func WithSharedLimiter(s SharedLimiter) RequesterOption {
+	return func(r *Requester) {
+		r.shared = s
+	}
+}
+
+// sharedBucketKeys names each rate limiter bucket by the exchange name and the
+// lowest endpoint sharing the bucket, so the same bucket has the same key in
+// every process
+func sharedBucketKeys(name string, defs RateLimitDefinitions) map[*rate.Limiter]string {
+	keys := make(map[*rate.Limiter]string)
+	for ep, l := range defs {
+		if l == nil {
+			continue
+		}
+		key := fmt.Sprintf("%s/%v", name, ep)
+		if existing, ok := keys[l.limiter]; !ok || key < existing {
+			keys[l.limiter] = key
+		}
+	}
+	return keys
+}
+
+// sharedRateLimit delays a request until its reservation from the shared
+// budget may be used. The local rate limiter is used if the shared limiter
+// errors
+func (r *Requester) sharedRateLimit(ctx context.Context, e EndpointLimit) error {
+	l := r.limiter[e]
+	if err := common.NilGuard(l); err != nil {
+		return err
+	}
+	key, ok := r.sharedKeys[l.limiter]
+	limit := l.limiter.Limit()
+	if !ok || l.weight == 0 || limit == rate.Inf || limit <= 0 {
+		return l.RateLimit(ctx)
+	}
+	interval := time.Duration(float64(time.Second) / float64(limit))
+
+	maxDelay := time.Duration(math.MaxInt64)
+	if hasDelayNotAllowed(ctx) {
+		maxDelay = 0
+	}
+	if dl, ok := ctx.Deadline(); ok {
+		maxDelay = min(maxDelay, time.Until(dl))
+	}
+	delay, reserved, err := r.shared.Reserve(ctx, key, interval, l.weight, maxDelay)
+	if err != nil {
+		log.Errorf(log.RequestSys, "%s shared rate limiter unable to reserve %s, using local rate limiter: %s", r.name, key, err)
+		return l.RateLimit(ctx)
+	}
+	if !reserved {
+		if maxDelay == 0 {
+			return ErrDelayNotAllowed
+		}
+		return fmt.Errorf("rate limit delay of %s will exceed deadline: %w", delay, context.DeadlineExceeded)
+	}
+	if delay <= 0 {
+		return nil
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(delay):
+		return nil
+	}
+}
@@ -0,0 +1,85 @@
+package request
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"testing/synctest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"golang.org/x/time/rate"
+)
+
+var errSharedLimiter = errors.New("shared limiter error")
+
+type testSharedLimiter struct {
+	m        sync.Mutex
+	delay    time.Duration
+	err      error
+	keys     []string
+	maxDelay time.Duration
+}
+
+func (s *testSharedLimiter) Reserve(_ context.Context, key string, _ time.Duration, _ Weight, maxDelay time.Duration) (time.Duration, bool, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.keys = append(s.keys, key)
+	s.maxDelay = maxDelay
+	if s.err != nil {
+		return 0, false, s.err
+	}
+	return s.delay, s.delay <= maxDelay, nil
+}
+
+func TestSharedBucketKeys(t *testing.T) {
+	t.Parallel()
+	shared := NewRateLimitWithWeight(time.Second, 10, 1)
+	defs := RateLimitDefinitions{
+		Auth:             shared,
+		UnAuth:           shared,
+		EndpointLimit(3): NewRateLimitWithWeight(time.Second, 5, 1),
+		EndpointLimit(4): nil,
+	}
+	keys := sharedBucketKeys("test", defs)
+	require.Len(t, keys, 2)
+	assert.Equal(t, "test/1", keys[shared.limiter], "bucket should be keyed by its lowest endpoint")
+	assert.Equal(t, "test/3", keys[defs[EndpointLimit(3)].limiter])
+}
+
+func TestSharedRateLimit(t *testing.T) {
+	t.Parallel()
+	synctest.Test(t, func(t *testing.T) { //nolint:thelper,nolintlint // false positive
+		s := &testSharedLimiter{delay: time.Second}
+		r, err := New("test", new(http.Client), WithLimiter(NewBasicRateLimit(time.Second, 10, 1)), WithSharedLimiter(s))
+		require.NoError(t, err, "New must not error")
+
+		start := time.Now()
+		require.NoError(t, r.InitiateRateLimit(t.Context(), Auth), "InitiateRateLimit must not error")
+		assert.Equal(t, time.Second, time.Since(start), "should wait for the shared reservation")
+		assert.Equal(t, []string{"test/0"}, s.keys)
+
+		err = r.InitiateRateLimit(WithDelayNotAllowed(t.Context()), Auth)
+		assert.ErrorIs(t, err, ErrDelayNotAllowed)
+		assert.Zero(t, s.maxDelay)
+
+		ctx, cancel := context.WithTimeout(t.Context(), time.Millisecond)
+		defer cancel()
+		assert.ErrorIs(t, r.InitiateRateLimit(ctx, Auth), context.DeadlineExceeded)
+
+		s.err = errSharedLimiter
+		require.NoError(t, r.InitiateRateLimit(t.Context(), Auth), "InitiateRateLimit must fall back to the local limiter")
+
+		r.limiter[Auth].limiter.SetLimit(rate.Inf)
+		s.keys = nil
+		require.NoError(t, r.InitiateRateLimit(t.Context(), Auth), "InitiateRateLimit must not error")
+		assert.Empty(t, s.keys, "unlimited endpoints should not use the shared limiter")
+
+		err = r.sharedRateLimit(t.Context(), 99)
+		assert.ErrorIs(t, err, common.ErrNilPointer)
+	})
+}
@@ -0,0 +1,115 @@
+package sharedlimit
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	// import sqlite3 driver
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+)
+
+// DefaultBusyTimeout is how long a reservation waits for another process
+// holding the store lock
+const DefaultBusyTimeout = 5 * time.Second
+
+var (
+	errPathUnset       = errors.New("shared rate limiter path unset")
+	errIntervalInvalid = errors.New("shared rate limiter interval must be positive")
+)
+
+// SQLiteStore is a request.SharedLimiter which keeps the rate limit budget in a
+// SQLite database so that bot processes on the same host share the budget.
+// Each bucket stores its theoretical arrival time, the time at which the next
+// token becomes available, and reservations are made in an immediate
+// transaction so only one process updates a bucket at a time
+type SQLiteStore struct {
+	db  *sql.DB
+	now func() time.Time
+}
+
+// NewSQLiteStore opens or creates the shared rate limit store at path
+func NewSQLiteStore(path string) (*SQLiteStore, error) {
+	if path == "" {
+		return nil, errPathUnset
+	}
+	q := url.Values{}
+	q.Set("_txlock", "immediate")
+	q.Set("_busy_timeout", fmt.Sprint(DefaultBusyTimeout.Milliseconds()))
+	q.Set("_journal_mode", "WAL")
+	db, err := sql.Open("sqlite3", "file:"+path+"?"+q.Encode())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := db.Exec(`CREATE TABLE IF NOT EXISTS rate_limit_buckets (
+		key TEXT PRIMARY KEY NOT NULL,
+		tat INTEGER NOT NULL
+	)`); err != nil {
+		return nil, errors.Join(fmt.Errorf("unable to create shared rate limit table: %w", err), db.Close())
+	}
+	return &SQLiteStore{db: db, now: time.Now}, nil
+}
+
+// Reserve reserves weight tokens from the bucket identified by key which
+// allows a single token every interval. The returned delay must elapse before
+// the request is sent. The reservation is not made and false is returned when
+// the delay would exceed maxDelay
+func (s *SQLiteStore) Reserve(ctx context.Context, key string, interval time.Duration, weight request.Weight, maxDelay time.Duration) (time.Duration, bool, error) {
+	if interval <= 0 {
+		return 0, false, errIntervalInvalid
+	}
+	if weight == 0 {
+		return 0, true, nil
+	}
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, false, err
+	}
+	delay, reserved, err := s.reserve(ctx, tx, key, interval, weight, maxDelay)
+	if err != nil {
+		return 0, false, errors.Join(err, tx.Rollback())
+	}
+	if !reserved {
+		return delay, false, tx.Rollback()
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, false, err
+	}
+	return delay, true, nil
+}
+
+// reserve updates the bucket's theoretical arrival time within tx
+func (s *SQLiteStore) reserve(ctx context.Context, tx *sql.Tx, key string, interval time.Duration, weight request.Weight, maxDelay time.Duration) (time.Duration, bool, error) {
+	now := s.now()
+	start := now
+	var tat int64
+	switch err := tx.QueryRowContext(ctx, `SELECT tat FROM rate_limit_buckets WHERE key = ?`, key).Scan(&tat); {
+	case errors.Is(err, sql.ErrNoRows):
+	case err != nil:
+		return 0, false, err
+	default:
+		if t := time.Unix(0, tat); t.After(now) {
+			start = t
+		}
+	}
+	// The request may be sent once its final token is available
+	delay := start.Sub(now) + time.Duration(weight-1)*interval
+	if delay > maxDelay {
+		return delay, false, nil
+	}
+	next := start.Add(time.Duration(weight) * interval)
+	if _, err := tx.ExecContext(ctx, `INSERT INTO rate_limit_buckets (key, tat) VALUES (?, ?)
+		ON CONFLICT(key) DO UPDATE SET tat = excluded.tat`, key, next.UnixNano()); err != nil {
+		return 0, false, err
+	}
+	return delay, true, nil
+}
+
+// Close closes the store
+func (s *SQLiteStore) Close() error {
+	return s.db.Close()
+}
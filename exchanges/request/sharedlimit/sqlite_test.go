@@ -0,0 +1,90 @@
+package sharedlimit
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+)
+
+var _ request.SharedLimiter = (*SQLiteStore)(nil)
+
+func newTestStore(t *testing.T, path string, now time.Time) *SQLiteStore {
+	t.Helper()
+	s, err := NewSQLiteStore(path)
+	require.NoError(t, err, "NewSQLiteStore must not error")
+	t.Cleanup(func() { assert.NoError(t, s.Close(), "Close should not error") })
+	s.now = func() time.Time { return now }
+	return s
+}
+
+func TestNewSQLiteStore(t *testing.T) {
+	t.Parallel()
+	_, err := NewSQLiteStore("")
+	require.ErrorIs(t, err, errPathUnset)
+
+	_, err = NewSQLiteStore(filepath.Join(t.TempDir(), "missing", "ratelimit.db"))
+	require.Error(t, err, "NewSQLiteStore must error when the directory does not exist")
+}
+
+func TestReserve(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	s := newTestStore(t, filepath.Join(t.TempDir(), "ratelimit.db"), now)
+
+	_, _, err := s.Reserve(t.Context(), "test", 0, 1, time.Second)
+	require.ErrorIs(t, err, errIntervalInvalid)
+
+	delay, reserved, err := s.Reserve(t.Context(), "test", time.Second, 0, 0)
+	require.NoError(t, err, "Reserve must not error")
+	assert.True(t, reserved, "zero weight should always be reserved")
+	assert.Zero(t, delay)
+
+	delay, reserved, err = s.Reserve(t.Context(), "test", time.Second, 1, 0)
+	require.NoError(t, err, "Reserve must not error")
+	assert.True(t, reserved, "first token should be reserved immediately")
+	assert.Zero(t, delay)
+
+	delay, reserved, err = s.Reserve(t.Context(), "test", time.Second, 1, 0)
+	require.NoError(t, err, "Reserve must not error")
+	assert.False(t, reserved, "reservation should not be made when the delay exceeds maxDelay")
+	assert.Equal(t, time.Second, delay)
+
+	delay, reserved, err = s.Reserve(t.Context(), "test", time.Second, 3, time.Minute)
+	require.NoError(t, err, "Reserve must not error")
+	assert.True(t, reserved)
+	assert.Equal(t, 3*time.Second, delay, "weighted request should wait for its final token")
+
+	delay, reserved, err = s.Reserve(t.Context(), "other", time.Second, 1, 0)
+	require.NoError(t, err, "Reserve must not error")
+	assert.True(t, reserved, "buckets should be independent")
+	assert.Zero(t, delay)
+
+	s.now = func() time.Time { return now.Add(time.Hour) }
+	delay, reserved, err = s.Reserve(t.Context(), "test", time.Second, 1, 0)
+	require.NoError(t, err, "Reserve must not error")
+	assert.True(t, reserved, "idle bucket should not accumulate tokens beyond a single request")
+	assert.Zero(t, delay)
+}
+
+func TestReserveSharedBetweenStores(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	path := filepath.Join(t.TempDir(), "ratelimit.db")
+	a := newTestStore(t, path, now)
+	b := newTestStore(t, path, now)
+
+	for i := range 4 {
+		s := a
+		if i%2 == 1 {
+			s = b
+		}
+		delay, reserved, err := s.Reserve(t.Context(), "test", time.Second, 1, time.Minute)
+		require.NoError(t, err, "Reserve must not error")
+		require.True(t, reserved, "Reserve must reserve")
+		assert.Equal(t, time.Duration(i)*time.Second, delay, "stores should draw from the same budget")
+	}
+}
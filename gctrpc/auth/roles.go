@@ -0,0 +1,152 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Role grants access to a group of gRPC methods
+type Role string
+
+// Roles which can be assigned to gRPC users
+const (
+	// RoleMarketData grants read-only access to market data and bot status
+	RoleMarketData Role = "marketdata"
+	// RoleTrading grants access to account balances, orders and positions
+	RoleTrading Role = "trading"
+	// RoleWithdrawals grants access to withdrawals and deposit addresses
+	RoleWithdrawals Role = "withdrawals"
+	// RoleAdmin grants access to all methods including bot and exchange
+	// management
+	RoleAdmin Role = "admin"
+)
+
+// ErrInvalidRole is returned when a role is not recognised
+var ErrInvalidRole = errors.New("invalid role")
+
+// methodRoles maps each gRPC method to the role required to call it. Methods
+// which are not listed require RoleAdmin
+var methodRoles = map[string]Role{
+	"GetInfo":                         RoleMarketData,
+	"GetSubsystems":                   RoleMarketData,
+	"GetRPCEndpoints":                 RoleMarketData,
+	"GetCommunicationRelayers":        RoleMarketData,
+	"GetExchanges":                    RoleMarketData,
+	"GetExchangeInfo":                 RoleMarketData,
+	"GetExchangePairs":                RoleMarketData,
+	"GetExchangeAssets":               RoleMarketData,
+	"GetTicker":                       RoleMarketData,
+	"GetTickers":                      RoleMarketData,
+	"GetTickerStream":                 RoleMarketData,
+	"GetExchangeTickerStream":         RoleMarketData,
+	"GetOrderbook":                    RoleMarketData,
+	"GetOrderbooks":                   RoleMarketData,
+	"GetOrderbookStream":              RoleMarketData,
+	"GetExchangeOrderbookStream":      RoleMarketData,
+	"GetOrderbookMovement":            RoleMarketData,
+	"GetOrderbookAmountByNominal":     RoleMarketData,
+	"GetOrderbookAmountByImpact":      RoleMarketData,
+	"GetForexProviders":               RoleMarketData,
+	"GetForexRates":                   RoleMarketData,
+	"GetHistoricCandles":              RoleMarketData,
+	"GetRecentTrades":                 RoleMarketData,
+	"GetHistoricTrades":               RoleMarketData,
+	"GetSavedTrades":                  RoleMarketData,
+	"FindMissingSavedCandleIntervals": RoleMarketData,
+	"FindMissingSavedTradeIntervals":  RoleMarketData,
+	"GetTechnicalAnalysis":            RoleMarketData,
+	"GetMarginRatesHistory":           RoleMarketData,
+	"GetFundingRates":                 RoleMarketData,
+	"GetLatestFundingRate":            RoleMarketData,
+	"GetOpenInterest":                 RoleMarketData,
+	"GetCurrencyTradeURL":             RoleMarketData,
+	"GetCompositePrices":              RoleMarketData,
+	"CurrencyStateGetAll":             RoleMarketData,
+	"CurrencyStateTrading":            RoleMarketData,
+	"CurrencyStateDeposit":            RoleMarketData,
+	"CurrencyStateWithdraw":           RoleMarketData,
+	"CurrencyStateTradingPair":        RoleMarketData,
+	"WebsocketGetInfo":                RoleMarketData,
+	"WebsocketGetSubscriptions":       RoleMarketData,
+	"GetDataHistoryJobDetails":        RoleMarketData,
+	"GetActiveDataHistoryJobs":        RoleMarketData,
+	"GetDataHistoryJobsBetween":       RoleMarketData,
+	"GetDataHistoryJobSummary":        RoleMarketData,
+	"GetDataQualityReports":           RoleMarketData,
+	"GetRateLimitStatus":              RoleMarketData,
+
+	"GetAccountBalances":         RoleTrading,
+	"UpdateAccountBalances":      RoleTrading,
+	"GetAccountBalancesStream":   RoleTrading,
+	"GetPortfolio":               RoleTrading,
+	"GetPortfolioSummary":        RoleTrading,
+	"GetOrders":                  RoleTrading,
+	"GetOrder":                   RoleTrading,
+	"GetManagedOrders":           RoleTrading,
+	"GetOrderUpdatesStream":      RoleTrading,
+	"GetFillsStream":             RoleTrading,
+	"SubmitOrder":                RoleTrading,
+	"SimulateOrder":              RoleTrading,
+	"WhaleBomb":                  RoleTrading,
+	"ModifyOrder":                RoleTrading,
+	"CancelOrder":                RoleTrading,
+	"CancelBatchOrders":          RoleTrading,
+	"CancelAllOrders":            RoleTrading,
+	"GetEvents":                  RoleTrading,
+	"AddEvent":                   RoleTrading,
+	"RemoveEvent":                RoleTrading,
+	"GetFuturesPositionsSummary": RoleTrading,
+	"GetFuturesPositionsOrders":  RoleTrading,
+	"GetManagedPosition":         RoleTrading,
+	"GetAllManagedPositions":     RoleTrading,
+	"GetCollateral":              RoleTrading,
+	"GetCollateralMode":          RoleTrading,
+	"SetCollateralMode":          RoleTrading,
+	"GetLeverage":                RoleTrading,
+	"SetLeverage":                RoleTrading,
+	"SetMarginType":              RoleTrading,
+	"ChangePositionMargin":       RoleTrading,
+
+	"GetCryptocurrencyDepositAddresses": RoleWithdrawals,
+	"GetCryptocurrencyDepositAddress":   RoleWithdrawals,
+	"GetAvailableTransferChains":        RoleWithdrawals,
+	"WithdrawFiatFunds":                 RoleWithdrawals,
+	"WithdrawCryptocurrencyFunds":       RoleWithdrawals,
+	"WithdrawalEventByID":               RoleWithdrawals,
+	"WithdrawalEventsByExchange":        RoleWithdrawals,
+	"WithdrawalEventsByDate":            RoleWithdrawals,
+	"GetPendingWithdrawals":             RoleWithdrawals,
+	"ApproveWithdrawal":                 RoleWithdrawals,
+	"RejectWithdrawal":                  RoleWithdrawals,
+}
+
+// ParseRole returns the role matching a string, ignoring case
+func ParseRole(s string) (Role, error) {
+	r := Role(strings.ToLower(s))
+	switch r {
+	case RoleMarketData, RoleTrading, RoleWithdrawals, RoleAdmin:
+		return r, nil
+	}
+	return "", fmt.Errorf("%w: %q", ErrInvalidRole, s)
+}
+
+// MethodRole returns the role required to call a gRPC method. Both full method
+// names e.g. /gctrpc.GoCryptoTraderService/GetTicker and method names are
+// accepted. Unrecognised methods require RoleAdmin
+func MethodRole(method string) Role {
+	if i := strings.LastIndexByte(method, '/'); i != -1 {
+		method = method[i+1:]
+	}
+	if r, ok := methodRoles[method]; ok {
+		return r
+	}
+	return RoleAdmin
+}
+
+// HasRole returns whether the roles grant access to methods requiring the
+// required role. RoleAdmin grants access to all methods
+func HasRole(roles []Role, required Role) bool {
+	return slices.Contains(roles, RoleAdmin) || slices.Contains(roles, required)
+}
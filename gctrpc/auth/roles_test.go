@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+)
+
+func TestMethodRolesExist(t *testing.T) {
+	t.Parallel()
+	methods := make(map[string]struct{})
+	for i := range gctrpc.GoCryptoTraderService_ServiceDesc.Methods {
+		methods[gctrpc.GoCryptoTraderService_ServiceDesc.Methods[i].MethodName] = struct{}{}
+	}
+	for i := range gctrpc.GoCryptoTraderService_ServiceDesc.Streams {
+		methods[gctrpc.GoCryptoTraderService_ServiceDesc.Streams[i].StreamName] = struct{}{}
+	}
+	for m := range methodRoles {
+		assert.Containsf(t, methods, m, "method role for %s must match a gRPC method", m)
+	}
+}
+
+func TestParseRole(t *testing.T) {
+	t.Parallel()
+	for _, r := range []Role{RoleMarketData, RoleTrading, RoleWithdrawals, RoleAdmin} {
+		got, err := ParseRole(string(r))
+		require.NoError(t, err)
+		assert.Equal(t, r, got)
+	}
+	got, err := ParseRole("Trading")
+	require.NoError(t, err)
+	assert.Equal(t, RoleTrading, got)
+	_, err = ParseRole("superuser")
+	assert.ErrorIs(t, err, ErrInvalidRole)
+}
+
+func TestMethodRole(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, RoleMarketData, MethodRole(gctrpc.GoCryptoTraderService_GetTicker_FullMethodName))
+	assert.Equal(t, RoleTrading, MethodRole("SubmitOrder"))
+	assert.Equal(t, RoleWithdrawals, MethodRole(gctrpc.GoCryptoTraderService_WithdrawCryptocurrencyFunds_FullMethodName))
+	assert.Equal(t, RoleAdmin, MethodRole(gctrpc.GoCryptoTraderService_DisableSubsystem_FullMethodName))
+	assert.Equal(t, RoleAdmin, MethodRole("/gctrpc.GoCryptoTraderService/NotARealMethod"), "unknown methods must require admin")
+}
+
+func TestHasRole(t *testing.T) {
+	t.Parallel()
+	assert.True(t, HasRole([]Role{RoleMarketData}, RoleMarketData))
+	assert.False(t, HasRole([]Role{RoleMarketData}, RoleTrading))
+	assert.False(t, HasRole([]Role{RoleTrading}, RoleAdmin))
+	assert.True(t, HasRole([]Role{RoleAdmin}, RoleWithdrawals))
+	assert.False(t, HasRole(nil, RoleMarketData))
+}
+
+func TestTokenAuth(t *testing.T) {
+	t.Parallel()
+	token, hash, err := GenerateToken()
+	require.NoError(t, err)
+	assert.Len(t, token, 64)
+	assert.Equal(t, HashToken(token), hash)
+	assert.NotEqual(t, token, hash)
+
+	md, err := TokenAuth{Token: token}.GetRequestMetadata(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer "+token, md["authorization"])
+	assert.True(t, TokenAuth{}.RequireTransportSecurity())
+}
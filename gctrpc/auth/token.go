@@ -0,0 +1,44 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+const tokenBytes = 32
+
+// TokenAuth stores a gRPC API token which is sent as a bearer token
+type TokenAuth struct {
+	Token string
+}
+
+// GetRequestMetadata is a implementation of the GetRequestMetadata function
+func (t TokenAuth) GetRequestMetadata(_ context.Context, _ ...string) (map[string]string, error) {
+	return map[string]string{
+		"authorization": "Bearer " + t.Token,
+	}, nil
+}
+
+// RequireTransportSecurity is required for token auth
+func (TokenAuth) RequireTransportSecurity() bool {
+	return true
+}
+
+// GenerateToken returns a new random API token and its hash for storing in
+// config. The token itself is not stored by the server
+func GenerateToken() (token, hash string, err error) {
+	b := make([]byte, tokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	token = hex.EncodeToString(b)
+	return token, HashToken(token), nil
+}
+
+// HashToken returns the hex encoded SHA-256 hash of an API token
+func HashToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
@@ -3059,6 +3059,7 @@ type OrderDetails struct {
 	Cost           float64                `protobuf:"fixed64,16,opt,name=cost,proto3" json:"cost,omitempty"`
 	Trades         []*TradeHistory        `protobuf:"bytes,17,rep,name=trades,proto3" json:"trades,omitempty"`
 	ContractAmount float64                `protobuf:"fixed64,18,opt,name=contract_amount,json=contractAmount,proto3" json:"contract_amount,omitempty"`
+	Account        string                 `protobuf:"bytes,19,opt,name=account,proto3" json:"account,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -3219,6 +3220,13 @@ func (x *OrderDetails) GetContractAmount() float64 {
 	return 0
 }
 
+func (x *OrderDetails) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
 type TradeHistory struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	CreationTime  int64                  `protobuf:"varint,1,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
@@ -6996,6 +7004,7 @@ type GetHistoricCandlesRequest struct {
 	UseDb                 bool                   `protobuf:"varint,9,opt,name=use_db,json=useDb,proto3" json:"use_db,omitempty"`
 	FillMissingWithTrades bool                   `protobuf:"varint,10,opt,name=fill_missing_with_trades,json=fillMissingWithTrades,proto3" json:"fill_missing_with_trades,omitempty"`
 	Force                 bool                   `protobuf:"varint,11,opt,name=force,proto3" json:"force,omitempty"`
+	UseCache              bool                   `protobuf:"varint,12,opt,name=use_cache,json=useCache,proto3" json:"use_cache,omitempty"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -7107,6 +7116,13 @@ func (x *GetHistoricCandlesRequest) GetForce() bool {
 	return false
 }
 
+func (x *GetHistoricCandlesRequest) GetUseCache() bool {
+	if x != nil {
+		return x.UseCache
+	}
+	return false
+}
+
 type GetHistoricCandlesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
@@ -10864,6 +10880,7 @@ type FuturePosition struct {
 	Orders                 []*OrderDetails        `protobuf:"bytes,17,rep,name=orders,proto3" json:"orders,omitempty"`
 	PositionStats          *FuturesPositionStats  `protobuf:"bytes,18,opt,name=position_stats,json=positionStats,proto3" json:"position_stats,omitempty"`
 	FundingData            *FundingData           `protobuf:"bytes,19,opt,name=funding_data,json=fundingData,proto3" json:"funding_data,omitempty"`
+	Account                string                 `protobuf:"bytes,20,opt,name=account,proto3" json:"account,omitempty"`
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
@@ -11031,6 +11048,13 @@ func (x *FuturePosition) GetFundingData() *FundingData {
 	return nil
 }
 
+func (x *FuturePosition) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
 type GetManagedPositionRequest struct {
 	state                   protoimpl.MessageState `protogen:"open.v1"`
 	Exchange                string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
@@ -15095,247 +15119,1875 @@ func (x *GetCurrencyTradeURLResponse) GetUrl() string {
 	return ""
 }
 
-var File_rpc_proto protoreflect.FileDescriptor
+type DataQualityCheckRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Exchange           string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset              string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair               *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Interval           int64                  `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	Start              string                 `protobuf:"bytes,5,opt,name=start,proto3" json:"start,omitempty"`
+	End                string                 `protobuf:"bytes,6,opt,name=end,proto3" json:"end,omitempty"`
+	SecondaryExchanges []string               `protobuf:"bytes,7,rep,name=secondary_exchanges,json=secondaryExchanges,proto3" json:"secondary_exchanges,omitempty"`
+	IncludeTrades      bool                   `protobuf:"varint,8,opt,name=include_trades,json=includeTrades,proto3" json:"include_trades,omitempty"`
+	CreateRepairJobs   bool                   `protobuf:"varint,9,opt,name=create_repair_jobs,json=createRepairJobs,proto3" json:"create_repair_jobs,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
 
-const file_rpc_proto_rawDesc = "" +
-	"\n" +
-	"\trpc.proto\x12\x06gctrpc\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x10\n" +
-	"\x0eGetInfoRequest\"\xb4\x04\n" +
-	"\x0fGetInfoResponse\x12\x16\n" +
-	"\x06uptime\x18\x01 \x01(\tR\x06uptime\x12/\n" +
-	"\x13available_exchanges\x18\x02 \x01(\x03R\x12availableExchanges\x12+\n" +
-	"\x11enabled_exchanges\x18\x03 \x01(\x03R\x10enabledExchanges\x124\n" +
-	"\x16default_forex_provider\x18\x04 \x01(\tR\x14defaultForexProvider\x122\n" +
-	"\x15default_fiat_currency\x18\x05 \x01(\tR\x13defaultFiatCurrency\x12W\n" +
-	"\x10subsystem_status\x18\x06 \x03(\v2,.gctrpc.GetInfoResponse.SubsystemStatusEntryR\x0fsubsystemStatus\x12N\n" +
-	"\rrpc_endpoints\x18\a \x03(\v2).gctrpc.GetInfoResponse.RpcEndpointsEntryR\frpcEndpoints\x1aB\n" +
-	"\x14SubsystemStatusEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\x1aT\n" +
-	"\x11RpcEndpointsEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
-	"\x05value\x18\x02 \x01(\v2\x13.gctrpc.RPCEndpointR\x05value:\x028\x01\"!\n" +
-	"\x1fGetCommunicationRelayersRequest\"N\n" +
-	"\x14CommunicationRelayer\x12\x18\n" +
-	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1c\n" +
-	"\tconnected\x18\x02 \x01(\bR\tconnected\"\x86\x02\n" +
-	" GetCommunicationRelayersResponse\x12z\n" +
-	"\x16communication_relayers\x18\x01 \x03(\v2C.gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntryR\x15communicationRelayers\x1af\n" +
-	"\x1aCommunicationRelayersEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x122\n" +
-	"\x05value\x18\x02 \x01(\v2\x1c.gctrpc.CommunicationRelayerR\x05value:\x028\x01\"7\n" +
-	"\x17GenericSubsystemRequest\x12\x1c\n" +
-	"\tsubsystem\x18\x01 \x01(\tR\tsubsystem\"\x16\n" +
-	"\x14GetSubsystemsRequest\"\xbe\x01\n" +
-	"\x15GetSubsystemsResponse\x12`\n" +
-	"\x11subsystems_status\x18\x01 \x03(\v23.gctrpc.GetSubsystemsResponse.SubsystemsStatusEntryR\x10subsystemsStatus\x1aC\n" +
-	"\x15SubsystemsStatusEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\"\x18\n" +
-	"\x16GetRPCEndpointsRequest\"N\n" +
-	"\vRPCEndpoint\x12\x18\n" +
-	"\astarted\x18\x01 \x01(\bR\astarted\x12%\n" +
-	"\x0elisten_address\x18\x02 \x01(\tR\rlistenAddress\"\xba\x01\n" +
-	"\x17GetRPCEndpointsResponse\x12L\n" +
-	"\tendpoints\x18\x01 \x03(\v2..gctrpc.GetRPCEndpointsResponse.EndpointsEntryR\tendpoints\x1aQ\n" +
-	"\x0eEndpointsEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
-	"\x05value\x18\x02 \x01(\v2\x13.gctrpc.RPCEndpointR\x05value:\x028\x01\"8\n" +
-	"\x1aGenericExchangeNameRequest\x12\x1a\n" +
-	"\bexchange\x18\x01 \x01(\tR\bexchange\"/\n" +
-	"\x13GetExchangesRequest\x12\x18\n" +
-	"\aenabled\x18\x01 \x01(\bR\aenabled\"4\n" +
-	"\x14GetExchangesResponse\x12\x1c\n" +
-	"\texchanges\x18\x01 \x01(\tR\texchanges\"3\n" +
-	"\x16GetExchangeOTPResponse\x12\x19\n" +
-	"\botp_code\x18\x01 \x01(\tR\aotpCode\"\x18\n" +
-	"\x16GetExchangeOTPsRequest\"\xa2\x01\n" +
-	"\x17GetExchangeOTPsResponse\x12J\n" +
-	"\totp_codes\x18\x01 \x03(\v2-.gctrpc.GetExchangeOTPsResponse.OtpCodesEntryR\botpCodes\x1a;\n" +
-	"\rOtpCodesEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"4\n" +
-	"\x16DisableExchangeRequest\x12\x1a\n" +
-	"\bexchange\x18\x01 \x01(\tR\bexchange\"^\n" +
-	"\x0ePairsSupported\x12'\n" +
-	"\x0favailable_pairs\x18\x01 \x01(\tR\x0eavailablePairs\x12#\n" +
-	"\renabled_pairs\x18\x02 \x01(\tR\fenabledPairs\"\x82\x04\n" +
-	"\x17GetExchangeInfoResponse\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
-	"\aenabled\x18\x02 \x01(\bR\aenabled\x12\x18\n" +
-	"\averbose\x18\x03 \x01(\bR\averbose\x12#\n" +
-	"\rusing_sandbox\x18\x04 \x01(\bR\fusingSandbox\x12!\n" +
-	"\fhttp_timeout\x18\x05 \x01(\tR\vhttpTimeout\x12%\n" +
-	"\x0ehttp_useragent\x18\x06 \x01(\tR\rhttpUseragent\x12\x1d\n" +
-	"\n" +
-	"http_proxy\x18\a \x01(\tR\thttpProxy\x12'\n" +
-	"\x0fbase_currencies\x18\b \x01(\tR\x0ebaseCurrencies\x12_\n" +
-	"\x10supported_assets\x18\t \x03(\v24.gctrpc.GetExchangeInfoResponse.SupportedAssetsEntryR\x0fsupportedAssets\x12+\n" +
-	"\x11authenticated_api\x18\n" +
-	" \x01(\bR\x10authenticatedApi\x1aZ\n" +
-	"\x14SupportedAssetsEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
-	"\x05value\x18\x02 \x01(\v2\x16.gctrpc.PairsSupportedR\x05value:\x028\x01\"w\n" +
-	"\x10GetTickerRequest\x12\x1a\n" +
-	"\bexchange\x18\x01 \x01(\tR\bexchange\x12(\n" +
-	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
-	"\n" +
-	"asset_type\x18\x03 \x01(\tR\tassetType\"V\n" +
-	"\fCurrencyPair\x12\x1c\n" +
-	"\tdelimiter\x18\x01 \x01(\tR\tdelimiter\x12\x12\n" +
-	"\x04base\x18\x02 \x01(\tR\x04base\x12\x14\n" +
-	"\x05quote\x18\x03 \x01(\tR\x05quote\"\x95\x02\n" +
-	"\x0eTickerResponse\x12(\n" +
-	"\x04pair\x18\x01 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12!\n" +
-	"\flast_updated\x18\x02 \x01(\x03R\vlastUpdated\x12#\n" +
-	"\rcurrency_pair\x18\x03 \x01(\tR\fcurrencyPair\x12\x12\n" +
-	"\x04last\x18\x04 \x01(\x01R\x04last\x12\x12\n" +
-	"\x04high\x18\x05 \x01(\x01R\x04high\x12\x10\n" +
-	"\x03low\x18\x06 \x01(\x01R\x03low\x12\x10\n" +
-	"\x03bid\x18\a \x01(\x01R\x03bid\x12\x10\n" +
-	"\x03ask\x18\b \x01(\x01R\x03ask\x12\x16\n" +
-	"\x06volume\x18\t \x01(\x01R\x06volume\x12\x1b\n" +
-	"\tprice_ath\x18\n" +
-	" \x01(\x01R\bpriceAth\"\x13\n" +
-	"\x11GetTickersRequest\"W\n" +
-	"\aTickers\x12\x1a\n" +
-	"\bexchange\x18\x01 \x01(\tR\bexchange\x120\n" +
-	"\atickers\x18\x02 \x03(\v2\x16.gctrpc.TickerResponseR\atickers\"?\n" +
-	"\x12GetTickersResponse\x12)\n" +
-	"\atickers\x18\x01 \x03(\v2\x0f.gctrpc.TickersR\atickers\"z\n" +
-	"\x13GetOrderbookRequest\x12\x1a\n" +
-	"\bexchange\x18\x01 \x01(\tR\bexchange\x12(\n" +
-	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
-	"\n" +
-	"asset_type\x18\x03 \x01(\tR\tassetType\"M\n" +
-	"\rOrderbookItem\x12\x16\n" +
-	"\x06amount\x18\x01 \x01(\x01R\x06amount\x12\x14\n" +
-	"\x05price\x18\x02 \x01(\x01R\x05price\x12\x0e\n" +
-	"\x02id\x18\x03 \x01(\x03R\x02id\"\x90\x02\n" +
-	"\x11OrderbookResponse\x12(\n" +
-	"\x04pair\x18\x01 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12#\n" +
-	"\rcurrency_pair\x18\x02 \x01(\tR\fcurrencyPair\x12)\n" +
-	"\x04bids\x18\x03 \x03(\v2\x15.gctrpc.OrderbookItemR\x04bids\x12)\n" +
-	"\x04asks\x18\x04 \x03(\v2\x15.gctrpc.OrderbookItemR\x04asks\x12!\n" +
-	"\flast_updated\x18\x05 \x01(\x03R\vlastUpdated\x12\x1d\n" +
-	"\n" +
-	"asset_type\x18\x06 \x01(\tR\tassetType\x12\x14\n" +
-	"\x05error\x18\a \x01(\tR\x05error\"\x16\n" +
-	"\x14GetOrderbooksRequest\"c\n" +
-	"\n" +
-	"Orderbooks\x12\x1a\n" +
-	"\bexchange\x18\x01 \x01(\tR\bexchange\x129\n" +
-	"\n" +
-	"orderbooks\x18\x02 \x03(\v2\x19.gctrpc.OrderbookResponseR\n" +
-	"orderbooks\"K\n" +
-	"\x15GetOrderbooksResponse\x122\n" +
-	"\n" +
-	"orderbooks\x18\x01 \x03(\v2\x12.gctrpc.OrderbooksR\n" +
-	"orderbooks\"V\n" +
-	"\x19GetAccountBalancesRequest\x12\x1a\n" +
-	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x1d\n" +
-	"\n" +
-	"asset_type\x18\x02 \x01(\tR\tassetType\"V\n" +
-	"\aAccount\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12;\n" +
-	"\n" +
-	"currencies\x18\x02 \x03(\v2\x1b.gctrpc.AccountCurrencyInfoR\n" +
-	"currencies\"\x81\x02\n" +
-	"\x13AccountCurrencyInfo\x12\x1a\n" +
-	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x1f\n" +
-	"\vtotal_value\x18\x02 \x01(\x01R\n" +
-	"totalValue\x12\x12\n" +
-	"\x04hold\x18\x03 \x01(\x01R\x04hold\x12\x12\n" +
-	"\x04free\x18\x04 \x01(\x01R\x04free\x12.\n" +
-	"\x13free_without_borrow\x18\x05 \x01(\x01R\x11freeWithoutBorrow\x12\x1a\n" +
-	"\bborrowed\x18\x06 \x01(\x01R\bborrowed\x129\n" +
-	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"e\n" +
-	"\x1aGetAccountBalancesResponse\x12\x1a\n" +
-	"\bexchange\x18\x01 \x01(\tR\bexchange\x12+\n" +
-	"\baccounts\x18\x02 \x03(\v2\x0f.gctrpc.AccountR\baccounts\"\x12\n" +
-	"\x10GetConfigRequest\"'\n" +
-	"\x11GetConfigResponse\x12\x12\n" +
-	"\x04data\x18\x01 \x01(\fR\x04data\"\x85\x01\n" +
-	"\x10PortfolioAddress\x12\x18\n" +
-	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x1b\n" +
-	"\tcoin_type\x18\x02 \x01(\tR\bcoinType\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
-	"\abalance\x18\x04 \x01(\x01R\abalance\"\x15\n" +
-	"\x13GetPortfolioRequest\"N\n" +
-	"\x14GetPortfolioResponse\x126\n" +
-	"\tportfolio\x18\x01 \x03(\v2\x18.gctrpc.PortfolioAddressR\tportfolio\"\x1c\n" +
-	"\x1aGetPortfolioSummaryRequest\"n\n" +
-	"\x04Coin\x12\x12\n" +
-	"\x04coin\x18\x01 \x01(\tR\x04coin\x12\x18\n" +
-	"\abalance\x18\x02 \x01(\x01R\abalance\x12\x18\n" +
-	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\x1e\n" +
-	"\n" +
-	"percentage\x18\x04 \x01(\x01R\n" +
-	"percentage\"h\n" +
-	"\x12OfflineCoinSummary\x12\x18\n" +
-	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x18\n" +
-	"\abalance\x18\x02 \x01(\x01R\abalance\x12\x1e\n" +
-	"\n" +
-	"percentage\x18\x03 \x01(\x01R\n" +
-	"percentage\"M\n" +
-	"\x11OnlineCoinSummary\x12\x18\n" +
-	"\abalance\x18\x01 \x01(\x01R\abalance\x12\x1e\n" +
-	"\n" +
-	"percentage\x18\x02 \x01(\x01R\n" +
-	"percentage\"H\n" +
-	"\fOfflineCoins\x128\n" +
-	"\taddresses\x18\x01 \x03(\v2\x1a.gctrpc.OfflineCoinSummaryR\taddresses\"\x98\x01\n" +
-	"\vOnlineCoins\x124\n" +
-	"\x05coins\x18\x01 \x03(\v2\x1e.gctrpc.OnlineCoins.CoinsEntryR\x05coins\x1aS\n" +
-	"\n" +
-	"CoinsEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12/\n" +
-	"\x05value\x18\x02 \x01(\v2\x19.gctrpc.OnlineCoinSummaryR\x05value:\x028\x01\"\xcb\x04\n" +
-	"\x1bGetPortfolioSummaryResponse\x12-\n" +
-	"\vcoin_totals\x18\x01 \x03(\v2\f.gctrpc.CoinR\n" +
-	"coinTotals\x121\n" +
-	"\rcoins_offline\x18\x02 \x03(\v2\f.gctrpc.CoinR\fcoinsOffline\x12p\n" +
-	"\x15coins_offline_summary\x18\x03 \x03(\v2<.gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntryR\x13coinsOfflineSummary\x12/\n" +
-	"\fcoins_online\x18\x04 \x03(\v2\f.gctrpc.CoinR\vcoinsOnline\x12m\n" +
-	"\x14coins_online_summary\x18\x05 \x03(\v2;.gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntryR\x12coinsOnlineSummary\x1a\\\n" +
-	"\x18CoinsOfflineSummaryEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12*\n" +
-	"\x05value\x18\x02 \x01(\v2\x14.gctrpc.OfflineCoinsR\x05value:\x028\x01\x1aZ\n" +
-	"\x17CoinsOnlineSummaryEntry\x12\x10\n" +
-	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
-	"\x05value\x18\x02 \x01(\v2\x13.gctrpc.OnlineCoinsR\x05value:\x028\x01\"\xe3\x01\n" +
-	"\x1aAddPortfolioAddressRequest\x12\x18\n" +
-	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x1b\n" +
-	"\tcoin_type\x18\x02 \x01(\tR\bcoinType\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
-	"\abalance\x18\x04 \x01(\x01R\abalance\x12/\n" +
-	"\x13supported_exchanges\x18\x05 \x01(\tR\x12supportedExchanges\x12!\n" +
-	"\fcold_storage\x18\x06 \x01(\bR\vcoldStorage\"x\n" +
-	"\x1dRemovePortfolioAddressRequest\x12\x18\n" +
-	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x1b\n" +
-	"\tcoin_type\x18\x02 \x01(\tR\bcoinType\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\"\x1a\n" +
-	"\x18GetForexProvidersRequest\"\xed\x01\n" +
-	"\rForexProvider\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
-	"\aenabled\x18\x02 \x01(\bR\aenabled\x12\x18\n" +
-	"\averbose\x18\x03 \x01(\bR\averbose\x12,\n" +
-	"\x12rest_polling_delay\x18\x04 \x01(\tR\x10restPollingDelay\x12\x17\n" +
-	"\aapi_key\x18\x05 \x01(\tR\x06apiKey\x12\"\n" +
-	"\rapi_key_level\x18\x06 \x01(\x03R\vapiKeyLevel\x12)\n" +
-	"\x10primary_provider\x18\a \x01(\bR\x0fprimaryProvider\"[\n" +
-	"\x19GetForexProvidersResponse\x12>\n" +
-	"\x0fforex_providers\x18\x01 \x03(\v2\x15.gctrpc.ForexProviderR\x0eforexProviders\"\x16\n" +
-	"\x14GetForexRatesRequest\"q\n" +
-	"\x14ForexRatesConversion\x12\x12\n" +
-	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+func (x *DataQualityCheckRequest) Reset() {
+	*x = DataQualityCheckRequest{}
+	mi := &file_rpc_proto_msgTypes[226]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataQualityCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataQualityCheckRequest) ProtoMessage() {}
+
+func (x *DataQualityCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[226]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataQualityCheckRequest.ProtoReflect.Descriptor instead.
+func (*DataQualityCheckRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{226}
+}
+
+func (x *DataQualityCheckRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *DataQualityCheckRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *DataQualityCheckRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *DataQualityCheckRequest) GetInterval() int64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *DataQualityCheckRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *DataQualityCheckRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *DataQualityCheckRequest) GetSecondaryExchanges() []string {
+	if x != nil {
+		return x.SecondaryExchanges
+	}
+	return nil
+}
+
+func (x *DataQualityCheckRequest) GetIncludeTrades() bool {
+	if x != nil {
+		return x.IncludeTrades
+	}
+	return false
+}
+
+func (x *DataQualityCheckRequest) GetCreateRepairJobs() bool {
+	if x != nil {
+		return x.CreateRepairJobs
+	}
+	return false
+}
+
+type DataQualityIssue struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Type              string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Start             string                 `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End               string                 `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	Description       string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	SecondaryExchange string                 `protobuf:"bytes,5,opt,name=secondary_exchange,json=secondaryExchange,proto3" json:"secondary_exchange,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DataQualityIssue) Reset() {
+	*x = DataQualityIssue{}
+	mi := &file_rpc_proto_msgTypes[227]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataQualityIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataQualityIssue) ProtoMessage() {}
+
+func (x *DataQualityIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[227]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataQualityIssue.ProtoReflect.Descriptor instead.
+func (*DataQualityIssue) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{227}
+}
+
+func (x *DataQualityIssue) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *DataQualityIssue) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *DataQualityIssue) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *DataQualityIssue) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DataQualityIssue) GetSecondaryExchange() string {
+	if x != nil {
+		return x.SecondaryExchange
+	}
+	return ""
+}
+
+type DataQualityReport struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Exchange       string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset          string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair           *CurrencyPair          `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
+	Interval       int64                  `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	Start          string                 `protobuf:"bytes,6,opt,name=start,proto3" json:"start,omitempty"`
+	End            string                 `protobuf:"bytes,7,opt,name=end,proto3" json:"end,omitempty"`
+	Created        string                 `protobuf:"bytes,8,opt,name=created,proto3" json:"created,omitempty"`
+	CandlesChecked int64                  `protobuf:"varint,9,opt,name=candles_checked,json=candlesChecked,proto3" json:"candles_checked,omitempty"`
+	TradesChecked  int64                  `protobuf:"varint,10,opt,name=trades_checked,json=tradesChecked,proto3" json:"trades_checked,omitempty"`
+	IssueSummary   map[string]int64       `protobuf:"bytes,11,rep,name=issue_summary,json=issueSummary,proto3" json:"issue_summary,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Issues         []*DataQualityIssue    `protobuf:"bytes,12,rep,name=issues,proto3" json:"issues,omitempty"`
+	RepairJobs     []string               `protobuf:"bytes,13,rep,name=repair_jobs,json=repairJobs,proto3" json:"repair_jobs,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DataQualityReport) Reset() {
+	*x = DataQualityReport{}
+	mi := &file_rpc_proto_msgTypes[228]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataQualityReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataQualityReport) ProtoMessage() {}
+
+func (x *DataQualityReport) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[228]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataQualityReport.ProtoReflect.Descriptor instead.
+func (*DataQualityReport) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{228}
+}
+
+func (x *DataQualityReport) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DataQualityReport) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *DataQualityReport) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *DataQualityReport) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *DataQualityReport) GetInterval() int64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *DataQualityReport) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *DataQualityReport) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *DataQualityReport) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+func (x *DataQualityReport) GetCandlesChecked() int64 {
+	if x != nil {
+		return x.CandlesChecked
+	}
+	return 0
+}
+
+func (x *DataQualityReport) GetTradesChecked() int64 {
+	if x != nil {
+		return x.TradesChecked
+	}
+	return 0
+}
+
+func (x *DataQualityReport) GetIssueSummary() map[string]int64 {
+	if x != nil {
+		return x.IssueSummary
+	}
+	return nil
+}
+
+func (x *DataQualityReport) GetIssues() []*DataQualityIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *DataQualityReport) GetRepairJobs() []string {
+	if x != nil {
+		return x.RepairJobs
+	}
+	return nil
+}
+
+type GetDataQualityReportsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Exchange      string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
+	IncludeIssues bool                   `protobuf:"varint,5,opt,name=include_issues,json=includeIssues,proto3" json:"include_issues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDataQualityReportsRequest) Reset() {
+	*x = GetDataQualityReportsRequest{}
+	mi := &file_rpc_proto_msgTypes[229]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDataQualityReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataQualityReportsRequest) ProtoMessage() {}
+
+func (x *GetDataQualityReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[229]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataQualityReportsRequest.ProtoReflect.Descriptor instead.
+func (*GetDataQualityReportsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{229}
+}
+
+func (x *GetDataQualityReportsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetDataQualityReportsRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetDataQualityReportsRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetDataQualityReportsRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *GetDataQualityReportsRequest) GetIncludeIssues() bool {
+	if x != nil {
+		return x.IncludeIssues
+	}
+	return false
+}
+
+type GetDataQualityReportsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reports       []*DataQualityReport   `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDataQualityReportsResponse) Reset() {
+	*x = GetDataQualityReportsResponse{}
+	mi := &file_rpc_proto_msgTypes[230]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDataQualityReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataQualityReportsResponse) ProtoMessage() {}
+
+func (x *GetDataQualityReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[230]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataQualityReportsResponse.ProtoReflect.Descriptor instead.
+func (*GetDataQualityReportsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{230}
+}
+
+func (x *GetDataQualityReportsResponse) GetReports() []*DataQualityReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+type GetCompositePricesRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Asset               string                 `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	IncludeConstituents bool                   `protobuf:"varint,3,opt,name=include_constituents,json=includeConstituents,proto3" json:"include_constituents,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetCompositePricesRequest) Reset() {
+	*x = GetCompositePricesRequest{}
+	mi := &file_rpc_proto_msgTypes[231]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompositePricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompositePricesRequest) ProtoMessage() {}
+
+func (x *GetCompositePricesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[231]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompositePricesRequest.ProtoReflect.Descriptor instead.
+func (*GetCompositePricesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{231}
+}
+
+func (x *GetCompositePricesRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetCompositePricesRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *GetCompositePricesRequest) GetIncludeConstituents() bool {
+	if x != nil {
+		return x.IncludeConstituents
+	}
+	return false
+}
+
+type CompositePriceConstituent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair           *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	Price          float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	ConvertedPrice float64                `protobuf:"fixed64,4,opt,name=converted_price,json=convertedPrice,proto3" json:"converted_price,omitempty"`
+	ConversionRate float64                `protobuf:"fixed64,5,opt,name=conversion_rate,json=conversionRate,proto3" json:"conversion_rate,omitempty"`
+	Volume         float64                `protobuf:"fixed64,6,opt,name=volume,proto3" json:"volume,omitempty"`
+	Excluded       bool                   `protobuf:"varint,7,opt,name=excluded,proto3" json:"excluded,omitempty"`
+	LastUpdated    string                 `protobuf:"bytes,8,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CompositePriceConstituent) Reset() {
+	*x = CompositePriceConstituent{}
+	mi := &file_rpc_proto_msgTypes[232]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompositePriceConstituent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompositePriceConstituent) ProtoMessage() {}
+
+func (x *CompositePriceConstituent) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[232]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompositePriceConstituent.ProtoReflect.Descriptor instead.
+func (*CompositePriceConstituent) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{232}
+}
+
+func (x *CompositePriceConstituent) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *CompositePriceConstituent) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *CompositePriceConstituent) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CompositePriceConstituent) GetConvertedPrice() float64 {
+	if x != nil {
+		return x.ConvertedPrice
+	}
+	return 0
+}
+
+func (x *CompositePriceConstituent) GetConversionRate() float64 {
+	if x != nil {
+		return x.ConversionRate
+	}
+	return 0
+}
+
+func (x *CompositePriceConstituent) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *CompositePriceConstituent) GetExcluded() bool {
+	if x != nil {
+		return x.Excluded
+	}
+	return false
+}
+
+func (x *CompositePriceConstituent) GetLastUpdated() string {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return ""
+}
+
+type CompositePrice struct {
+	state               protoimpl.MessageState       `protogen:"open.v1"`
+	Exchange            string                       `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset               string                       `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                *CurrencyPair                `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	VolumeWeightedPrice float64                      `protobuf:"fixed64,4,opt,name=volume_weighted_price,json=volumeWeightedPrice,proto3" json:"volume_weighted_price,omitempty"`
+	MedianPrice         float64                      `protobuf:"fixed64,5,opt,name=median_price,json=medianPrice,proto3" json:"median_price,omitempty"`
+	FilteredPrice       float64                      `protobuf:"fixed64,6,opt,name=filtered_price,json=filteredPrice,proto3" json:"filtered_price,omitempty"`
+	Bid                 float64                      `protobuf:"fixed64,7,opt,name=bid,proto3" json:"bid,omitempty"`
+	Ask                 float64                      `protobuf:"fixed64,8,opt,name=ask,proto3" json:"ask,omitempty"`
+	TotalVolume         float64                      `protobuf:"fixed64,9,opt,name=total_volume,json=totalVolume,proto3" json:"total_volume,omitempty"`
+	LastUpdated         string                       `protobuf:"bytes,10,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	Constituents        []*CompositePriceConstituent `protobuf:"bytes,11,rep,name=constituents,proto3" json:"constituents,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CompositePrice) Reset() {
+	*x = CompositePrice{}
+	mi := &file_rpc_proto_msgTypes[233]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompositePrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompositePrice) ProtoMessage() {}
+
+func (x *CompositePrice) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[233]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompositePrice.ProtoReflect.Descriptor instead.
+func (*CompositePrice) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{233}
+}
+
+func (x *CompositePrice) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *CompositePrice) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *CompositePrice) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *CompositePrice) GetVolumeWeightedPrice() float64 {
+	if x != nil {
+		return x.VolumeWeightedPrice
+	}
+	return 0
+}
+
+func (x *CompositePrice) GetMedianPrice() float64 {
+	if x != nil {
+		return x.MedianPrice
+	}
+	return 0
+}
+
+func (x *CompositePrice) GetFilteredPrice() float64 {
+	if x != nil {
+		return x.FilteredPrice
+	}
+	return 0
+}
+
+func (x *CompositePrice) GetBid() float64 {
+	if x != nil {
+		return x.Bid
+	}
+	return 0
+}
+
+func (x *CompositePrice) GetAsk() float64 {
+	if x != nil {
+		return x.Ask
+	}
+	return 0
+}
+
+func (x *CompositePrice) GetTotalVolume() float64 {
+	if x != nil {
+		return x.TotalVolume
+	}
+	return 0
+}
+
+func (x *CompositePrice) GetLastUpdated() string {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return ""
+}
+
+func (x *CompositePrice) GetConstituents() []*CompositePriceConstituent {
+	if x != nil {
+		return x.Constituents
+	}
+	return nil
+}
+
+type GetCompositePricesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prices        []*CompositePrice      `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompositePricesResponse) Reset() {
+	*x = GetCompositePricesResponse{}
+	mi := &file_rpc_proto_msgTypes[234]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompositePricesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompositePricesResponse) ProtoMessage() {}
+
+func (x *GetCompositePricesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[234]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompositePricesResponse.ProtoReflect.Descriptor instead.
+func (*GetCompositePricesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{234}
+}
+
+func (x *GetCompositePricesResponse) GetPrices() []*CompositePrice {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+type RateLimitBudget struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Limit         int64                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Remaining     int64                  `protobuf:"varint,3,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	ResetTime     string                 `protobuf:"bytes,4,opt,name=reset_time,json=resetTime,proto3" json:"reset_time,omitempty"`
+	Updated       string                 `protobuf:"bytes,5,opt,name=updated,proto3" json:"updated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RateLimitBudget) Reset() {
+	*x = RateLimitBudget{}
+	mi := &file_rpc_proto_msgTypes[235]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RateLimitBudget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimitBudget) ProtoMessage() {}
+
+func (x *RateLimitBudget) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[235]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimitBudget.ProtoReflect.Descriptor instead.
+func (*RateLimitBudget) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{235}
+}
+
+func (x *RateLimitBudget) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *RateLimitBudget) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *RateLimitBudget) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *RateLimitBudget) GetResetTime() string {
+	if x != nil {
+		return x.ResetTime
+	}
+	return ""
+}
+
+func (x *RateLimitBudget) GetUpdated() string {
+	if x != nil {
+		return x.Updated
+	}
+	return ""
+}
+
+type GetRateLimitStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	BackoffUntil  string                 `protobuf:"bytes,2,opt,name=backoff_until,json=backoffUntil,proto3" json:"backoff_until,omitempty"`
+	Budgets       []*RateLimitBudget     `protobuf:"bytes,3,rep,name=budgets,proto3" json:"budgets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRateLimitStatusResponse) Reset() {
+	*x = GetRateLimitStatusResponse{}
+	mi := &file_rpc_proto_msgTypes[236]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRateLimitStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRateLimitStatusResponse) ProtoMessage() {}
+
+func (x *GetRateLimitStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[236]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRateLimitStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetRateLimitStatusResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{236}
+}
+
+func (x *GetRateLimitStatusResponse) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetRateLimitStatusResponse) GetBackoffUntil() string {
+	if x != nil {
+		return x.BackoffUntil
+	}
+	return ""
+}
+
+func (x *GetRateLimitStatusResponse) GetBudgets() []*RateLimitBudget {
+	if x != nil {
+		return x.Budgets
+	}
+	return nil
+}
+
+type GetDatabaseChangeFeedStreamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channels      []string               `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDatabaseChangeFeedStreamRequest) Reset() {
+	*x = GetDatabaseChangeFeedStreamRequest{}
+	mi := &file_rpc_proto_msgTypes[237]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDatabaseChangeFeedStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDatabaseChangeFeedStreamRequest) ProtoMessage() {}
+
+func (x *GetDatabaseChangeFeedStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[237]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDatabaseChangeFeedStreamRequest.ProtoReflect.Descriptor instead.
+func (*GetDatabaseChangeFeedStreamRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{237}
+}
+
+func (x *GetDatabaseChangeFeedStreamRequest) GetChannels() []string {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+type DatabaseChangeFeedResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Payload       string                 `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatabaseChangeFeedResponse) Reset() {
+	*x = DatabaseChangeFeedResponse{}
+	mi := &file_rpc_proto_msgTypes[238]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatabaseChangeFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatabaseChangeFeedResponse) ProtoMessage() {}
+
+func (x *DatabaseChangeFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[238]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatabaseChangeFeedResponse.ProtoReflect.Descriptor instead.
+func (*DatabaseChangeFeedResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{238}
+}
+
+func (x *DatabaseChangeFeedResponse) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *DatabaseChangeFeedResponse) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *DatabaseChangeFeedResponse) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type PendingWithdrawal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Exchange      string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Chain         string                 `protobuf:"bytes,4,opt,name=chain,proto3" json:"chain,omitempty"`
+	Address       string                 `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Amount        float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	AmountUsd     float64                `protobuf:"fixed64,7,opt,name=amount_usd,json=amountUsd,proto3" json:"amount_usd,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,8,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,10,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PendingWithdrawal) Reset() {
+	*x = PendingWithdrawal{}
+	mi := &file_rpc_proto_msgTypes[239]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PendingWithdrawal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingWithdrawal) ProtoMessage() {}
+
+func (x *PendingWithdrawal) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[239]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingWithdrawal.ProtoReflect.Descriptor instead.
+func (*PendingWithdrawal) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{239}
+}
+
+func (x *PendingWithdrawal) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PendingWithdrawal) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *PendingWithdrawal) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PendingWithdrawal) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *PendingWithdrawal) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PendingWithdrawal) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PendingWithdrawal) GetAmountUsd() float64 {
+	if x != nil {
+		return x.AmountUsd
+	}
+	return 0
+}
+
+func (x *PendingWithdrawal) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *PendingWithdrawal) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *PendingWithdrawal) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type GetPendingWithdrawalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPendingWithdrawalsRequest) Reset() {
+	*x = GetPendingWithdrawalsRequest{}
+	mi := &file_rpc_proto_msgTypes[240]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPendingWithdrawalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPendingWithdrawalsRequest) ProtoMessage() {}
+
+func (x *GetPendingWithdrawalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[240]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPendingWithdrawalsRequest.ProtoReflect.Descriptor instead.
+func (*GetPendingWithdrawalsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{240}
+}
+
+type GetPendingWithdrawalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Withdrawals   []*PendingWithdrawal   `protobuf:"bytes,1,rep,name=withdrawals,proto3" json:"withdrawals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPendingWithdrawalsResponse) Reset() {
+	*x = GetPendingWithdrawalsResponse{}
+	mi := &file_rpc_proto_msgTypes[241]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPendingWithdrawalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPendingWithdrawalsResponse) ProtoMessage() {}
+
+func (x *GetPendingWithdrawalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[241]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPendingWithdrawalsResponse.ProtoReflect.Descriptor instead.
+func (*GetPendingWithdrawalsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{241}
+}
+
+func (x *GetPendingWithdrawalsResponse) GetWithdrawals() []*PendingWithdrawal {
+	if x != nil {
+		return x.Withdrawals
+	}
+	return nil
+}
+
+type ApproveWithdrawalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OtpCode       string                 `protobuf:"bytes,2,opt,name=otp_code,json=otpCode,proto3" json:"otp_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveWithdrawalRequest) Reset() {
+	*x = ApproveWithdrawalRequest{}
+	mi := &file_rpc_proto_msgTypes[242]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveWithdrawalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveWithdrawalRequest) ProtoMessage() {}
+
+func (x *ApproveWithdrawalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[242]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveWithdrawalRequest.ProtoReflect.Descriptor instead.
+func (*ApproveWithdrawalRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{242}
+}
+
+func (x *ApproveWithdrawalRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApproveWithdrawalRequest) GetOtpCode() string {
+	if x != nil {
+		return x.OtpCode
+	}
+	return ""
+}
+
+type RejectWithdrawalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectWithdrawalRequest) Reset() {
+	*x = RejectWithdrawalRequest{}
+	mi := &file_rpc_proto_msgTypes[243]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectWithdrawalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectWithdrawalRequest) ProtoMessage() {}
+
+func (x *RejectWithdrawalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[243]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectWithdrawalRequest.ProtoReflect.Descriptor instead.
+func (*RejectWithdrawalRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{243}
+}
+
+func (x *RejectWithdrawalRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ReloadConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReloadConfigRequest) Reset() {
+	*x = ReloadConfigRequest{}
+	mi := &file_rpc_proto_msgTypes[244]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadConfigRequest) ProtoMessage() {}
+
+func (x *ReloadConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[244]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadConfigRequest.ProtoReflect.Descriptor instead.
+func (*ReloadConfigRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{244}
+}
+
+type ReloadConfigResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Applied         []string               `protobuf:"bytes,1,rep,name=applied,proto3" json:"applied,omitempty"`
+	RestartRequired []string               `protobuf:"bytes,2,rep,name=restart_required,json=restartRequired,proto3" json:"restart_required,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReloadConfigResponse) Reset() {
+	*x = ReloadConfigResponse{}
+	mi := &file_rpc_proto_msgTypes[245]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReloadConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloadConfigResponse) ProtoMessage() {}
+
+func (x *ReloadConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[245]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloadConfigResponse.ProtoReflect.Descriptor instead.
+func (*ReloadConfigResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{245}
+}
+
+func (x *ReloadConfigResponse) GetApplied() []string {
+	if x != nil {
+		return x.Applied
+	}
+	return nil
+}
+
+func (x *ReloadConfigResponse) GetRestartRequired() []string {
+	if x != nil {
+		return x.RestartRequired
+	}
+	return nil
+}
+
+type GetOrderUpdatesStreamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	AssetType     string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderUpdatesStreamRequest) Reset() {
+	*x = GetOrderUpdatesStreamRequest{}
+	mi := &file_rpc_proto_msgTypes[246]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderUpdatesStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderUpdatesStreamRequest) ProtoMessage() {}
+
+func (x *GetOrderUpdatesStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[246]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderUpdatesStreamRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderUpdatesStreamRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{246}
+}
+
+func (x *GetOrderUpdatesStreamRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetOrderUpdatesStreamRequest) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
+}
+
+func (x *GetOrderUpdatesStreamRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+type GetFillsStreamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	AssetType     string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFillsStreamRequest) Reset() {
+	*x = GetFillsStreamRequest{}
+	mi := &file_rpc_proto_msgTypes[247]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFillsStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFillsStreamRequest) ProtoMessage() {}
+
+func (x *GetFillsStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[247]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFillsStreamRequest.ProtoReflect.Descriptor instead.
+func (*GetFillsStreamRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{247}
+}
+
+func (x *GetFillsStreamRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetFillsStreamRequest) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
+}
+
+func (x *GetFillsStreamRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+type FillResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	AssetType     string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	OrderId       string                 `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ClientOrderId string                 `protobuf:"bytes,5,opt,name=client_order_id,json=clientOrderId,proto3" json:"client_order_id,omitempty"`
+	TradeId       string                 `protobuf:"bytes,6,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	Side          string                 `protobuf:"bytes,7,opt,name=side,proto3" json:"side,omitempty"`
+	Price         float64                `protobuf:"fixed64,8,opt,name=price,proto3" json:"price,omitempty"`
+	Amount        float64                `protobuf:"fixed64,9,opt,name=amount,proto3" json:"amount,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,10,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FillResponse) Reset() {
+	*x = FillResponse{}
+	mi := &file_rpc_proto_msgTypes[248]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FillResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FillResponse) ProtoMessage() {}
+
+func (x *FillResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[248]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FillResponse.ProtoReflect.Descriptor instead.
+func (*FillResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{248}
+}
+
+func (x *FillResponse) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *FillResponse) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
+}
+
+func (x *FillResponse) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *FillResponse) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *FillResponse) GetClientOrderId() string {
+	if x != nil {
+		return x.ClientOrderId
+	}
+	return ""
+}
+
+func (x *FillResponse) GetTradeId() string {
+	if x != nil {
+		return x.TradeId
+	}
+	return ""
+}
+
+func (x *FillResponse) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+func (x *FillResponse) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *FillResponse) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *FillResponse) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+var File_rpc_proto protoreflect.FileDescriptor
+
+const file_rpc_proto_rawDesc = "" +
+	"\n" +
+	"\trpc.proto\x12\x06gctrpc\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x10\n" +
+	"\x0eGetInfoRequest\"\xb4\x04\n" +
+	"\x0fGetInfoResponse\x12\x16\n" +
+	"\x06uptime\x18\x01 \x01(\tR\x06uptime\x12/\n" +
+	"\x13available_exchanges\x18\x02 \x01(\x03R\x12availableExchanges\x12+\n" +
+	"\x11enabled_exchanges\x18\x03 \x01(\x03R\x10enabledExchanges\x124\n" +
+	"\x16default_forex_provider\x18\x04 \x01(\tR\x14defaultForexProvider\x122\n" +
+	"\x15default_fiat_currency\x18\x05 \x01(\tR\x13defaultFiatCurrency\x12W\n" +
+	"\x10subsystem_status\x18\x06 \x03(\v2,.gctrpc.GetInfoResponse.SubsystemStatusEntryR\x0fsubsystemStatus\x12N\n" +
+	"\rrpc_endpoints\x18\a \x03(\v2).gctrpc.GetInfoResponse.RpcEndpointsEntryR\frpcEndpoints\x1aB\n" +
+	"\x14SubsystemStatusEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\x1aT\n" +
+	"\x11RpcEndpointsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
+	"\x05value\x18\x02 \x01(\v2\x13.gctrpc.RPCEndpointR\x05value:\x028\x01\"!\n" +
+	"\x1fGetCommunicationRelayersRequest\"N\n" +
+	"\x14CommunicationRelayer\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1c\n" +
+	"\tconnected\x18\x02 \x01(\bR\tconnected\"\x86\x02\n" +
+	" GetCommunicationRelayersResponse\x12z\n" +
+	"\x16communication_relayers\x18\x01 \x03(\v2C.gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntryR\x15communicationRelayers\x1af\n" +
+	"\x1aCommunicationRelayersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x122\n" +
+	"\x05value\x18\x02 \x01(\v2\x1c.gctrpc.CommunicationRelayerR\x05value:\x028\x01\"7\n" +
+	"\x17GenericSubsystemRequest\x12\x1c\n" +
+	"\tsubsystem\x18\x01 \x01(\tR\tsubsystem\"\x16\n" +
+	"\x14GetSubsystemsRequest\"\xbe\x01\n" +
+	"\x15GetSubsystemsResponse\x12`\n" +
+	"\x11subsystems_status\x18\x01 \x03(\v23.gctrpc.GetSubsystemsResponse.SubsystemsStatusEntryR\x10subsystemsStatus\x1aC\n" +
+	"\x15SubsystemsStatusEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\"\x18\n" +
+	"\x16GetRPCEndpointsRequest\"N\n" +
+	"\vRPCEndpoint\x12\x18\n" +
+	"\astarted\x18\x01 \x01(\bR\astarted\x12%\n" +
+	"\x0elisten_address\x18\x02 \x01(\tR\rlistenAddress\"\xba\x01\n" +
+	"\x17GetRPCEndpointsResponse\x12L\n" +
+	"\tendpoints\x18\x01 \x03(\v2..gctrpc.GetRPCEndpointsResponse.EndpointsEntryR\tendpoints\x1aQ\n" +
+	"\x0eEndpointsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
+	"\x05value\x18\x02 \x01(\v2\x13.gctrpc.RPCEndpointR\x05value:\x028\x01\"8\n" +
+	"\x1aGenericExchangeNameRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\"/\n" +
+	"\x13GetExchangesRequest\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\"4\n" +
+	"\x14GetExchangesResponse\x12\x1c\n" +
+	"\texchanges\x18\x01 \x01(\tR\texchanges\"3\n" +
+	"\x16GetExchangeOTPResponse\x12\x19\n" +
+	"\botp_code\x18\x01 \x01(\tR\aotpCode\"\x18\n" +
+	"\x16GetExchangeOTPsRequest\"\xa2\x01\n" +
+	"\x17GetExchangeOTPsResponse\x12J\n" +
+	"\totp_codes\x18\x01 \x03(\v2-.gctrpc.GetExchangeOTPsResponse.OtpCodesEntryR\botpCodes\x1a;\n" +
+	"\rOtpCodesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"4\n" +
+	"\x16DisableExchangeRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\"^\n" +
+	"\x0ePairsSupported\x12'\n" +
+	"\x0favailable_pairs\x18\x01 \x01(\tR\x0eavailablePairs\x12#\n" +
+	"\renabled_pairs\x18\x02 \x01(\tR\fenabledPairs\"\x82\x04\n" +
+	"\x17GetExchangeInfoResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12\x18\n" +
+	"\averbose\x18\x03 \x01(\bR\averbose\x12#\n" +
+	"\rusing_sandbox\x18\x04 \x01(\bR\fusingSandbox\x12!\n" +
+	"\fhttp_timeout\x18\x05 \x01(\tR\vhttpTimeout\x12%\n" +
+	"\x0ehttp_useragent\x18\x06 \x01(\tR\rhttpUseragent\x12\x1d\n" +
+	"\n" +
+	"http_proxy\x18\a \x01(\tR\thttpProxy\x12'\n" +
+	"\x0fbase_currencies\x18\b \x01(\tR\x0ebaseCurrencies\x12_\n" +
+	"\x10supported_assets\x18\t \x03(\v24.gctrpc.GetExchangeInfoResponse.SupportedAssetsEntryR\x0fsupportedAssets\x12+\n" +
+	"\x11authenticated_api\x18\n" +
+	" \x01(\bR\x10authenticatedApi\x1aZ\n" +
+	"\x14SupportedAssetsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.gctrpc.PairsSupportedR\x05value:\x028\x01\"w\n" +
+	"\x10GetTickerRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12(\n" +
+	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x03 \x01(\tR\tassetType\"V\n" +
+	"\fCurrencyPair\x12\x1c\n" +
+	"\tdelimiter\x18\x01 \x01(\tR\tdelimiter\x12\x12\n" +
+	"\x04base\x18\x02 \x01(\tR\x04base\x12\x14\n" +
+	"\x05quote\x18\x03 \x01(\tR\x05quote\"\x95\x02\n" +
+	"\x0eTickerResponse\x12(\n" +
+	"\x04pair\x18\x01 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12!\n" +
+	"\flast_updated\x18\x02 \x01(\x03R\vlastUpdated\x12#\n" +
+	"\rcurrency_pair\x18\x03 \x01(\tR\fcurrencyPair\x12\x12\n" +
+	"\x04last\x18\x04 \x01(\x01R\x04last\x12\x12\n" +
+	"\x04high\x18\x05 \x01(\x01R\x04high\x12\x10\n" +
+	"\x03low\x18\x06 \x01(\x01R\x03low\x12\x10\n" +
+	"\x03bid\x18\a \x01(\x01R\x03bid\x12\x10\n" +
+	"\x03ask\x18\b \x01(\x01R\x03ask\x12\x16\n" +
+	"\x06volume\x18\t \x01(\x01R\x06volume\x12\x1b\n" +
+	"\tprice_ath\x18\n" +
+	" \x01(\x01R\bpriceAth\"\x13\n" +
+	"\x11GetTickersRequest\"W\n" +
+	"\aTickers\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x120\n" +
+	"\atickers\x18\x02 \x03(\v2\x16.gctrpc.TickerResponseR\atickers\"?\n" +
+	"\x12GetTickersResponse\x12)\n" +
+	"\atickers\x18\x01 \x03(\v2\x0f.gctrpc.TickersR\atickers\"z\n" +
+	"\x13GetOrderbookRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12(\n" +
+	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x03 \x01(\tR\tassetType\"M\n" +
+	"\rOrderbookItem\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x01R\x06amount\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x01R\x05price\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\x03R\x02id\"\x90\x02\n" +
+	"\x11OrderbookResponse\x12(\n" +
+	"\x04pair\x18\x01 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12#\n" +
+	"\rcurrency_pair\x18\x02 \x01(\tR\fcurrencyPair\x12)\n" +
+	"\x04bids\x18\x03 \x03(\v2\x15.gctrpc.OrderbookItemR\x04bids\x12)\n" +
+	"\x04asks\x18\x04 \x03(\v2\x15.gctrpc.OrderbookItemR\x04asks\x12!\n" +
+	"\flast_updated\x18\x05 \x01(\x03R\vlastUpdated\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x06 \x01(\tR\tassetType\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\"\x16\n" +
+	"\x14GetOrderbooksRequest\"c\n" +
+	"\n" +
+	"Orderbooks\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x129\n" +
+	"\n" +
+	"orderbooks\x18\x02 \x03(\v2\x19.gctrpc.OrderbookResponseR\n" +
+	"orderbooks\"K\n" +
+	"\x15GetOrderbooksResponse\x122\n" +
+	"\n" +
+	"orderbooks\x18\x01 \x03(\v2\x12.gctrpc.OrderbooksR\n" +
+	"orderbooks\"V\n" +
+	"\x19GetAccountBalancesRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x02 \x01(\tR\tassetType\"V\n" +
+	"\aAccount\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12;\n" +
+	"\n" +
+	"currencies\x18\x02 \x03(\v2\x1b.gctrpc.AccountCurrencyInfoR\n" +
+	"currencies\"\x81\x02\n" +
+	"\x13AccountCurrencyInfo\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x1f\n" +
+	"\vtotal_value\x18\x02 \x01(\x01R\n" +
+	"totalValue\x12\x12\n" +
+	"\x04hold\x18\x03 \x01(\x01R\x04hold\x12\x12\n" +
+	"\x04free\x18\x04 \x01(\x01R\x04free\x12.\n" +
+	"\x13free_without_borrow\x18\x05 \x01(\x01R\x11freeWithoutBorrow\x12\x1a\n" +
+	"\bborrowed\x18\x06 \x01(\x01R\bborrowed\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"e\n" +
+	"\x1aGetAccountBalancesResponse\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12+\n" +
+	"\baccounts\x18\x02 \x03(\v2\x0f.gctrpc.AccountR\baccounts\"\x12\n" +
+	"\x10GetConfigRequest\"'\n" +
+	"\x11GetConfigResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"\x85\x01\n" +
+	"\x10PortfolioAddress\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x1b\n" +
+	"\tcoin_type\x18\x02 \x01(\tR\bcoinType\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
+	"\abalance\x18\x04 \x01(\x01R\abalance\"\x15\n" +
+	"\x13GetPortfolioRequest\"N\n" +
+	"\x14GetPortfolioResponse\x126\n" +
+	"\tportfolio\x18\x01 \x03(\v2\x18.gctrpc.PortfolioAddressR\tportfolio\"\x1c\n" +
+	"\x1aGetPortfolioSummaryRequest\"n\n" +
+	"\x04Coin\x12\x12\n" +
+	"\x04coin\x18\x01 \x01(\tR\x04coin\x12\x18\n" +
+	"\abalance\x18\x02 \x01(\x01R\abalance\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\x1e\n" +
+	"\n" +
+	"percentage\x18\x04 \x01(\x01R\n" +
+	"percentage\"h\n" +
+	"\x12OfflineCoinSummary\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x18\n" +
+	"\abalance\x18\x02 \x01(\x01R\abalance\x12\x1e\n" +
+	"\n" +
+	"percentage\x18\x03 \x01(\x01R\n" +
+	"percentage\"M\n" +
+	"\x11OnlineCoinSummary\x12\x18\n" +
+	"\abalance\x18\x01 \x01(\x01R\abalance\x12\x1e\n" +
+	"\n" +
+	"percentage\x18\x02 \x01(\x01R\n" +
+	"percentage\"H\n" +
+	"\fOfflineCoins\x128\n" +
+	"\taddresses\x18\x01 \x03(\v2\x1a.gctrpc.OfflineCoinSummaryR\taddresses\"\x98\x01\n" +
+	"\vOnlineCoins\x124\n" +
+	"\x05coins\x18\x01 \x03(\v2\x1e.gctrpc.OnlineCoins.CoinsEntryR\x05coins\x1aS\n" +
+	"\n" +
+	"CoinsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12/\n" +
+	"\x05value\x18\x02 \x01(\v2\x19.gctrpc.OnlineCoinSummaryR\x05value:\x028\x01\"\xcb\x04\n" +
+	"\x1bGetPortfolioSummaryResponse\x12-\n" +
+	"\vcoin_totals\x18\x01 \x03(\v2\f.gctrpc.CoinR\n" +
+	"coinTotals\x121\n" +
+	"\rcoins_offline\x18\x02 \x03(\v2\f.gctrpc.CoinR\fcoinsOffline\x12p\n" +
+	"\x15coins_offline_summary\x18\x03 \x03(\v2<.gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntryR\x13coinsOfflineSummary\x12/\n" +
+	"\fcoins_online\x18\x04 \x03(\v2\f.gctrpc.CoinR\vcoinsOnline\x12m\n" +
+	"\x14coins_online_summary\x18\x05 \x03(\v2;.gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntryR\x12coinsOnlineSummary\x1a\\\n" +
+	"\x18CoinsOfflineSummaryEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12*\n" +
+	"\x05value\x18\x02 \x01(\v2\x14.gctrpc.OfflineCoinsR\x05value:\x028\x01\x1aZ\n" +
+	"\x17CoinsOnlineSummaryEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
+	"\x05value\x18\x02 \x01(\v2\x13.gctrpc.OnlineCoinsR\x05value:\x028\x01\"\xe3\x01\n" +
+	"\x1aAddPortfolioAddressRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x1b\n" +
+	"\tcoin_type\x18\x02 \x01(\tR\bcoinType\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x18\n" +
+	"\abalance\x18\x04 \x01(\x01R\abalance\x12/\n" +
+	"\x13supported_exchanges\x18\x05 \x01(\tR\x12supportedExchanges\x12!\n" +
+	"\fcold_storage\x18\x06 \x01(\bR\vcoldStorage\"x\n" +
+	"\x1dRemovePortfolioAddressRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x1b\n" +
+	"\tcoin_type\x18\x02 \x01(\tR\bcoinType\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\"\x1a\n" +
+	"\x18GetForexProvidersRequest\"\xed\x01\n" +
+	"\rForexProvider\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12\x18\n" +
+	"\averbose\x18\x03 \x01(\bR\averbose\x12,\n" +
+	"\x12rest_polling_delay\x18\x04 \x01(\tR\x10restPollingDelay\x12\x17\n" +
+	"\aapi_key\x18\x05 \x01(\tR\x06apiKey\x12\"\n" +
+	"\rapi_key_level\x18\x06 \x01(\x03R\vapiKeyLevel\x12)\n" +
+	"\x10primary_provider\x18\a \x01(\bR\x0fprimaryProvider\"[\n" +
+	"\x19GetForexProvidersResponse\x12>\n" +
+	"\x0fforex_providers\x18\x01 \x03(\v2\x15.gctrpc.ForexProviderR\x0eforexProviders\"\x16\n" +
+	"\x14GetForexRatesRequest\"q\n" +
+	"\x14ForexRatesConversion\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
 	"\x02to\x18\x02 \x01(\tR\x02to\x12\x12\n" +
 	"\x04rate\x18\x03 \x01(\x01R\x04rate\x12!\n" +
 	"\finverse_rate\x18\x04 \x01(\x01R\vinverseRate\"V\n" +
 	"\x15GetForexRatesResponse\x12=\n" +
 	"\vforex_rates\x18\x01 \x03(\v2\x1c.gctrpc.ForexRatesConversionR\n" +
-	"forexRates\"\xb5\x04\n" +
+	"forexRates\"\xcf\x04\n" +
 	"\fOrderDetails\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\x12&\n" +
@@ -15360,7 +17012,8 @@ const file_rpc_proto_rawDesc = "" +
 	"\x03fee\x18\x0f \x01(\x01R\x03fee\x12\x12\n" +
 	"\x04cost\x18\x10 \x01(\x01R\x04cost\x12,\n" +
 	"\x06trades\x18\x11 \x03(\v2\x14.gctrpc.TradeHistoryR\x06trades\x12'\n" +
-	"\x0fcontract_amount\x18\x12 \x01(\x01R\x0econtractAmount\"\xf3\x01\n" +
+	"\x0fcontract_amount\x18\x12 \x01(\x01R\x0econtractAmount\x12\x18\n" +
+	"\aaccount\x18\x13 \x01(\tR\aaccount\"\xf3\x01\n" +
 	"\fTradeHistory\x12#\n" +
 	"\rcreation_time\x18\x01 \x01(\x03R\fcreationTime\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\x12\x14\n" +
@@ -15660,7 +17313,7 @@ const file_rpc_proto_rawDesc = "" +
 	"\x03end\x18\x05 \x01(\tR\x03end\x12#\n" +
 	"\rtime_interval\x18\x06 \x01(\x03R\ftimeInterval\x12\x12\n" +
 	"\x04sync\x18\a \x01(\bR\x04sync\x12\x14\n" +
-	"\x05force\x18\b \x01(\bR\x05force\"\xe6\x02\n" +
+	"\x05force\x18\b \x01(\bR\x05force\"\x83\x03\n" +
 	"\x19GetHistoricCandlesRequest\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12(\n" +
 	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
@@ -15675,7 +17328,8 @@ const file_rpc_proto_rawDesc = "" +
 	"\x06use_db\x18\t \x01(\bR\x05useDb\x127\n" +
 	"\x18fill_missing_with_trades\x18\n" +
 	" \x01(\bR\x15fillMissingWithTrades\x12\x14\n" +
-	"\x05force\x18\v \x01(\bR\x05force\"\xce\x01\n" +
+	"\x05force\x18\v \x01(\bR\x05force\x12\x1b\n" +
+	"\tuse_cache\x18\f \x01(\bR\buseCache\"\xce\x01\n" +
 	"\x1aGetHistoricCandlesResponse\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12(\n" +
 	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x14\n" +
@@ -15974,7 +17628,7 @@ const file_rpc_proto_rawDesc = "" +
 	"\fisolated_upl\x18\x18 \x01(\tR\visolatedUpl\x12+\n" +
 	"\x11notional_leverage\x18\x19 \x01(\tR\x10notionalLeverage\x12!\n" +
 	"\ftotal_equity\x18\x1a \x01(\tR\vtotalEquity\x12'\n" +
-	"\x0fstrategy_equity\x18\x1b \x01(\tR\x0estrategyEquity\"\x84\x06\n" +
+	"\x0fstrategy_equity\x18\x1b \x01(\tR\x0estrategyEquity\"\x9e\x06\n" +
 	"\x0eFuturePosition\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x14\n" +
 	"\x05asset\x18\x02 \x01(\tR\x05asset\x12(\n" +
@@ -15996,7 +17650,8 @@ const file_rpc_proto_rawDesc = "" +
 	"\x18contract_settlement_type\x18\x10 \x01(\tR\x16contractSettlementType\x12,\n" +
 	"\x06orders\x18\x11 \x03(\v2\x14.gctrpc.OrderDetailsR\x06orders\x12C\n" +
 	"\x0eposition_stats\x18\x12 \x01(\v2\x1c.gctrpc.FuturesPositionStatsR\rpositionStats\x126\n" +
-	"\ffunding_data\x18\x13 \x01(\v2\x13.gctrpc.FundingDataR\vfundingData\"\xd3\x02\n" +
+	"\ffunding_data\x18\x13 \x01(\v2\x13.gctrpc.FundingDataR\vfundingData\x12\x18\n" +
+	"\aaccount\x18\x14 \x01(\tR\aaccount\"\xd3\x02\n" +
 	"\x19GetManagedPositionRequest\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x14\n" +
 	"\x05asset\x18\x02 \x01(\tR\x05asset\x12(\n" +
@@ -16367,7 +18022,145 @@ const file_rpc_proto_rawDesc = "" +
 	"\x05asset\x18\x02 \x01(\tR\x05asset\x12(\n" +
 	"\x04pair\x18\x03 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\"/\n" +
 	"\x1bGetCurrencyTradeURLResponse\x12\x10\n" +
-	"\x03url\x18\x01 \x01(\tR\x03url2\xccl\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"\xbf\x02\n" +
+	"\x17DataQualityCheckRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x14\n" +
+	"\x05asset\x18\x02 \x01(\tR\x05asset\x12(\n" +
+	"\x04pair\x18\x03 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1a\n" +
+	"\binterval\x18\x04 \x01(\x03R\binterval\x12\x14\n" +
+	"\x05start\x18\x05 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x06 \x01(\tR\x03end\x12/\n" +
+	"\x13secondary_exchanges\x18\a \x03(\tR\x12secondaryExchanges\x12%\n" +
+	"\x0einclude_trades\x18\b \x01(\bR\rincludeTrades\x12,\n" +
+	"\x12create_repair_jobs\x18\t \x01(\bR\x10createRepairJobs\"\x9f\x01\n" +
+	"\x10DataQualityIssue\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\tR\x03end\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12-\n" +
+	"\x12secondary_exchange\x18\x05 \x01(\tR\x11secondaryExchange\"\x93\x04\n" +
+	"\x11DataQualityReport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bexchange\x18\x02 \x01(\tR\bexchange\x12\x14\n" +
+	"\x05asset\x18\x03 \x01(\tR\x05asset\x12(\n" +
+	"\x04pair\x18\x04 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1a\n" +
+	"\binterval\x18\x05 \x01(\x03R\binterval\x12\x14\n" +
+	"\x05start\x18\x06 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\a \x01(\tR\x03end\x12\x18\n" +
+	"\acreated\x18\b \x01(\tR\acreated\x12'\n" +
+	"\x0fcandles_checked\x18\t \x01(\x03R\x0ecandlesChecked\x12%\n" +
+	"\x0etrades_checked\x18\n" +
+	" \x01(\x03R\rtradesChecked\x12P\n" +
+	"\rissue_summary\x18\v \x03(\v2+.gctrpc.DataQualityReport.IssueSummaryEntryR\fissueSummary\x120\n" +
+	"\x06issues\x18\f \x03(\v2\x18.gctrpc.DataQualityIssueR\x06issues\x12\x1f\n" +
+	"\vrepair_jobs\x18\r \x03(\tR\n" +
+	"repairJobs\x1a?\n" +
+	"\x11IssueSummaryEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\xb1\x01\n" +
+	"\x1cGetDataQualityReportsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bexchange\x18\x02 \x01(\tR\bexchange\x12\x14\n" +
+	"\x05asset\x18\x03 \x01(\tR\x05asset\x12(\n" +
+	"\x04pair\x18\x04 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12%\n" +
+	"\x0einclude_issues\x18\x05 \x01(\bR\rincludeIssues\"T\n" +
+	"\x1dGetDataQualityReportsResponse\x123\n" +
+	"\areports\x18\x01 \x03(\v2\x19.gctrpc.DataQualityReportR\areports\"\x8e\x01\n" +
+	"\x19GetCompositePricesRequest\x12\x14\n" +
+	"\x05asset\x18\x01 \x01(\tR\x05asset\x12(\n" +
+	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x121\n" +
+	"\x14include_constituents\x18\x03 \x01(\bR\x13includeConstituents\"\xa0\x02\n" +
+	"\x19CompositePriceConstituent\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12(\n" +
+	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x01R\x05price\x12'\n" +
+	"\x0fconverted_price\x18\x04 \x01(\x01R\x0econvertedPrice\x12'\n" +
+	"\x0fconversion_rate\x18\x05 \x01(\x01R\x0econversionRate\x12\x16\n" +
+	"\x06volume\x18\x06 \x01(\x01R\x06volume\x12\x1a\n" +
+	"\bexcluded\x18\a \x01(\bR\bexcluded\x12!\n" +
+	"\flast_updated\x18\b \x01(\tR\vlastUpdated\"\x9b\x03\n" +
+	"\x0eCompositePrice\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x14\n" +
+	"\x05asset\x18\x02 \x01(\tR\x05asset\x12(\n" +
+	"\x04pair\x18\x03 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x122\n" +
+	"\x15volume_weighted_price\x18\x04 \x01(\x01R\x13volumeWeightedPrice\x12!\n" +
+	"\fmedian_price\x18\x05 \x01(\x01R\vmedianPrice\x12%\n" +
+	"\x0efiltered_price\x18\x06 \x01(\x01R\rfilteredPrice\x12\x10\n" +
+	"\x03bid\x18\a \x01(\x01R\x03bid\x12\x10\n" +
+	"\x03ask\x18\b \x01(\x01R\x03ask\x12!\n" +
+	"\ftotal_volume\x18\t \x01(\x01R\vtotalVolume\x12!\n" +
+	"\flast_updated\x18\n" +
+	" \x01(\tR\vlastUpdated\x12E\n" +
+	"\fconstituents\x18\v \x03(\v2!.gctrpc.CompositePriceConstituentR\fconstituents\"L\n" +
+	"\x1aGetCompositePricesResponse\x12.\n" +
+	"\x06prices\x18\x01 \x03(\v2\x16.gctrpc.CompositePriceR\x06prices\"\x92\x01\n" +
+	"\x0fRateLimitBudget\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\x12\x1c\n" +
+	"\tremaining\x18\x03 \x01(\x03R\tremaining\x12\x1d\n" +
+	"\n" +
+	"reset_time\x18\x04 \x01(\tR\tresetTime\x12\x18\n" +
+	"\aupdated\x18\x05 \x01(\tR\aupdated\"\x90\x01\n" +
+	"\x1aGetRateLimitStatusResponse\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12#\n" +
+	"\rbackoff_until\x18\x02 \x01(\tR\fbackoffUntil\x121\n" +
+	"\abudgets\x18\x03 \x03(\v2\x17.gctrpc.RateLimitBudgetR\abudgets\"@\n" +
+	"\"GetDatabaseChangeFeedStreamRequest\x12\x1a\n" +
+	"\bchannels\x18\x01 \x03(\tR\bchannels\"n\n" +
+	"\x1aDatabaseChangeFeedResponse\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\tR\achannel\x12\x18\n" +
+	"\apayload\x18\x02 \x01(\tR\apayload\x12\x1c\n" +
+	"\ttimestamp\x18\x03 \x01(\x03R\ttimestamp\"\xa3\x02\n" +
+	"\x11PendingWithdrawal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bexchange\x18\x02 \x01(\tR\bexchange\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x14\n" +
+	"\x05chain\x18\x04 \x01(\tR\x05chain\x12\x18\n" +
+	"\aaddress\x18\x05 \x01(\tR\aaddress\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x01R\x06amount\x12\x1d\n" +
+	"\n" +
+	"amount_usd\x18\a \x01(\x01R\tamountUsd\x12!\n" +
+	"\frequested_by\x18\b \x01(\tR\vrequestedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\n" +
+	" \x01(\tR\texpiresAt\"\x1e\n" +
+	"\x1cGetPendingWithdrawalsRequest\"\\\n" +
+	"\x1dGetPendingWithdrawalsResponse\x12;\n" +
+	"\vwithdrawals\x18\x01 \x03(\v2\x19.gctrpc.PendingWithdrawalR\vwithdrawals\"E\n" +
+	"\x18ApproveWithdrawalRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\botp_code\x18\x02 \x01(\tR\aotpCode\")\n" +
+	"\x17RejectWithdrawalRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x15\n" +
+	"\x13ReloadConfigRequest\"[\n" +
+	"\x14ReloadConfigResponse\x12\x18\n" +
+	"\aapplied\x18\x01 \x03(\tR\aapplied\x12)\n" +
+	"\x10restart_required\x18\x02 \x03(\tR\x0frestartRequired\"\x83\x01\n" +
+	"\x1cGetOrderUpdatesStreamRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x02 \x01(\tR\tassetType\x12(\n" +
+	"\x04pair\x18\x03 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\"|\n" +
+	"\x15GetFillsStreamRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x02 \x01(\tR\tassetType\x12(\n" +
+	"\x04pair\x18\x03 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\"\xb1\x02\n" +
+	"\fFillResponse\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x02 \x01(\tR\tassetType\x12(\n" +
+	"\x04pair\x18\x03 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x19\n" +
+	"\border_id\x18\x04 \x01(\tR\aorderId\x12&\n" +
+	"\x0fclient_order_id\x18\x05 \x01(\tR\rclientOrderId\x12\x19\n" +
+	"\btrade_id\x18\x06 \x01(\tR\atradeId\x12\x12\n" +
+	"\x04side\x18\a \x01(\tR\x04side\x12\x14\n" +
+	"\x05price\x18\b \x01(\x01R\x05price\x12\x16\n" +
+	"\x06amount\x18\t \x01(\x01R\x06amount\x12\x1c\n" +
+	"\ttimestamp\x18\n" +
+	" \x01(\x03R\ttimestamp2\x9bx\n" +
 	"\x15GoCryptoTraderService\x12O\n" +
 	"\aGetInfo\x12\x16.gctrpc.GetInfoRequest\x1a\x17.gctrpc.GetInfoResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/getinfo\x12g\n" +
 	"\rGetSubsystems\x12\x1c.gctrpc.GetSubsystemsRequest\x1a\x1d.gctrpc.GetSubsystemsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/getsubsystems\x12h\n" +
@@ -16484,7 +18277,19 @@ const file_rpc_proto_rawDesc = "" +
 	"\vSetLeverage\x12\x1a.gctrpc.SetLeverageRequest\x1a\x1b.gctrpc.SetLeverageResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/getleverage\x12\x86\x01\n" +
 	"\x14ChangePositionMargin\x12#.gctrpc.ChangePositionMarginRequest\x1a$.gctrpc.ChangePositionMarginResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/changepositionmargin\x12o\n" +
 	"\x0fGetOpenInterest\x12\x1e.gctrpc.GetOpenInterestRequest\x1a\x1f.gctrpc.GetOpenInterestResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/getopeninterest\x12\x7f\n" +
-	"\x13GetCurrencyTradeURL\x12\".gctrpc.GetCurrencyTradeURLRequest\x1a#.gctrpc.GetCurrencyTradeURLResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/getcurrencytradeurlB0Z.github.com/thrasher-corp/gocryptotrader/gctrpcb\x06proto3"
+	"\x13GetCurrencyTradeURL\x12\".gctrpc.GetCurrencyTradeURLRequest\x1a#.gctrpc.GetCurrencyTradeURLResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/getcurrencytradeurl\x12u\n" +
+	"\x13RunDataQualityCheck\x12\x1f.gctrpc.DataQualityCheckRequest\x1a\x19.gctrpc.DataQualityReport\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/rundataqualitycheck\x12\x87\x01\n" +
+	"\x15GetDataQualityReports\x12$.gctrpc.GetDataQualityReportsRequest\x1a%.gctrpc.GetDataQualityReportsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/getdataqualityreports\x12{\n" +
+	"\x12GetCompositePrices\x12!.gctrpc.GetCompositePricesRequest\x1a\".gctrpc.GetCompositePricesResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/getcompositeprices\x12\x98\x01\n" +
+	"\x1bGetDatabaseChangeFeedStream\x12*.gctrpc.GetDatabaseChangeFeedStreamRequest\x1a\".gctrpc.DatabaseChangeFeedResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/getdatabasechangefeedstream0\x01\x12|\n" +
+	"\x12GetRateLimitStatus\x12\".gctrpc.GenericExchangeNameRequest\x1a\".gctrpc.GetRateLimitStatusResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/getratelimitstatus\x12\x82\x01\n" +
+	"\x19ReloadExchangeCredentials\x12\".gctrpc.GenericExchangeNameRequest\x1a\x17.gctrpc.GenericResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/v1/reloadexchangecredentials\x12\x87\x01\n" +
+	"\x15GetPendingWithdrawals\x12$.gctrpc.GetPendingWithdrawalsRequest\x1a%.gctrpc.GetPendingWithdrawalsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/getpendingwithdrawals\x12q\n" +
+	"\x11ApproveWithdrawal\x12 .gctrpc.ApproveWithdrawalRequest\x1a\x18.gctrpc.WithdrawResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/approvewithdrawal\x12m\n" +
+	"\x10RejectWithdrawal\x12\x1f.gctrpc.RejectWithdrawalRequest\x1a\x17.gctrpc.GenericResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/rejectwithdrawal\x12f\n" +
+	"\fReloadConfig\x12\x1b.gctrpc.ReloadConfigRequest\x1a\x1c.gctrpc.ReloadConfigResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/reloadconfig\x12x\n" +
+	"\x15GetOrderUpdatesStream\x12$.gctrpc.GetOrderUpdatesStreamRequest\x1a\x14.gctrpc.OrderDetails\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/getorderupdatesstream0\x01\x12c\n" +
+	"\x0eGetFillsStream\x12\x1d.gctrpc.GetFillsStreamRequest\x1a\x14.gctrpc.FillResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/getfillsstream0\x01B0Z.github.com/thrasher-corp/gocryptotrader/gctrpcb\x06proto3"
 
 var (
 	file_rpc_proto_rawDescOnce sync.Once
@@ -16498,7 +18303,7 @@ func file_rpc_proto_rawDescGZIP() []byte {
 	return file_rpc_proto_rawDescData
 }
 
-var file_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 240)
+var file_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 264)
 var file_rpc_proto_goTypes = []any{
 	(*GetInfoRequest)(nil),                            // 0: gctrpc.GetInfoRequest
 	(*GetInfoResponse)(nil),                           // 1: gctrpc.GetInfoResponse
@@ -16726,30 +18531,54 @@ var file_rpc_proto_goTypes = []any{
 	(*OpenInterestDataResponse)(nil),                  // 223: gctrpc.OpenInterestDataResponse
 	(*GetCurrencyTradeURLRequest)(nil),                // 224: gctrpc.GetCurrencyTradeURLRequest
 	(*GetCurrencyTradeURLResponse)(nil),               // 225: gctrpc.GetCurrencyTradeURLResponse
-	nil,                                               // 226: gctrpc.GetInfoResponse.SubsystemStatusEntry
-	nil,                                               // 227: gctrpc.GetInfoResponse.RpcEndpointsEntry
-	nil,                                               // 228: gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry
-	nil,                                               // 229: gctrpc.GetSubsystemsResponse.SubsystemsStatusEntry
-	nil,                                               // 230: gctrpc.GetRPCEndpointsResponse.EndpointsEntry
-	nil,                                               // 231: gctrpc.GetExchangeOTPsResponse.OtpCodesEntry
-	nil,                                               // 232: gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry
-	nil,                                               // 233: gctrpc.OnlineCoins.CoinsEntry
-	nil,                                               // 234: gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry
-	nil,                                               // 235: gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry
-	nil,                                               // 236: gctrpc.Orders.OrderStatusEntry
-	nil,                                               // 237: gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry
-	nil,                                               // 238: gctrpc.GetExchangePairsResponse.SupportedAssetsEntry
-	nil,                                               // 239: gctrpc.GetTechnicalAnalysisResponse.SignalsEntry
-	(*timestamppb.Timestamp)(nil),                     // 240: google.protobuf.Timestamp
+	(*DataQualityCheckRequest)(nil),                   // 226: gctrpc.DataQualityCheckRequest
+	(*DataQualityIssue)(nil),                          // 227: gctrpc.DataQualityIssue
+	(*DataQualityReport)(nil),                         // 228: gctrpc.DataQualityReport
+	(*GetDataQualityReportsRequest)(nil),              // 229: gctrpc.GetDataQualityReportsRequest
+	(*GetDataQualityReportsResponse)(nil),             // 230: gctrpc.GetDataQualityReportsResponse
+	(*GetCompositePricesRequest)(nil),                 // 231: gctrpc.GetCompositePricesRequest
+	(*CompositePriceConstituent)(nil),                 // 232: gctrpc.CompositePriceConstituent
+	(*CompositePrice)(nil),                            // 233: gctrpc.CompositePrice
+	(*GetCompositePricesResponse)(nil),                // 234: gctrpc.GetCompositePricesResponse
+	(*RateLimitBudget)(nil),                           // 235: gctrpc.RateLimitBudget
+	(*GetRateLimitStatusResponse)(nil),                // 236: gctrpc.GetRateLimitStatusResponse
+	(*GetDatabaseChangeFeedStreamRequest)(nil),        // 237: gctrpc.GetDatabaseChangeFeedStreamRequest
+	(*DatabaseChangeFeedResponse)(nil),                // 238: gctrpc.DatabaseChangeFeedResponse
+	(*PendingWithdrawal)(nil),                         // 239: gctrpc.PendingWithdrawal
+	(*GetPendingWithdrawalsRequest)(nil),              // 240: gctrpc.GetPendingWithdrawalsRequest
+	(*GetPendingWithdrawalsResponse)(nil),             // 241: gctrpc.GetPendingWithdrawalsResponse
+	(*ApproveWithdrawalRequest)(nil),                  // 242: gctrpc.ApproveWithdrawalRequest
+	(*RejectWithdrawalRequest)(nil),                   // 243: gctrpc.RejectWithdrawalRequest
+	(*ReloadConfigRequest)(nil),                       // 244: gctrpc.ReloadConfigRequest
+	(*ReloadConfigResponse)(nil),                      // 245: gctrpc.ReloadConfigResponse
+	(*GetOrderUpdatesStreamRequest)(nil),              // 246: gctrpc.GetOrderUpdatesStreamRequest
+	(*GetFillsStreamRequest)(nil),                     // 247: gctrpc.GetFillsStreamRequest
+	(*FillResponse)(nil),                              // 248: gctrpc.FillResponse
+	nil,                                               // 249: gctrpc.GetInfoResponse.SubsystemStatusEntry
+	nil,                                               // 250: gctrpc.GetInfoResponse.RpcEndpointsEntry
+	nil,                                               // 251: gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry
+	nil,                                               // 252: gctrpc.GetSubsystemsResponse.SubsystemsStatusEntry
+	nil,                                               // 253: gctrpc.GetRPCEndpointsResponse.EndpointsEntry
+	nil,                                               // 254: gctrpc.GetExchangeOTPsResponse.OtpCodesEntry
+	nil,                                               // 255: gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry
+	nil,                                               // 256: gctrpc.OnlineCoins.CoinsEntry
+	nil,                                               // 257: gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry
+	nil,                                               // 258: gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry
+	nil,                                               // 259: gctrpc.Orders.OrderStatusEntry
+	nil,                                               // 260: gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry
+	nil,                                               // 261: gctrpc.GetExchangePairsResponse.SupportedAssetsEntry
+	nil,                                               // 262: gctrpc.GetTechnicalAnalysisResponse.SignalsEntry
+	nil,                                               // 263: gctrpc.DataQualityReport.IssueSummaryEntry
+	(*timestamppb.Timestamp)(nil),                     // 264: google.protobuf.Timestamp
 }
 var file_rpc_proto_depIdxs = []int32{
-	226, // 0: gctrpc.GetInfoResponse.subsystem_status:type_name -> gctrpc.GetInfoResponse.SubsystemStatusEntry
-	227, // 1: gctrpc.GetInfoResponse.rpc_endpoints:type_name -> gctrpc.GetInfoResponse.RpcEndpointsEntry
-	228, // 2: gctrpc.GetCommunicationRelayersResponse.communication_relayers:type_name -> gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry
-	229, // 3: gctrpc.GetSubsystemsResponse.subsystems_status:type_name -> gctrpc.GetSubsystemsResponse.SubsystemsStatusEntry
-	230, // 4: gctrpc.GetRPCEndpointsResponse.endpoints:type_name -> gctrpc.GetRPCEndpointsResponse.EndpointsEntry
-	231, // 5: gctrpc.GetExchangeOTPsResponse.otp_codes:type_name -> gctrpc.GetExchangeOTPsResponse.OtpCodesEntry
-	232, // 6: gctrpc.GetExchangeInfoResponse.supported_assets:type_name -> gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry
+	249, // 0: gctrpc.GetInfoResponse.subsystem_status:type_name -> gctrpc.GetInfoResponse.SubsystemStatusEntry
+	250, // 1: gctrpc.GetInfoResponse.rpc_endpoints:type_name -> gctrpc.GetInfoResponse.RpcEndpointsEntry
+	251, // 2: gctrpc.GetCommunicationRelayersResponse.communication_relayers:type_name -> gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry
+	252, // 3: gctrpc.GetSubsystemsResponse.subsystems_status:type_name -> gctrpc.GetSubsystemsResponse.SubsystemsStatusEntry
+	253, // 4: gctrpc.GetRPCEndpointsResponse.endpoints:type_name -> gctrpc.GetRPCEndpointsResponse.EndpointsEntry
+	254, // 5: gctrpc.GetExchangeOTPsResponse.otp_codes:type_name -> gctrpc.GetExchangeOTPsResponse.OtpCodesEntry
+	255, // 6: gctrpc.GetExchangeInfoResponse.supported_assets:type_name -> gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry
 	21,  // 7: gctrpc.GetTickerRequest.pair:type_name -> gctrpc.CurrencyPair
 	21,  // 8: gctrpc.TickerResponse.pair:type_name -> gctrpc.CurrencyPair
 	22,  // 9: gctrpc.Tickers.tickers:type_name -> gctrpc.TickerResponse
@@ -16761,16 +18590,16 @@ var file_rpc_proto_depIdxs = []int32{
 	28,  // 15: gctrpc.Orderbooks.orderbooks:type_name -> gctrpc.OrderbookResponse
 	30,  // 16: gctrpc.GetOrderbooksResponse.orderbooks:type_name -> gctrpc.Orderbooks
 	34,  // 17: gctrpc.Account.currencies:type_name -> gctrpc.AccountCurrencyInfo
-	240, // 18: gctrpc.AccountCurrencyInfo.updated_at:type_name -> google.protobuf.Timestamp
+	264, // 18: gctrpc.AccountCurrencyInfo.updated_at:type_name -> google.protobuf.Timestamp
 	33,  // 19: gctrpc.GetAccountBalancesResponse.accounts:type_name -> gctrpc.Account
 	38,  // 20: gctrpc.GetPortfolioResponse.portfolio:type_name -> gctrpc.PortfolioAddress
 	43,  // 21: gctrpc.OfflineCoins.addresses:type_name -> gctrpc.OfflineCoinSummary
-	233, // 22: gctrpc.OnlineCoins.coins:type_name -> gctrpc.OnlineCoins.CoinsEntry
+	256, // 22: gctrpc.OnlineCoins.coins:type_name -> gctrpc.OnlineCoins.CoinsEntry
 	42,  // 23: gctrpc.GetPortfolioSummaryResponse.coin_totals:type_name -> gctrpc.Coin
 	42,  // 24: gctrpc.GetPortfolioSummaryResponse.coins_offline:type_name -> gctrpc.Coin
-	234, // 25: gctrpc.GetPortfolioSummaryResponse.coins_offline_summary:type_name -> gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry
+	257, // 25: gctrpc.GetPortfolioSummaryResponse.coins_offline_summary:type_name -> gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry
 	42,  // 26: gctrpc.GetPortfolioSummaryResponse.coins_online:type_name -> gctrpc.Coin
-	235, // 27: gctrpc.GetPortfolioSummaryResponse.coins_online_summary:type_name -> gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry
+	258, // 27: gctrpc.GetPortfolioSummaryResponse.coins_online_summary:type_name -> gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry
 	51,  // 28: gctrpc.GetForexProvidersResponse.forex_providers:type_name -> gctrpc.ForexProvider
 	54,  // 29: gctrpc.GetForexRatesResponse.forex_rates:type_name -> gctrpc.ForexRatesConversion
 	57,  // 30: gctrpc.OrderDetails.trades:type_name -> gctrpc.TradeHistory
@@ -16784,7 +18613,7 @@ var file_rpc_proto_depIdxs = []int32{
 	21,  // 38: gctrpc.WhaleBombRequest.pair:type_name -> gctrpc.CurrencyPair
 	21,  // 39: gctrpc.CancelOrderRequest.pair:type_name -> gctrpc.CurrencyPair
 	21,  // 40: gctrpc.CancelBatchOrdersRequest.pair:type_name -> gctrpc.CurrencyPair
-	236, // 41: gctrpc.Orders.order_status:type_name -> gctrpc.Orders.OrderStatusEntry
+	259, // 41: gctrpc.Orders.order_status:type_name -> gctrpc.Orders.OrderStatusEntry
 	69,  // 42: gctrpc.CancelBatchOrdersResponse.orders:type_name -> gctrpc.Orders
 	69,  // 43: gctrpc.CancelAllOrdersResponse.orders:type_name -> gctrpc.Orders
 	74,  // 44: gctrpc.GetEventsResponse.condition_params:type_name -> gctrpc.ConditionParams
@@ -16792,16 +18621,16 @@ var file_rpc_proto_depIdxs = []int32{
 	74,  // 46: gctrpc.AddEventRequest.condition_params:type_name -> gctrpc.ConditionParams
 	21,  // 47: gctrpc.AddEventRequest.pair:type_name -> gctrpc.CurrencyPair
 	80,  // 48: gctrpc.DepositAddresses.addresses:type_name -> gctrpc.DepositAddress
-	237, // 49: gctrpc.GetCryptocurrencyDepositAddressesResponse.addresses:type_name -> gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry
+	260, // 49: gctrpc.GetCryptocurrencyDepositAddressesResponse.addresses:type_name -> gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry
 	95,  // 50: gctrpc.WithdrawalEventByIDResponse.event:type_name -> gctrpc.WithdrawalEventResponse
 	95,  // 51: gctrpc.WithdrawalEventsByExchangeResponse.event:type_name -> gctrpc.WithdrawalEventResponse
 	96,  // 52: gctrpc.WithdrawalEventResponse.exchange:type_name -> gctrpc.WithdrawalExchangeEvent
 	97,  // 53: gctrpc.WithdrawalEventResponse.request:type_name -> gctrpc.WithdrawalRequestEvent
-	240, // 54: gctrpc.WithdrawalEventResponse.created_at:type_name -> google.protobuf.Timestamp
-	240, // 55: gctrpc.WithdrawalEventResponse.updated_at:type_name -> google.protobuf.Timestamp
+	264, // 54: gctrpc.WithdrawalEventResponse.created_at:type_name -> google.protobuf.Timestamp
+	264, // 55: gctrpc.WithdrawalEventResponse.updated_at:type_name -> google.protobuf.Timestamp
 	98,  // 56: gctrpc.WithdrawalRequestEvent.fiat:type_name -> gctrpc.FiatWithdrawalEvent
 	99,  // 57: gctrpc.WithdrawalRequestEvent.crypto:type_name -> gctrpc.CryptoWithdrawalEvent
-	238, // 58: gctrpc.GetExchangePairsResponse.supported_assets:type_name -> gctrpc.GetExchangePairsResponse.SupportedAssetsEntry
+	261, // 58: gctrpc.GetExchangePairsResponse.supported_assets:type_name -> gctrpc.GetExchangePairsResponse.SupportedAssetsEntry
 	21,  // 59: gctrpc.SetExchangePairRequest.pairs:type_name -> gctrpc.CurrencyPair
 	21,  // 60: gctrpc.GetOrderbookStreamRequest.pair:type_name -> gctrpc.CurrencyPair
 	21,  // 61: gctrpc.GetTickerStreamRequest.pair:type_name -> gctrpc.CurrencyPair
@@ -16871,10 +18700,10 @@ var file_rpc_proto_depIdxs = []int32{
 	21,  // 125: gctrpc.GetLatestFundingRateRequest.pair:type_name -> gctrpc.CurrencyPair
 	171, // 126: gctrpc.GetLatestFundingRateResponse.rate:type_name -> gctrpc.FundingData
 	21,  // 127: gctrpc.GetTechnicalAnalysisRequest.pair:type_name -> gctrpc.CurrencyPair
-	240, // 128: gctrpc.GetTechnicalAnalysisRequest.start:type_name -> google.protobuf.Timestamp
-	240, // 129: gctrpc.GetTechnicalAnalysisRequest.end:type_name -> google.protobuf.Timestamp
+	264, // 128: gctrpc.GetTechnicalAnalysisRequest.start:type_name -> google.protobuf.Timestamp
+	264, // 129: gctrpc.GetTechnicalAnalysisRequest.end:type_name -> google.protobuf.Timestamp
 	21,  // 130: gctrpc.GetTechnicalAnalysisRequest.other_pair:type_name -> gctrpc.CurrencyPair
-	239, // 131: gctrpc.GetTechnicalAnalysisResponse.signals:type_name -> gctrpc.GetTechnicalAnalysisResponse.SignalsEntry
+	262, // 131: gctrpc.GetTechnicalAnalysisResponse.signals:type_name -> gctrpc.GetTechnicalAnalysisResponse.SignalsEntry
 	212, // 132: gctrpc.GetMarginRatesHistoryRequest.rates:type_name -> gctrpc.MarginRate
 	210, // 133: gctrpc.MarginRate.lending_payment:type_name -> gctrpc.LendingPayment
 	211, // 134: gctrpc.MarginRate.borrow_cost:type_name -> gctrpc.BorrowCost
@@ -16889,251 +18718,291 @@ var file_rpc_proto_depIdxs = []int32{
 	223, // 143: gctrpc.GetOpenInterestResponse.data:type_name -> gctrpc.OpenInterestDataResponse
 	21,  // 144: gctrpc.OpenInterestDataResponse.pair:type_name -> gctrpc.CurrencyPair
 	21,  // 145: gctrpc.GetCurrencyTradeURLRequest.pair:type_name -> gctrpc.CurrencyPair
-	9,   // 146: gctrpc.GetInfoResponse.RpcEndpointsEntry.value:type_name -> gctrpc.RPCEndpoint
-	3,   // 147: gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry.value:type_name -> gctrpc.CommunicationRelayer
-	9,   // 148: gctrpc.GetRPCEndpointsResponse.EndpointsEntry.value:type_name -> gctrpc.RPCEndpoint
-	18,  // 149: gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry.value:type_name -> gctrpc.PairsSupported
-	44,  // 150: gctrpc.OnlineCoins.CoinsEntry.value:type_name -> gctrpc.OnlineCoinSummary
-	45,  // 151: gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry.value:type_name -> gctrpc.OfflineCoins
-	46,  // 152: gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry.value:type_name -> gctrpc.OnlineCoins
-	81,  // 153: gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry.value:type_name -> gctrpc.DepositAddresses
-	18,  // 154: gctrpc.GetExchangePairsResponse.SupportedAssetsEntry.value:type_name -> gctrpc.PairsSupported
-	207, // 155: gctrpc.GetTechnicalAnalysisResponse.SignalsEntry.value:type_name -> gctrpc.ListOfSignals
-	0,   // 156: gctrpc.GoCryptoTraderService.GetInfo:input_type -> gctrpc.GetInfoRequest
-	6,   // 157: gctrpc.GoCryptoTraderService.GetSubsystems:input_type -> gctrpc.GetSubsystemsRequest
-	5,   // 158: gctrpc.GoCryptoTraderService.EnableSubsystem:input_type -> gctrpc.GenericSubsystemRequest
-	5,   // 159: gctrpc.GoCryptoTraderService.DisableSubsystem:input_type -> gctrpc.GenericSubsystemRequest
-	8,   // 160: gctrpc.GoCryptoTraderService.GetRPCEndpoints:input_type -> gctrpc.GetRPCEndpointsRequest
-	2,   // 161: gctrpc.GoCryptoTraderService.GetCommunicationRelayers:input_type -> gctrpc.GetCommunicationRelayersRequest
-	12,  // 162: gctrpc.GoCryptoTraderService.GetExchanges:input_type -> gctrpc.GetExchangesRequest
-	11,  // 163: gctrpc.GoCryptoTraderService.DisableExchange:input_type -> gctrpc.GenericExchangeNameRequest
-	11,  // 164: gctrpc.GoCryptoTraderService.GetExchangeInfo:input_type -> gctrpc.GenericExchangeNameRequest
-	11,  // 165: gctrpc.GoCryptoTraderService.GetExchangeOTPCode:input_type -> gctrpc.GenericExchangeNameRequest
-	15,  // 166: gctrpc.GoCryptoTraderService.GetExchangeOTPCodes:input_type -> gctrpc.GetExchangeOTPsRequest
-	11,  // 167: gctrpc.GoCryptoTraderService.EnableExchange:input_type -> gctrpc.GenericExchangeNameRequest
-	20,  // 168: gctrpc.GoCryptoTraderService.GetTicker:input_type -> gctrpc.GetTickerRequest
-	23,  // 169: gctrpc.GoCryptoTraderService.GetTickers:input_type -> gctrpc.GetTickersRequest
-	26,  // 170: gctrpc.GoCryptoTraderService.GetOrderbook:input_type -> gctrpc.GetOrderbookRequest
-	29,  // 171: gctrpc.GoCryptoTraderService.GetOrderbooks:input_type -> gctrpc.GetOrderbooksRequest
-	32,  // 172: gctrpc.GoCryptoTraderService.GetAccountBalances:input_type -> gctrpc.GetAccountBalancesRequest
-	32,  // 173: gctrpc.GoCryptoTraderService.UpdateAccountBalances:input_type -> gctrpc.GetAccountBalancesRequest
-	32,  // 174: gctrpc.GoCryptoTraderService.GetAccountBalancesStream:input_type -> gctrpc.GetAccountBalancesRequest
-	36,  // 175: gctrpc.GoCryptoTraderService.GetConfig:input_type -> gctrpc.GetConfigRequest
-	39,  // 176: gctrpc.GoCryptoTraderService.GetPortfolio:input_type -> gctrpc.GetPortfolioRequest
-	41,  // 177: gctrpc.GoCryptoTraderService.GetPortfolioSummary:input_type -> gctrpc.GetPortfolioSummaryRequest
-	48,  // 178: gctrpc.GoCryptoTraderService.AddPortfolioAddress:input_type -> gctrpc.AddPortfolioAddressRequest
-	49,  // 179: gctrpc.GoCryptoTraderService.RemovePortfolioAddress:input_type -> gctrpc.RemovePortfolioAddressRequest
-	50,  // 180: gctrpc.GoCryptoTraderService.GetForexProviders:input_type -> gctrpc.GetForexProvidersRequest
-	53,  // 181: gctrpc.GoCryptoTraderService.GetForexRates:input_type -> gctrpc.GetForexRatesRequest
-	58,  // 182: gctrpc.GoCryptoTraderService.GetOrders:input_type -> gctrpc.GetOrdersRequest
-	60,  // 183: gctrpc.GoCryptoTraderService.GetOrder:input_type -> gctrpc.GetOrderRequest
-	61,  // 184: gctrpc.GoCryptoTraderService.SubmitOrder:input_type -> gctrpc.SubmitOrderRequest
-	64,  // 185: gctrpc.GoCryptoTraderService.SimulateOrder:input_type -> gctrpc.SimulateOrderRequest
-	66,  // 186: gctrpc.GoCryptoTraderService.WhaleBomb:input_type -> gctrpc.WhaleBombRequest
-	67,  // 187: gctrpc.GoCryptoTraderService.CancelOrder:input_type -> gctrpc.CancelOrderRequest
-	68,  // 188: gctrpc.GoCryptoTraderService.CancelBatchOrders:input_type -> gctrpc.CancelBatchOrdersRequest
-	71,  // 189: gctrpc.GoCryptoTraderService.CancelAllOrders:input_type -> gctrpc.CancelAllOrdersRequest
-	73,  // 190: gctrpc.GoCryptoTraderService.GetEvents:input_type -> gctrpc.GetEventsRequest
-	76,  // 191: gctrpc.GoCryptoTraderService.AddEvent:input_type -> gctrpc.AddEventRequest
-	78,  // 192: gctrpc.GoCryptoTraderService.RemoveEvent:input_type -> gctrpc.RemoveEventRequest
-	79,  // 193: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddresses:input_type -> gctrpc.GetCryptocurrencyDepositAddressesRequest
-	83,  // 194: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddress:input_type -> gctrpc.GetCryptocurrencyDepositAddressRequest
-	85,  // 195: gctrpc.GoCryptoTraderService.GetAvailableTransferChains:input_type -> gctrpc.GetAvailableTransferChainsRequest
-	87,  // 196: gctrpc.GoCryptoTraderService.WithdrawFiatFunds:input_type -> gctrpc.WithdrawFiatRequest
-	88,  // 197: gctrpc.GoCryptoTraderService.WithdrawCryptocurrencyFunds:input_type -> gctrpc.WithdrawCryptoRequest
-	90,  // 198: gctrpc.GoCryptoTraderService.WithdrawalEventByID:input_type -> gctrpc.WithdrawalEventByIDRequest
-	92,  // 199: gctrpc.GoCryptoTraderService.WithdrawalEventsByExchange:input_type -> gctrpc.WithdrawalEventsByExchangeRequest
-	93,  // 200: gctrpc.GoCryptoTraderService.WithdrawalEventsByDate:input_type -> gctrpc.WithdrawalEventsByDateRequest
-	100, // 201: gctrpc.GoCryptoTraderService.GetLoggerDetails:input_type -> gctrpc.GetLoggerDetailsRequest
-	102, // 202: gctrpc.GoCryptoTraderService.SetLoggerDetails:input_type -> gctrpc.SetLoggerDetailsRequest
-	103, // 203: gctrpc.GoCryptoTraderService.GetExchangePairs:input_type -> gctrpc.GetExchangePairsRequest
-	105, // 204: gctrpc.GoCryptoTraderService.SetExchangePair:input_type -> gctrpc.SetExchangePairRequest
-	106, // 205: gctrpc.GoCryptoTraderService.GetOrderbookStream:input_type -> gctrpc.GetOrderbookStreamRequest
-	107, // 206: gctrpc.GoCryptoTraderService.GetExchangeOrderbookStream:input_type -> gctrpc.GetExchangeOrderbookStreamRequest
-	108, // 207: gctrpc.GoCryptoTraderService.GetTickerStream:input_type -> gctrpc.GetTickerStreamRequest
-	109, // 208: gctrpc.GoCryptoTraderService.GetExchangeTickerStream:input_type -> gctrpc.GetExchangeTickerStreamRequest
-	110, // 209: gctrpc.GoCryptoTraderService.GetAuditEvent:input_type -> gctrpc.GetAuditEventRequest
-	121, // 210: gctrpc.GoCryptoTraderService.GCTScriptExecute:input_type -> gctrpc.GCTScriptExecuteRequest
-	126, // 211: gctrpc.GoCryptoTraderService.GCTScriptUpload:input_type -> gctrpc.GCTScriptUploadRequest
-	127, // 212: gctrpc.GoCryptoTraderService.GCTScriptReadScript:input_type -> gctrpc.GCTScriptReadScriptRequest
-	124, // 213: gctrpc.GoCryptoTraderService.GCTScriptStatus:input_type -> gctrpc.GCTScriptStatusRequest
-	128, // 214: gctrpc.GoCryptoTraderService.GCTScriptQuery:input_type -> gctrpc.GCTScriptQueryRequest
-	122, // 215: gctrpc.GoCryptoTraderService.GCTScriptStop:input_type -> gctrpc.GCTScriptStopRequest
-	123, // 216: gctrpc.GoCryptoTraderService.GCTScriptStopAll:input_type -> gctrpc.GCTScriptStopAllRequest
-	125, // 217: gctrpc.GoCryptoTraderService.GCTScriptListAll:input_type -> gctrpc.GCTScriptListAllRequest
-	129, // 218: gctrpc.GoCryptoTraderService.GCTScriptAutoLoadToggle:input_type -> gctrpc.GCTScriptAutoLoadRequest
-	116, // 219: gctrpc.GoCryptoTraderService.GetHistoricCandles:input_type -> gctrpc.GetHistoricCandlesRequest
-	133, // 220: gctrpc.GoCryptoTraderService.SetExchangeAsset:input_type -> gctrpc.SetExchangeAssetRequest
-	134, // 221: gctrpc.GoCryptoTraderService.SetAllExchangePairs:input_type -> gctrpc.SetExchangeAllPairsRequest
-	135, // 222: gctrpc.GoCryptoTraderService.UpdateExchangeSupportedPairs:input_type -> gctrpc.UpdateExchangeSupportedPairsRequest
-	136, // 223: gctrpc.GoCryptoTraderService.GetExchangeAssets:input_type -> gctrpc.GetExchangeAssetsRequest
-	138, // 224: gctrpc.GoCryptoTraderService.WebsocketGetInfo:input_type -> gctrpc.WebsocketGetInfoRequest
-	140, // 225: gctrpc.GoCryptoTraderService.WebsocketSetEnabled:input_type -> gctrpc.WebsocketSetEnabledRequest
-	141, // 226: gctrpc.GoCryptoTraderService.WebsocketGetSubscriptions:input_type -> gctrpc.WebsocketGetSubscriptionsRequest
-	144, // 227: gctrpc.GoCryptoTraderService.WebsocketSetProxy:input_type -> gctrpc.WebsocketSetProxyRequest
-	145, // 228: gctrpc.GoCryptoTraderService.WebsocketSetURL:input_type -> gctrpc.WebsocketSetURLRequest
-	112, // 229: gctrpc.GoCryptoTraderService.GetRecentTrades:input_type -> gctrpc.GetSavedTradesRequest
-	112, // 230: gctrpc.GoCryptoTraderService.GetHistoricTrades:input_type -> gctrpc.GetSavedTradesRequest
-	112, // 231: gctrpc.GoCryptoTraderService.GetSavedTrades:input_type -> gctrpc.GetSavedTradesRequest
-	115, // 232: gctrpc.GoCryptoTraderService.ConvertTradesToCandles:input_type -> gctrpc.ConvertTradesToCandlesRequest
-	146, // 233: gctrpc.GoCryptoTraderService.FindMissingSavedCandleIntervals:input_type -> gctrpc.FindMissingCandlePeriodsRequest
-	147, // 234: gctrpc.GoCryptoTraderService.FindMissingSavedTradeIntervals:input_type -> gctrpc.FindMissingTradePeriodsRequest
-	149, // 235: gctrpc.GoCryptoTraderService.SetExchangeTradeProcessing:input_type -> gctrpc.SetExchangeTradeProcessingRequest
-	150, // 236: gctrpc.GoCryptoTraderService.UpsertDataHistoryJob:input_type -> gctrpc.UpsertDataHistoryJobRequest
-	154, // 237: gctrpc.GoCryptoTraderService.GetDataHistoryJobDetails:input_type -> gctrpc.GetDataHistoryJobDetailsRequest
-	0,   // 238: gctrpc.GoCryptoTraderService.GetActiveDataHistoryJobs:input_type -> gctrpc.GetInfoRequest
-	158, // 239: gctrpc.GoCryptoTraderService.GetDataHistoryJobsBetween:input_type -> gctrpc.GetDataHistoryJobsBetweenRequest
-	154, // 240: gctrpc.GoCryptoTraderService.GetDataHistoryJobSummary:input_type -> gctrpc.GetDataHistoryJobDetailsRequest
-	159, // 241: gctrpc.GoCryptoTraderService.SetDataHistoryJobStatus:input_type -> gctrpc.SetDataHistoryJobStatusRequest
-	160, // 242: gctrpc.GoCryptoTraderService.UpdateDataHistoryJobPrerequisite:input_type -> gctrpc.UpdateDataHistoryJobPrerequisiteRequest
-	58,  // 243: gctrpc.GoCryptoTraderService.GetManagedOrders:input_type -> gctrpc.GetOrdersRequest
-	161, // 244: gctrpc.GoCryptoTraderService.ModifyOrder:input_type -> gctrpc.ModifyOrderRequest
-	163, // 245: gctrpc.GoCryptoTraderService.CurrencyStateGetAll:input_type -> gctrpc.CurrencyStateGetAllRequest
-	164, // 246: gctrpc.GoCryptoTraderService.CurrencyStateTrading:input_type -> gctrpc.CurrencyStateTradingRequest
-	167, // 247: gctrpc.GoCryptoTraderService.CurrencyStateDeposit:input_type -> gctrpc.CurrencyStateDepositRequest
-	166, // 248: gctrpc.GoCryptoTraderService.CurrencyStateWithdraw:input_type -> gctrpc.CurrencyStateWithdrawRequest
-	165, // 249: gctrpc.GoCryptoTraderService.CurrencyStateTradingPair:input_type -> gctrpc.CurrencyStateTradingPairRequest
-	177, // 250: gctrpc.GoCryptoTraderService.GetFuturesPositionsSummary:input_type -> gctrpc.GetFuturesPositionsSummaryRequest
-	179, // 251: gctrpc.GoCryptoTraderService.GetFuturesPositionsOrders:input_type -> gctrpc.GetFuturesPositionsOrdersRequest
-	195, // 252: gctrpc.GoCryptoTraderService.GetCollateral:input_type -> gctrpc.GetCollateralRequest
-	204, // 253: gctrpc.GoCryptoTraderService.Shutdown:input_type -> gctrpc.ShutdownRequest
-	206, // 254: gctrpc.GoCryptoTraderService.GetTechnicalAnalysis:input_type -> gctrpc.GetTechnicalAnalysisRequest
-	209, // 255: gctrpc.GoCryptoTraderService.GetMarginRatesHistory:input_type -> gctrpc.GetMarginRatesHistoryRequest
-	174, // 256: gctrpc.GoCryptoTraderService.GetManagedPosition:input_type -> gctrpc.GetManagedPositionRequest
-	175, // 257: gctrpc.GoCryptoTraderService.GetAllManagedPositions:input_type -> gctrpc.GetAllManagedPositionsRequest
-	200, // 258: gctrpc.GoCryptoTraderService.GetFundingRates:input_type -> gctrpc.GetFundingRatesRequest
-	202, // 259: gctrpc.GoCryptoTraderService.GetLatestFundingRate:input_type -> gctrpc.GetLatestFundingRateRequest
-	214, // 260: gctrpc.GoCryptoTraderService.GetOrderbookMovement:input_type -> gctrpc.GetOrderbookMovementRequest
-	216, // 261: gctrpc.GoCryptoTraderService.GetOrderbookAmountByNominal:input_type -> gctrpc.GetOrderbookAmountByNominalRequest
-	218, // 262: gctrpc.GoCryptoTraderService.GetOrderbookAmountByImpact:input_type -> gctrpc.GetOrderbookAmountByImpactRequest
-	181, // 263: gctrpc.GoCryptoTraderService.GetCollateralMode:input_type -> gctrpc.GetCollateralModeRequest
-	191, // 264: gctrpc.GoCryptoTraderService.GetLeverage:input_type -> gctrpc.GetLeverageRequest
-	183, // 265: gctrpc.GoCryptoTraderService.SetCollateralMode:input_type -> gctrpc.SetCollateralModeRequest
-	189, // 266: gctrpc.GoCryptoTraderService.SetMarginType:input_type -> gctrpc.SetMarginTypeRequest
-	193, // 267: gctrpc.GoCryptoTraderService.SetLeverage:input_type -> gctrpc.SetLeverageRequest
-	187, // 268: gctrpc.GoCryptoTraderService.ChangePositionMargin:input_type -> gctrpc.ChangePositionMarginRequest
-	220, // 269: gctrpc.GoCryptoTraderService.GetOpenInterest:input_type -> gctrpc.GetOpenInterestRequest
-	224, // 270: gctrpc.GoCryptoTraderService.GetCurrencyTradeURL:input_type -> gctrpc.GetCurrencyTradeURLRequest
-	1,   // 271: gctrpc.GoCryptoTraderService.GetInfo:output_type -> gctrpc.GetInfoResponse
-	7,   // 272: gctrpc.GoCryptoTraderService.GetSubsystems:output_type -> gctrpc.GetSubsystemsResponse
-	132, // 273: gctrpc.GoCryptoTraderService.EnableSubsystem:output_type -> gctrpc.GenericResponse
-	132, // 274: gctrpc.GoCryptoTraderService.DisableSubsystem:output_type -> gctrpc.GenericResponse
-	10,  // 275: gctrpc.GoCryptoTraderService.GetRPCEndpoints:output_type -> gctrpc.GetRPCEndpointsResponse
-	4,   // 276: gctrpc.GoCryptoTraderService.GetCommunicationRelayers:output_type -> gctrpc.GetCommunicationRelayersResponse
-	13,  // 277: gctrpc.GoCryptoTraderService.GetExchanges:output_type -> gctrpc.GetExchangesResponse
-	132, // 278: gctrpc.GoCryptoTraderService.DisableExchange:output_type -> gctrpc.GenericResponse
-	19,  // 279: gctrpc.GoCryptoTraderService.GetExchangeInfo:output_type -> gctrpc.GetExchangeInfoResponse
-	14,  // 280: gctrpc.GoCryptoTraderService.GetExchangeOTPCode:output_type -> gctrpc.GetExchangeOTPResponse
-	16,  // 281: gctrpc.GoCryptoTraderService.GetExchangeOTPCodes:output_type -> gctrpc.GetExchangeOTPsResponse
-	132, // 282: gctrpc.GoCryptoTraderService.EnableExchange:output_type -> gctrpc.GenericResponse
-	22,  // 283: gctrpc.GoCryptoTraderService.GetTicker:output_type -> gctrpc.TickerResponse
-	25,  // 284: gctrpc.GoCryptoTraderService.GetTickers:output_type -> gctrpc.GetTickersResponse
-	28,  // 285: gctrpc.GoCryptoTraderService.GetOrderbook:output_type -> gctrpc.OrderbookResponse
-	31,  // 286: gctrpc.GoCryptoTraderService.GetOrderbooks:output_type -> gctrpc.GetOrderbooksResponse
-	35,  // 287: gctrpc.GoCryptoTraderService.GetAccountBalances:output_type -> gctrpc.GetAccountBalancesResponse
-	35,  // 288: gctrpc.GoCryptoTraderService.UpdateAccountBalances:output_type -> gctrpc.GetAccountBalancesResponse
-	35,  // 289: gctrpc.GoCryptoTraderService.GetAccountBalancesStream:output_type -> gctrpc.GetAccountBalancesResponse
-	37,  // 290: gctrpc.GoCryptoTraderService.GetConfig:output_type -> gctrpc.GetConfigResponse
-	40,  // 291: gctrpc.GoCryptoTraderService.GetPortfolio:output_type -> gctrpc.GetPortfolioResponse
-	47,  // 292: gctrpc.GoCryptoTraderService.GetPortfolioSummary:output_type -> gctrpc.GetPortfolioSummaryResponse
-	132, // 293: gctrpc.GoCryptoTraderService.AddPortfolioAddress:output_type -> gctrpc.GenericResponse
-	132, // 294: gctrpc.GoCryptoTraderService.RemovePortfolioAddress:output_type -> gctrpc.GenericResponse
-	52,  // 295: gctrpc.GoCryptoTraderService.GetForexProviders:output_type -> gctrpc.GetForexProvidersResponse
-	55,  // 296: gctrpc.GoCryptoTraderService.GetForexRates:output_type -> gctrpc.GetForexRatesResponse
-	59,  // 297: gctrpc.GoCryptoTraderService.GetOrders:output_type -> gctrpc.GetOrdersResponse
-	56,  // 298: gctrpc.GoCryptoTraderService.GetOrder:output_type -> gctrpc.OrderDetails
-	63,  // 299: gctrpc.GoCryptoTraderService.SubmitOrder:output_type -> gctrpc.SubmitOrderResponse
-	65,  // 300: gctrpc.GoCryptoTraderService.SimulateOrder:output_type -> gctrpc.SimulateOrderResponse
-	65,  // 301: gctrpc.GoCryptoTraderService.WhaleBomb:output_type -> gctrpc.SimulateOrderResponse
-	132, // 302: gctrpc.GoCryptoTraderService.CancelOrder:output_type -> gctrpc.GenericResponse
-	70,  // 303: gctrpc.GoCryptoTraderService.CancelBatchOrders:output_type -> gctrpc.CancelBatchOrdersResponse
-	72,  // 304: gctrpc.GoCryptoTraderService.CancelAllOrders:output_type -> gctrpc.CancelAllOrdersResponse
-	75,  // 305: gctrpc.GoCryptoTraderService.GetEvents:output_type -> gctrpc.GetEventsResponse
-	77,  // 306: gctrpc.GoCryptoTraderService.AddEvent:output_type -> gctrpc.AddEventResponse
-	132, // 307: gctrpc.GoCryptoTraderService.RemoveEvent:output_type -> gctrpc.GenericResponse
-	82,  // 308: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddresses:output_type -> gctrpc.GetCryptocurrencyDepositAddressesResponse
-	84,  // 309: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddress:output_type -> gctrpc.GetCryptocurrencyDepositAddressResponse
-	86,  // 310: gctrpc.GoCryptoTraderService.GetAvailableTransferChains:output_type -> gctrpc.GetAvailableTransferChainsResponse
-	89,  // 311: gctrpc.GoCryptoTraderService.WithdrawFiatFunds:output_type -> gctrpc.WithdrawResponse
-	89,  // 312: gctrpc.GoCryptoTraderService.WithdrawCryptocurrencyFunds:output_type -> gctrpc.WithdrawResponse
-	91,  // 313: gctrpc.GoCryptoTraderService.WithdrawalEventByID:output_type -> gctrpc.WithdrawalEventByIDResponse
-	94,  // 314: gctrpc.GoCryptoTraderService.WithdrawalEventsByExchange:output_type -> gctrpc.WithdrawalEventsByExchangeResponse
-	94,  // 315: gctrpc.GoCryptoTraderService.WithdrawalEventsByDate:output_type -> gctrpc.WithdrawalEventsByExchangeResponse
-	101, // 316: gctrpc.GoCryptoTraderService.GetLoggerDetails:output_type -> gctrpc.GetLoggerDetailsResponse
-	101, // 317: gctrpc.GoCryptoTraderService.SetLoggerDetails:output_type -> gctrpc.GetLoggerDetailsResponse
-	104, // 318: gctrpc.GoCryptoTraderService.GetExchangePairs:output_type -> gctrpc.GetExchangePairsResponse
-	132, // 319: gctrpc.GoCryptoTraderService.SetExchangePair:output_type -> gctrpc.GenericResponse
-	28,  // 320: gctrpc.GoCryptoTraderService.GetOrderbookStream:output_type -> gctrpc.OrderbookResponse
-	28,  // 321: gctrpc.GoCryptoTraderService.GetExchangeOrderbookStream:output_type -> gctrpc.OrderbookResponse
-	22,  // 322: gctrpc.GoCryptoTraderService.GetTickerStream:output_type -> gctrpc.TickerResponse
-	22,  // 323: gctrpc.GoCryptoTraderService.GetExchangeTickerStream:output_type -> gctrpc.TickerResponse
-	111, // 324: gctrpc.GoCryptoTraderService.GetAuditEvent:output_type -> gctrpc.GetAuditEventResponse
-	132, // 325: gctrpc.GoCryptoTraderService.GCTScriptExecute:output_type -> gctrpc.GenericResponse
-	132, // 326: gctrpc.GoCryptoTraderService.GCTScriptUpload:output_type -> gctrpc.GenericResponse
-	131, // 327: gctrpc.GoCryptoTraderService.GCTScriptReadScript:output_type -> gctrpc.GCTScriptQueryResponse
-	130, // 328: gctrpc.GoCryptoTraderService.GCTScriptStatus:output_type -> gctrpc.GCTScriptStatusResponse
-	131, // 329: gctrpc.GoCryptoTraderService.GCTScriptQuery:output_type -> gctrpc.GCTScriptQueryResponse
-	132, // 330: gctrpc.GoCryptoTraderService.GCTScriptStop:output_type -> gctrpc.GenericResponse
-	132, // 331: gctrpc.GoCryptoTraderService.GCTScriptStopAll:output_type -> gctrpc.GenericResponse
-	130, // 332: gctrpc.GoCryptoTraderService.GCTScriptListAll:output_type -> gctrpc.GCTScriptStatusResponse
-	132, // 333: gctrpc.GoCryptoTraderService.GCTScriptAutoLoadToggle:output_type -> gctrpc.GenericResponse
-	117, // 334: gctrpc.GoCryptoTraderService.GetHistoricCandles:output_type -> gctrpc.GetHistoricCandlesResponse
-	132, // 335: gctrpc.GoCryptoTraderService.SetExchangeAsset:output_type -> gctrpc.GenericResponse
-	132, // 336: gctrpc.GoCryptoTraderService.SetAllExchangePairs:output_type -> gctrpc.GenericResponse
-	132, // 337: gctrpc.GoCryptoTraderService.UpdateExchangeSupportedPairs:output_type -> gctrpc.GenericResponse
-	137, // 338: gctrpc.GoCryptoTraderService.GetExchangeAssets:output_type -> gctrpc.GetExchangeAssetsResponse
-	139, // 339: gctrpc.GoCryptoTraderService.WebsocketGetInfo:output_type -> gctrpc.WebsocketGetInfoResponse
-	132, // 340: gctrpc.GoCryptoTraderService.WebsocketSetEnabled:output_type -> gctrpc.GenericResponse
-	143, // 341: gctrpc.GoCryptoTraderService.WebsocketGetSubscriptions:output_type -> gctrpc.WebsocketGetSubscriptionsResponse
-	132, // 342: gctrpc.GoCryptoTraderService.WebsocketSetProxy:output_type -> gctrpc.GenericResponse
-	132, // 343: gctrpc.GoCryptoTraderService.WebsocketSetURL:output_type -> gctrpc.GenericResponse
-	114, // 344: gctrpc.GoCryptoTraderService.GetRecentTrades:output_type -> gctrpc.SavedTradesResponse
-	114, // 345: gctrpc.GoCryptoTraderService.GetHistoricTrades:output_type -> gctrpc.SavedTradesResponse
-	114, // 346: gctrpc.GoCryptoTraderService.GetSavedTrades:output_type -> gctrpc.SavedTradesResponse
-	117, // 347: gctrpc.GoCryptoTraderService.ConvertTradesToCandles:output_type -> gctrpc.GetHistoricCandlesResponse
-	148, // 348: gctrpc.GoCryptoTraderService.FindMissingSavedCandleIntervals:output_type -> gctrpc.FindMissingIntervalsResponse
-	148, // 349: gctrpc.GoCryptoTraderService.FindMissingSavedTradeIntervals:output_type -> gctrpc.FindMissingIntervalsResponse
-	132, // 350: gctrpc.GoCryptoTraderService.SetExchangeTradeProcessing:output_type -> gctrpc.GenericResponse
-	153, // 351: gctrpc.GoCryptoTraderService.UpsertDataHistoryJob:output_type -> gctrpc.UpsertDataHistoryJobResponse
-	155, // 352: gctrpc.GoCryptoTraderService.GetDataHistoryJobDetails:output_type -> gctrpc.DataHistoryJob
-	157, // 353: gctrpc.GoCryptoTraderService.GetActiveDataHistoryJobs:output_type -> gctrpc.DataHistoryJobs
-	157, // 354: gctrpc.GoCryptoTraderService.GetDataHistoryJobsBetween:output_type -> gctrpc.DataHistoryJobs
-	155, // 355: gctrpc.GoCryptoTraderService.GetDataHistoryJobSummary:output_type -> gctrpc.DataHistoryJob
-	132, // 356: gctrpc.GoCryptoTraderService.SetDataHistoryJobStatus:output_type -> gctrpc.GenericResponse
-	132, // 357: gctrpc.GoCryptoTraderService.UpdateDataHistoryJobPrerequisite:output_type -> gctrpc.GenericResponse
-	59,  // 358: gctrpc.GoCryptoTraderService.GetManagedOrders:output_type -> gctrpc.GetOrdersResponse
-	162, // 359: gctrpc.GoCryptoTraderService.ModifyOrder:output_type -> gctrpc.ModifyOrderResponse
-	168, // 360: gctrpc.GoCryptoTraderService.CurrencyStateGetAll:output_type -> gctrpc.CurrencyStateResponse
-	132, // 361: gctrpc.GoCryptoTraderService.CurrencyStateTrading:output_type -> gctrpc.GenericResponse
-	132, // 362: gctrpc.GoCryptoTraderService.CurrencyStateDeposit:output_type -> gctrpc.GenericResponse
-	132, // 363: gctrpc.GoCryptoTraderService.CurrencyStateWithdraw:output_type -> gctrpc.GenericResponse
-	132, // 364: gctrpc.GoCryptoTraderService.CurrencyStateTradingPair:output_type -> gctrpc.GenericResponse
-	178, // 365: gctrpc.GoCryptoTraderService.GetFuturesPositionsSummary:output_type -> gctrpc.GetFuturesPositionsSummaryResponse
-	180, // 366: gctrpc.GoCryptoTraderService.GetFuturesPositionsOrders:output_type -> gctrpc.GetFuturesPositionsOrdersResponse
-	196, // 367: gctrpc.GoCryptoTraderService.GetCollateral:output_type -> gctrpc.GetCollateralResponse
-	205, // 368: gctrpc.GoCryptoTraderService.Shutdown:output_type -> gctrpc.ShutdownResponse
-	208, // 369: gctrpc.GoCryptoTraderService.GetTechnicalAnalysis:output_type -> gctrpc.GetTechnicalAnalysisResponse
-	213, // 370: gctrpc.GoCryptoTraderService.GetMarginRatesHistory:output_type -> gctrpc.GetMarginRatesHistoryResponse
-	176, // 371: gctrpc.GoCryptoTraderService.GetManagedPosition:output_type -> gctrpc.GetManagedPositionsResponse
-	176, // 372: gctrpc.GoCryptoTraderService.GetAllManagedPositions:output_type -> gctrpc.GetManagedPositionsResponse
-	201, // 373: gctrpc.GoCryptoTraderService.GetFundingRates:output_type -> gctrpc.GetFundingRatesResponse
-	203, // 374: gctrpc.GoCryptoTraderService.GetLatestFundingRate:output_type -> gctrpc.GetLatestFundingRateResponse
-	215, // 375: gctrpc.GoCryptoTraderService.GetOrderbookMovement:output_type -> gctrpc.GetOrderbookMovementResponse
-	217, // 376: gctrpc.GoCryptoTraderService.GetOrderbookAmountByNominal:output_type -> gctrpc.GetOrderbookAmountByNominalResponse
-	219, // 377: gctrpc.GoCryptoTraderService.GetOrderbookAmountByImpact:output_type -> gctrpc.GetOrderbookAmountByImpactResponse
-	182, // 378: gctrpc.GoCryptoTraderService.GetCollateralMode:output_type -> gctrpc.GetCollateralModeResponse
-	192, // 379: gctrpc.GoCryptoTraderService.GetLeverage:output_type -> gctrpc.GetLeverageResponse
-	184, // 380: gctrpc.GoCryptoTraderService.SetCollateralMode:output_type -> gctrpc.SetCollateralModeResponse
-	190, // 381: gctrpc.GoCryptoTraderService.SetMarginType:output_type -> gctrpc.SetMarginTypeResponse
-	194, // 382: gctrpc.GoCryptoTraderService.SetLeverage:output_type -> gctrpc.SetLeverageResponse
-	188, // 383: gctrpc.GoCryptoTraderService.ChangePositionMargin:output_type -> gctrpc.ChangePositionMarginResponse
-	222, // 384: gctrpc.GoCryptoTraderService.GetOpenInterest:output_type -> gctrpc.GetOpenInterestResponse
-	225, // 385: gctrpc.GoCryptoTraderService.GetCurrencyTradeURL:output_type -> gctrpc.GetCurrencyTradeURLResponse
-	271, // [271:386] is the sub-list for method output_type
-	156, // [156:271] is the sub-list for method input_type
-	156, // [156:156] is the sub-list for extension type_name
-	156, // [156:156] is the sub-list for extension extendee
-	0,   // [0:156] is the sub-list for field type_name
+	21,  // 146: gctrpc.DataQualityCheckRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 147: gctrpc.DataQualityReport.pair:type_name -> gctrpc.CurrencyPair
+	263, // 148: gctrpc.DataQualityReport.issue_summary:type_name -> gctrpc.DataQualityReport.IssueSummaryEntry
+	227, // 149: gctrpc.DataQualityReport.issues:type_name -> gctrpc.DataQualityIssue
+	21,  // 150: gctrpc.GetDataQualityReportsRequest.pair:type_name -> gctrpc.CurrencyPair
+	228, // 151: gctrpc.GetDataQualityReportsResponse.reports:type_name -> gctrpc.DataQualityReport
+	21,  // 152: gctrpc.GetCompositePricesRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 153: gctrpc.CompositePriceConstituent.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 154: gctrpc.CompositePrice.pair:type_name -> gctrpc.CurrencyPair
+	232, // 155: gctrpc.CompositePrice.constituents:type_name -> gctrpc.CompositePriceConstituent
+	233, // 156: gctrpc.GetCompositePricesResponse.prices:type_name -> gctrpc.CompositePrice
+	235, // 157: gctrpc.GetRateLimitStatusResponse.budgets:type_name -> gctrpc.RateLimitBudget
+	239, // 158: gctrpc.GetPendingWithdrawalsResponse.withdrawals:type_name -> gctrpc.PendingWithdrawal
+	21,  // 159: gctrpc.GetOrderUpdatesStreamRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 160: gctrpc.GetFillsStreamRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 161: gctrpc.FillResponse.pair:type_name -> gctrpc.CurrencyPair
+	9,   // 162: gctrpc.GetInfoResponse.RpcEndpointsEntry.value:type_name -> gctrpc.RPCEndpoint
+	3,   // 163: gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry.value:type_name -> gctrpc.CommunicationRelayer
+	9,   // 164: gctrpc.GetRPCEndpointsResponse.EndpointsEntry.value:type_name -> gctrpc.RPCEndpoint
+	18,  // 165: gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry.value:type_name -> gctrpc.PairsSupported
+	44,  // 166: gctrpc.OnlineCoins.CoinsEntry.value:type_name -> gctrpc.OnlineCoinSummary
+	45,  // 167: gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry.value:type_name -> gctrpc.OfflineCoins
+	46,  // 168: gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry.value:type_name -> gctrpc.OnlineCoins
+	81,  // 169: gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry.value:type_name -> gctrpc.DepositAddresses
+	18,  // 170: gctrpc.GetExchangePairsResponse.SupportedAssetsEntry.value:type_name -> gctrpc.PairsSupported
+	207, // 171: gctrpc.GetTechnicalAnalysisResponse.SignalsEntry.value:type_name -> gctrpc.ListOfSignals
+	0,   // 172: gctrpc.GoCryptoTraderService.GetInfo:input_type -> gctrpc.GetInfoRequest
+	6,   // 173: gctrpc.GoCryptoTraderService.GetSubsystems:input_type -> gctrpc.GetSubsystemsRequest
+	5,   // 174: gctrpc.GoCryptoTraderService.EnableSubsystem:input_type -> gctrpc.GenericSubsystemRequest
+	5,   // 175: gctrpc.GoCryptoTraderService.DisableSubsystem:input_type -> gctrpc.GenericSubsystemRequest
+	8,   // 176: gctrpc.GoCryptoTraderService.GetRPCEndpoints:input_type -> gctrpc.GetRPCEndpointsRequest
+	2,   // 177: gctrpc.GoCryptoTraderService.GetCommunicationRelayers:input_type -> gctrpc.GetCommunicationRelayersRequest
+	12,  // 178: gctrpc.GoCryptoTraderService.GetExchanges:input_type -> gctrpc.GetExchangesRequest
+	11,  // 179: gctrpc.GoCryptoTraderService.DisableExchange:input_type -> gctrpc.GenericExchangeNameRequest
+	11,  // 180: gctrpc.GoCryptoTraderService.GetExchangeInfo:input_type -> gctrpc.GenericExchangeNameRequest
+	11,  // 181: gctrpc.GoCryptoTraderService.GetExchangeOTPCode:input_type -> gctrpc.GenericExchangeNameRequest
+	15,  // 182: gctrpc.GoCryptoTraderService.GetExchangeOTPCodes:input_type -> gctrpc.GetExchangeOTPsRequest
+	11,  // 183: gctrpc.GoCryptoTraderService.EnableExchange:input_type -> gctrpc.GenericExchangeNameRequest
+	20,  // 184: gctrpc.GoCryptoTraderService.GetTicker:input_type -> gctrpc.GetTickerRequest
+	23,  // 185: gctrpc.GoCryptoTraderService.GetTickers:input_type -> gctrpc.GetTickersRequest
+	26,  // 186: gctrpc.GoCryptoTraderService.GetOrderbook:input_type -> gctrpc.GetOrderbookRequest
+	29,  // 187: gctrpc.GoCryptoTraderService.GetOrderbooks:input_type -> gctrpc.GetOrderbooksRequest
+	32,  // 188: gctrpc.GoCryptoTraderService.GetAccountBalances:input_type -> gctrpc.GetAccountBalancesRequest
+	32,  // 189: gctrpc.GoCryptoTraderService.UpdateAccountBalances:input_type -> gctrpc.GetAccountBalancesRequest
+	32,  // 190: gctrpc.GoCryptoTraderService.GetAccountBalancesStream:input_type -> gctrpc.GetAccountBalancesRequest
+	36,  // 191: gctrpc.GoCryptoTraderService.GetConfig:input_type -> gctrpc.GetConfigRequest
+	39,  // 192: gctrpc.GoCryptoTraderService.GetPortfolio:input_type -> gctrpc.GetPortfolioRequest
+	41,  // 193: gctrpc.GoCryptoTraderService.GetPortfolioSummary:input_type -> gctrpc.GetPortfolioSummaryRequest
+	48,  // 194: gctrpc.GoCryptoTraderService.AddPortfolioAddress:input_type -> gctrpc.AddPortfolioAddressRequest
+	49,  // 195: gctrpc.GoCryptoTraderService.RemovePortfolioAddress:input_type -> gctrpc.RemovePortfolioAddressRequest
+	50,  // 196: gctrpc.GoCryptoTraderService.GetForexProviders:input_type -> gctrpc.GetForexProvidersRequest
+	53,  // 197: gctrpc.GoCryptoTraderService.GetForexRates:input_type -> gctrpc.GetForexRatesRequest
+	58,  // 198: gctrpc.GoCryptoTraderService.GetOrders:input_type -> gctrpc.GetOrdersRequest
+	60,  // 199: gctrpc.GoCryptoTraderService.GetOrder:input_type -> gctrpc.GetOrderRequest
+	61,  // 200: gctrpc.GoCryptoTraderService.SubmitOrder:input_type -> gctrpc.SubmitOrderRequest
+	64,  // 201: gctrpc.GoCryptoTraderService.SimulateOrder:input_type -> gctrpc.SimulateOrderRequest
+	66,  // 202: gctrpc.GoCryptoTraderService.WhaleBomb:input_type -> gctrpc.WhaleBombRequest
+	67,  // 203: gctrpc.GoCryptoTraderService.CancelOrder:input_type -> gctrpc.CancelOrderRequest
+	68,  // 204: gctrpc.GoCryptoTraderService.CancelBatchOrders:input_type -> gctrpc.CancelBatchOrdersRequest
+	71,  // 205: gctrpc.GoCryptoTraderService.CancelAllOrders:input_type -> gctrpc.CancelAllOrdersRequest
+	73,  // 206: gctrpc.GoCryptoTraderService.GetEvents:input_type -> gctrpc.GetEventsRequest
+	76,  // 207: gctrpc.GoCryptoTraderService.AddEvent:input_type -> gctrpc.AddEventRequest
+	78,  // 208: gctrpc.GoCryptoTraderService.RemoveEvent:input_type -> gctrpc.RemoveEventRequest
+	79,  // 209: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddresses:input_type -> gctrpc.GetCryptocurrencyDepositAddressesRequest
+	83,  // 210: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddress:input_type -> gctrpc.GetCryptocurrencyDepositAddressRequest
+	85,  // 211: gctrpc.GoCryptoTraderService.GetAvailableTransferChains:input_type -> gctrpc.GetAvailableTransferChainsRequest
+	87,  // 212: gctrpc.GoCryptoTraderService.WithdrawFiatFunds:input_type -> gctrpc.WithdrawFiatRequest
+	88,  // 213: gctrpc.GoCryptoTraderService.WithdrawCryptocurrencyFunds:input_type -> gctrpc.WithdrawCryptoRequest
+	90,  // 214: gctrpc.GoCryptoTraderService.WithdrawalEventByID:input_type -> gctrpc.WithdrawalEventByIDRequest
+	92,  // 215: gctrpc.GoCryptoTraderService.WithdrawalEventsByExchange:input_type -> gctrpc.WithdrawalEventsByExchangeRequest
+	93,  // 216: gctrpc.GoCryptoTraderService.WithdrawalEventsByDate:input_type -> gctrpc.WithdrawalEventsByDateRequest
+	100, // 217: gctrpc.GoCryptoTraderService.GetLoggerDetails:input_type -> gctrpc.GetLoggerDetailsRequest
+	102, // 218: gctrpc.GoCryptoTraderService.SetLoggerDetails:input_type -> gctrpc.SetLoggerDetailsRequest
+	103, // 219: gctrpc.GoCryptoTraderService.GetExchangePairs:input_type -> gctrpc.GetExchangePairsRequest
+	105, // 220: gctrpc.GoCryptoTraderService.SetExchangePair:input_type -> gctrpc.SetExchangePairRequest
+	106, // 221: gctrpc.GoCryptoTraderService.GetOrderbookStream:input_type -> gctrpc.GetOrderbookStreamRequest
+	107, // 222: gctrpc.GoCryptoTraderService.GetExchangeOrderbookStream:input_type -> gctrpc.GetExchangeOrderbookStreamRequest
+	108, // 223: gctrpc.GoCryptoTraderService.GetTickerStream:input_type -> gctrpc.GetTickerStreamRequest
+	109, // 224: gctrpc.GoCryptoTraderService.GetExchangeTickerStream:input_type -> gctrpc.GetExchangeTickerStreamRequest
+	110, // 225: gctrpc.GoCryptoTraderService.GetAuditEvent:input_type -> gctrpc.GetAuditEventRequest
+	121, // 226: gctrpc.GoCryptoTraderService.GCTScriptExecute:input_type -> gctrpc.GCTScriptExecuteRequest
+	126, // 227: gctrpc.GoCryptoTraderService.GCTScriptUpload:input_type -> gctrpc.GCTScriptUploadRequest
+	127, // 228: gctrpc.GoCryptoTraderService.GCTScriptReadScript:input_type -> gctrpc.GCTScriptReadScriptRequest
+	124, // 229: gctrpc.GoCryptoTraderService.GCTScriptStatus:input_type -> gctrpc.GCTScriptStatusRequest
+	128, // 230: gctrpc.GoCryptoTraderService.GCTScriptQuery:input_type -> gctrpc.GCTScriptQueryRequest
+	122, // 231: gctrpc.GoCryptoTraderService.GCTScriptStop:input_type -> gctrpc.GCTScriptStopRequest
+	123, // 232: gctrpc.GoCryptoTraderService.GCTScriptStopAll:input_type -> gctrpc.GCTScriptStopAllRequest
+	125, // 233: gctrpc.GoCryptoTraderService.GCTScriptListAll:input_type -> gctrpc.GCTScriptListAllRequest
+	129, // 234: gctrpc.GoCryptoTraderService.GCTScriptAutoLoadToggle:input_type -> gctrpc.GCTScriptAutoLoadRequest
+	116, // 235: gctrpc.GoCryptoTraderService.GetHistoricCandles:input_type -> gctrpc.GetHistoricCandlesRequest
+	133, // 236: gctrpc.GoCryptoTraderService.SetExchangeAsset:input_type -> gctrpc.SetExchangeAssetRequest
+	134, // 237: gctrpc.GoCryptoTraderService.SetAllExchangePairs:input_type -> gctrpc.SetExchangeAllPairsRequest
+	135, // 238: gctrpc.GoCryptoTraderService.UpdateExchangeSupportedPairs:input_type -> gctrpc.UpdateExchangeSupportedPairsRequest
+	136, // 239: gctrpc.GoCryptoTraderService.GetExchangeAssets:input_type -> gctrpc.GetExchangeAssetsRequest
+	138, // 240: gctrpc.GoCryptoTraderService.WebsocketGetInfo:input_type -> gctrpc.WebsocketGetInfoRequest
+	140, // 241: gctrpc.GoCryptoTraderService.WebsocketSetEnabled:input_type -> gctrpc.WebsocketSetEnabledRequest
+	141, // 242: gctrpc.GoCryptoTraderService.WebsocketGetSubscriptions:input_type -> gctrpc.WebsocketGetSubscriptionsRequest
+	144, // 243: gctrpc.GoCryptoTraderService.WebsocketSetProxy:input_type -> gctrpc.WebsocketSetProxyRequest
+	145, // 244: gctrpc.GoCryptoTraderService.WebsocketSetURL:input_type -> gctrpc.WebsocketSetURLRequest
+	112, // 245: gctrpc.GoCryptoTraderService.GetRecentTrades:input_type -> gctrpc.GetSavedTradesRequest
+	112, // 246: gctrpc.GoCryptoTraderService.GetHistoricTrades:input_type -> gctrpc.GetSavedTradesRequest
+	112, // 247: gctrpc.GoCryptoTraderService.GetSavedTrades:input_type -> gctrpc.GetSavedTradesRequest
+	115, // 248: gctrpc.GoCryptoTraderService.ConvertTradesToCandles:input_type -> gctrpc.ConvertTradesToCandlesRequest
+	146, // 249: gctrpc.GoCryptoTraderService.FindMissingSavedCandleIntervals:input_type -> gctrpc.FindMissingCandlePeriodsRequest
+	147, // 250: gctrpc.GoCryptoTraderService.FindMissingSavedTradeIntervals:input_type -> gctrpc.FindMissingTradePeriodsRequest
+	149, // 251: gctrpc.GoCryptoTraderService.SetExchangeTradeProcessing:input_type -> gctrpc.SetExchangeTradeProcessingRequest
+	150, // 252: gctrpc.GoCryptoTraderService.UpsertDataHistoryJob:input_type -> gctrpc.UpsertDataHistoryJobRequest
+	154, // 253: gctrpc.GoCryptoTraderService.GetDataHistoryJobDetails:input_type -> gctrpc.GetDataHistoryJobDetailsRequest
+	0,   // 254: gctrpc.GoCryptoTraderService.GetActiveDataHistoryJobs:input_type -> gctrpc.GetInfoRequest
+	158, // 255: gctrpc.GoCryptoTraderService.GetDataHistoryJobsBetween:input_type -> gctrpc.GetDataHistoryJobsBetweenRequest
+	154, // 256: gctrpc.GoCryptoTraderService.GetDataHistoryJobSummary:input_type -> gctrpc.GetDataHistoryJobDetailsRequest
+	159, // 257: gctrpc.GoCryptoTraderService.SetDataHistoryJobStatus:input_type -> gctrpc.SetDataHistoryJobStatusRequest
+	160, // 258: gctrpc.GoCryptoTraderService.UpdateDataHistoryJobPrerequisite:input_type -> gctrpc.UpdateDataHistoryJobPrerequisiteRequest
+	58,  // 259: gctrpc.GoCryptoTraderService.GetManagedOrders:input_type -> gctrpc.GetOrdersRequest
+	161, // 260: gctrpc.GoCryptoTraderService.ModifyOrder:input_type -> gctrpc.ModifyOrderRequest
+	163, // 261: gctrpc.GoCryptoTraderService.CurrencyStateGetAll:input_type -> gctrpc.CurrencyStateGetAllRequest
+	164, // 262: gctrpc.GoCryptoTraderService.CurrencyStateTrading:input_type -> gctrpc.CurrencyStateTradingRequest
+	167, // 263: gctrpc.GoCryptoTraderService.CurrencyStateDeposit:input_type -> gctrpc.CurrencyStateDepositRequest
+	166, // 264: gctrpc.GoCryptoTraderService.CurrencyStateWithdraw:input_type -> gctrpc.CurrencyStateWithdrawRequest
+	165, // 265: gctrpc.GoCryptoTraderService.CurrencyStateTradingPair:input_type -> gctrpc.CurrencyStateTradingPairRequest
+	177, // 266: gctrpc.GoCryptoTraderService.GetFuturesPositionsSummary:input_type -> gctrpc.GetFuturesPositionsSummaryRequest
+	179, // 267: gctrpc.GoCryptoTraderService.GetFuturesPositionsOrders:input_type -> gctrpc.GetFuturesPositionsOrdersRequest
+	195, // 268: gctrpc.GoCryptoTraderService.GetCollateral:input_type -> gctrpc.GetCollateralRequest
+	204, // 269: gctrpc.GoCryptoTraderService.Shutdown:input_type -> gctrpc.ShutdownRequest
+	206, // 270: gctrpc.GoCryptoTraderService.GetTechnicalAnalysis:input_type -> gctrpc.GetTechnicalAnalysisRequest
+	209, // 271: gctrpc.GoCryptoTraderService.GetMarginRatesHistory:input_type -> gctrpc.GetMarginRatesHistoryRequest
+	174, // 272: gctrpc.GoCryptoTraderService.GetManagedPosition:input_type -> gctrpc.GetManagedPositionRequest
+	175, // 273: gctrpc.GoCryptoTraderService.GetAllManagedPositions:input_type -> gctrpc.GetAllManagedPositionsRequest
+	200, // 274: gctrpc.GoCryptoTraderService.GetFundingRates:input_type -> gctrpc.GetFundingRatesRequest
+	202, // 275: gctrpc.GoCryptoTraderService.GetLatestFundingRate:input_type -> gctrpc.GetLatestFundingRateRequest
+	214, // 276: gctrpc.GoCryptoTraderService.GetOrderbookMovement:input_type -> gctrpc.GetOrderbookMovementRequest
+	216, // 277: gctrpc.GoCryptoTraderService.GetOrderbookAmountByNominal:input_type -> gctrpc.GetOrderbookAmountByNominalRequest
+	218, // 278: gctrpc.GoCryptoTraderService.GetOrderbookAmountByImpact:input_type -> gctrpc.GetOrderbookAmountByImpactRequest
+	181, // 279: gctrpc.GoCryptoTraderService.GetCollateralMode:input_type -> gctrpc.GetCollateralModeRequest
+	191, // 280: gctrpc.GoCryptoTraderService.GetLeverage:input_type -> gctrpc.GetLeverageRequest
+	183, // 281: gctrpc.GoCryptoTraderService.SetCollateralMode:input_type -> gctrpc.SetCollateralModeRequest
+	189, // 282: gctrpc.GoCryptoTraderService.SetMarginType:input_type -> gctrpc.SetMarginTypeRequest
+	193, // 283: gctrpc.GoCryptoTraderService.SetLeverage:input_type -> gctrpc.SetLeverageRequest
+	187, // 284: gctrpc.GoCryptoTraderService.ChangePositionMargin:input_type -> gctrpc.ChangePositionMarginRequest
+	220, // 285: gctrpc.GoCryptoTraderService.GetOpenInterest:input_type -> gctrpc.GetOpenInterestRequest
+	224, // 286: gctrpc.GoCryptoTraderService.GetCurrencyTradeURL:input_type -> gctrpc.GetCurrencyTradeURLRequest
+	226, // 287: gctrpc.GoCryptoTraderService.RunDataQualityCheck:input_type -> gctrpc.DataQualityCheckRequest
+	229, // 288: gctrpc.GoCryptoTraderService.GetDataQualityReports:input_type -> gctrpc.GetDataQualityReportsRequest
+	231, // 289: gctrpc.GoCryptoTraderService.GetCompositePrices:input_type -> gctrpc.GetCompositePricesRequest
+	237, // 290: gctrpc.GoCryptoTraderService.GetDatabaseChangeFeedStream:input_type -> gctrpc.GetDatabaseChangeFeedStreamRequest
+	11,  // 291: gctrpc.GoCryptoTraderService.GetRateLimitStatus:input_type -> gctrpc.GenericExchangeNameRequest
+	11,  // 292: gctrpc.GoCryptoTraderService.ReloadExchangeCredentials:input_type -> gctrpc.GenericExchangeNameRequest
+	240, // 293: gctrpc.GoCryptoTraderService.GetPendingWithdrawals:input_type -> gctrpc.GetPendingWithdrawalsRequest
+	242, // 294: gctrpc.GoCryptoTraderService.ApproveWithdrawal:input_type -> gctrpc.ApproveWithdrawalRequest
+	243, // 295: gctrpc.GoCryptoTraderService.RejectWithdrawal:input_type -> gctrpc.RejectWithdrawalRequest
+	244, // 296: gctrpc.GoCryptoTraderService.ReloadConfig:input_type -> gctrpc.ReloadConfigRequest
+	246, // 297: gctrpc.GoCryptoTraderService.GetOrderUpdatesStream:input_type -> gctrpc.GetOrderUpdatesStreamRequest
+	247, // 298: gctrpc.GoCryptoTraderService.GetFillsStream:input_type -> gctrpc.GetFillsStreamRequest
+	1,   // 299: gctrpc.GoCryptoTraderService.GetInfo:output_type -> gctrpc.GetInfoResponse
+	7,   // 300: gctrpc.GoCryptoTraderService.GetSubsystems:output_type -> gctrpc.GetSubsystemsResponse
+	132, // 301: gctrpc.GoCryptoTraderService.EnableSubsystem:output_type -> gctrpc.GenericResponse
+	132, // 302: gctrpc.GoCryptoTraderService.DisableSubsystem:output_type -> gctrpc.GenericResponse
+	10,  // 303: gctrpc.GoCryptoTraderService.GetRPCEndpoints:output_type -> gctrpc.GetRPCEndpointsResponse
+	4,   // 304: gctrpc.GoCryptoTraderService.GetCommunicationRelayers:output_type -> gctrpc.GetCommunicationRelayersResponse
+	13,  // 305: gctrpc.GoCryptoTraderService.GetExchanges:output_type -> gctrpc.GetExchangesResponse
+	132, // 306: gctrpc.GoCryptoTraderService.DisableExchange:output_type -> gctrpc.GenericResponse
+	19,  // 307: gctrpc.GoCryptoTraderService.GetExchangeInfo:output_type -> gctrpc.GetExchangeInfoResponse
+	14,  // 308: gctrpc.GoCryptoTraderService.GetExchangeOTPCode:output_type -> gctrpc.GetExchangeOTPResponse
+	16,  // 309: gctrpc.GoCryptoTraderService.GetExchangeOTPCodes:output_type -> gctrpc.GetExchangeOTPsResponse
+	132, // 310: gctrpc.GoCryptoTraderService.EnableExchange:output_type -> gctrpc.GenericResponse
+	22,  // 311: gctrpc.GoCryptoTraderService.GetTicker:output_type -> gctrpc.TickerResponse
+	25,  // 312: gctrpc.GoCryptoTraderService.GetTickers:output_type -> gctrpc.GetTickersResponse
+	28,  // 313: gctrpc.GoCryptoTraderService.GetOrderbook:output_type -> gctrpc.OrderbookResponse
+	31,  // 314: gctrpc.GoCryptoTraderService.GetOrderbooks:output_type -> gctrpc.GetOrderbooksResponse
+	35,  // 315: gctrpc.GoCryptoTraderService.GetAccountBalances:output_type -> gctrpc.GetAccountBalancesResponse
+	35,  // 316: gctrpc.GoCryptoTraderService.UpdateAccountBalances:output_type -> gctrpc.GetAccountBalancesResponse
+	35,  // 317: gctrpc.GoCryptoTraderService.GetAccountBalancesStream:output_type -> gctrpc.GetAccountBalancesResponse
+	37,  // 318: gctrpc.GoCryptoTraderService.GetConfig:output_type -> gctrpc.GetConfigResponse
+	40,  // 319: gctrpc.GoCryptoTraderService.GetPortfolio:output_type -> gctrpc.GetPortfolioResponse
+	47,  // 320: gctrpc.GoCryptoTraderService.GetPortfolioSummary:output_type -> gctrpc.GetPortfolioSummaryResponse
+	132, // 321: gctrpc.GoCryptoTraderService.AddPortfolioAddress:output_type -> gctrpc.GenericResponse
+	132, // 322: gctrpc.GoCryptoTraderService.RemovePortfolioAddress:output_type -> gctrpc.GenericResponse
+	52,  // 323: gctrpc.GoCryptoTraderService.GetForexProviders:output_type -> gctrpc.GetForexProvidersResponse
+	55,  // 324: gctrpc.GoCryptoTraderService.GetForexRates:output_type -> gctrpc.GetForexRatesResponse
+	59,  // 325: gctrpc.GoCryptoTraderService.GetOrders:output_type -> gctrpc.GetOrdersResponse
+	56,  // 326: gctrpc.GoCryptoTraderService.GetOrder:output_type -> gctrpc.OrderDetails
+	63,  // 327: gctrpc.GoCryptoTraderService.SubmitOrder:output_type -> gctrpc.SubmitOrderResponse
+	65,  // 328: gctrpc.GoCryptoTraderService.SimulateOrder:output_type -> gctrpc.SimulateOrderResponse
+	65,  // 329: gctrpc.GoCryptoTraderService.WhaleBomb:output_type -> gctrpc.SimulateOrderResponse
+	132, // 330: gctrpc.GoCryptoTraderService.CancelOrder:output_type -> gctrpc.GenericResponse
+	70,  // 331: gctrpc.GoCryptoTraderService.CancelBatchOrders:output_type -> gctrpc.CancelBatchOrdersResponse
+	72,  // 332: gctrpc.GoCryptoTraderService.CancelAllOrders:output_type -> gctrpc.CancelAllOrdersResponse
+	75,  // 333: gctrpc.GoCryptoTraderService.GetEvents:output_type -> gctrpc.GetEventsResponse
+	77,  // 334: gctrpc.GoCryptoTraderService.AddEvent:output_type -> gctrpc.AddEventResponse
+	132, // 335: gctrpc.GoCryptoTraderService.RemoveEvent:output_type -> gctrpc.GenericResponse
+	82,  // 336: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddresses:output_type -> gctrpc.GetCryptocurrencyDepositAddressesResponse
+	84,  // 337: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddress:output_type -> gctrpc.GetCryptocurrencyDepositAddressResponse
+	86,  // 338: gctrpc.GoCryptoTraderService.GetAvailableTransferChains:output_type -> gctrpc.GetAvailableTransferChainsResponse
+	89,  // 339: gctrpc.GoCryptoTraderService.WithdrawFiatFunds:output_type -> gctrpc.WithdrawResponse
+	89,  // 340: gctrpc.GoCryptoTraderService.WithdrawCryptocurrencyFunds:output_type -> gctrpc.WithdrawResponse
+	91,  // 341: gctrpc.GoCryptoTraderService.WithdrawalEventByID:output_type -> gctrpc.WithdrawalEventByIDResponse
+	94,  // 342: gctrpc.GoCryptoTraderService.WithdrawalEventsByExchange:output_type -> gctrpc.WithdrawalEventsByExchangeResponse
+	94,  // 343: gctrpc.GoCryptoTraderService.WithdrawalEventsByDate:output_type -> gctrpc.WithdrawalEventsByExchangeResponse
+	101, // 344: gctrpc.GoCryptoTraderService.GetLoggerDetails:output_type -> gctrpc.GetLoggerDetailsResponse
+	101, // 345: gctrpc.GoCryptoTraderService.SetLoggerDetails:output_type -> gctrpc.GetLoggerDetailsResponse
+	104, // 346: gctrpc.GoCryptoTraderService.GetExchangePairs:output_type -> gctrpc.GetExchangePairsResponse
+	132, // 347: gctrpc.GoCryptoTraderService.SetExchangePair:output_type -> gctrpc.GenericResponse
+	28,  // 348: gctrpc.GoCryptoTraderService.GetOrderbookStream:output_type -> gctrpc.OrderbookResponse
+	28,  // 349: gctrpc.GoCryptoTraderService.GetExchangeOrderbookStream:output_type -> gctrpc.OrderbookResponse
+	22,  // 350: gctrpc.GoCryptoTraderService.GetTickerStream:output_type -> gctrpc.TickerResponse
+	22,  // 351: gctrpc.GoCryptoTraderService.GetExchangeTickerStream:output_type -> gctrpc.TickerResponse
+	111, // 352: gctrpc.GoCryptoTraderService.GetAuditEvent:output_type -> gctrpc.GetAuditEventResponse
+	132, // 353: gctrpc.GoCryptoTraderService.GCTScriptExecute:output_type -> gctrpc.GenericResponse
+	132, // 354: gctrpc.GoCryptoTraderService.GCTScriptUpload:output_type -> gctrpc.GenericResponse
+	131, // 355: gctrpc.GoCryptoTraderService.GCTScriptReadScript:output_type -> gctrpc.GCTScriptQueryResponse
+	130, // 356: gctrpc.GoCryptoTraderService.GCTScriptStatus:output_type -> gctrpc.GCTScriptStatusResponse
+	131, // 357: gctrpc.GoCryptoTraderService.GCTScriptQuery:output_type -> gctrpc.GCTScriptQueryResponse
+	132, // 358: gctrpc.GoCryptoTraderService.GCTScriptStop:output_type -> gctrpc.GenericResponse
+	132, // 359: gctrpc.GoCryptoTraderService.GCTScriptStopAll:output_type -> gctrpc.GenericResponse
+	130, // 360: gctrpc.GoCryptoTraderService.GCTScriptListAll:output_type -> gctrpc.GCTScriptStatusResponse
+	132, // 361: gctrpc.GoCryptoTraderService.GCTScriptAutoLoadToggle:output_type -> gctrpc.GenericResponse
+	117, // 362: gctrpc.GoCryptoTraderService.GetHistoricCandles:output_type -> gctrpc.GetHistoricCandlesResponse
+	132, // 363: gctrpc.GoCryptoTraderService.SetExchangeAsset:output_type -> gctrpc.GenericResponse
+	132, // 364: gctrpc.GoCryptoTraderService.SetAllExchangePairs:output_type -> gctrpc.GenericResponse
+	132, // 365: gctrpc.GoCryptoTraderService.UpdateExchangeSupportedPairs:output_type -> gctrpc.GenericResponse
+	137, // 366: gctrpc.GoCryptoTraderService.GetExchangeAssets:output_type -> gctrpc.GetExchangeAssetsResponse
+	139, // 367: gctrpc.GoCryptoTraderService.WebsocketGetInfo:output_type -> gctrpc.WebsocketGetInfoResponse
+	132, // 368: gctrpc.GoCryptoTraderService.WebsocketSetEnabled:output_type -> gctrpc.GenericResponse
+	143, // 369: gctrpc.GoCryptoTraderService.WebsocketGetSubscriptions:output_type -> gctrpc.WebsocketGetSubscriptionsResponse
+	132, // 370: gctrpc.GoCryptoTraderService.WebsocketSetProxy:output_type -> gctrpc.GenericResponse
+	132, // 371: gctrpc.GoCryptoTraderService.WebsocketSetURL:output_type -> gctrpc.GenericResponse
+	114, // 372: gctrpc.GoCryptoTraderService.GetRecentTrades:output_type -> gctrpc.SavedTradesResponse
+	114, // 373: gctrpc.GoCryptoTraderService.GetHistoricTrades:output_type -> gctrpc.SavedTradesResponse
+	114, // 374: gctrpc.GoCryptoTraderService.GetSavedTrades:output_type -> gctrpc.SavedTradesResponse
+	117, // 375: gctrpc.GoCryptoTraderService.ConvertTradesToCandles:output_type -> gctrpc.GetHistoricCandlesResponse
+	148, // 376: gctrpc.GoCryptoTraderService.FindMissingSavedCandleIntervals:output_type -> gctrpc.FindMissingIntervalsResponse
+	148, // 377: gctrpc.GoCryptoTraderService.FindMissingSavedTradeIntervals:output_type -> gctrpc.FindMissingIntervalsResponse
+	132, // 378: gctrpc.GoCryptoTraderService.SetExchangeTradeProcessing:output_type -> gctrpc.GenericResponse
+	153, // 379: gctrpc.GoCryptoTraderService.UpsertDataHistoryJob:output_type -> gctrpc.UpsertDataHistoryJobResponse
+	155, // 380: gctrpc.GoCryptoTraderService.GetDataHistoryJobDetails:output_type -> gctrpc.DataHistoryJob
+	157, // 381: gctrpc.GoCryptoTraderService.GetActiveDataHistoryJobs:output_type -> gctrpc.DataHistoryJobs
+	157, // 382: gctrpc.GoCryptoTraderService.GetDataHistoryJobsBetween:output_type -> gctrpc.DataHistoryJobs
+	155, // 383: gctrpc.GoCryptoTraderService.GetDataHistoryJobSummary:output_type -> gctrpc.DataHistoryJob
+	132, // 384: gctrpc.GoCryptoTraderService.SetDataHistoryJobStatus:output_type -> gctrpc.GenericResponse
+	132, // 385: gctrpc.GoCryptoTraderService.UpdateDataHistoryJobPrerequisite:output_type -> gctrpc.GenericResponse
+	59,  // 386: gctrpc.GoCryptoTraderService.GetManagedOrders:output_type -> gctrpc.GetOrdersResponse
+	162, // 387: gctrpc.GoCryptoTraderService.ModifyOrder:output_type -> gctrpc.ModifyOrderResponse
+	168, // 388: gctrpc.GoCryptoTraderService.CurrencyStateGetAll:output_type -> gctrpc.CurrencyStateResponse
+	132, // 389: gctrpc.GoCryptoTraderService.CurrencyStateTrading:output_type -> gctrpc.GenericResponse
+	132, // 390: gctrpc.GoCryptoTraderService.CurrencyStateDeposit:output_type -> gctrpc.GenericResponse
+	132, // 391: gctrpc.GoCryptoTraderService.CurrencyStateWithdraw:output_type -> gctrpc.GenericResponse
+	132, // 392: gctrpc.GoCryptoTraderService.CurrencyStateTradingPair:output_type -> gctrpc.GenericResponse
+	178, // 393: gctrpc.GoCryptoTraderService.GetFuturesPositionsSummary:output_type -> gctrpc.GetFuturesPositionsSummaryResponse
+	180, // 394: gctrpc.GoCryptoTraderService.GetFuturesPositionsOrders:output_type -> gctrpc.GetFuturesPositionsOrdersResponse
+	196, // 395: gctrpc.GoCryptoTraderService.GetCollateral:output_type -> gctrpc.GetCollateralResponse
+	205, // 396: gctrpc.GoCryptoTraderService.Shutdown:output_type -> gctrpc.ShutdownResponse
+	208, // 397: gctrpc.GoCryptoTraderService.GetTechnicalAnalysis:output_type -> gctrpc.GetTechnicalAnalysisResponse
+	213, // 398: gctrpc.GoCryptoTraderService.GetMarginRatesHistory:output_type -> gctrpc.GetMarginRatesHistoryResponse
+	176, // 399: gctrpc.GoCryptoTraderService.GetManagedPosition:output_type -> gctrpc.GetManagedPositionsResponse
+	176, // 400: gctrpc.GoCryptoTraderService.GetAllManagedPositions:output_type -> gctrpc.GetManagedPositionsResponse
+	201, // 401: gctrpc.GoCryptoTraderService.GetFundingRates:output_type -> gctrpc.GetFundingRatesResponse
+	203, // 402: gctrpc.GoCryptoTraderService.GetLatestFundingRate:output_type -> gctrpc.GetLatestFundingRateResponse
+	215, // 403: gctrpc.GoCryptoTraderService.GetOrderbookMovement:output_type -> gctrpc.GetOrderbookMovementResponse
+	217, // 404: gctrpc.GoCryptoTraderService.GetOrderbookAmountByNominal:output_type -> gctrpc.GetOrderbookAmountByNominalResponse
+	219, // 405: gctrpc.GoCryptoTraderService.GetOrderbookAmountByImpact:output_type -> gctrpc.GetOrderbookAmountByImpactResponse
+	182, // 406: gctrpc.GoCryptoTraderService.GetCollateralMode:output_type -> gctrpc.GetCollateralModeResponse
+	192, // 407: gctrpc.GoCryptoTraderService.GetLeverage:output_type -> gctrpc.GetLeverageResponse
+	184, // 408: gctrpc.GoCryptoTraderService.SetCollateralMode:output_type -> gctrpc.SetCollateralModeResponse
+	190, // 409: gctrpc.GoCryptoTraderService.SetMarginType:output_type -> gctrpc.SetMarginTypeResponse
+	194, // 410: gctrpc.GoCryptoTraderService.SetLeverage:output_type -> gctrpc.SetLeverageResponse
+	188, // 411: gctrpc.GoCryptoTraderService.ChangePositionMargin:output_type -> gctrpc.ChangePositionMarginResponse
+	222, // 412: gctrpc.GoCryptoTraderService.GetOpenInterest:output_type -> gctrpc.GetOpenInterestResponse
+	225, // 413: gctrpc.GoCryptoTraderService.GetCurrencyTradeURL:output_type -> gctrpc.GetCurrencyTradeURLResponse
+	228, // 414: gctrpc.GoCryptoTraderService.RunDataQualityCheck:output_type -> gctrpc.DataQualityReport
+	230, // 415: gctrpc.GoCryptoTraderService.GetDataQualityReports:output_type -> gctrpc.GetDataQualityReportsResponse
+	234, // 416: gctrpc.GoCryptoTraderService.GetCompositePrices:output_type -> gctrpc.GetCompositePricesResponse
+	238, // 417: gctrpc.GoCryptoTraderService.GetDatabaseChangeFeedStream:output_type -> gctrpc.DatabaseChangeFeedResponse
+	236, // 418: gctrpc.GoCryptoTraderService.GetRateLimitStatus:output_type -> gctrpc.GetRateLimitStatusResponse
+	132, // 419: gctrpc.GoCryptoTraderService.ReloadExchangeCredentials:output_type -> gctrpc.GenericResponse
+	241, // 420: gctrpc.GoCryptoTraderService.GetPendingWithdrawals:output_type -> gctrpc.GetPendingWithdrawalsResponse
+	89,  // 421: gctrpc.GoCryptoTraderService.ApproveWithdrawal:output_type -> gctrpc.WithdrawResponse
+	132, // 422: gctrpc.GoCryptoTraderService.RejectWithdrawal:output_type -> gctrpc.GenericResponse
+	245, // 423: gctrpc.GoCryptoTraderService.ReloadConfig:output_type -> gctrpc.ReloadConfigResponse
+	56,  // 424: gctrpc.GoCryptoTraderService.GetOrderUpdatesStream:output_type -> gctrpc.OrderDetails
+	248, // 425: gctrpc.GoCryptoTraderService.GetFillsStream:output_type -> gctrpc.FillResponse
+	299, // [299:426] is the sub-list for method output_type
+	172, // [172:299] is the sub-list for method input_type
+	172, // [172:172] is the sub-list for extension type_name
+	172, // [172:172] is the sub-list for extension extendee
+	0,   // [0:172] is the sub-list for field type_name
 }
 
 func init() { file_rpc_proto_init() }
@@ -17147,7 +19016,7 @@ func file_rpc_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_proto_rawDesc), len(file_rpc_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   240,
+			NumMessages:   264,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
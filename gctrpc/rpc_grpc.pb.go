@@ -134,6 +134,18 @@ const (
 	GoCryptoTraderService_ChangePositionMargin_FullMethodName              = "/gctrpc.GoCryptoTraderService/ChangePositionMargin"
 	GoCryptoTraderService_GetOpenInterest_FullMethodName                   = "/gctrpc.GoCryptoTraderService/GetOpenInterest"
 	GoCryptoTraderService_GetCurrencyTradeURL_FullMethodName               = "/gctrpc.GoCryptoTraderService/GetCurrencyTradeURL"
+	GoCryptoTraderService_RunDataQualityCheck_FullMethodName               = "/gctrpc.GoCryptoTraderService/RunDataQualityCheck"
+	GoCryptoTraderService_GetDataQualityReports_FullMethodName             = "/gctrpc.GoCryptoTraderService/GetDataQualityReports"
+	GoCryptoTraderService_GetCompositePrices_FullMethodName                = "/gctrpc.GoCryptoTraderService/GetCompositePrices"
+	GoCryptoTraderService_GetDatabaseChangeFeedStream_FullMethodName       = "/gctrpc.GoCryptoTraderService/GetDatabaseChangeFeedStream"
+	GoCryptoTraderService_GetRateLimitStatus_FullMethodName                = "/gctrpc.GoCryptoTraderService/GetRateLimitStatus"
+	GoCryptoTraderService_ReloadExchangeCredentials_FullMethodName         = "/gctrpc.GoCryptoTraderService/ReloadExchangeCredentials"
+	GoCryptoTraderService_GetPendingWithdrawals_FullMethodName             = "/gctrpc.GoCryptoTraderService/GetPendingWithdrawals"
+	GoCryptoTraderService_ApproveWithdrawal_FullMethodName                 = "/gctrpc.GoCryptoTraderService/ApproveWithdrawal"
+	GoCryptoTraderService_RejectWithdrawal_FullMethodName                  = "/gctrpc.GoCryptoTraderService/RejectWithdrawal"
+	GoCryptoTraderService_ReloadConfig_FullMethodName                      = "/gctrpc.GoCryptoTraderService/ReloadConfig"
+	GoCryptoTraderService_GetOrderUpdatesStream_FullMethodName             = "/gctrpc.GoCryptoTraderService/GetOrderUpdatesStream"
+	GoCryptoTraderService_GetFillsStream_FullMethodName                    = "/gctrpc.GoCryptoTraderService/GetFillsStream"
 )
 
 // GoCryptoTraderServiceClient is the client API for GoCryptoTraderService service.
@@ -255,6 +267,18 @@ type GoCryptoTraderServiceClient interface {
 	ChangePositionMargin(ctx context.Context, in *ChangePositionMarginRequest, opts ...grpc.CallOption) (*ChangePositionMarginResponse, error)
 	GetOpenInterest(ctx context.Context, in *GetOpenInterestRequest, opts ...grpc.CallOption) (*GetOpenInterestResponse, error)
 	GetCurrencyTradeURL(ctx context.Context, in *GetCurrencyTradeURLRequest, opts ...grpc.CallOption) (*GetCurrencyTradeURLResponse, error)
+	RunDataQualityCheck(ctx context.Context, in *DataQualityCheckRequest, opts ...grpc.CallOption) (*DataQualityReport, error)
+	GetDataQualityReports(ctx context.Context, in *GetDataQualityReportsRequest, opts ...grpc.CallOption) (*GetDataQualityReportsResponse, error)
+	GetCompositePrices(ctx context.Context, in *GetCompositePricesRequest, opts ...grpc.CallOption) (*GetCompositePricesResponse, error)
+	GetDatabaseChangeFeedStream(ctx context.Context, in *GetDatabaseChangeFeedStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DatabaseChangeFeedResponse], error)
+	GetRateLimitStatus(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetRateLimitStatusResponse, error)
+	ReloadExchangeCredentials(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetPendingWithdrawals(ctx context.Context, in *GetPendingWithdrawalsRequest, opts ...grpc.CallOption) (*GetPendingWithdrawalsResponse, error)
+	ApproveWithdrawal(ctx context.Context, in *ApproveWithdrawalRequest, opts ...grpc.CallOption) (*WithdrawResponse, error)
+	RejectWithdrawal(ctx context.Context, in *RejectWithdrawalRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	ReloadConfig(ctx context.Context, in *ReloadConfigRequest, opts ...grpc.CallOption) (*ReloadConfigResponse, error)
+	GetOrderUpdatesStream(ctx context.Context, in *GetOrderUpdatesStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OrderDetails], error)
+	GetFillsStream(ctx context.Context, in *GetFillsStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FillResponse], error)
 }
 
 type goCryptoTraderServiceClient struct {
@@ -1469,6 +1493,153 @@ func (c *goCryptoTraderServiceClient) GetCurrencyTradeURL(ctx context.Context, i
 	return out, nil
 }
 
+func (c *goCryptoTraderServiceClient) RunDataQualityCheck(ctx context.Context, in *DataQualityCheckRequest, opts ...grpc.CallOption) (*DataQualityReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DataQualityReport)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_RunDataQualityCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetDataQualityReports(ctx context.Context, in *GetDataQualityReportsRequest, opts ...grpc.CallOption) (*GetDataQualityReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDataQualityReportsResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetDataQualityReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetCompositePrices(ctx context.Context, in *GetCompositePricesRequest, opts ...grpc.CallOption) (*GetCompositePricesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCompositePricesResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetCompositePrices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetDatabaseChangeFeedStream(ctx context.Context, in *GetDatabaseChangeFeedStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DatabaseChangeFeedResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTraderService_ServiceDesc.Streams[6], GoCryptoTraderService_GetDatabaseChangeFeedStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetDatabaseChangeFeedStreamRequest, DatabaseChangeFeedResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoCryptoTraderService_GetDatabaseChangeFeedStreamClient = grpc.ServerStreamingClient[DatabaseChangeFeedResponse]
+
+func (c *goCryptoTraderServiceClient) GetRateLimitStatus(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetRateLimitStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRateLimitStatusResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetRateLimitStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) ReloadExchangeCredentials(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_ReloadExchangeCredentials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetPendingWithdrawals(ctx context.Context, in *GetPendingWithdrawalsRequest, opts ...grpc.CallOption) (*GetPendingWithdrawalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPendingWithdrawalsResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetPendingWithdrawals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) ApproveWithdrawal(ctx context.Context, in *ApproveWithdrawalRequest, opts ...grpc.CallOption) (*WithdrawResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WithdrawResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_ApproveWithdrawal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) RejectWithdrawal(ctx context.Context, in *RejectWithdrawalRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_RejectWithdrawal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) ReloadConfig(ctx context.Context, in *ReloadConfigRequest, opts ...grpc.CallOption) (*ReloadConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReloadConfigResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_ReloadConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetOrderUpdatesStream(ctx context.Context, in *GetOrderUpdatesStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OrderDetails], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTraderService_ServiceDesc.Streams[7], GoCryptoTraderService_GetOrderUpdatesStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetOrderUpdatesStreamRequest, OrderDetails]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoCryptoTraderService_GetOrderUpdatesStreamClient = grpc.ServerStreamingClient[OrderDetails]
+
+func (c *goCryptoTraderServiceClient) GetFillsStream(ctx context.Context, in *GetFillsStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FillResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTraderService_ServiceDesc.Streams[8], GoCryptoTraderService_GetFillsStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetFillsStreamRequest, FillResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoCryptoTraderService_GetFillsStreamClient = grpc.ServerStreamingClient[FillResponse]
+
 // GoCryptoTraderServiceServer is the server API for GoCryptoTraderService service.
 // All implementations must embed UnimplementedGoCryptoTraderServiceServer
 // for forward compatibility.
@@ -1588,6 +1759,18 @@ type GoCryptoTraderServiceServer interface {
 	ChangePositionMargin(context.Context, *ChangePositionMarginRequest) (*ChangePositionMarginResponse, error)
 	GetOpenInterest(context.Context, *GetOpenInterestRequest) (*GetOpenInterestResponse, error)
 	GetCurrencyTradeURL(context.Context, *GetCurrencyTradeURLRequest) (*GetCurrencyTradeURLResponse, error)
+	RunDataQualityCheck(context.Context, *DataQualityCheckRequest) (*DataQualityReport, error)
+	GetDataQualityReports(context.Context, *GetDataQualityReportsRequest) (*GetDataQualityReportsResponse, error)
+	GetCompositePrices(context.Context, *GetCompositePricesRequest) (*GetCompositePricesResponse, error)
+	GetDatabaseChangeFeedStream(*GetDatabaseChangeFeedStreamRequest, grpc.ServerStreamingServer[DatabaseChangeFeedResponse]) error
+	GetRateLimitStatus(context.Context, *GenericExchangeNameRequest) (*GetRateLimitStatusResponse, error)
+	ReloadExchangeCredentials(context.Context, *GenericExchangeNameRequest) (*GenericResponse, error)
+	GetPendingWithdrawals(context.Context, *GetPendingWithdrawalsRequest) (*GetPendingWithdrawalsResponse, error)
+	ApproveWithdrawal(context.Context, *ApproveWithdrawalRequest) (*WithdrawResponse, error)
+	RejectWithdrawal(context.Context, *RejectWithdrawalRequest) (*GenericResponse, error)
+	ReloadConfig(context.Context, *ReloadConfigRequest) (*ReloadConfigResponse, error)
+	GetOrderUpdatesStream(*GetOrderUpdatesStreamRequest, grpc.ServerStreamingServer[OrderDetails]) error
+	GetFillsStream(*GetFillsStreamRequest, grpc.ServerStreamingServer[FillResponse]) error
 	mustEmbedUnimplementedGoCryptoTraderServiceServer()
 }
 
@@ -1943,6 +2126,42 @@ func (UnimplementedGoCryptoTraderServiceServer) GetOpenInterest(context.Context,
 func (UnimplementedGoCryptoTraderServiceServer) GetCurrencyTradeURL(context.Context, *GetCurrencyTradeURLRequest) (*GetCurrencyTradeURLResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetCurrencyTradeURL not implemented")
 }
+func (UnimplementedGoCryptoTraderServiceServer) RunDataQualityCheck(context.Context, *DataQualityCheckRequest) (*DataQualityReport, error) {
+	return nil, status.Error(codes.Unimplemented, "method RunDataQualityCheck not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetDataQualityReports(context.Context, *GetDataQualityReportsRequest) (*GetDataQualityReportsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDataQualityReports not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetCompositePrices(context.Context, *GetCompositePricesRequest) (*GetCompositePricesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCompositePrices not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetDatabaseChangeFeedStream(*GetDatabaseChangeFeedStreamRequest, grpc.ServerStreamingServer[DatabaseChangeFeedResponse]) error {
+	return status.Error(codes.Unimplemented, "method GetDatabaseChangeFeedStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetRateLimitStatus(context.Context, *GenericExchangeNameRequest) (*GetRateLimitStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRateLimitStatus not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) ReloadExchangeCredentials(context.Context, *GenericExchangeNameRequest) (*GenericResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReloadExchangeCredentials not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetPendingWithdrawals(context.Context, *GetPendingWithdrawalsRequest) (*GetPendingWithdrawalsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPendingWithdrawals not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) ApproveWithdrawal(context.Context, *ApproveWithdrawalRequest) (*WithdrawResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ApproveWithdrawal not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) RejectWithdrawal(context.Context, *RejectWithdrawalRequest) (*GenericResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RejectWithdrawal not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) ReloadConfig(context.Context, *ReloadConfigRequest) (*ReloadConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReloadConfig not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetOrderUpdatesStream(*GetOrderUpdatesStreamRequest, grpc.ServerStreamingServer[OrderDetails]) error {
+	return status.Error(codes.Unimplemented, "method GetOrderUpdatesStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetFillsStream(*GetFillsStreamRequest, grpc.ServerStreamingServer[FillResponse]) error {
+	return status.Error(codes.Unimplemented, "method GetFillsStream not implemented")
+}
 func (UnimplementedGoCryptoTraderServiceServer) mustEmbedUnimplementedGoCryptoTraderServiceServer() {}
 func (UnimplementedGoCryptoTraderServiceServer) testEmbeddedByValue()                               {}
 
@@ -3992,6 +4211,201 @@ func _GoCryptoTraderService_GetCurrencyTradeURL_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoCryptoTraderService_RunDataQualityCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DataQualityCheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).RunDataQualityCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_RunDataQualityCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).RunDataQualityCheck(ctx, req.(*DataQualityCheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetDataQualityReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataQualityReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetDataQualityReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetDataQualityReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetDataQualityReports(ctx, req.(*GetDataQualityReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetCompositePrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompositePricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetCompositePrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetCompositePrices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetCompositePrices(ctx, req.(*GetCompositePricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetDatabaseChangeFeedStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetDatabaseChangeFeedStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServiceServer).GetDatabaseChangeFeedStream(m, &grpc.GenericServerStream[GetDatabaseChangeFeedStreamRequest, DatabaseChangeFeedResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoCryptoTraderService_GetDatabaseChangeFeedStreamServer = grpc.ServerStreamingServer[DatabaseChangeFeedResponse]
+
+func _GoCryptoTraderService_GetRateLimitStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExchangeNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetRateLimitStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetRateLimitStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetRateLimitStatus(ctx, req.(*GenericExchangeNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_ReloadExchangeCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExchangeNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).ReloadExchangeCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_ReloadExchangeCredentials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).ReloadExchangeCredentials(ctx, req.(*GenericExchangeNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetPendingWithdrawals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPendingWithdrawalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetPendingWithdrawals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetPendingWithdrawals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetPendingWithdrawals(ctx, req.(*GetPendingWithdrawalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_ApproveWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).ApproveWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_ApproveWithdrawal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).ApproveWithdrawal(ctx, req.(*ApproveWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_RejectWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).RejectWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_RejectWithdrawal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).RejectWithdrawal(ctx, req.(*RejectWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_ReloadConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).ReloadConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_ReloadConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).ReloadConfig(ctx, req.(*ReloadConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetOrderUpdatesStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetOrderUpdatesStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServiceServer).GetOrderUpdatesStream(m, &grpc.GenericServerStream[GetOrderUpdatesStreamRequest, OrderDetails]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoCryptoTraderService_GetOrderUpdatesStreamServer = grpc.ServerStreamingServer[OrderDetails]
+
+func _GoCryptoTraderService_GetFillsStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetFillsStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServiceServer).GetFillsStream(m, &grpc.GenericServerStream[GetFillsStreamRequest, FillResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GoCryptoTraderService_GetFillsStreamServer = grpc.ServerStreamingServer[FillResponse]
+
 // GoCryptoTraderService_ServiceDesc is the grpc.ServiceDesc for GoCryptoTraderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -4435,6 +4849,42 @@ var GoCryptoTraderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCurrencyTradeURL",
 			Handler:    _GoCryptoTraderService_GetCurrencyTradeURL_Handler,
 		},
+		{
+			MethodName: "RunDataQualityCheck",
+			Handler:    _GoCryptoTraderService_RunDataQualityCheck_Handler,
+		},
+		{
+			MethodName: "GetDataQualityReports",
+			Handler:    _GoCryptoTraderService_GetDataQualityReports_Handler,
+		},
+		{
+			MethodName: "GetCompositePrices",
+			Handler:    _GoCryptoTraderService_GetCompositePrices_Handler,
+		},
+		{
+			MethodName: "GetRateLimitStatus",
+			Handler:    _GoCryptoTraderService_GetRateLimitStatus_Handler,
+		},
+		{
+			MethodName: "ReloadExchangeCredentials",
+			Handler:    _GoCryptoTraderService_ReloadExchangeCredentials_Handler,
+		},
+		{
+			MethodName: "GetPendingWithdrawals",
+			Handler:    _GoCryptoTraderService_GetPendingWithdrawals_Handler,
+		},
+		{
+			MethodName: "ApproveWithdrawal",
+			Handler:    _GoCryptoTraderService_ApproveWithdrawal_Handler,
+		},
+		{
+			MethodName: "RejectWithdrawal",
+			Handler:    _GoCryptoTraderService_RejectWithdrawal_Handler,
+		},
+		{
+			MethodName: "ReloadConfig",
+			Handler:    _GoCryptoTraderService_ReloadConfig_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -4467,6 +4917,21 @@ var GoCryptoTraderService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _GoCryptoTraderService_GetHistoricTrades_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetDatabaseChangeFeedStream",
+			Handler:       _GoCryptoTraderService_GetDatabaseChangeFeedStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetOrderUpdatesStream",
+			Handler:       _GoCryptoTraderService_GetOrderUpdatesStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetFillsStream",
+			Handler:       _GoCryptoTraderService_GetFillsStream_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpc.proto",
 }
@@ -19,6 +19,8 @@ type Config struct {
 	AllowImports       bool          `json:"allow_imports"`
 	AutoLoad           []string      `json:"auto_load"`
 	Verbose            bool          `json:"verbose"`
+	OHLCVCache         bool          `json:"ohlcv_cache"`
+	OHLCVCachePersist  bool          `json:"ohlcv_cache_persist"`
 }
 
 // Error interface to meet error requirements
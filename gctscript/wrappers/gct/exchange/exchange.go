@@ -224,7 +224,12 @@ func (e Exchange) OHLCV(ctx context.Context, exch string, pair currency.Pair, it
 	if err != nil {
 		return nil, err
 	}
-	ret, err := ex.GetHistoricCandlesExtended(ctx, pair, item, interval, start, end)
+	var ret *kline.Item
+	if cfg := engine.Bot.Config; cfg != nil && cfg.GCTScript.OHLCVCache {
+		ret, err = kline.GetHistoricCandlesWithCache(ctx, ex, pair, item, interval, start, end, cfg.GCTScript.OHLCVCachePersist)
+	} else {
+		ret, err = ex.GetHistoricCandlesExtended(ctx, pair, item, interval, start, end)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -48,7 +48,7 @@ func TestMain(m *testing.M) {
 		log.Fatalf("Error during ExchangeManager.Add: %s", err)
 	}
 	engine.Bot.ExchangeManager = em
-	engine.Bot.WithdrawManager, err = engine.SetupWithdrawManager(em, nil, true)
+	engine.Bot.WithdrawManager, err = engine.SetupWithdrawManager(em, nil, nil, true, nil)
 	if err != nil {
 		log.Fatalf("Error during engine.SetupWithdrawManage: %s", err)
 	}
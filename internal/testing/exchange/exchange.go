@@ -134,6 +134,39 @@ func MockWsInstance[T any, PT interface {
 	return e
 }
 
+// MockWsReplayInstance creates a new Exchange instance with a mock websocket instance replaying a websocket capture
+// Fields named in ignoreFields, such as request IDs, are ignored when matching outbound messages to the capture
+// See mock.NewWebsocketReplay for details of how the capture is replayed
+func MockWsReplayInstance[T any, PT interface {
+	*T
+	exchange.IBotExchange
+}](tb testing.TB, capturePath string, ignoreFields ...string) *T {
+	tb.Helper()
+
+	r, err := mock.NewWebsocketReplay(capturePath, ignoreFields...)
+	require.NoErrorf(tb, err, "NewWebsocketReplay must not error for %q", capturePath)
+	return MockWsInstance[T, PT](tb, r.ServeHTTP)
+}
+
+// MockWsFixtureInstance creates a new Exchange instance with a mock websocket instance responding as declared by a
+// mock.WebsocketFixture JSON file. The returned mock can push further messages, such as order events
+// Tests fail if any message sent by the exchange matches no fixture rule
+func MockWsFixtureInstance[T any, PT interface {
+	*T
+	exchange.IBotExchange
+}](tb testing.TB, fixturePath string) (*T, *mock.WebsocketMock) {
+	tb.Helper()
+
+	m, err := mock.NewWebsocketMock(fixturePath)
+	require.NoErrorf(tb, err, "NewWebsocketMock must not error for %q", fixturePath)
+	tb.Cleanup(func() {
+		for _, msg := range m.Unmatched() {
+			assert.Failf(tb, "Websocket message should match a fixture rule", "%s", msg)
+		}
+	})
+	return MockWsInstance[T, PT](tb, m.ServeHTTP), m
+}
+
 // FixtureError contains an error and the message that caused it
 type FixtureError struct {
 	Err error
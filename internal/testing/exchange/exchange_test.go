@@ -106,6 +106,19 @@ func TestMockWsInstanceVerbose(t *testing.T) {
 	assert.True(t, b.IsVerbose(), "MockWsInstance should honour the verbose override")
 }
 
+func TestMockWsReplayInstance(t *testing.T) {
+	b := MockWsReplayInstance[binance.Exchange](t, "testdata/ws_capture.jsonl")
+	require.NotNil(t, b, "MockWsReplayInstance must not be nil")
+	assert.True(t, b.GetBase().Websocket.IsConnected(), "Websocket manager should be connected to the replay server")
+}
+
+func TestMockWsFixtureInstance(t *testing.T) {
+	b, m := MockWsFixtureInstance[binance.Exchange](t, "testdata/ws_fixture.json")
+	require.NotNil(t, b, "MockWsFixtureInstance must not be nil")
+	require.NotNil(t, m, "MockWsFixtureInstance must return the websocket mock")
+	assert.True(t, b.GetBase().Websocket.IsConnected(), "Websocket manager should be connected to the websocket mock")
+}
+
 func TestMockWsInstanceSupportsMultiConnectionManagement(t *testing.T) {
 	b := MockWsInstance[bybit.Exchange](t, mockws.CurryWsMockUpgrader(t, func(_ testing.TB, _ []byte, _ *gws.Conn) error { return nil }))
 	require.NotNil(t, b, "MockWsInstance must not be nil for multi-connection websocket exchanges")
@@ -0,0 +1,84 @@
+package log
+
+import "fmt"
+
+// Structured logging fields attached by core engine paths so that log events
+// can be filtered and aggregated by log shipping backends
+const (
+	ExchangeKey   Key = "exchange"
+	AssetKey      Key = "asset"
+	PairKey       Key = "pair"
+	OrderIDKey    Key = "order_id"
+	SubsystemKey  Key = "subsystem"
+	ErrorKey      Key = "error"
+	ErrorClassKey Key = "error_class"
+)
+
+// With sets a field and returns the fields, allocating them when nil. Empty
+// string values are not set. Fields must not be modified once they have been
+// passed to a logging function
+func (e ExtraFields) With(k Key, v any) ExtraFields {
+	if s, ok := v.(string); ok && s == "" {
+		return e
+	}
+	if e == nil {
+		e = make(ExtraFields, 4)
+	}
+	e[k] = v
+	return e
+}
+
+// WithSubsystem sets the subsystem field
+func (e ExtraFields) WithSubsystem(name string) ExtraFields {
+	return e.With(SubsystemKey, name)
+}
+
+// WithExchange sets the exchange, asset and pair fields. Asset and pair are
+// optional and are not set when nil or when their string value is empty
+func (e ExtraFields) WithExchange(exch string, a, p fmt.Stringer) ExtraFields {
+	e = e.With(ExchangeKey, exch)
+	if a != nil {
+		e = e.With(AssetKey, a.String())
+	}
+	if p != nil {
+		e = e.With(PairKey, p.String())
+	}
+	return e
+}
+
+// WithOrderID sets the order ID field
+func (e ExtraFields) WithOrderID(id string) ExtraFields {
+	return e.With(OrderIDKey, id)
+}
+
+// WithError sets the error and error class fields
+func (e ExtraFields) WithError(err error) ExtraFields {
+	if err == nil {
+		return e
+	}
+	return e.With(ErrorKey, err.Error()).With(ErrorClassKey, ErrorClass(err))
+}
+
+// ErrorClass returns the message of the innermost wrapped error. Errors which
+// wrap a sentinel error e.g. fmt.Errorf("%w: %s", errX, details) return the
+// sentinel's message, allowing occurrences to be grouped regardless of details
+func ErrorClass(err error) string {
+	if err == nil {
+		return ""
+	}
+	for {
+		var next error
+		switch u := err.(type) { //nolint:errorlint // Walking the chain manually to find the innermost error
+		case interface{ Unwrap() error }:
+			next = u.Unwrap()
+		case interface{ Unwrap() []error }:
+			if errs := u.Unwrap(); len(errs) != 0 {
+				next = errs[0]
+			}
+		}
+		if next == nil {
+			return err.Error()
+		}
+		err = next
+	}
+}
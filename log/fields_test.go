@@ -0,0 +1,38 @@
+package log
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testStringer string
+
+func (s testStringer) String() string { return string(s) }
+
+func TestExtraFields(t *testing.T) {
+	t.Parallel()
+	var e ExtraFields
+	assert.Nil(t, e.With(OrderIDKey, ""), "With should not allocate for empty strings")
+	assert.Nil(t, e.WithError(nil), "WithError should not allocate for nil errors")
+
+	e = e.WithSubsystem("orders").WithExchange("Binance", testStringer("spot"), nil).WithOrderID("1337")
+	assert.Equal(t, ExtraFields{SubsystemKey: "orders", ExchangeKey: "Binance", AssetKey: "spot", OrderIDKey: "1337"}, e)
+
+	errTest := errors.New("insufficient balance")
+	e = ExtraFields{"persistent": true}.WithExchange("", testStringer(""), testStringer("BTC-USDT")).WithError(fmt.Errorf("%w: 1 BTC", errTest))
+	assert.Equal(t, ExtraFields{"persistent": true, PairKey: "BTC-USDT", ErrorKey: "insufficient balance: 1 BTC", ErrorClassKey: "insufficient balance"}, e)
+}
+
+func TestErrorClass(t *testing.T) {
+	t.Parallel()
+	errTest := errors.New("rate limited")
+	assert.Empty(t, ErrorClass(nil))
+	assert.Equal(t, "rate limited", ErrorClass(errTest))
+	assert.Equal(t, "rate limited", ErrorClass(fmt.Errorf("binance: %w", fmt.Errorf("%w: retry in 1s", errTest))), "ErrorClass should return the innermost error")
+	assert.Equal(t, "rate limited", ErrorClass(errors.Join(errTest, context.Canceled)), "ErrorClass should follow the first joined error")
+	assert.Equal(t, "no wrapping", ErrorClass(fmt.Errorf("no %s", "wrapping")))
+}
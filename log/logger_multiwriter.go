@@ -82,7 +82,11 @@ func loggerWorker() {
 		for x := range j.Writers {
 			// NOTE: byte slice is not copied, this is a pointer to the buffer.
 			// This is only safe if the buffer is not modified after this point.
-			n, err = j.Writers[x].Write(buffer)
+			if sw, ok := j.Writers[x].(severityWriter); ok {
+				n, err = sw.writeSeverity(j.Severity, buffer)
+			} else {
+				n, err = j.Writers[x].Write(buffer)
+			}
 			if err != nil {
 				displayError(fmt.Errorf("%T %w", j.Writers[x], err))
 			} else if n != len(buffer) {
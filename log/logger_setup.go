@@ -39,6 +39,21 @@ func getWriters(s *SubLoggerConfig) (*multiWriterHolder, error) {
 				return nil, errFileLoggingNotConfiguredCorrectly
 			}
 			writer = globalLogFile
+		case "syslog":
+			if globalSinks.syslog == nil {
+				return nil, fmt.Errorf("%w: %s", errSinkNotConfigured, outputWriters[x])
+			}
+			writer = globalSinks.syslog
+		case "gelf":
+			if globalSinks.gelf == nil {
+				return nil, fmt.Errorf("%w: %s", errSinkNotConfigured, outputWriters[x])
+			}
+			writer = globalSinks.gelf
+		case "http":
+			if globalSinks.http == nil {
+				return nil, fmt.Errorf("%w: %s", errSinkNotConfigured, outputWriters[x])
+			}
+			writer = globalSinks.http
 		default:
 			// Note: Do not want to add an io.Discard here as this adds
 			// additional write calls for no reason.
@@ -84,6 +99,11 @@ func SetGlobalLogConfig(incoming *Config) error {
 	if incoming.LoggerFileConfig != nil {
 		fileConf = *incoming.LoggerFileConfig
 	}
+	var sinkConf *SinkConfig
+	if incoming.SinkSettings != nil {
+		sinkConf = &SinkConfig{}
+		*sinkConf = *incoming.SinkSettings
+	}
 	subs := make([]SubLoggerConfig, len(incoming.SubLoggers))
 	copy(subs, incoming.SubLoggers)
 	mu.Lock()
@@ -91,6 +111,7 @@ func SetGlobalLogConfig(incoming *Config) error {
 	globalLogConfig.SubLoggerConfig = incoming.SubLoggerConfig
 	globalLogConfig.Enabled = convert.BoolPtr(incoming.Enabled != nil && *incoming.Enabled)
 	globalLogConfig.LoggerFileConfig = &fileConf
+	globalLogConfig.SinkSettings = sinkConf
 	globalLogConfig.AdvancedSettings = incoming.AdvancedSettings
 	return nil
 }
@@ -161,6 +182,15 @@ func SetupGlobalLogger(botName string, structuredOutput bool) error {
 		}
 	}
 
+	sinks, err := newLogSinks(globalLogConfig.SinkSettings)
+	if err != nil {
+		return err
+	}
+	if err := globalSinks.close(); err != nil {
+		displayError(err)
+	}
+	globalSinks = sinks
+
 	writers, err := getWriters(&globalLogConfig.SubLoggerConfig)
 	if err != nil {
 		return err
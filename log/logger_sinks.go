@@ -0,0 +1,454 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+const (
+	defaultSyslogFacility    = 1 // user-level messages
+	defaultSyslogTag         = "gocryptotrader"
+	defaultHTTPBatchSize     = 100
+	defaultHTTPFlushInterval = time.Second * 5
+	defaultHTTPTimeout       = time.Second * 10
+	sinkDialTimeout          = time.Second * 5
+	sinkRedialInterval       = time.Second * 10
+	// gelfChunkSize is the maximum UDP datagram size recommended by the GELF
+	// specification less the 12 byte chunk header
+	gelfChunkSize      = 8192 - 12
+	gelfMaxChunks      = 128
+	syslogTimestampFmt = "2006-01-02T15:04:05.000000Z07:00"
+)
+
+var (
+	errSinkNotConfigured      = errors.New("log sink not configured")
+	errSinkAddressUnset       = errors.New("log sink address unset")
+	errSinkUnsupportedNetwork = errors.New("log sink network must be udp or tcp")
+	errSinkURLUnset           = errors.New("log sink URL unset")
+	errSinkUnavailable        = errors.New("log sink unavailable")
+	errSinkClosed             = errors.New("log sink closed")
+	errSinkQueueFull          = errors.New("log sink queue is full")
+	errGELFMessageTooLarge    = errors.New("GELF message exceeds maximum chunk count")
+	errHTTPSinkBadStatus      = errors.New("log sink HTTP request unsuccessful")
+)
+
+// severityWriter is implemented by sinks which record the severity of each log
+// event in their own message format
+type severityWriter interface {
+	writeSeverity(severity string, p []byte) (int, error)
+}
+
+// logSinks holds the log shipping sinks created from the global log config
+type logSinks struct {
+	syslog *syslogSink
+	gelf   *gelfSink
+	http   *httpSink
+}
+
+// newLogSinks creates the sinks which are configured
+func newLogSinks(c *SinkConfig) (*logSinks, error) {
+	s := &logSinks{}
+	if c == nil {
+		return s, nil
+	}
+	var err error
+	if c.Syslog != nil {
+		if s.syslog, err = newSyslogSink(c.Syslog); err != nil {
+			return nil, err
+		}
+	}
+	if c.GELF != nil {
+		if s.gelf, err = newGELFSink(c.GELF); err != nil {
+			return nil, err
+		}
+	}
+	if c.HTTP != nil {
+		if s.http, err = newHTTPSink(c.HTTP); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// close closes all sinks
+func (s *logSinks) close() error {
+	var errs error
+	if s.syslog != nil {
+		errs = errors.Join(errs, s.syslog.Close())
+	}
+	if s.gelf != nil {
+		errs = errors.Join(errs, s.gelf.Close())
+	}
+	if s.http != nil {
+		errs = errors.Join(errs, s.http.Close())
+	}
+	return errs
+}
+
+// sinkConn is a lazily dialled network connection which is redialled after
+// write errors. Dialling is rate limited so an unavailable sink does not stall
+// the log worker
+type sinkConn struct {
+	network string
+	address string
+	m       sync.Mutex
+	conn    net.Conn
+	retryAt time.Time
+	closed  bool
+}
+
+func newSinkConn(network, address string) (*sinkConn, error) {
+	if address == "" {
+		return nil, errSinkAddressUnset
+	}
+	switch network = strings.ToLower(network); network {
+	case "":
+		network = "udp"
+	case "udp", "tcp":
+	default:
+		return nil, fmt.Errorf("%w: %q", errSinkUnsupportedNetwork, network)
+	}
+	return &sinkConn{network: network, address: address}, nil
+}
+
+// write writes each packet to the connection, dialling if required
+func (c *sinkConn) write(packets ...[]byte) error {
+	c.m.Lock()
+	defer c.m.Unlock()
+	if c.closed {
+		return errSinkClosed
+	}
+	if c.conn == nil {
+		if time.Now().Before(c.retryAt) {
+			return fmt.Errorf("%w: %s", errSinkUnavailable, c.address)
+		}
+		conn, err := net.DialTimeout(c.network, c.address, sinkDialTimeout)
+		if err != nil {
+			c.retryAt = time.Now().Add(sinkRedialInterval)
+			return err
+		}
+		c.conn = conn
+	}
+	for _, p := range packets {
+		if _, err := c.conn.Write(p); err != nil {
+			_ = c.conn.Close()
+			c.conn = nil
+			return err
+		}
+	}
+	return nil
+}
+
+// Close closes the connection
+func (c *sinkConn) Close() error {
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.closed = true
+	if c.conn == nil {
+		return nil
+	}
+	err := c.conn.Close()
+	c.conn = nil
+	return err
+}
+
+// syslogSink writes log events as RFC 5424 syslog messages
+type syslogSink struct {
+	*sinkConn
+	facility int
+	tag      string
+	hostname string
+}
+
+func newSyslogSink(c *SyslogSinkConfig) (*syslogSink, error) {
+	conn, err := newSinkConn(c.Network, c.Address)
+	if err != nil {
+		return nil, fmt.Errorf("syslog %w", err)
+	}
+	s := &syslogSink{sinkConn: conn, facility: c.Facility, tag: c.Tag, hostname: hostname()}
+	if s.facility <= 0 {
+		s.facility = defaultSyslogFacility
+	}
+	if s.tag == "" {
+		s.tag = defaultSyslogTag
+	}
+	return s, nil
+}
+
+// Write writes a log line as an informational message
+func (s *syslogSink) Write(p []byte) (int, error) {
+	return s.writeSeverity("info", p)
+}
+
+func (s *syslogSink) writeSeverity(severity string, p []byte) (int, error) {
+	msg := fmt.Appendf(nil, "<%d>1 %s %s %s %d - - ", s.facility*8+syslogSeverity(severity), time.Now().Format(syslogTimestampFmt), s.hostname, s.tag, os.Getpid())
+	msg = append(msg, p...)
+	if len(msg) == 0 || msg[len(msg)-1] != '\n' {
+		// Newline framing is required for TCP syslog
+		msg = append(msg, '\n')
+	}
+	if err := s.write(msg); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// gelfSink writes log events as Graylog Extended Log Format messages
+type gelfSink struct {
+	*sinkConn
+	host string
+}
+
+func newGELFSink(c *GELFSinkConfig) (*gelfSink, error) {
+	conn, err := newSinkConn(c.Network, c.Address)
+	if err != nil {
+		return nil, fmt.Errorf("GELF %w", err)
+	}
+	g := &gelfSink{sinkConn: conn, host: c.Host}
+	if g.host == "" {
+		g.host = hostname()
+	}
+	return g, nil
+}
+
+// Write writes a log line as an informational message
+func (g *gelfSink) Write(p []byte) (int, error) {
+	return g.writeSeverity("info", p)
+}
+
+func (g *gelfSink) writeSeverity(severity string, p []byte) (int, error) {
+	payload, err := json.Marshal(g.message(severity, p))
+	if err != nil {
+		return 0, err
+	}
+	if g.network == "tcp" {
+		// GELF TCP messages are null byte delimited
+		err = g.write(append(payload, 0))
+	} else {
+		var chunks [][]byte
+		if chunks, err = gelfChunks(payload); err == nil {
+			err = g.write(chunks...)
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// message converts a log line to a GELF message. Structured log lines have
+// their message and timestamp mapped to GELF fields and all other fields sent
+// as additional fields
+func (g *gelfSink) message(severity string, p []byte) map[string]any {
+	msg := map[string]any{
+		"version": "1.1",
+		"host":    g.host,
+		"level":   syslogSeverity(severity),
+	}
+	var structured map[string]any
+	if bytes.HasPrefix(p, []byte("{")) && json.Unmarshal(p, &structured) == nil {
+		for k, v := range structured {
+			switch Key(k) {
+			case message:
+				msg["short_message"] = v
+			case timestamp:
+				if ms, ok := v.(float64); ok {
+					msg["timestamp"] = ms / 1000
+				}
+			case "id":
+				// _id is reserved by GELF
+				msg["_log_id"] = v
+			default:
+				msg["_"+k] = v
+			}
+		}
+	} else {
+		msg["short_message"] = string(bytes.TrimSpace(p))
+	}
+	if s, ok := msg["short_message"].(string); !ok || s == "" {
+		// short_message is required to be non-empty
+		msg["short_message"] = "-"
+	}
+	return msg
+}
+
+// gelfChunks splits a GELF payload into UDP datagrams
+func gelfChunks(payload []byte) ([][]byte, error) {
+	if len(payload) <= gelfChunkSize {
+		return [][]byte{payload}, nil
+	}
+	count := (len(payload) + gelfChunkSize - 1) / gelfChunkSize
+	if count > gelfMaxChunks {
+		return nil, fmt.Errorf("%w: %d bytes", errGELFMessageTooLarge, len(payload))
+	}
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+	chunks := make([][]byte, 0, count)
+	for i := range count {
+		data := payload[i*gelfChunkSize : min((i+1)*gelfChunkSize, len(payload))]
+		chunk := make([]byte, 0, 12+len(data))
+		chunk = append(chunk, 0x1e, 0x0f)
+		chunk = append(chunk, id...)
+		chunk = append(chunk, byte(i), byte(count))
+		chunks = append(chunks, append(chunk, data...))
+	}
+	return chunks, nil
+}
+
+// httpSink POSTs log lines to an HTTP endpoint in batches from a background
+// routine so that slow endpoints do not stall the log worker
+type httpSink struct {
+	url           string
+	headers       map[string]string
+	batchSize     int
+	flushInterval time.Duration
+	client        *http.Client
+	queue         chan []byte
+	shutdown      chan struct{}
+	closeOnce     sync.Once
+	wg            sync.WaitGroup
+}
+
+func newHTTPSink(c *HTTPSinkConfig) (*httpSink, error) {
+	if c.URL == "" {
+		return nil, errSinkURLUnset
+	}
+	s := &httpSink{
+		url:           c.URL,
+		headers:       c.Headers,
+		batchSize:     c.BatchSize,
+		flushInterval: c.FlushInterval,
+		client:        &http.Client{Timeout: c.Timeout},
+		queue:         make(chan []byte, defaultJobChannelCapacity),
+		shutdown:      make(chan struct{}),
+	}
+	if s.batchSize <= 0 {
+		s.batchSize = defaultHTTPBatchSize
+	}
+	if s.flushInterval <= 0 {
+		s.flushInterval = defaultHTTPFlushInterval
+	}
+	if s.client.Timeout <= 0 {
+		s.client.Timeout = defaultHTTPTimeout
+	}
+	s.wg.Add(1)
+	go s.run()
+	return s, nil
+}
+
+// Write queues a copy of a log line to be sent
+func (s *httpSink) Write(p []byte) (int, error) {
+	select {
+	case <-s.shutdown:
+		return 0, errSinkClosed
+	default:
+	}
+	select {
+	case s.queue <- bytes.Clone(p):
+		return len(p), nil
+	default:
+		return 0, errSinkQueueFull
+	}
+}
+
+// Close sends any queued log lines and stops the sink
+func (s *httpSink) Close() error {
+	s.closeOnce.Do(func() { close(s.shutdown) })
+	s.wg.Wait()
+	return nil
+}
+
+func (s *httpSink) run() {
+	defer s.wg.Done()
+	t := time.NewTicker(s.flushInterval)
+	defer t.Stop()
+	batch := make([][]byte, 0, s.batchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		displayError(s.send(batch))
+		batch = batch[:0]
+	}
+	for {
+		select {
+		case line := <-s.queue:
+			if batch = append(batch, line); len(batch) >= s.batchSize {
+				flush()
+			}
+		case <-t.C:
+			flush()
+		case <-s.shutdown:
+			for {
+				select {
+				case line := <-s.queue:
+					if batch = append(batch, line); len(batch) >= s.batchSize {
+						flush()
+					}
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+// send POSTs a batch of log lines
+func (s *httpSink) send(batch [][]byte) error {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, s.url, bytes.NewReader(bytes.Join(batch, nil)))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	for k, v := range s.headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	if err := resp.Body.Close(); err != nil {
+		return err
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%w: %s", errHTTPSinkBadStatus, resp.Status)
+	}
+	return nil
+}
+
+// syslogSeverity maps a log severity to a syslog severity code, which is also
+// used for GELF levels
+func syslogSeverity(severity string) int {
+	switch severity {
+	case "error":
+		return 3
+	case "warn":
+		return 4
+	case "debug":
+		return 7
+	default:
+		return 6
+	}
+}
+
+func hostname() string {
+	h, err := os.Hostname()
+	if err != nil || h == "" {
+		return "-"
+	}
+	return h
+}
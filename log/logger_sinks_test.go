@@ -0,0 +1,218 @@
+package log
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+func TestNewLogSinks(t *testing.T) {
+	t.Parallel()
+	s, err := newLogSinks(nil)
+	require.NoError(t, err, "newLogSinks must not error")
+	assert.Equal(t, &logSinks{}, s, "newLogSinks should return no sinks for a nil config")
+	require.NoError(t, s.close(), "close must not error without sinks")
+
+	_, err = newLogSinks(&SinkConfig{Syslog: &SyslogSinkConfig{}})
+	assert.ErrorIs(t, err, errSinkAddressUnset)
+	_, err = newLogSinks(&SinkConfig{GELF: &GELFSinkConfig{Network: "unix", Address: "/tmp/gelf"}})
+	assert.ErrorIs(t, err, errSinkUnsupportedNetwork)
+	_, err = newLogSinks(&SinkConfig{HTTP: &HTTPSinkConfig{}})
+	assert.ErrorIs(t, err, errSinkURLUnset)
+
+	s, err = newLogSinks(&SinkConfig{
+		Syslog: &SyslogSinkConfig{Address: "127.0.0.1:514"},
+		GELF:   &GELFSinkConfig{Network: "TCP", Address: "127.0.0.1:12201", Host: "bot"},
+		HTTP:   &HTTPSinkConfig{URL: "http://127.0.0.1"},
+	})
+	require.NoError(t, err, "newLogSinks must not error")
+	assert.Equal(t, "udp", s.syslog.network, "syslog network should default to udp")
+	assert.Equal(t, defaultSyslogFacility, s.syslog.facility, "syslog facility should be defaulted")
+	assert.Equal(t, defaultSyslogTag, s.syslog.tag, "syslog tag should be defaulted")
+	assert.Equal(t, "tcp", s.gelf.network, "GELF network should be lowercased")
+	assert.Equal(t, "bot", s.gelf.host, "GELF host should be set")
+	assert.Equal(t, defaultHTTPBatchSize, s.http.batchSize, "HTTP batch size should be defaulted")
+	assert.Equal(t, defaultHTTPFlushInterval, s.http.flushInterval, "HTTP flush interval should be defaulted")
+	assert.Equal(t, defaultHTTPTimeout, s.http.client.Timeout, "HTTP timeout should be defaulted")
+	require.NoError(t, s.close(), "close must not error")
+	_, err = s.syslog.Write([]byte("closed"))
+	assert.ErrorIs(t, err, errSinkClosed)
+	_, err = s.http.Write([]byte("closed"))
+	assert.ErrorIs(t, err, errSinkClosed)
+}
+
+func TestGetWritersSinks(t *testing.T) {
+	t.Parallel()
+	mu.Lock()
+	defer mu.Unlock()
+	for _, output := range []string{"syslog", "gelf", "http"} {
+		_, err := getWriters(&SubLoggerConfig{Output: "console|" + output})
+		assert.ErrorIsf(t, err, errSinkNotConfigured, "getWriters should error for unconfigured %s sink", output)
+	}
+}
+
+func TestSyslogSink(t *testing.T) {
+	t.Parallel()
+	l, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err, "ListenPacket must not error")
+	defer l.Close()
+
+	s, err := newSyslogSink(&SyslogSinkConfig{Address: l.LocalAddr().String(), Facility: 16, Tag: "gct"})
+	require.NoError(t, err, "newSyslogSink must not error")
+	defer s.Close()
+
+	line := []byte("order submitted\n")
+	n, err := s.writeSeverity("error", line)
+	require.NoError(t, err, "writeSeverity must not error")
+	assert.Equal(t, len(line), n, "writeSeverity should return the length of the log line")
+
+	buf := make([]byte, 1024)
+	require.NoError(t, l.SetReadDeadline(time.Now().Add(time.Second*5)), "SetReadDeadline must not error")
+	n, _, err = l.ReadFrom(buf)
+	require.NoError(t, err, "ReadFrom must not error")
+	msg := string(buf[:n])
+	assert.True(t, strings.HasPrefix(msg, "<131>1 "), "message should have the local0 error priority")
+	assert.Contains(t, msg, " gct ", "message should contain the tag")
+	assert.True(t, strings.HasSuffix(msg, " - - order submitted\n"), "message should end with the log line")
+}
+
+func TestSinkConnRedial(t *testing.T) {
+	t.Parallel()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err, "Listen must not error")
+	addr := l.Addr().String()
+	require.NoError(t, l.Close(), "Close must not error")
+
+	c, err := newSinkConn("tcp", addr)
+	require.NoError(t, err, "newSinkConn must not error")
+	assert.Error(t, c.write([]byte("a")), "write should error when the sink cannot be dialled")
+	assert.ErrorIs(t, c.write([]byte("b")), errSinkUnavailable, "write should not redial before the redial interval")
+}
+
+func TestGELFSink(t *testing.T) {
+	t.Parallel()
+	l, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err, "ListenPacket must not error")
+	defer l.Close()
+
+	g, err := newGELFSink(&GELFSinkConfig{Address: l.LocalAddr().String(), Host: "bot"})
+	require.NoError(t, err, "newGELFSink must not error")
+	defer g.Close()
+
+	line, err := json.Marshal(map[Key]any{message: "order submitted", timestamp: 1700000000123, severity: "warn", ExchangeKey: "Binance", "id": 1})
+	require.NoError(t, err, "Marshal must not error")
+	_, err = g.writeSeverity("warn", append(line, '\n'))
+	require.NoError(t, err, "writeSeverity must not error")
+
+	buf := make([]byte, 1024)
+	require.NoError(t, l.SetReadDeadline(time.Now().Add(time.Second*5)), "SetReadDeadline must not error")
+	n, _, err := l.ReadFrom(buf)
+	require.NoError(t, err, "ReadFrom must not error")
+	var msg map[string]any
+	require.NoError(t, json.Unmarshal(buf[:n], &msg), "Unmarshal must not error")
+	assert.Equal(t, map[string]any{
+		"version":       "1.1",
+		"host":          "bot",
+		"level":         4.0,
+		"short_message": "order submitted",
+		"timestamp":     1700000000.123,
+		"_severity":     "warn",
+		"_exchange":     "Binance",
+		"_log_id":       1.0,
+	}, msg, "structured fields should be sent as additional fields")
+
+	assert.Equal(t, "plain text", g.message("info", []byte("plain text\n"))["short_message"], "unstructured lines should be sent as the short message")
+	assert.Equal(t, "-", g.message("info", []byte("\n"))["short_message"], "empty lines should send a placeholder short message")
+}
+
+func TestGELFChunks(t *testing.T) {
+	t.Parallel()
+	chunks, err := gelfChunks([]byte("small"))
+	require.NoError(t, err, "gelfChunks must not error")
+	assert.Equal(t, [][]byte{[]byte("small")}, chunks, "small payloads should not be chunked")
+
+	payload := bytes.Repeat([]byte("a"), gelfChunkSize*2+1)
+	chunks, err = gelfChunks(payload)
+	require.NoError(t, err, "gelfChunks must not error")
+	require.Len(t, chunks, 3, "payload must be split into 3 chunks")
+	var joined []byte
+	for i, c := range chunks {
+		assert.Equal(t, []byte{0x1e, 0x0f}, c[:2], "chunk should have the GELF magic bytes")
+		assert.Equal(t, chunks[0][2:10], c[2:10], "chunks should share a message ID")
+		assert.Equal(t, []byte{byte(i), 3}, c[10:12], "chunk should have the sequence number and count")
+		joined = append(joined, c[12:]...)
+	}
+	assert.Equal(t, payload, joined, "chunk data should reassemble the payload")
+
+	_, err = gelfChunks(make([]byte, gelfChunkSize*gelfMaxChunks+1))
+	assert.ErrorIs(t, err, errGELFMessageTooLarge)
+}
+
+func TestHTTPSink(t *testing.T) {
+	t.Parallel()
+	var m sync.Mutex
+	var bodies []string
+	status := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/x-ndjson", r.Header.Get("Content-Type"), "Content-Type should be set")
+		assert.Equal(t, "secret", r.Header.Get("X-Api-Key"), "configured headers should be set")
+		b, err := io.ReadAll(r.Body)
+		assert.NoError(t, err, "ReadAll should not error")
+		m.Lock()
+		bodies = append(bodies, string(b))
+		w.WriteHeader(status)
+		m.Unlock()
+	}))
+	defer srv.Close()
+
+	s, err := newHTTPSink(&HTTPSinkConfig{URL: srv.URL, Headers: map[string]string{"X-Api-Key": "secret"}, BatchSize: 2, FlushInterval: time.Hour})
+	require.NoError(t, err, "newHTTPSink must not error")
+
+	line := []byte("one\n")
+	n, err := s.Write(line)
+	require.NoError(t, err, "Write must not error")
+	assert.Equal(t, len(line), n, "Write should return the length of the log line")
+	line[0] = 'x' // The log worker reuses its buffer after writing
+	_, err = s.Write([]byte("two\n"))
+	require.NoError(t, err, "Write must not error")
+	_, err = s.Write([]byte("three\n"))
+	require.NoError(t, err, "Write must not error")
+	require.NoError(t, s.Close(), "Close must not error")
+
+	m.Lock()
+	assert.Equal(t, []string{"one\ntwo\n", "three\n"}, bodies, "lines should be sent in batches and flushed on close")
+	status = http.StatusInternalServerError
+	m.Unlock()
+	assert.ErrorIs(t, s.send([][]byte{[]byte("four\n")}), errHTTPSinkBadStatus)
+}
+
+type testSeverityWriter struct {
+	*testBuffer
+	severity string
+}
+
+func (w *testSeverityWriter) writeSeverity(severity string, p []byte) (int, error) {
+	w.severity = severity
+	return w.Write(p)
+}
+
+func TestStageLogEventSeverityWriter(t *testing.T) {
+	t.Parallel()
+	w := &testSeverityWriter{testBuffer: newTestBuffer()}
+	mw, err := multiWriter(w)
+	require.NoError(t, err, "multiWriter must not error")
+	mw.StageLogEvent(func() string { return "payload" }, "", "", "", "", "", "error", false, false, false, nil)
+	<-w.Finished
+	assert.Equal(t, "error", w.severity, "severity writers should receive the log severity")
+	assert.Contains(t, w.Read(), "payload", "severity writers should receive the log line")
+}
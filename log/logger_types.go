@@ -3,6 +3,7 @@ package log
 import (
 	"io"
 	"sync"
+	"time"
 )
 
 const (
@@ -25,6 +26,8 @@ var (
 	globalLogConfig = &Config{}
 	// GlobalLogFile hold global configuration options for file logger
 	globalLogFile = &Rotate{}
+	// globalSinks holds the log shipping sinks configured for the global logger
+	globalSinks = &logSinks{}
 
 	jobsPool    = &sync.Pool{New: func() any { return new(job) }}
 	jobsChannel = make(chan *job, defaultJobChannelCapacity)
@@ -60,6 +63,7 @@ type Config struct {
 	Enabled *bool `json:"enabled"`
 	SubLoggerConfig
 	LoggerFileConfig *loggerFileConfig `json:"fileSettings,omitempty"`
+	SinkSettings     *SinkConfig       `json:"sinkSettings,omitempty"`
 	AdvancedSettings advancedSettings  `json:"advancedSettings"`
 	SubLoggers       []SubLoggerConfig `json:"subloggers,omitempty"`
 }
@@ -93,6 +97,48 @@ type loggerFileConfig struct {
 	MaxSize  int64  `json:"maxsize,omitempty"`
 }
 
+// SinkConfig holds log shipping sink settings. A configured sink is written to
+// by adding "syslog", "gelf" or "http" to a logger output e.g. "console|gelf"
+type SinkConfig struct {
+	Syslog *SyslogSinkConfig `json:"syslog,omitempty"`
+	GELF   *GELFSinkConfig   `json:"gelf,omitempty"`
+	HTTP   *HTTPSinkConfig   `json:"http,omitempty"`
+}
+
+// SyslogSinkConfig holds settings for shipping logs to a syslog server using
+// RFC 5424 messages
+type SyslogSinkConfig struct {
+	// Network is either udp or tcp, defaults to udp
+	Network string `json:"network"`
+	Address string `json:"address"`
+	// Facility is the syslog facility code, defaults to user-level messages
+	Facility int `json:"facility"`
+	// Tag is the syslog app name, defaults to gocryptotrader
+	Tag string `json:"tag"`
+}
+
+// GELFSinkConfig holds settings for shipping logs to a Graylog Extended Log
+// Format input. Structured log fields are sent as GELF additional fields
+type GELFSinkConfig struct {
+	// Network is either udp or tcp, defaults to udp
+	Network string `json:"network"`
+	Address string `json:"address"`
+	// Host is the GELF source host, defaults to the system hostname
+	Host string `json:"host"`
+}
+
+// HTTPSinkConfig holds settings for shipping logs in batches to an HTTP
+// endpoint. Each batch is POSTed as newline delimited log lines
+type HTTPSinkConfig struct {
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers,omitempty"`
+	// BatchSize is the maximum number of log lines sent per request
+	BatchSize int `json:"batchSize"`
+	// FlushInterval is the maximum time a log line is held before being sent
+	FlushInterval time.Duration `json:"flushInterval"`
+	Timeout       time.Duration `json:"timeout"`
+}
+
 // Logger each instance of logger settings
 type Logger struct {
 	ShowLogSystemName                                bool
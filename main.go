@@ -39,6 +39,11 @@ func main() {
 	flag.BoolVar(&settings.EnableAllPairs, "enableallpairs", false, "enables all pairs for enabled exchanges")
 	flag.BoolVar(&settings.EnablePortfolioManager, "portfoliomanager", true, "enables the portfolio manager")
 	flag.BoolVar(&settings.EnableDataHistoryManager, "datahistorymanager", false, "enables the data history manager")
+	flag.BoolVar(&settings.EnableDataQualityManager, "dataqualitymanager", false, "enables the data quality manager")
+	flag.BoolVar(&settings.EnableCompositePriceManager, "compositepricemanager", false, "enables the composite price manager")
+	flag.BoolVar(&settings.EnableDatabaseRetention, "databaseretentionmanager", false, "enables the database retention manager")
+	flag.BoolVar(&settings.EnableMetricsManager, "metricsmanager", false, "enables the OpenMetrics exporter")
+	flag.BoolVar(&settings.EnableHealthManager, "healthmanager", false, "enables the health and readiness HTTP endpoints")
 	flag.DurationVar(&settings.PortfolioManagerDelay, "portfoliomanagerdelay", 0, "sets the portfolio managers sleep delay between updates")
 	flag.BoolVar(&settings.EnableGRPC, "grpc", true, "enables the grpc server")
 	flag.BoolVar(&settings.EnableGRPCProxy, "grpcproxy", false, "enables the grpc proxy server")
@@ -147,6 +152,7 @@ func main() {
 	engine.Bot.EnsureRuntimeContext()
 	var shutdownRequested atomic.Bool
 	go waitForInterrupt(settings.Shutdown, engine.Bot, &shutdownRequested)
+	go waitForReload(engine.Bot)
 
 	if err = engine.Bot.Start(); err != nil {
 		if shutdownRequested.Load() {
@@ -164,6 +170,15 @@ func main() {
 	engine.Bot.Stop()
 }
 
+func waitForReload(bot *engine.Engine) {
+	for sig := range signaler.WaitForReload() {
+		gctlog.Infof(gctlog.Global, "Captured %v, config reload requested.\n", sig)
+		if _, err := bot.ReloadConfig(bot.EnsureRuntimeContext()); err != nil {
+			gctlog.Errorf(gctlog.Global, "Config reload failed: %s\n", err)
+		}
+	}
+}
+
 func waitForInterrupt(waiter chan<- struct{}, bot *engine.Engine, requested *atomic.Bool) {
 	interrupt := <-signaler.WaitForInterrupt()
 	gctlog.Infof(gctlog.Global, "Captured %v, shutdown requested.\n", interrupt)
@@ -325,14 +325,15 @@ func (b *Base) UpdatePortfolio(ctx context.Context, addresses []string, coinType
 	return errs
 }
 
-// GetPortfolioByExchange returns currency portfolio amount by exchange
+// GetPortfolioByExchange returns currency portfolio amount by exchange address,
+// which for named exchange accounts includes the account name
 func (b *Base) GetPortfolioByExchange(exchangeName string) map[currency.Code]float64 {
 	b.mtx.RLock()
 	defer b.mtx.RUnlock()
 
 	result := make(map[currency.Code]float64)
 	for x := range b.Addresses {
-		if strings.Contains(b.Addresses[x].Address, exchangeName) {
+		if b.Addresses[x].Address == exchangeName {
 			result[b.Addresses[x].CoinType] = b.Addresses[x].Balance
 		}
 	}
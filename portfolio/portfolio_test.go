@@ -233,6 +233,9 @@ func TestGetPortfolioByExchange(t *testing.T) {
 	assert.NoError(t, b.AddAddress("someaddress", "LTC", currency.NewCode(PersonalAddress), 0.03))
 	assert.Equal(t, 0.07, b.GetPortfolioByExchange("Okx")[currency.LTC], "GetPortfolioByExchange should return the correct balance")
 	assert.Equal(t, 0.05, b.GetPortfolioByExchange("Bitfinex")[currency.LTC], "GetPortfolioByExchange should return the correct balance")
+	b.AddExchangeAddress("Okx/hedge", currency.LTC, 0.02)
+	assert.Equal(t, 0.07, b.GetPortfolioByExchange("Okx")[currency.LTC], "GetPortfolioByExchange should not include other account balances")
+	assert.Equal(t, 0.02, b.GetPortfolioByExchange("Okx/hedge")[currency.LTC], "GetPortfolioByExchange should return the account balance")
 }
 
 func TestGetExchangePortfolio(t *testing.T) {
@@ -1,4 +1,5 @@
 // Package signaler provides cross-platform signal handling for graceful application shutdown
+// and configuration reloads
 package signaler
 
 import (
@@ -13,3 +14,12 @@ func WaitForInterrupt() chan os.Signal {
 	signal.Notify(c, os.Interrupt, syscall.SIGTERM)
 	return c
 }
+
+// WaitForReload returns a channel to receive configuration reload signals.
+// SIGHUP is never delivered on Windows, where the gRPC ReloadConfig call
+// should be used instead
+func WaitForReload() chan os.Signal {
+	c := make(chan os.Signal, 1)
+	signal.Notify(c, syscall.SIGHUP)
+	return c
+}